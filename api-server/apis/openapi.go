@@ -10,6 +10,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"path"
@@ -27,12 +29,88 @@ const (
 	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 )
 
+// Defines values for AccountEntryKind.
+const (
+	AccountEntryKindTransaction AccountEntryKind = "transaction"
+	AccountEntryKindTransferIn  AccountEntryKind = "transfer_in"
+	AccountEntryKindTransferOut AccountEntryKind = "transfer_out"
+)
+
+// Defines values for AccountType.
+const (
+	AccountTypeBank       AccountType = "bank"
+	AccountTypeCash       AccountType = "cash"
+	AccountTypeCreditCard AccountType = "credit_card"
+	AccountTypeEMoney     AccountType = "e_money"
+)
+
+// Defines values for AmountSign.
+const (
+	NegativeIsExpense AmountSign = "negative_is_expense"
+	PositiveIsExpense AmountSign = "positive_is_expense"
+)
+
+// Defines values for BatchTransactionAction.
+const (
+	BatchTransactionActionCreate BatchTransactionAction = "create"
+	BatchTransactionActionDelete BatchTransactionAction = "delete"
+	BatchTransactionActionUpdate BatchTransactionAction = "update"
+)
+
+// Defines values for BatchTransactionStatus.
+const (
+	Failed    BatchTransactionStatus = "failed"
+	Skipped   BatchTransactionStatus = "skipped"
+	Succeeded BatchTransactionStatus = "succeeded"
+)
+
+// Defines values for BudgetCopyConflictPolicy.
+const (
+	Overwrite BudgetCopyConflictPolicy = "overwrite"
+	Skip      BudgetCopyConflictPolicy = "skip"
+)
+
+// Defines values for BudgetRollover.
+const (
+	BudgetRolloverNone              BudgetRollover = "none"
+	BudgetRolloverSurplus           BudgetRollover = "surplus"
+	BudgetRolloverSurplusAndDeficit BudgetRollover = "surplus_and_deficit"
+)
+
+// Defines values for BudgetSortKey.
+const (
+	BudgetSortKeyAmount    BudgetSortKey = "amount"
+	BudgetSortKeyCreatedAt BudgetSortKey = "created_at"
+	BudgetSortKeyMonth     BudgetSortKey = "month"
+)
+
+// Defines values for CategorizationMatchType.
+const (
+	Contains CategorizationMatchType = "contains"
+	Prefix   CategorizationMatchType = "prefix"
+	Regex    CategorizationMatchType = "regex"
+)
+
 // Defines values for CategoryType.
 const (
 	Expense CategoryType = "expense"
 	Income  CategoryType = "income"
 )
 
+// Defines values for ChangeAction.
+const (
+	ChangeActionCreate  ChangeAction = "create"
+	ChangeActionDelete  ChangeAction = "delete"
+	ChangeActionRestore ChangeAction = "restore"
+	ChangeActionUpdate  ChangeAction = "update"
+)
+
+// Defines values for ChangeRecordType.
+const (
+	ChangeRecordTypeBudget      ChangeRecordType = "budget"
+	ChangeRecordTypeTransaction ChangeRecordType = "transaction"
+)
+
 // Defines values for ErrorInfoType.
 const (
 	ErrorInfoTypeErrorInfo ErrorInfoType = "ErrorInfo"
@@ -40,26 +118,49 @@ const (
 
 // Defines values for ErrorReason.
 const (
-	BUDGETALREADYEXISTS    ErrorReason = "BUDGET_ALREADY_EXISTS"
-	BUDGETNOTFOUND         ErrorReason = "BUDGET_NOT_FOUND"
-	CATEGORYINUSE          ErrorReason = "CATEGORY_IN_USE"
-	CATEGORYNOTFOUND       ErrorReason = "CATEGORY_NOT_FOUND"
-	DATABASEERROR          ErrorReason = "DATABASE_ERROR"
-	EMAILALREADYEXISTS     ErrorReason = "EMAIL_ALREADY_EXISTS"
-	INVALIDAMOUNT          ErrorReason = "INVALID_AMOUNT"
-	INVALIDBUDGETAMOUNT    ErrorReason = "INVALID_BUDGET_AMOUNT"
-	INVALIDCATEGORYCOLOR   ErrorReason = "INVALID_CATEGORY_COLOR"
-	INVALIDCATEGORYNAME    ErrorReason = "INVALID_CATEGORY_NAME"
-	INVALIDCREDENTIALS     ErrorReason = "INVALID_CREDENTIALS"
-	INVALIDDATE            ErrorReason = "INVALID_DATE"
-	INVALIDEMAIL           ErrorReason = "INVALID_EMAIL"
-	INVALIDMONTH           ErrorReason = "INVALID_MONTH"
-	INVALIDPASSWORD        ErrorReason = "INVALID_PASSWORD"
-	INVALIDTRANSACTIONTYPE ErrorReason = "INVALID_TRANSACTION_TYPE"
-	TRANSACTIONNOTFOUND    ErrorReason = "TRANSACTION_NOT_FOUND"
-	UNKNOWNERROR           ErrorReason = "UNKNOWN_ERROR"
-	USERNOTFOUND           ErrorReason = "USER_NOT_FOUND"
-	VALIDATIONERROR        ErrorReason = "VALIDATION_ERROR"
+	ACCOUNTINUSE                 ErrorReason = "ACCOUNT_IN_USE"
+	ACCOUNTNOTFOUND              ErrorReason = "ACCOUNT_NOT_FOUND"
+	ATTACHMENTNOTFOUND           ErrorReason = "ATTACHMENT_NOT_FOUND"
+	BASECURRENCYINUSE            ErrorReason = "BASE_CURRENCY_IN_USE"
+	BUDGETALREADYEXISTS          ErrorReason = "BUDGET_ALREADY_EXISTS"
+	BUDGETNOTFOUND               ErrorReason = "BUDGET_NOT_FOUND"
+	BUDGETTEMPLATEALREADYEXISTS  ErrorReason = "BUDGET_TEMPLATE_ALREADY_EXISTS"
+	BUDGETTEMPLATENOTFOUND       ErrorReason = "BUDGET_TEMPLATE_NOT_FOUND"
+	CATEGORIZATIONRULENOTFOUND   ErrorReason = "CATEGORIZATION_RULE_NOT_FOUND"
+	CATEGORYINTRASH              ErrorReason = "CATEGORY_IN_TRASH"
+	CATEGORYINUSE                ErrorReason = "CATEGORY_IN_USE"
+	CATEGORYNOTFOUND             ErrorReason = "CATEGORY_NOT_FOUND"
+	CREDITCARDINUSE              ErrorReason = "CREDIT_CARD_IN_USE"
+	CREDITCARDNOTFOUND           ErrorReason = "CREDIT_CARD_NOT_FOUND"
+	DATABASEERROR                ErrorReason = "DATABASE_ERROR"
+	EMAILALREADYEXISTS           ErrorReason = "EMAIL_ALREADY_EXISTS"
+	EXCHANGERATEALREADYEXISTS    ErrorReason = "EXCHANGE_RATE_ALREADY_EXISTS"
+	EXCHANGERATENOTFOUND         ErrorReason = "EXCHANGE_RATE_NOT_FOUND"
+	IMPORTPROFILENOTFOUND        ErrorReason = "IMPORT_PROFILE_NOT_FOUND"
+	INSTALLMENTNOTALLOWED        ErrorReason = "INSTALLMENT_NOT_ALLOWED"
+	INSTALLMENTPLANNOTFOUND      ErrorReason = "INSTALLMENT_PLAN_NOT_FOUND"
+	INVALIDAMOUNT                ErrorReason = "INVALID_AMOUNT"
+	INVALIDBUDGETAMOUNT          ErrorReason = "INVALID_BUDGET_AMOUNT"
+	INVALIDCATEGORYCOLOR         ErrorReason = "INVALID_CATEGORY_COLOR"
+	INVALIDCATEGORYNAME          ErrorReason = "INVALID_CATEGORY_NAME"
+	INVALIDCREDENTIALS           ErrorReason = "INVALID_CREDENTIALS"
+	INVALIDCSV                   ErrorReason = "INVALID_CSV"
+	INVALIDCURSOR                ErrorReason = "INVALID_CURSOR"
+	INVALIDDATE                  ErrorReason = "INVALID_DATE"
+	INVALIDEMAIL                 ErrorReason = "INVALID_EMAIL"
+	INVALIDMONTH                 ErrorReason = "INVALID_MONTH"
+	INVALIDPASSWORD              ErrorReason = "INVALID_PASSWORD"
+	INVALIDTRANSACTIONTYPE       ErrorReason = "INVALID_TRANSACTION_TYPE"
+	POSSIBLEDUPLICATETRANSACTION ErrorReason = "POSSIBLE_DUPLICATE_TRANSACTION"
+	RECURRINGTRANSACTIONNOTFOUND ErrorReason = "RECURRING_TRANSACTION_NOT_FOUND"
+	TAGALREADYEXISTS             ErrorReason = "TAG_ALREADY_EXISTS"
+	TAGNOTFOUND                  ErrorReason = "TAG_NOT_FOUND"
+	TRANSACTIONLOCKED            ErrorReason = "TRANSACTION_LOCKED"
+	TRANSACTIONNOTFOUND          ErrorReason = "TRANSACTION_NOT_FOUND"
+	TRANSFERNOTFOUND             ErrorReason = "TRANSFER_NOT_FOUND"
+	UNKNOWNERROR                 ErrorReason = "UNKNOWN_ERROR"
+	USERNOTFOUND                 ErrorReason = "USER_NOT_FOUND"
+	VALIDATIONERROR              ErrorReason = "VALIDATION_ERROR"
 )
 
 // Defines values for ErrorStatus.
@@ -73,49 +174,75 @@ const (
 	UNAUTHENTICATED    ErrorStatus = "UNAUTHENTICATED"
 )
 
-// Budget Budget
-type Budget struct {
-	// Amount 予算額
-	Amount int32 `json:"amount"`
-
-	// Category カテゴリ情報
-	Category Category `json:"category"`
+// Defines values for ImportEncoding.
+const (
+	ShiftJis ImportEncoding = "shift_jis"
+	Utf8     ImportEncoding = "utf-8"
+)
 
-	// CategoryId カテゴリID
-	CategoryId int32 `json:"category_id"`
+// Defines values for ModelsMonthStartAdjustment.
+const (
+	ModelsMonthStartAdjustmentNextWeekday     ModelsMonthStartAdjustment = "next_weekday"
+	ModelsMonthStartAdjustmentNone            ModelsMonthStartAdjustment = "none"
+	ModelsMonthStartAdjustmentPreviousWeekday ModelsMonthStartAdjustment = "previous_weekday"
+)
 
-	// CreatedAt 作成日時
-	CreatedAt time.Time `json:"created_at"`
+// Defines values for RecurrenceFrequency.
+const (
+	Monthly RecurrenceFrequency = "monthly"
+	Weekly  RecurrenceFrequency = "weekly"
+	Yearly  RecurrenceFrequency = "yearly"
+)
 
-	// Id 予算ID
-	Id int32 `json:"id"`
+// Defines values for SortOrder.
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
 
-	// Month 対象月（YYYY-MM形式）
-	Month string `json:"month"`
+// Defines values for TransactionExportFormat.
+const (
+	Csv    TransactionExportFormat = "csv"
+	Ndjson TransactionExportFormat = "ndjson"
+)
 
-	// UpdatedAt 更新日時
-	UpdatedAt time.Time `json:"updated_at"`
+// Defines values for TransactionSortKey.
+const (
+	TransactionSortKeyAmount    TransactionSortKey = "amount"
+	TransactionSortKeyCreatedAt TransactionSortKey = "created_at"
+	TransactionSortKeyDate      TransactionSortKey = "date"
+)
 
-	// UserId ユーザーID
-	UserId int32 `json:"user_id"`
-}
+// Defines values for TrendCompare.
+const (
+	PreviousPeriod TrendCompare = "previous_period"
+	PreviousYear   TrendCompare = "previous_year"
+)
 
-// Category Category
-type Category struct {
-	// Color カテゴリの色
-	Color string `json:"color"`
+// Defines values for TrendPeriod.
+const (
+	Day   TrendPeriod = "day"
+	Month TrendPeriod = "month"
+	Week  TrendPeriod = "week"
+	Year  TrendPeriod = "year"
+)
 
+// Account Account
+type Account struct {
 	// CreatedAt 作成日時
 	CreatedAt time.Time `json:"created_at"`
 
-	// Id カテゴリID
+	// Id 口座ID
 	Id int32 `json:"id"`
 
-	// Name カテゴリ名
+	// Name 口座名
 	Name string `json:"name"`
 
-	// Type カテゴリタイプ（収入/支出）
-	Type CategoryType `json:"type"`
+	// OpeningBalance 開始残高
+	OpeningBalance int32 `json:"opening_balance"`
+
+	// Type 口座の種類
+	Type AccountType `json:"type"`
 
 	// UpdatedAt 更新日時
 	UpdatedAt time.Time `json:"updated_at"`
@@ -124,152 +251,168 @@ type Category struct {
 	UserId int32 `json:"user_id"`
 }
 
-// CategoryType カテゴリタイプ
-type CategoryType string
-
-// CreateBudgetInput Create Budget Input
-type CreateBudgetInput struct {
-	// Amount 予算額
-	Amount int32 `json:"amount"`
-
-	// CategoryId カテゴリID
-	CategoryId int32 `json:"category_id"`
+// AccountBalance AccountBalance
+type AccountBalance struct {
+	// Account 口座情報
+	Account Account `json:"account"`
 
-	// Month 対象月（YYYY-MM形式）
-	Month string `json:"month"`
-}
+	// Balance 現在（本日まで）の残高
+	Balance int32 `json:"balance"`
 
-// CreateBudgetResponse Create Budget Response
-type CreateBudgetResponse struct {
-	// Budget Budget
-	Budget Budget `json:"budget"`
+	// History 期間内の日別の残高推移
+	History []AccountBalancePoint `json:"history"`
 }
 
-// CreateCategoryInput Create Category Input
-type CreateCategoryInput struct {
-	// Color カテゴリの色
-	Color string `json:"color"`
-
-	// Name カテゴリ名
-	Name string `json:"name"`
-
-	// Type カテゴリタイプ（収入/支出）
-	Type CategoryType `json:"type"`
-}
+// AccountBalancePoint AccountBalancePoint
+type AccountBalancePoint struct {
+	// Balance その日の終わりの残高
+	Balance int32 `json:"balance"`
 
-// CreateCategoryResponse Create Category Response
-type CreateCategoryResponse struct {
-	// Category Category
-	Category Category `json:"category"`
+	// Date 日付
+	Date openapi_types.Date `json:"date"`
 }
 
-// CreateTransactionInput Create Transaction Input
-type CreateTransactionInput struct {
-	// Amount 金額
+// AccountEntry AccountEntry
+type AccountEntry struct {
+	// Amount 入出金額（入金は正、出金は負の値）
 	Amount int32 `json:"amount"`
 
-	// CategoryId カテゴリID
-	CategoryId int32 `json:"category_id"`
+	// Balance この入出金を反映した後の残高
+	Balance int32 `json:"balance"`
 
-	// Date 取引日
+	// Cleared 消込済みかどうか（振替は常にtrue）
+	Cleared bool `json:"cleared"`
+
+	// Date 日付
 	Date openapi_types.Date `json:"date"`
 
 	// Description 説明
-	Description *string `json:"description,omitempty"`
-}
+	Description string `json:"description"`
 
-// CreateTransactionResponse Create Transaction Response
-type CreateTransactionResponse struct {
-	// Transaction Transaction
-	Transaction Transaction `json:"transaction"`
-}
+	// Kind 入出金の種類
+	Kind AccountEntryKind `json:"kind"`
 
-// CsrfResponse defines model for CsrfResponse.
-type CsrfResponse struct {
-	CsrfToken string `json:"csrfToken"`
+	// TransactionId 取引ID（kindがtransactionの場合）
+	TransactionId *int32 `json:"transaction_id,omitempty"`
+
+	// TransferId 振替ID（kindがtransfer_inまたはtransfer_outの場合）
+	TransferId *int32 `json:"transfer_id,omitempty"`
 }
 
-// ErrorBody エラーレスポンスボディ
-type ErrorBody struct {
-	// Error エラーレスポンス
-	Error ErrorResponse `json:"error"`
+// AccountEntryKind 口座の入出金の種類
+type AccountEntryKind string
+
+// AccountType 口座の種類
+type AccountType string
+
+// AmountSign 金額の符号の扱い
+type AmountSign string
+
+// ApplyCategorizationRuleInput Apply Categorization Rule Input
+type ApplyCategorizationRuleInput struct {
+	// DryRun trueの場合は変更せず、変更される取引のみを返す（デフォルト: false）
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// EndDate 対象とする取引の終了日
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// StartDate 対象とする取引の開始日
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
 }
 
-// ErrorInfo エラー詳細情報
-type ErrorInfo struct {
-	// Type 型識別子
-	Type ErrorInfoType `json:"@type"`
+// ApplyCategorizationRuleResponse Apply Categorization Rule Response
+type ApplyCategorizationRuleResponse struct {
+	// DryRun 変更せずに確認のみ行った場合はtrue
+	DryRun bool `json:"dry_run"`
 
-	// Domain エラーが発生したドメイン
-	Domain string `json:"domain"`
+	// MatchedCount ルールに一致し、カテゴリが変更される（された）取引の件数
+	MatchedCount int32 `json:"matched_count"`
 
-	// Metadata 追加のメタデータ（キー・バリュー）
-	Metadata *map[string]string `json:"metadata,omitempty"`
+	// Transactions カテゴリが変更される（された）取引。日付の新しい順に最大100件
+	Transactions []Transaction `json:"transactions"`
 
-	// Reason エラーの理由
-	Reason ErrorReason `json:"reason"`
+	// UpdatedCount カテゴリを変更した取引の件数（dry_runの場合は0）
+	UpdatedCount int32 `json:"updated_count"`
 }
 
-// ErrorInfoType 型識別子
-type ErrorInfoType string
+// Attachment Attachment
+type Attachment struct {
+	// ContentType ファイルの形式（image/jpeg、image/png、image/webp、application/pdf）
+	ContentType string `json:"content_type"`
 
-// ErrorReason エラー理由の定数
-type ErrorReason string
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-// ErrorResponse エラーレスポンス
-type ErrorResponse struct {
-	// Code HTTPステータスコード
-	Code int32 `json:"code"`
+	// FileName ファイル名
+	FileName string `json:"file_name"`
 
-	// Details 詳細なエラー情報
-	Details *[]ErrorInfo `json:"details,omitempty"`
+	// Id 添付ファイルID
+	Id int32 `json:"id"`
 
-	// Message 人間が読めるエラーメッセージ
-	Message string `json:"message"`
+	// Size ファイルサイズ（バイト）
+	Size int32 `json:"size"`
 
-	// Status 標準エラーステータス
-	Status ErrorStatus `json:"status"`
+	// TransactionId 取引ID
+	TransactionId int32 `json:"transaction_id"`
 }
 
-// ErrorStatus 標準エラーステータス（Google API Standard準拠）
-type ErrorStatus string
+// BatchTransactionAction 一括操作の種類
+type BatchTransactionAction string
 
-// FetchBudgetListResponse Fetch Budget List Response
-type FetchBudgetListResponse struct {
-	Budgets []Budget `json:"budgets"`
-}
+// BatchTransactionOperation Batch Transaction Operation
+type BatchTransactionOperation struct {
+	// Action 操作の種類
+	Action BatchTransactionAction `json:"action"`
 
-// FetchBudgetResponse Fetch Budget Response
-type FetchBudgetResponse struct {
-	// Budget Budget
-	Budget Budget `json:"budget"`
-}
+	// Create 作成内容（createの場合は必須）
+	Create *CreateTransactionInput `json:"create,omitempty"`
 
-// FetchCategoryListsResponse Fetch Category Lists Response
-type FetchCategoryListsResponse struct {
-	Categories []Category `json:"categories"`
+	// Id 対象の取引ID（update・deleteの場合は必須）
+	Id *int32 `json:"id,omitempty"`
+
+	// Update 更新内容（updateの場合は必須）
+	Update *UpdateTransactionInput `json:"update,omitempty"`
 }
 
-// FetchCategoryResponse Fetch Category Response
-type FetchCategoryResponse struct {
-	// Category Category
-	Category Category `json:"category"`
+// BatchTransactionResult Batch Transaction Result
+type BatchTransactionResult struct {
+	// Action 操作の種類
+	Action BatchTransactionAction `json:"action"`
+
+	// Errors エラー（キー: 項目名、値: メッセージ）。failedの場合のみ
+	Errors *map[string]string `json:"errors,omitempty"`
+
+	// Index operations内の位置（0始まり）
+	Index int32 `json:"index"`
+
+	// Status 結果
+	Status BatchTransactionStatus `json:"status"`
+
+	// Transaction 作成・更新後の取引（succeededの場合のみ。deleteの場合は省略）
+	Transaction *Transaction `json:"transaction,omitempty"`
 }
 
-// FetchTransactionListResponse Fetch Transaction List Response
-type FetchTransactionListResponse struct {
-	Transactions []Transaction `json:"transactions"`
+// BatchTransactionStatus 一括操作の結果
+type BatchTransactionStatus string
+
+// BatchTransactionsInput Batch Transactions Input
+type BatchTransactionsInput struct {
+	// Operations 操作の一覧（1〜100件）。指定した順に実行する
+	Operations []BatchTransactionOperation `json:"operations"`
 }
 
-// FetchTransactionResponse Fetch Transaction Response
-type FetchTransactionResponse struct {
-	// Transaction Transaction
-	Transaction Transaction `json:"transaction"`
+// BatchTransactionsResponse Batch Transactions Response
+type BatchTransactionsResponse struct {
+	// Applied すべての操作が実行されたか。1件でも失敗した場合はすべて取り消してfalseを返す
+	Applied bool `json:"applied"`
+
+	// Results 操作ごとの結果
+	Results []BatchTransactionResult `json:"results"`
 }
 
-// Transaction Transaction
-type Transaction struct {
-	// Amount 金額
+// Budget Budget
+type Budget struct {
+	// Amount 予算額
 	Amount int32 `json:"amount"`
 
 	// Category カテゴリ情報
@@ -281,15 +424,12 @@ type Transaction struct {
 	// CreatedAt 作成日時
 	CreatedAt time.Time `json:"created_at"`
 
-	// Date 取引日
-	Date openapi_types.Date `json:"date"`
-
-	// Description 説明
-	Description string `json:"description"`
-
-	// Id 取引ID
+	// Id 予算ID
 	Id int32 `json:"id"`
 
+	// Month 対象月（YYYY-MM形式）
+	Month string `json:"month"`
+
 	// UpdatedAt 更新日時
 	UpdatedAt time.Time `json:"updated_at"`
 
@@ -297,1373 +437,8988 @@ type Transaction struct {
 	UserId int32 `json:"user_id"`
 }
 
-// UpdateBudgetInput Update Budget Input (partial update)
-type UpdateBudgetInput struct {
-	// Amount 予算額
-	Amount *int32 `json:"amount,omitempty"`
+// BudgetAvailableItem Budget Available Item
+type BudgetAvailableItem struct {
+	// Available 利用可能額（予算額 + 繰越額）
+	Available int32 `json:"available"`
+
+	// BudgetId 予算ID（対象月の予算が未設定の場合は省略）
+	BudgetId *int32 `json:"budget_id,omitempty"`
+
+	// CarriedOver 前月までの繰越額。超過分を繰り越した場合は負の値
+	CarriedOver int32 `json:"carried_over"`
+
+	// Category カテゴリ情報
+	Category Category `json:"category"`
 
 	// CategoryId カテゴリID
-	CategoryId *int32 `json:"category_id,omitempty"`
+	CategoryId int32 `json:"category_id"`
 
-	// Month 対象月（YYYY-MM形式）
-	Month *string `json:"month,omitempty"`
-}
+	// Planned 対象月の予算額（予算未設定の場合は0）
+	Planned int32 `json:"planned"`
 
-// UpdateBudgetResponse Update Budget Response
-type UpdateBudgetResponse struct {
-	// Budget Budget
-	Budget Budget `json:"budget"`
+	// Remaining 残額（利用可能額 - 実績額）
+	Remaining int32 `json:"remaining"`
+
+	// Spent 実績額
+	Spent int32 `json:"spent"`
 }
 
-// UpdateCategoryInput Update Category Input (partial update)
-type UpdateCategoryInput struct {
-	// Color カテゴリの色
-	Color *string `json:"color,omitempty"`
+// BudgetAvailableTotal Budget Available Total
+type BudgetAvailableTotal struct {
+	// Available 利用可能額の合計
+	Available int32 `json:"available"`
 
-	// Name カテゴリ名
-	Name *string `json:"name,omitempty"`
-}
+	// CarriedOver 繰越額の合計
+	CarriedOver int32 `json:"carried_over"`
 
-// UpdateCategoryResponse Update Category Response
-type UpdateCategoryResponse struct {
-	// Category Category
-	Category Category `json:"category"`
+	// Planned 予算額の合計
+	Planned int32 `json:"planned"`
+
+	// Remaining 残額の合計
+	Remaining int32 `json:"remaining"`
+
+	// Spent 実績額の合計
+	Spent int32 `json:"spent"`
 }
 
-// UpdateTransactionInput Update Transaction Input (partial update)
-type UpdateTransactionInput struct {
-	// Amount 金額
-	Amount *int32 `json:"amount,omitempty"`
+// BudgetCopyConflictPolicy 予算のコピーで、コピー先の月に同じカテゴリの予算が既にある場合の扱い
+type BudgetCopyConflictPolicy string
 
-	// CategoryId カテゴリID
-	CategoryId *int32 `json:"category_id,omitempty"`
+// BudgetRollover 予算の繰越方法
+type BudgetRollover string
 
-	// Date 取引日
-	Date *openapi_types.Date `json:"date,omitempty"`
+// BudgetSortKey 予算一覧の並び替えキー
+type BudgetSortKey string
 
-	// Description 説明
-	Description *string `json:"description,omitempty"`
-}
+// BudgetSummaryItem Budget Summary Item
+type BudgetSummaryItem struct {
+	// BudgetId 予算ID（予算未設定の場合は省略）
+	BudgetId *int32 `json:"budget_id,omitempty"`
 
-// UpdateTransactionResponse Update Transaction Response
-type UpdateTransactionResponse struct {
-	// Transaction Transaction
-	Transaction Transaction `json:"transaction"`
+	// Category カテゴリ情報
+	Category Category `json:"category"`
+
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
+
+	// PercentUsed 予算消化率（%）。予算未設定の場合は省略
+	PercentUsed *float64 `json:"percent_used,omitempty"`
+
+	// Planned 予算額（予算未設定の場合は0）
+	Planned int32 `json:"planned"`
+
+	// Remaining 残額（予算額 - 実績額）
+	Remaining int32 `json:"remaining"`
+
+	// Spent 実績額
+	Spent int32 `json:"spent"`
 }
 
-// UserSignInInput Sign In Input
-type UserSignInInput struct {
-	// Email メールアドレス
-	Email string `json:"email"`
+// BudgetSummaryTotal Budget Summary Total
+type BudgetSummaryTotal struct {
+	// PercentUsed 予算消化率（%）。予算が1件もない場合は省略
+	PercentUsed *float64 `json:"percent_used,omitempty"`
 
-	// Password パスワード
-	Password string `json:"password"`
+	// Planned 予算額の合計
+	Planned int32 `json:"planned"`
+
+	// Remaining 残額の合計
+	Remaining int32 `json:"remaining"`
+
+	// Spent 実績額の合計
+	Spent int32 `json:"spent"`
 }
 
-// UserSignUpInput Sign Up Input
-type UserSignUpInput struct {
-	// Email メールアドレス
-	Email string `json:"email"`
+// BudgetTemplate BudgetTemplate
+type BudgetTemplate struct {
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-	// Name ユーザー名
+	// Id 予算テンプレートID
+	Id int32 `json:"id"`
+
+	// Items カテゴリごとの予算額（カテゴリIDの昇順）
+	Items []BudgetTemplateItem `json:"items"`
+
+	// Name テンプレート名
 	Name string `json:"name"`
 
-	// Password パスワード
-	Password string `json:"password"`
-}
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
 
-// UserUserCheckSignedInResponse User CheckSignedIn Response
-type UserUserCheckSignedInResponse struct {
-	// IsSignedIn ログイン状態
-	IsSignedIn bool `json:"is_signed_in"`
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-// UserUserSignInResponse User Sign In Response
-type UserUserSignInResponse = map[string]interface{}
+// BudgetTemplateItem BudgetTemplateItem
+type BudgetTemplateItem struct {
+	// Amount 予算額
+	Amount int32 `json:"amount"`
 
-// UserUserSignOutResponse User Sign Out Response
-type UserUserSignOutResponse struct {
-	// Message メッセージ
-	Message string `json:"message"`
-}
+	// Category カテゴリ情報
+	Category Category `json:"category"`
 
-// UserUserSignUpResponse User Sign Up Response
-type UserUserSignUpResponse struct {
-	// Message メッセージ
-	Message string `json:"message"`
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
 }
 
-// GetBudgetsParams defines parameters for GetBudgets.
-type GetBudgetsParams struct {
-	// Month 対象月（YYYY-MM形式）
-	Month *string `form:"month,omitempty" json:"month,omitempty"`
+// BudgetTemplateItemInput Budget Template Item Input
+type BudgetTemplateItemInput struct {
+	// Amount 予算額
+	Amount int32 `json:"amount"`
 
 	// CategoryId カテゴリID
-	CategoryId *int32 `form:"category_id,omitempty" json:"category_id,omitempty"`
+	CategoryId int32 `json:"category_id"`
 }
 
-// GetTransactionsParams defines parameters for GetTransactions.
-type GetTransactionsParams struct {
-	// StartDate 開始日（YYYY-MM-DD形式）
-	StartDate *string `form:"start_date,omitempty" json:"start_date,omitempty"`
+// CalendarDay Calendar Day
+type CalendarDay struct {
+	// Balance その日までの累計残高（収入 - 支出）。前月以前の取引を含む
+	Balance int32 `json:"balance"`
 
-	// EndDate 終了日（YYYY-MM-DD形式）
-	EndDate *string `form:"end_date,omitempty" json:"end_date,omitempty"`
+	// Date 日付
+	Date openapi_types.Date `json:"date"`
 
-	// Type カテゴリタイプ（income/expense）
-	Type *CategoryType `form:"type,omitempty" json:"type,omitempty"`
+	// Expense 支出合計
+	Expense int32 `json:"expense"`
 
-	// CategoryId カテゴリID
-	CategoryId *int32 `form:"category_id,omitempty" json:"category_id,omitempty"`
+	// Income 収入合計
+	Income int32 `json:"income"`
+
+	// TransactionCount 取引件数
+	TransactionCount int32 `json:"transaction_count"`
 }
 
-// PostBudgetsJSONRequestBody defines body for PostBudgets for application/json ContentType.
-type PostBudgetsJSONRequestBody = CreateBudgetInput
+// CategorizationMatchType 自動分類ルールの説明との一致方法
+type CategorizationMatchType string
 
-// PatchBudgetsIdJSONRequestBody defines body for PatchBudgetsId for application/json ContentType.
-type PatchBudgetsIdJSONRequestBody = UpdateBudgetInput
+// CategorizationRule CategorizationRule
+type CategorizationRule struct {
+	// Category カテゴリ情報
+	Category Category `json:"category"`
 
-// PostCategoriesJSONRequestBody defines body for PostCategories for application/json ContentType.
-type PostCategoriesJSONRequestBody = CreateCategoryInput
+	// CategoryId 一致した取引に設定するカテゴリID
+	CategoryId int32 `json:"category_id"`
 
-// PatchCategoriesIdJSONRequestBody defines body for PatchCategoriesId for application/json ContentType.
-type PatchCategoriesIdJSONRequestBody = UpdateCategoryInput
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-// PostTransactionsJSONRequestBody defines body for PostTransactions for application/json ContentType.
-type PostTransactionsJSONRequestBody = CreateTransactionInput
+	// Id 自動分類ルールID
+	Id int32 `json:"id"`
 
-// PatchTransactionsIdJSONRequestBody defines body for PatchTransactionsId for application/json ContentType.
-type PatchTransactionsIdJSONRequestBody = UpdateTransactionInput
+	// MatchType 説明との一致方法
+	MatchType CategorizationMatchType `json:"match_type"`
 
-// PostUsersSignInJSONRequestBody defines body for PostUsersSignIn for application/json ContentType.
-type PostUsersSignInJSONRequestBody = UserSignInInput
+	// MaxAmount 金額（基準通貨）の上限（この金額を含む）
+	MaxAmount *int32 `json:"max_amount,omitempty"`
 
-// PostUsersSignUpJSONRequestBody defines body for PostUsersSignUp for application/json ContentType.
-type PostUsersSignUpJSONRequestBody = UserSignUpInput
+	// MinAmount 金額（基準通貨）の下限（この金額を含む）
+	MinAmount *int32 `json:"min_amount,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Get Budgets
-	// (GET /budgets)
-	GetBudgets(ctx echo.Context, params GetBudgetsParams) error
-	// Create Budget
-	// (POST /budgets)
-	PostBudgets(ctx echo.Context) error
-	// Delete Budget
-	// (DELETE /budgets/{id})
-	DeleteBudgetsId(ctx echo.Context, id int32) error
-	// Get Budget
-	// (GET /budgets/{id})
-	GetBudgetsId(ctx echo.Context, id int32) error
-	// Update Budget
-	// (PATCH /budgets/{id})
-	PatchBudgetsId(ctx echo.Context, id int32) error
-	// Get Categories
-	// (GET /categories)
-	GetCategories(ctx echo.Context) error
-	// Create Category
-	// (POST /categories)
-	PostCategories(ctx echo.Context) error
-	// Delete Category
-	// (DELETE /categories/{id})
-	DeleteCategoriesId(ctx echo.Context, id int32) error
-	// Get Category
-	// (GET /categories/{id})
-	GetCategoriesId(ctx echo.Context, id int32) error
-	// Update Category
-	// (PATCH /categories/{id})
-	PatchCategoriesId(ctx echo.Context, id int32) error
-	// Get Csrf
-	// (GET /csrf)
-	GetCsrf(ctx echo.Context) error
-	// Get Transactions
-	// (GET /transactions)
-	GetTransactions(ctx echo.Context, params GetTransactionsParams) error
-	// Create Transaction
-	// (POST /transactions)
-	PostTransactions(ctx echo.Context) error
-	// Delete Transaction
-	// (DELETE /transactions/{id})
-	DeleteTransactionsId(ctx echo.Context, id int32) error
-	// Get Transaction
-	// (GET /transactions/{id})
-	GetTransactionsId(ctx echo.Context, id int32) error
-	// Update Transaction
-	// (PATCH /transactions/{id})
-	PatchTransactionsId(ctx echo.Context, id int32) error
-	// User CheckSignedIn
-	// (GET /users/checkSignedIn)
-	GetUsersCheckSignedIn(ctx echo.Context) error
-	// User SignIn
-	// (POST /users/signIn)
-	PostUsersSignIn(ctx echo.Context) error
-	// User SignOut
-	// (POST /users/signOut)
-	PostUsersSignOut(ctx echo.Context) error
-	// User SignUp
-	// (POST /users/signUp)
-	PostUsersSignUp(ctx echo.Context) error
-}
+	// Pattern 説明と照合する文字列または正規表現
+	Pattern string `json:"pattern"`
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	// Priority 優先度。小さいほど先に判定する（同じ場合はIDの小さい順）
+	Priority int32 `json:"priority"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-// GetBudgets converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBudgets(ctx echo.Context) error {
-	var err error
+// Category Category
+type Category struct {
+	// BudgetRollover 予算の繰越方法
+	BudgetRollover BudgetRollover `json:"budget_rollover"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// Color カテゴリの色
+	Color string `json:"color"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetBudgetsParams
-	// ------------- Optional query parameter "month" -------------
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-	err = runtime.BindQueryParameter("form", false, false, "month", ctx.QueryParams(), &params.Month)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter month: %s", err))
-	}
+	// Id カテゴリID
+	Id int32 `json:"id"`
 
-	// ------------- Optional query parameter "category_id" -------------
+	// Name カテゴリ名
+	Name string `json:"name"`
 
-	err = runtime.BindQueryParameter("form", false, false, "category_id", ctx.QueryParams(), &params.CategoryId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_id: %s", err))
-	}
+	// Type カテゴリタイプ（収入/支出）
+	Type CategoryType `json:"type"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBudgets(ctx, params)
-	return err
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-// PostBudgets converts echo context to params.
-func (w *ServerInterfaceWrapper) PostBudgets(ctx echo.Context) error {
-	var err error
+// CategoryType カテゴリタイプ
+type CategoryType string
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+// ChangeAction 変更履歴の操作の種類
+type ChangeAction string
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostBudgets(ctx)
-	return err
-}
+// ChangeHistory Change History
+type ChangeHistory struct {
+	// Action 操作の種類
+	Action ChangeAction `json:"action"`
 
-// DeleteBudgetsId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteBudgetsId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
+	// After 変更された項目の変更後の値。ゴミ箱に移動した場合は省略
+	After *map[string]interface{} `json:"after,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+	// Before 変更された項目の変更前の値。登録・ゴミ箱から元に戻した場合は省略
+	Before *map[string]interface{} `json:"before,omitempty"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// CreatedAt 変更日時
+	CreatedAt time.Time `json:"created_at"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteBudgetsId(ctx, id)
-	return err
+	// Id 変更履歴ID
+	Id int32 `json:"id"`
+
+	// RecordId 対象のID
+	RecordId int32 `json:"record_id"`
+
+	// RecordType 対象の種類
+	RecordType ChangeRecordType `json:"record_type"`
 }
 
-// GetBudgetsId converts echo context to params.
-func (w *ServerInterfaceWrapper) GetBudgetsId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
+// ChangeRecordType 変更履歴の対象の種類
+type ChangeRecordType string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+// CopyBudgetsInput Copy Budgets Input
+type CopyBudgetsInput struct {
+	// OnConflict コピー先の月に同じカテゴリの予算が既にある場合の扱い（省略時はskip）
+	OnConflict *BudgetCopyConflictPolicy `json:"on_conflict,omitempty"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// SourceMonth コピー元の月（YYYY-MM形式）。source_monthとtemplate_idのどちらか一方を指定する
+	SourceMonth *string `json:"source_month,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBudgetsId(ctx, id)
-	return err
+	// TargetMonth コピー先の月（YYYY-MM形式）
+	TargetMonth string `json:"target_month"`
+
+	// TemplateId コピー元の予算テンプレートID。source_monthとtemplate_idのどちらか一方を指定する
+	TemplateId *int32 `json:"template_id,omitempty"`
 }
 
-// PatchBudgetsId converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchBudgetsId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
+// CopyBudgetsResponse Copy Budgets Response
+type CopyBudgetsResponse struct {
+	// Budgets コピー後のコピー先の月の予算
+	Budgets []Budget `json:"budgets"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+	// Created 新しく作成した予算の件数
+	Created int32 `json:"created"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// Skipped 既存の予算を変更しなかった件数
+	Skipped int32 `json:"skipped"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchBudgetsId(ctx, id)
-	return err
+	// Updated 予算額を上書きした予算の件数
+	Updated int32 `json:"updated"`
 }
 
-// GetCategories converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCategories(ctx echo.Context) error {
-	var err error
+// CreateAccountInput Create Account Input
+type CreateAccountInput struct {
+	// Name 口座名
+	Name string `json:"name"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// OpeningBalance 開始残高（省略時は0）
+	OpeningBalance *int32 `json:"opening_balance,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCategories(ctx)
-	return err
+	// Type 口座の種類
+	Type AccountType `json:"type"`
 }
 
-// PostCategories converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCategories(ctx echo.Context) error {
-	var err error
+// CreateAccountResponse Create Account Response
+type CreateAccountResponse struct {
+	// Account Account
+	Account Account `json:"account"`
+}
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+// CreateBudgetInput Create Budget Input
+type CreateBudgetInput struct {
+	// Amount 予算額
+	Amount int32 `json:"amount"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCategories(ctx)
-	return err
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
+
+	// Month 対象月（YYYY-MM形式）
+	Month string `json:"month"`
 }
 
-// DeleteCategoriesId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCategoriesId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
+// CreateBudgetResponse Create Budget Response
+type CreateBudgetResponse struct {
+	// Budget Budget
+	Budget Budget `json:"budget"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+// CreateBudgetTemplateInput Create Budget Template Input
+type CreateBudgetTemplateInput struct {
+	// Items カテゴリごとの予算額（1〜100件、カテゴリの重複不可）
+	Items []BudgetTemplateItemInput `json:"items"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// Name テンプレート名
+	Name string `json:"name"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCategoriesId(ctx, id)
-	return err
+// CreateBudgetTemplateResponse Create Budget Template Response
+type CreateBudgetTemplateResponse struct {
+	// BudgetTemplate BudgetTemplate
+	BudgetTemplate BudgetTemplate `json:"budget_template"`
 }
 
-// GetCategoriesId converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCategoriesId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
+// CreateCategorizationRuleInput Create Categorization Rule Input
+type CreateCategorizationRuleInput struct {
+	// CategoryId 一致した取引に設定するカテゴリID
+	CategoryId int32 `json:"category_id"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+	// MatchType 説明との一致方法
+	MatchType CategorizationMatchType `json:"match_type"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// MaxAmount 金額（基準通貨）の上限（この金額を含む）
+	MaxAmount *int32 `json:"max_amount,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCategoriesId(ctx, id)
-	return err
+	// MinAmount 金額（基準通貨）の下限（この金額を含む）
+	MinAmount *int32 `json:"min_amount,omitempty"`
+
+	// Pattern 説明と照合する文字列または正規表現
+	Pattern string `json:"pattern"`
+
+	// Priority 優先度。小さいほど先に判定する（デフォルト: 0）
+	Priority *int32 `json:"priority,omitempty"`
 }
 
-// PatchCategoriesId converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchCategoriesId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
+// CreateCategorizationRuleResponse Create Categorization Rule Response
+type CreateCategorizationRuleResponse struct {
+	// CategorizationRule CategorizationRule
+	CategorizationRule CategorizationRule `json:"categorization_rule"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+// CreateCategoryInput Create Category Input
+type CreateCategoryInput struct {
+	// BudgetRollover 予算の繰越方法（省略時はnone）
+	BudgetRollover *BudgetRollover `json:"budget_rollover,omitempty"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// Color カテゴリの色
+	Color string `json:"color"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchCategoriesId(ctx, id)
-	return err
-}
+	// Name カテゴリ名
+	Name string `json:"name"`
 
-// GetCsrf converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCsrf(ctx echo.Context) error {
-	var err error
+	// Type カテゴリタイプ（収入/支出）
+	Type CategoryType `json:"type"`
+}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCsrf(ctx)
-	return err
+// CreateCategoryResponse Create Category Response
+type CreateCategoryResponse struct {
+	// Category Category
+	Category Category `json:"category"`
 }
 
-// GetTransactions converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTransactions(ctx echo.Context) error {
-	var err error
+// CreateCreditCardInput Create Credit Card Input
+type CreateCreditCardInput struct {
+	// ClosingDay 締め日（1〜31）。月末締めの場合は31
+	ClosingDay int32 `json:"closing_day"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// Name カード名
+	Name string `json:"name"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTransactionsParams
-	// ------------- Optional query parameter "start_date" -------------
+	// PaymentDay 支払日（1〜31）。月末払いの場合は31
+	PaymentDay int32 `json:"payment_day"`
 
-	err = runtime.BindQueryParameter("form", false, false, "start_date", ctx.QueryParams(), &params.StartDate)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
-	}
+	// PaymentMonthOffset 締め日から支払日までの月数（1〜2、省略時は1）
+	PaymentMonthOffset *int32 `json:"payment_month_offset,omitempty"`
+}
 
-	// ------------- Optional query parameter "end_date" -------------
+// CreateCreditCardResponse Create Credit Card Response
+type CreateCreditCardResponse struct {
+	// CreditCard CreditCard
+	CreditCard CreditCard `json:"credit_card"`
+}
 
-	err = runtime.BindQueryParameter("form", false, false, "end_date", ctx.QueryParams(), &params.EndDate)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
-	}
+// CreateExchangeRateInput Create Exchange Rate Input
+type CreateExchangeRateInput struct {
+	// Currency 通貨（ISO 4217）。基準通貨は指定できない
+	Currency string `json:"currency"`
 
-	// ------------- Optional query parameter "type" -------------
+	// Date 適用開始日
+	Date openapi_types.Date `json:"date"`
 
-	err = runtime.BindQueryParameter("form", false, false, "type", ctx.QueryParams(), &params.Type)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
-	}
+	// Rate レート。1 currency あたりの基準通貨の額（整数部・小数部それぞれ10桁以内）
+	Rate string `json:"rate"`
+}
 
-	// ------------- Optional query parameter "category_id" -------------
+// CreateExchangeRateResponse Create Exchange Rate Response
+type CreateExchangeRateResponse struct {
+	// ExchangeRate ExchangeRate
+	ExchangeRate ExchangeRate `json:"exchange_rate"`
+}
 
-	err = runtime.BindQueryParameter("form", false, false, "category_id", ctx.QueryParams(), &params.CategoryId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_id: %s", err))
-	}
+// CreateImportProfileInput Create Import Profile Input
+type CreateImportProfileInput struct {
+	// AmountColumn 金額の列番号（1始まり）
+	AmountColumn int32 `json:"amount_column"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTransactions(ctx, params)
-	return err
-}
+	// AmountSign 金額の符号の扱い
+	AmountSign AmountSign `json:"amount_sign"`
 
-// PostTransactions converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTransactions(ctx echo.Context) error {
-	var err error
+	// DateColumn 日付の列番号（1始まり）
+	DateColumn int32 `json:"date_column"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// DateFormat 日付の形式（YYYY, MM, M, DD, Dと区切り文字の組み合わせ。例: YYYY/MM/DD）
+	DateFormat string `json:"date_format"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTransactions(ctx)
-	return err
-}
+	// DefaultCategoryId 支出に割り当てるカテゴリID
+	DefaultCategoryId int32 `json:"default_category_id"`
 
-// DeleteTransactionsId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTransactionsId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
+	// DescriptionColumn 説明の列番号（1始まり）
+	DescriptionColumn *int32 `json:"description_column,omitempty"`
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+	// Encoding 文字コード
+	Encoding ImportEncoding `json:"encoding"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// HasHeader 1行目（skip_rows適用後）をヘッダー行として読み飛ばすか（省略時はfalse）
+	HasHeader *bool `json:"has_header,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTransactionsId(ctx, id)
-	return err
+	// IncomeCategoryId 収入に割り当てるカテゴリID
+	IncomeCategoryId *int32 `json:"income_category_id,omitempty"`
+
+	// Name 取込設定名
+	Name string `json:"name"`
+
+	// SkipRows 先頭で読み飛ばす行数（省略時は0）
+	SkipRows *int32 `json:"skip_rows,omitempty"`
 }
 
-// GetTransactionsId converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTransactionsId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
+// CreateImportProfileResponse Create Import Profile Response
+type CreateImportProfileResponse struct {
+	// ImportProfile ImportProfile
+	ImportProfile ImportProfile `json:"import_profile"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+// CreateInstallmentPlanInput Create Installment Plan Input
+type CreateInstallmentPlanInput struct {
+	// AnnualInterestRate 手数料の実質年率（%、0〜30）。省略時は手数料なし
+	AnnualInterestRate *string `json:"annual_interest_rate,omitempty"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// FirstBillingMonth 初回の支払月（YYYY-MM形式）。購入日の月以降を指定する
+	FirstBillingMonth string `json:"first_billing_month"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTransactionsId(ctx, id)
-	return err
+	// Payments 支払回数（2〜60）。分割払いにする取引の金額以下で指定する
+	Payments int32 `json:"payments"`
 }
 
-// PatchTransactionsId converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTransactionsId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "id" -------------
-	var id int32
+// CreateInstallmentPlanResponse Create Installment Plan Response
+type CreateInstallmentPlanResponse struct {
+	// InstallmentPlan InstallmentPlan
+	InstallmentPlan InstallmentPlan `json:"installment_plan"`
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
-	}
+// CreateRecurringTransactionInput Create Recurring Transaction Input
+type CreateRecurringTransactionInput struct {
+	// Amount 金額（基準通貨）
+	Amount int32 `json:"amount"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTransactionsId(ctx, id)
-	return err
-}
+	// DayOfMonth 日（1〜31）。monthly/yearlyの場合は必須
+	DayOfMonth *int32 `json:"day_of_month,omitempty"`
 
-// GetUsersCheckSignedIn converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUsersCheckSignedIn(ctx echo.Context) error {
-	var err error
+	// DayOfWeek 曜日（0=日曜〜6=土曜）。weeklyの場合は必須
+	DayOfWeek *int32 `json:"day_of_week,omitempty"`
 
-	ctx.Set(ApiKeyAuthScopes, []string{})
+	// Description 説明
+	Description *string `json:"description,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUsersCheckSignedIn(ctx)
-	return err
-}
+	// EndDate 終了日
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
 
-// PostUsersSignIn converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersSignIn(ctx echo.Context) error {
-	var err error
+	// Frequency 繰り返しの頻度
+	Frequency RecurrenceFrequency `json:"frequency"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersSignIn(ctx)
-	return err
-}
+	// Interval 繰り返しの間隔（省略時は1）
+	Interval *int32 `json:"interval,omitempty"`
 
-// PostUsersSignOut converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersSignOut(ctx echo.Context) error {
-	var err error
+	// MonthOfYear 月（1〜12）。yearlyの場合は必須
+	MonthOfYear *int32 `json:"month_of_year,omitempty"`
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersSignOut(ctx)
-	return err
+	// StartDate 開始日
+	StartDate openapi_types.Date `json:"start_date"`
 }
 
-// PostUsersSignUp converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersSignUp(ctx echo.Context) error {
-	var err error
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersSignUp(ctx)
-	return err
+// CreateRecurringTransactionResponse Create Recurring Transaction Response
+type CreateRecurringTransactionResponse struct {
+	// RecurringTransaction RecurringTransaction
+	RecurringTransaction RecurringTransaction `json:"recurring_transaction"`
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+// CreateTagInput Create Tag Input
+type CreateTagInput struct {
+	// Name タグ名
+	Name string `json:"name"`
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+// CreateTagResponse Create Tag Response
+type CreateTagResponse struct {
+	// Tag Tag
+	Tag Tag `json:"tag"`
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// CreateTransactionInput Create Transaction Input
+type CreateTransactionInput struct {
+	// AccountId 口座ID
+	AccountId *int32 `json:"account_id,omitempty"`
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+	// Amount 金額（取引の通貨の補助単位。USDであればセント）
+	Amount int32 `json:"amount"`
 
-	router.GET(baseURL+"/budgets", wrapper.GetBudgets)
-	router.POST(baseURL+"/budgets", wrapper.PostBudgets)
-	router.DELETE(baseURL+"/budgets/:id", wrapper.DeleteBudgetsId)
-	router.GET(baseURL+"/budgets/:id", wrapper.GetBudgetsId)
-	router.PATCH(baseURL+"/budgets/:id", wrapper.PatchBudgetsId)
-	router.GET(baseURL+"/categories", wrapper.GetCategories)
-	router.POST(baseURL+"/categories", wrapper.PostCategories)
-	router.DELETE(baseURL+"/categories/:id", wrapper.DeleteCategoriesId)
-	router.GET(baseURL+"/categories/:id", wrapper.GetCategoriesId)
-	router.PATCH(baseURL+"/categories/:id", wrapper.PatchCategoriesId)
-	router.GET(baseURL+"/csrf", wrapper.GetCsrf)
-	router.GET(baseURL+"/transactions", wrapper.GetTransactions)
-	router.POST(baseURL+"/transactions", wrapper.PostTransactions)
-	router.DELETE(baseURL+"/transactions/:id", wrapper.DeleteTransactionsId)
-	router.GET(baseURL+"/transactions/:id", wrapper.GetTransactionsId)
-	router.PATCH(baseURL+"/transactions/:id", wrapper.PatchTransactionsId)
-	router.GET(baseURL+"/users/checkSignedIn", wrapper.GetUsersCheckSignedIn)
-	router.POST(baseURL+"/users/signIn", wrapper.PostUsersSignIn)
-	router.POST(baseURL+"/users/signOut", wrapper.PostUsersSignOut)
-	router.POST(baseURL+"/users/signUp", wrapper.PostUsersSignUp)
+	// AutoCategorize trueの場合は自動分類ルールを優先度の順に判定し、最初に一致したルールのカテゴリを設定する。一致するルールがない場合はcategory_idを使う。分割明細を指定した場合や一括操作では判定しない（デフォルト: false）
+	AutoCategorize *bool `json:"auto_categorize,omitempty"`
 
-}
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
 
-type GetBudgetsRequestObject struct {
-	Params GetBudgetsParams
-}
+	// Cleared 消込済み（銀行の明細で確認済み）かどうか
+	Cleared *bool `json:"cleared,omitempty"`
 
-type GetBudgetsResponseObject interface {
-	VisitGetBudgetsResponse(w http.ResponseWriter) error
-}
+	// CreditCardId クレジットカードID
+	CreditCardId *int32 `json:"credit_card_id,omitempty"`
 
-type GetBudgets200JSONResponse FetchBudgetListResponse
+	// Currency 通貨（ISO 4217、省略時は基準通貨）。基準通貨以外の場合は取引日以前で最新の為替レートで換算する
+	Currency *string `json:"currency,omitempty"`
 
-func (response GetBudgets200JSONResponse) VisitGetBudgetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Date 取引日
+	Date openapi_types.Date `json:"date"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Description 説明
+	Description *string `json:"description,omitempty"`
 
-type GetBudgets400JSONResponse ErrorBody
+	// Force trueの場合は重複の確認をせずに登録する。一括操作では重複を確認しない（デフォルト: false）
+	Force *bool `json:"force,omitempty"`
 
-func (response GetBudgets400JSONResponse) VisitGetBudgetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Splits 分割明細（2〜20件）。金額の合計は取引の金額と一致させる
+	Splits *[]TransactionSplitInput `json:"splits,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Tags タグIDの一覧（20件以内）
+	Tags *[]int32 `json:"tags,omitempty"`
 }
 
-type GetBudgets500JSONResponse ErrorBody
+// CreateTransactionResponse Create Transaction Response
+type CreateTransactionResponse struct {
+	// Transaction Transaction
+	Transaction Transaction `json:"transaction"`
+}
 
-func (response GetBudgets500JSONResponse) VisitGetBudgetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// CreateTransferInput Create Transfer Input
+type CreateTransferInput struct {
+	// Amount 金額
+	Amount int32 `json:"amount"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Date 振替日
+	Date openapi_types.Date `json:"date"`
 
-type PostBudgetsRequestObject struct {
-	Body *PostBudgetsJSONRequestBody
+	// Description 説明
+	Description *string `json:"description,omitempty"`
+
+	// FromAccountId 振替元の口座ID
+	FromAccountId int32 `json:"from_account_id"`
+
+	// ToAccountId 振替先の口座ID
+	ToAccountId int32 `json:"to_account_id"`
 }
 
-type PostBudgetsResponseObject interface {
-	VisitPostBudgetsResponse(w http.ResponseWriter) error
+// CreateTransferResponse Create Transfer Response
+type CreateTransferResponse struct {
+	// Transfer 口座間の振替。振替元の出金と振替先の入金の組を1件として記録し、収入・支出には含めない。取引一覧・カレンダーには含まれず、口座の残高と入出金一覧に反映される
+	Transfer Transfer `json:"transfer"`
 }
 
-type PostBudgets201JSONResponse CreateBudgetResponse
+// CreditCard CreditCard
+type CreditCard struct {
+	// ClosingDay 締め日（1〜31）。月の日数を超える場合は月末日に締める
+	ClosingDay int32 `json:"closing_day"`
 
-func (response PostBudgets201JSONResponse) VisitPostBudgetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Id クレジットカードID
+	Id int32 `json:"id"`
 
-type PostBudgets400JSONResponse ErrorBody
+	// Name カード名
+	Name string `json:"name"`
 
-func (response PostBudgets400JSONResponse) VisitPostBudgetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// PaymentDay 支払日（1〜31）。月の日数を超える場合は月末日に支払う
+	PaymentDay int32 `json:"payment_day"`
 
-	return json.NewEncoder(w).Encode(response)
+	// PaymentMonthOffset 締め日から支払日までの月数（1: 翌月払い、2: 翌々月払い）
+	PaymentMonthOffset int32 `json:"payment_month_offset"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-type PostBudgets409JSONResponse ErrorBody
+// CreditCardStatement CreditCardStatement
+type CreditCardStatement struct {
+	// ClosingMonth 締め日の月（YYYY-MM形式）
+	ClosingMonth string `json:"closing_month"`
 
-func (response PostBudgets409JSONResponse) VisitPostBudgetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// Count 利用期間の取引件数
+	Count int32 `json:"count"`
 
-	return json.NewEncoder(w).Encode(response)
+	// DueDate 支払日
+	DueDate openapi_types.Date `json:"due_date"`
+
+	// PeriodEnd 利用期間の終了日（締め日）
+	PeriodEnd openapi_types.Date `json:"period_end"`
+
+	// PeriodStart 利用期間の開始日（前回の締め日の翌日）
+	PeriodStart openapi_types.Date `json:"period_start"`
+
+	// Total 請求額（利用期間の支出の合計から収入（返金など）の合計を引いたもの）
+	Total int32 `json:"total"`
 }
 
-type PostBudgets500JSONResponse ErrorBody
+// CreditCardWithdrawal CreditCardWithdrawal
+type CreditCardWithdrawal struct {
+	// Date 引き落とし日
+	Date openapi_types.Date `json:"date"`
 
-func (response PostBudgets500JSONResponse) VisitPostBudgetsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Items カードごとの請求
+	Items []CreditCardWithdrawalItem `json:"items"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Total その日の引き落とし額の合計
+	Total int32 `json:"total"`
 }
 
-type DeleteBudgetsIdRequestObject struct {
-	Id int32 `json:"id"`
+// CreditCardWithdrawalItem CreditCardWithdrawalItem
+type CreditCardWithdrawalItem struct {
+	// CreditCard クレジットカード情報
+	CreditCard CreditCard `json:"credit_card"`
+
+	// Statement 引き落とされる請求
+	Statement CreditCardStatement `json:"statement"`
 }
 
-type DeleteBudgetsIdResponseObject interface {
-	VisitDeleteBudgetsIdResponse(w http.ResponseWriter) error
+// CsrfResponse defines model for CsrfResponse.
+type CsrfResponse struct {
+	CsrfToken string `json:"csrfToken"`
 }
 
-type DeleteBudgetsId204Response struct {
+// DuplicateTransactionGroup Duplicate Transaction Group
+type DuplicateTransactionGroup struct {
+	// Transactions 重複の疑いがある取引（日付・IDの古い順）
+	Transactions []Transaction `json:"transactions"`
 }
 
-func (response DeleteBudgetsId204Response) VisitDeleteBudgetsIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+// ErrorBody エラーレスポンスボディ
+type ErrorBody struct {
+	// Error エラーレスポンス
+	Error ErrorResponse `json:"error"`
 }
 
-type DeleteBudgetsId404JSONResponse ErrorBody
+// ErrorInfo エラー詳細情報
+type ErrorInfo struct {
+	// Type 型識別子
+	Type ErrorInfoType `json:"@type"`
 
-func (response DeleteBudgetsId404JSONResponse) VisitDeleteBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// Domain エラーが発生したドメイン
+	Domain string `json:"domain"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Metadata 追加のメタデータ（キー・バリュー）
+	Metadata *map[string]string `json:"metadata,omitempty"`
+
+	// Reason エラーの理由
+	Reason ErrorReason `json:"reason"`
 }
 
-type DeleteBudgetsId500JSONResponse ErrorBody
+// ErrorInfoType 型識別子
+type ErrorInfoType string
 
-func (response DeleteBudgetsId500JSONResponse) VisitDeleteBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// ErrorReason エラー理由の定数
+type ErrorReason string
 
-	return json.NewEncoder(w).Encode(response)
-}
+// ErrorResponse エラーレスポンス
+type ErrorResponse struct {
+	// Code HTTPステータスコード
+	Code int32 `json:"code"`
 
-type GetBudgetsIdRequestObject struct {
-	Id int32 `json:"id"`
-}
+	// Details 詳細なエラー情報
+	Details *[]ErrorInfo `json:"details,omitempty"`
 
-type GetBudgetsIdResponseObject interface {
-	VisitGetBudgetsIdResponse(w http.ResponseWriter) error
+	// Message 人間が読めるエラーメッセージ
+	Message string `json:"message"`
+
+	// Status 標準エラーステータス
+	Status ErrorStatus `json:"status"`
 }
 
-type GetBudgetsId200JSONResponse FetchBudgetResponse
+// ErrorStatus 標準エラーステータス（Google API Standard準拠）
+type ErrorStatus string
 
-func (response GetBudgetsId200JSONResponse) VisitGetBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ExchangeRate ExchangeRate
+type ExchangeRate struct {
+	// BaseCurrency 基準通貨（ISO 4217）
+	BaseCurrency string `json:"base_currency"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-type GetBudgetsId400JSONResponse ErrorBody
+	// Currency 通貨（ISO 4217）
+	Currency string `json:"currency"`
 
-func (response GetBudgetsId400JSONResponse) VisitGetBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Date 適用開始日。この日以降、次のレートの適用開始日までの取引の換算に使う
+	Date openapi_types.Date `json:"date"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Id 為替レートID
+	Id int32 `json:"id"`
 
-type GetBudgetsId404JSONResponse ErrorBody
+	// Rate レート。1 currency あたりの基準通貨の額（例: 151.25）
+	Rate string `json:"rate"`
 
-func (response GetBudgetsId404JSONResponse) VisitGetBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
 
-	return json.NewEncoder(w).Encode(response)
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-type GetBudgetsId500JSONResponse ErrorBody
+// FetchAccountBalancesResponse Fetch Account Balances Response
+type FetchAccountBalancesResponse struct {
+	Balances []AccountBalance `json:"balances"`
+}
 
-func (response GetBudgetsId500JSONResponse) VisitGetBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// FetchAccountEntryListResponse Fetch Account Entry List Response
+type FetchAccountEntryListResponse struct {
+	// Entries 期間内の入出金（日付の昇順）
+	Entries []AccountEntry `json:"entries"`
 
-	return json.NewEncoder(w).Encode(response)
+	// OpeningBalance 期間の開始日より前の残高（開始残高を含む）
+	OpeningBalance int32 `json:"opening_balance"`
 }
 
-type PatchBudgetsIdRequestObject struct {
-	Id   int32 `json:"id"`
-	Body *PatchBudgetsIdJSONRequestBody
+// FetchAccountListResponse Fetch Account List Response
+type FetchAccountListResponse struct {
+	Accounts []Account `json:"accounts"`
 }
 
-type PatchBudgetsIdResponseObject interface {
-	VisitPatchBudgetsIdResponse(w http.ResponseWriter) error
+// FetchAccountResponse Fetch Account Response
+type FetchAccountResponse struct {
+	// Account Account
+	Account Account `json:"account"`
 }
 
-type PatchBudgetsId200JSONResponse UpdateBudgetResponse
+// FetchAttachmentListResponse Fetch Attachment List Response
+type FetchAttachmentListResponse struct {
+	Attachments []Attachment `json:"attachments"`
+}
 
-func (response PatchBudgetsId200JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// FetchBudgetAvailableResponse Fetch Budget Available Response
+type FetchBudgetAvailableResponse struct {
+	// EndDate 集計期間の終了日
+	EndDate openapi_types.Date `json:"end_date"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Items カテゴリごとの利用可能額と実績
+	Items []BudgetAvailableItem `json:"items"`
 
-type PatchBudgetsId400JSONResponse ErrorBody
+	// Month 対象月（YYYY-MM形式）
+	Month string `json:"month"`
 
-func (response PatchBudgetsId400JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// StartDate 集計期間の開始日
+	StartDate openapi_types.Date `json:"start_date"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Total 月の合計
+	Total BudgetAvailableTotal `json:"total"`
 }
 
-type PatchBudgetsId404JSONResponse ErrorBody
-
-func (response PatchBudgetsId404JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// FetchBudgetListResponse Fetch Budget List Response
+type FetchBudgetListResponse struct {
+	Budgets []Budget `json:"budgets"`
 
-	return json.NewEncoder(w).Encode(response)
+	// NextCursor 次のページを取得するためのカーソル。続きがない場合は省略
+	NextCursor *string `json:"next_cursor,omitempty"`
 }
 
-type PatchBudgetsId409JSONResponse ErrorBody
+// FetchBudgetResponse Fetch Budget Response
+type FetchBudgetResponse struct {
+	// Budget Budget
+	Budget Budget `json:"budget"`
+}
 
-func (response PatchBudgetsId409JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+// FetchBudgetSummaryResponse Fetch Budget Summary Response
+type FetchBudgetSummaryResponse struct {
+	// EndDate 集計期間の終了日
+	EndDate openapi_types.Date `json:"end_date"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Items カテゴリごとの予算と実績
+	Items []BudgetSummaryItem `json:"items"`
 
-type PatchBudgetsId500JSONResponse ErrorBody
+	// Month 対象月（YYYY-MM形式）
+	Month string `json:"month"`
 
-func (response PatchBudgetsId500JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// StartDate 集計期間の開始日
+	StartDate openapi_types.Date `json:"start_date"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Total 月の合計
+	Total BudgetSummaryTotal `json:"total"`
 }
 
-type GetCategoriesRequestObject struct {
+// FetchBudgetTemplateListResponse Fetch Budget Template List Response
+type FetchBudgetTemplateListResponse struct {
+	BudgetTemplates []BudgetTemplate `json:"budget_templates"`
 }
 
-type GetCategoriesResponseObject interface {
-	VisitGetCategoriesResponse(w http.ResponseWriter) error
+// FetchBudgetTemplateResponse Fetch Budget Template Response
+type FetchBudgetTemplateResponse struct {
+	// BudgetTemplate BudgetTemplate
+	BudgetTemplate BudgetTemplate `json:"budget_template"`
 }
 
-type GetCategories200JSONResponse FetchCategoryListsResponse
+// FetchCalendarResponse Fetch Calendar Response
+type FetchCalendarResponse struct {
+	// Budgets 対象月の予算
+	Budgets []Budget `json:"budgets"`
 
-func (response GetCategories200JSONResponse) VisitGetCategoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Days 日別の集計（月の全日分）
+	Days []CalendarDay `json:"days"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Month 対象月（YYYY-MM形式）
+	Month string `json:"month"`
 
-type GetCategories500JSONResponse ErrorBody
+	// TotalExpense 月の支出合計
+	TotalExpense int32 `json:"total_expense"`
 
-func (response GetCategories500JSONResponse) VisitGetCategoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// TotalIncome 月の収入合計
+	TotalIncome int32 `json:"total_income"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// FetchCategorizationRuleListResponse Fetch Categorization Rule List Response
+type FetchCategorizationRuleListResponse struct {
+	CategorizationRules []CategorizationRule `json:"categorization_rules"`
 }
 
-type PostCategoriesRequestObject struct {
-	Body *PostCategoriesJSONRequestBody
+// FetchCategorizationRuleResponse Fetch Categorization Rule Response
+type FetchCategorizationRuleResponse struct {
+	// CategorizationRule CategorizationRule
+	CategorizationRule CategorizationRule `json:"categorization_rule"`
 }
 
-type PostCategoriesResponseObject interface {
-	VisitPostCategoriesResponse(w http.ResponseWriter) error
+// FetchCategoryListsResponse Fetch Category Lists Response
+type FetchCategoryListsResponse struct {
+	Categories []Category `json:"categories"`
 }
 
-type PostCategories201JSONResponse CreateCategoryResponse
-
-func (response PostCategories201JSONResponse) VisitPostCategoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
+// FetchCategoryResponse Fetch Category Response
+type FetchCategoryResponse struct {
+	// Category Category
+	Category Category `json:"category"`
 }
 
-type PostCategories400JSONResponse ErrorBody
-
-func (response PostCategories400JSONResponse) VisitPostCategoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// FetchCreditCardListResponse Fetch Credit Card List Response
+type FetchCreditCardListResponse struct {
+	CreditCards []CreditCard `json:"credit_cards"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// FetchCreditCardResponse Fetch Credit Card Response
+type FetchCreditCardResponse struct {
+	// CreditCard CreditCard
+	CreditCard CreditCard `json:"credit_card"`
 }
 
-type PostCategories500JSONResponse ErrorBody
+// FetchCreditCardStatementsResponse Fetch Credit Card Statements Response
+type FetchCreditCardStatementsResponse struct {
+	Statements []CreditCardStatement `json:"statements"`
+}
 
-func (response PostCategories500JSONResponse) VisitPostCategoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// FetchCreditCardWithdrawalsResponse Fetch Credit Card Withdrawals Response
+type FetchCreditCardWithdrawalsResponse struct {
+	Withdrawals []CreditCardWithdrawal `json:"withdrawals"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// FetchDuplicateTransactionsResponse Fetch Duplicate Transactions Response
+type FetchDuplicateTransactionsResponse struct {
+	Groups []DuplicateTransactionGroup `json:"groups"`
 }
 
-type DeleteCategoriesIdRequestObject struct {
-	Id int32 `json:"id"`
+// FetchExchangeRateListResponse Fetch Exchange Rate List Response
+type FetchExchangeRateListResponse struct {
+	ExchangeRates []ExchangeRate `json:"exchange_rates"`
 }
 
-type DeleteCategoriesIdResponseObject interface {
-	VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error
+// FetchImportProfileListResponse Fetch Import Profile List Response
+type FetchImportProfileListResponse struct {
+	ImportProfiles []ImportProfile `json:"import_profiles"`
 }
 
-type DeleteCategoriesId204Response struct {
+// FetchImportProfileResponse Fetch Import Profile Response
+type FetchImportProfileResponse struct {
+	// ImportProfile ImportProfile
+	ImportProfile ImportProfile `json:"import_profile"`
 }
 
-func (response DeleteCategoriesId204Response) VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+// FetchInstallmentPlanListResponse Fetch Installment Plan List Response
+type FetchInstallmentPlanListResponse struct {
+	InstallmentPlans []InstallmentPlan `json:"installment_plans"`
 }
 
-type DeleteCategoriesId400JSONResponse ErrorBody
+// FetchInstallmentPlanResponse Fetch Installment Plan Response
+type FetchInstallmentPlanResponse struct {
+	// InstallmentPlan InstallmentPlan
+	InstallmentPlan InstallmentPlan `json:"installment_plan"`
+}
 
-func (response DeleteCategoriesId400JSONResponse) VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// FetchReconciliationListResponse Fetch Reconciliation List Response
+type FetchReconciliationListResponse struct {
+	Reconciliations []Reconciliation `json:"reconciliations"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// FetchRecurringTransactionListResponse Fetch Recurring Transaction List Response
+type FetchRecurringTransactionListResponse struct {
+	RecurringTransactions []RecurringTransaction `json:"recurring_transactions"`
 }
 
-type DeleteCategoriesId404JSONResponse ErrorBody
+// FetchRecurringTransactionResponse Fetch Recurring Transaction Response
+type FetchRecurringTransactionResponse struct {
+	// RecurringTransaction RecurringTransaction
+	RecurringTransaction RecurringTransaction `json:"recurring_transaction"`
+}
 
-func (response DeleteCategoriesId404JSONResponse) VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// FetchTagListResponse Fetch Tag List Response
+type FetchTagListResponse struct {
+	Tags []Tag `json:"tags"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// FetchTagResponse Fetch Tag Response
+type FetchTagResponse struct {
+	// Tag Tag
+	Tag Tag `json:"tag"`
 }
 
-type DeleteCategoriesId500JSONResponse ErrorBody
+// FetchTagSummaryResponse Fetch Tag Summary Response
+type FetchTagSummaryResponse struct {
+	// EndDate 終了日
+	EndDate openapi_types.Date `json:"end_date"`
 
-func (response DeleteCategoriesId500JSONResponse) VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Items タグごとの集計
+	Items []TagSummaryItem `json:"items"`
 
-	return json.NewEncoder(w).Encode(response)
+	// StartDate 開始日
+	StartDate openapi_types.Date `json:"start_date"`
 }
 
-type GetCategoriesIdRequestObject struct {
-	Id int32 `json:"id"`
+// FetchTransactionHistoryResponse Fetch Transaction History Response
+type FetchTransactionHistoryResponse struct {
+	Histories []ChangeHistory `json:"histories"`
 }
 
-type GetCategoriesIdResponseObject interface {
-	VisitGetCategoriesIdResponse(w http.ResponseWriter) error
+// FetchTransactionListResponse Fetch Transaction List Response
+type FetchTransactionListResponse struct {
+	// NextCursor 次のページを取得するためのカーソル。続きがない場合は省略
+	NextCursor   *string       `json:"next_cursor,omitempty"`
+	Transactions []Transaction `json:"transactions"`
 }
 
-type GetCategoriesId200JSONResponse FetchCategoryResponse
-
-func (response GetCategoriesId200JSONResponse) VisitGetCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// FetchTransactionResponse Fetch Transaction Response
+type FetchTransactionResponse struct {
+	// Transaction Transaction
+	Transaction Transaction `json:"transaction"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// FetchTransferListResponse Fetch Transfer List Response
+type FetchTransferListResponse struct {
+	Transfers []Transfer `json:"transfers"`
 }
 
-type GetCategoriesId400JSONResponse ErrorBody
+// FetchTransferResponse Fetch Transfer Response
+type FetchTransferResponse struct {
+	// Transfer 口座間の振替。振替元の出金と振替先の入金の組を1件として記録し、収入・支出には含めない。取引一覧・カレンダーには含まれず、口座の残高と入出金一覧に反映される
+	Transfer Transfer `json:"transfer"`
+}
 
-func (response GetCategoriesId400JSONResponse) VisitGetCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// FetchTrashResponse Fetch Trash Response
+type FetchTrashResponse struct {
+	Budgets    []TrashedBudget   `json:"budgets"`
+	Categories []TrashedCategory `json:"categories"`
 
-	return json.NewEncoder(w).Encode(response)
+	// RetentionDays ゴミ箱の保持期間（日数）
+	RetentionDays int32                `json:"retention_days"`
+	Transactions  []TrashedTransaction `json:"transactions"`
 }
 
-type GetCategoriesId404JSONResponse ErrorBody
+// FetchTrendReportResponse Fetch Trend Report Response
+type FetchTrendReportResponse struct {
+	// Buckets 期間ごとの集計（古い順）
+	Buckets []TrendBucket `json:"buckets"`
 
-func (response GetCategoriesId404JSONResponse) VisitGetCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// Categories 集計に含まれるカテゴリ
+	Categories []Category `json:"categories"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Compare 比較対象。指定しなかった場合は省略
+	Compare *TrendCompare `json:"compare,omitempty"`
 
-type GetCategoriesId500JSONResponse ErrorBody
+	// EndDate 終了日
+	EndDate openapi_types.Date `json:"end_date"`
 
-func (response GetCategoriesId500JSONResponse) VisitGetCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Period 集計単位
+	Period TrendPeriod `json:"period"`
 
-	return json.NewEncoder(w).Encode(response)
+	// StartDate 開始日
+	StartDate openapi_types.Date `json:"start_date"`
 }
 
-type PatchCategoriesIdRequestObject struct {
-	Id   int32 `json:"id"`
-	Body *PatchCategoriesIdJSONRequestBody
+// GenerateRecurringTransactionsInput Generate Recurring Transactions Input
+type GenerateRecurringTransactionsInput struct {
+	// Until この日までの定期取引を生成する（省略時は当日）
+	Until *openapi_types.Date `json:"until,omitempty"`
 }
 
-type PatchCategoriesIdResponseObject interface {
-	VisitPatchCategoriesIdResponse(w http.ResponseWriter) error
+// GenerateRecurringTransactionsResponse Generate Recurring Transactions Response
+type GenerateRecurringTransactionsResponse struct {
+	// GeneratedCount 新たに生成された取引の件数
+	GeneratedCount int32 `json:"generated_count"`
 }
 
-type PatchCategoriesId200JSONResponse UpdateCategoryResponse
+// ImportEncoding CSVの文字コード
+type ImportEncoding string
 
-func (response PatchCategoriesId200JSONResponse) VisitPatchCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ImportExchangeRatesInput Import Exchange Rates Input
+type ImportExchangeRatesInput struct {
+	// File CSVファイル（10MB以内）
+	File openapi_types.File `json:"file"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// ImportExchangeRatesResponse Import Exchange Rates Response
+type ImportExchangeRatesResponse struct {
+	// ImportedCount 登録・上書きした件数
+	ImportedCount int32 `json:"imported_count"`
 }
 
-type PatchCategoriesId400JSONResponse ErrorBody
+// ImportProfile ImportProfile
+type ImportProfile struct {
+	// AmountColumn 金額の列番号（1始まり）
+	AmountColumn int32 `json:"amount_column"`
 
-func (response PatchCategoriesId400JSONResponse) VisitPatchCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// AmountSign 金額の符号の扱い
+	AmountSign AmountSign `json:"amount_sign"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-type PatchCategoriesId404JSONResponse ErrorBody
+	// DateColumn 日付の列番号（1始まり）
+	DateColumn int32 `json:"date_column"`
 
-func (response PatchCategoriesId404JSONResponse) VisitPatchCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// DateFormat 日付の形式（例: YYYY/MM/DD）
+	DateFormat string `json:"date_format"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// DefaultCategoryId 支出に割り当てるカテゴリID
+	DefaultCategoryId int32 `json:"default_category_id"`
 
-type PatchCategoriesId500JSONResponse ErrorBody
+	// DescriptionColumn 説明の列番号（1始まり）
+	DescriptionColumn *int32 `json:"description_column,omitempty"`
 
-func (response PatchCategoriesId500JSONResponse) VisitPatchCategoriesIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Encoding 文字コード
+	Encoding ImportEncoding `json:"encoding"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// HasHeader 1行目（skip_rows適用後）をヘッダー行として読み飛ばすか
+	HasHeader bool `json:"has_header"`
 
-type GetCsrfRequestObject struct {
-}
+	// Id 取込設定ID
+	Id int32 `json:"id"`
 
-type GetCsrfResponseObject interface {
-	VisitGetCsrfResponse(w http.ResponseWriter) error
-}
+	// IncomeCategoryId 収入に割り当てるカテゴリID。未指定の場合、収入の行はエラーになる
+	IncomeCategoryId *int32 `json:"income_category_id,omitempty"`
 
-type GetCsrf200JSONResponse CsrfResponse
+	// Name 取込設定名
+	Name string `json:"name"`
 
-func (response GetCsrf200JSONResponse) VisitGetCsrfResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// SkipRows 先頭で読み飛ばす行数
+	SkipRows int32 `json:"skip_rows"`
 
-	return json.NewEncoder(w).Encode(response)
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-type GetCsrf500JSONResponse ErrorBody
+// ImportTransactionRow Import Transaction Row
+type ImportTransactionRow struct {
+	// Amount 金額
+	Amount *int32 `json:"amount,omitempty"`
 
-func (response GetCsrf500JSONResponse) VisitGetCsrfResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// CategoryId カテゴリID
+	CategoryId *int32 `json:"category_id,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Date 取引日
+	Date *openapi_types.Date `json:"date,omitempty"`
 
-type GetTransactionsRequestObject struct {
-	Params GetTransactionsParams
-}
+	// Description 説明
+	Description *string `json:"description,omitempty"`
 
-type GetTransactionsResponseObject interface {
-	VisitGetTransactionsResponse(w http.ResponseWriter) error
+	// Errors 検証エラー（キー: 項目名、値: メッセージ）。エラーがない行は省略
+	Errors *map[string]string `json:"errors,omitempty"`
+
+	// RowNumber CSV上の行番号（1始まり）
+	RowNumber int32 `json:"row_number"`
 }
 
-type GetTransactions200JSONResponse FetchTransactionListResponse
+// ImportTransactionsInput Import Transactions Input
+type ImportTransactionsInput struct {
+	// DryRun trueの場合は登録せずに検証結果のみを返す（省略時はfalse）
+	DryRun *bool `json:"dry_run,omitempty"`
 
-func (response GetTransactions200JSONResponse) VisitGetTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// File CSVファイル
+	File openapi_types.File `json:"file"`
 
-	return json.NewEncoder(w).Encode(response)
+	// ProfileId 取込設定ID
+	ProfileId int32 `json:"profile_id"`
 }
 
-type GetTransactions400JSONResponse ErrorBody
+// ImportTransactionsResponse Import Transactions Response
+type ImportTransactionsResponse struct {
+	// AcceptedCount 検証を通過した行数
+	AcceptedCount int32 `json:"accepted_count"`
 
-func (response GetTransactions400JSONResponse) VisitGetTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// DryRun 検証のみ（登録なし）で実行したか
+	DryRun bool `json:"dry_run"`
 
-	return json.NewEncoder(w).Encode(response)
+	// ImportedCount 登録した取引の件数（dry_runの場合は0）
+	ImportedCount int32 `json:"imported_count"`
+
+	// RejectedCount エラーのあった行数
+	RejectedCount int32 `json:"rejected_count"`
+
+	// Rows 行ごとの検証結果
+	Rows []ImportTransactionRow `json:"rows"`
 }
 
-type GetTransactions500JSONResponse ErrorBody
+// InstallmentPlan InstallmentPlan
+type InstallmentPlan struct {
+	// AnnualInterestRate 手数料の実質年率（%）。手数料がない場合は省略
+	AnnualInterestRate *string `json:"annual_interest_rate,omitempty"`
 
-func (response GetTransactions500JSONResponse) VisitGetTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// FirstBillingMonth 初回の支払月（YYYY-MM形式）
+	FirstBillingMonth string `json:"first_billing_month"`
 
-type PostTransactionsRequestObject struct {
-	Body *PostTransactionsJSONRequestBody
-}
+	// Id 分割払いID
+	Id int32 `json:"id"`
 
-type PostTransactionsResponseObject interface {
-	VisitPostTransactionsResponse(w http.ResponseWriter) error
-}
+	// Payments 支払回数
+	Payments int32 `json:"payments"`
 
-type PostTransactions201JSONResponse CreateTransactionResponse
+	// Principal 購入金額（基準通貨の補助単位）
+	Principal int32 `json:"principal"`
 
-func (response PostTransactions201JSONResponse) VisitPostTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// PurchaseCurrency 購入した取引の通貨（ISO 4217）。分割払いを取り消すと取引をこの通貨に戻す
+	PurchaseCurrency string `json:"purchase_currency"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// PurchaseDate 購入日（分割払いにする前の取引の日付）。1回目の支払いの取引の日付は初回の支払月の開始日になる
+	PurchaseDate openapi_types.Date `json:"purchase_date"`
 
-type PostTransactions400JSONResponse ErrorBody
+	// PurchaseExchangeRate 購入した取引の換算に使ったレート（1 purchase_currency あたりの基準通貨の額）。基準通貨の取引の場合は省略
+	PurchaseExchangeRate *string `json:"purchase_exchange_rate,omitempty"`
 
-func (response PostTransactions400JSONResponse) VisitPostTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// PurchaseOriginalAmount 購入した取引の通貨での元の金額（補助単位）
+	PurchaseOriginalAmount int32 `json:"purchase_original_amount"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// TotalInterest 手数料の合計（基準通貨の補助単位）
+	TotalInterest int32 `json:"total_interest"`
 
-type PostTransactions500JSONResponse ErrorBody
+	// Transactions 各回の支払いの取引。支払回の順
+	Transactions []Transaction `json:"transactions"`
 
-func (response PostTransactions500JSONResponse) VisitPostTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
 
-	return json.NewEncoder(w).Encode(response)
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-type DeleteTransactionsIdRequestObject struct {
-	Id int32 `json:"id"`
-}
+// MergeDuplicateTransactionsInput Merge Duplicate Transactions Input
+type MergeDuplicateTransactionsInput struct {
+	// DuplicateIds ゴミ箱に移動する取引のID（1〜20件）
+	DuplicateIds []int32 `json:"duplicate_ids"`
 
-type DeleteTransactionsIdResponseObject interface {
-	VisitDeleteTransactionsIdResponse(w http.ResponseWriter) error
+	// KeepId 残す取引のID
+	KeepId int32 `json:"keep_id"`
 }
 
-type DeleteTransactionsId204Response struct {
+// MergeDuplicateTransactionsResponse Merge Duplicate Transactions Response
+type MergeDuplicateTransactionsResponse struct {
+	// Transaction 残した取引
+	Transaction Transaction `json:"transaction"`
 }
 
-func (response DeleteTransactionsId204Response) VisitDeleteTransactionsIdResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// ModelsMonthStartAdjustment 月の開始日が土日に当たる場合の調整方法
+type ModelsMonthStartAdjustment string
 
-type DeleteTransactionsId404JSONResponse ErrorBody
+// ModelsUser User
+type ModelsUser struct {
+	// BaseCurrency 基準通貨（ISO 4217）。集計や取引一覧の金額はこの通貨に換算される
+	BaseCurrency string `json:"base_currency"`
 
-func (response DeleteTransactionsId404JSONResponse) VisitDeleteTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Email メールアドレス
+	Email string `json:"email"`
 
-type DeleteTransactionsId500JSONResponse ErrorBody
+	// Id ユーザーID
+	Id int32 `json:"id"`
 
-func (response DeleteTransactionsId500JSONResponse) VisitDeleteTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// MonthStartAdjustment 月の開始日が土日に当たる場合の調整方法
+	MonthStartAdjustment ModelsMonthStartAdjustment `json:"month_start_adjustment"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// MonthStartDay 月の開始日（1〜28）。予算の対象月YYYY-MMは、その月の開始日から翌月の開始日の前日までの期間になる
+	MonthStartDay int32 `json:"month_start_day"`
 
-type GetTransactionsIdRequestObject struct {
-	Id int32 `json:"id"`
-}
+	// Name ユーザー名
+	Name string `json:"name"`
 
-type GetTransactionsIdResponseObject interface {
-	VisitGetTransactionsIdResponse(w http.ResponseWriter) error
+	// TimeZone タイムゾーン（IANAタイムゾーン名）。「今日」や「今月」などの日付の境界はこのタイムゾーンで判定する
+	TimeZone string `json:"time_zone"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
 }
 
-type GetTransactionsId200JSONResponse FetchTransactionResponse
+// RecategorizeTransactionsFilter Recategorize Transactions Filter
+type RecategorizeTransactionsFilter struct {
+	// CategoryId カテゴリID
+	CategoryId *int32 `json:"category_id,omitempty"`
 
-func (response GetTransactionsId200JSONResponse) VisitGetTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// CategoryIds カテゴリIDのいずれかに一致する取引を対象とする
+	CategoryIds *[]int32 `json:"category_ids,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// EndDate 終了日（YYYY-MM-DD形式）
+	EndDate *string `json:"end_date,omitempty"`
 
-type GetTransactionsId400JSONResponse ErrorBody
+	// ExcludeCategoryIds 除外するカテゴリID
+	ExcludeCategoryIds *[]int32 `json:"exclude_category_ids,omitempty"`
 
-func (response GetTransactionsId400JSONResponse) VisitGetTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// MaxAmount 金額の上限
+	MaxAmount *int32 `json:"max_amount,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// MinAmount 金額の下限
+	MinAmount *int32 `json:"min_amount,omitempty"`
 
-type GetTransactionsId404JSONResponse ErrorBody
+	// Q 説明のキーワード。空白区切りで指定した場合はすべてを含む取引を対象とする
+	Q *string `json:"q,omitempty"`
 
-func (response GetTransactionsId404JSONResponse) VisitGetTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// StartDate 開始日（YYYY-MM-DD形式）
+	StartDate *string `json:"start_date,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Type カテゴリタイプ（income/expense）
+	Type *CategoryType `json:"type,omitempty"`
 }
 
-type GetTransactionsId500JSONResponse ErrorBody
+// RecategorizeTransactionsInput Recategorize Transactions Input
+type RecategorizeTransactionsInput struct {
+	// CategoryId 移動先のカテゴリID
+	CategoryId int32 `json:"category_id"`
 
-func (response GetTransactionsId500JSONResponse) VisitGetTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Filter 対象の取引の条件。少なくとも1つ指定する
+	Filter RecategorizeTransactionsFilter `json:"filter"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// RecategorizeTransactionsResponse Recategorize Transactions Response
+type RecategorizeTransactionsResponse struct {
+	// UpdatedCount カテゴリを変更した取引の件数
+	UpdatedCount int32 `json:"updated_count"`
 }
 
-type PatchTransactionsIdRequestObject struct {
-	Id   int32 `json:"id"`
-	Body *PatchTransactionsIdJSONRequestBody
+// ReconcileAccountInput Reconcile Account Input
+type ReconcileAccountInput struct {
+	// DryRun trueの場合は差額を計算するのみで照合しない
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// ExpectedBalance 明細に記載された残高
+	ExpectedBalance int32 `json:"expected_balance"`
+
+	// StatementDate 明細の日付
+	StatementDate openapi_types.Date `json:"statement_date"`
 }
 
-type PatchTransactionsIdResponseObject interface {
-	VisitPatchTransactionsIdResponse(w http.ResponseWriter) error
+// ReconcileAccountResponse Reconcile Account Response
+type ReconcileAccountResponse struct {
+	// Result ReconciliationResult
+	Result ReconciliationResult `json:"result"`
 }
 
-type PatchTransactionsId200JSONResponse UpdateTransactionResponse
+// Reconciliation Reconciliation
+type Reconciliation struct {
+	// AccountId 口座ID
+	AccountId int32 `json:"account_id"`
 
-func (response PatchTransactionsId200JSONResponse) VisitPatchTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Balance 明細の日付時点の残高
+	Balance int32 `json:"balance"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-type PatchTransactionsId400JSONResponse ErrorBody
+	// Id 照合ID
+	Id int32 `json:"id"`
 
-func (response PatchTransactionsId400JSONResponse) VisitPatchTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// StatementDate 明細の日付
+	StatementDate openapi_types.Date `json:"statement_date"`
 
-	return json.NewEncoder(w).Encode(response)
+	// TransactionCount 照合済みにした取引の件数
+	TransactionCount int32 `json:"transaction_count"`
+
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-type PatchTransactionsId404JSONResponse ErrorBody
+// ReconciliationResult ReconciliationResult
+type ReconciliationResult struct {
+	// ClearedBalance 明細の日付までの消込済みの残高（開始残高に、消込済みの取引の収入・支出とすべての振替の入出金を反映したもの）
+	ClearedBalance int32 `json:"cleared_balance"`
 
-func (response PatchTransactionsId404JSONResponse) VisitPatchTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ClearedCount 明細の日付までの消込済みで未照合の取引の件数（照合すると照合済みになる取引）
+	ClearedCount int32 `json:"cleared_count"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Difference 明細の残高と消込済みの残高の差額（expected_balance - cleared_balance）
+	Difference int32 `json:"difference"`
 
-type PatchTransactionsId500JSONResponse ErrorBody
+	// ExpectedBalance 明細の残高
+	ExpectedBalance int32 `json:"expected_balance"`
 
-func (response PatchTransactionsId500JSONResponse) VisitPatchTransactionsIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Reconciliation 照合した場合の照合結果。dry_runの場合や差額がある場合は省略
+	Reconciliation *Reconciliation `json:"reconciliation,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// StatementDate 明細の日付
+	StatementDate openapi_types.Date `json:"statement_date"`
 
-type GetUsersCheckSignedInRequestObject struct {
+	// UnclearedCount 明細の日付までの未消込の取引の件数
+	UnclearedCount int32 `json:"uncleared_count"`
 }
 
-type GetUsersCheckSignedInResponseObject interface {
-	VisitGetUsersCheckSignedInResponse(w http.ResponseWriter) error
-}
+// RecurrenceFrequency 繰り返しの頻度
+type RecurrenceFrequency string
 
-type GetUsersCheckSignedIn200JSONResponse UserUserCheckSignedInResponse
+// RecurringTransaction RecurringTransaction
+type RecurringTransaction struct {
+	// Amount 金額（基準通貨）
+	Amount int32 `json:"amount"`
 
-func (response GetUsersCheckSignedIn200JSONResponse) VisitGetUsersCheckSignedInResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Category カテゴリ情報
+	Category Category `json:"category"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
 
-type PostUsersSignInRequestObject struct {
-	Body *PostUsersSignInJSONRequestBody
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
+
+	// DayOfMonth 日（1〜31）。月末を超える場合はその月の末日
+	DayOfMonth *int32 `json:"day_of_month,omitempty"`
+
+	// DayOfWeek 曜日（0=日曜〜6=土曜）
+	DayOfWeek *int32 `json:"day_of_week,omitempty"`
+
+	// Description 説明
+	Description string `json:"description"`
+
+	// EndDate 終了日
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Frequency 繰り返しの頻度
+	Frequency RecurrenceFrequency `json:"frequency"`
+
+	// GeneratedUntil 取引を生成済みの最終日
+	GeneratedUntil *openapi_types.Date `json:"generated_until,omitempty"`
+
+	// Id 定期取引ID
+	Id int32 `json:"id"`
+
+	// Interval 繰り返しの間隔（例: frequencyがmonthlyで3なら3ヶ月ごと）
+	Interval int32 `json:"interval"`
+
+	// MonthOfYear 月（1〜12）
+	MonthOfYear *int32 `json:"month_of_year,omitempty"`
+
+	// StartDate 開始日
+	StartDate openapi_types.Date `json:"start_date"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-type PostUsersSignInResponseObject interface {
-	VisitPostUsersSignInResponse(w http.ResponseWriter) error
+// RestoreBudgetResponse Restore Budget Response
+type RestoreBudgetResponse struct {
+	// Budget Budget
+	Budget Budget `json:"budget"`
 }
 
-type PostUsersSignIn200ResponseHeaders struct {
-	SetCookie string
+// RestoreCategoryResponse Restore Category Response
+type RestoreCategoryResponse struct {
+	// Category Category
+	Category Category `json:"category"`
 }
 
-type PostUsersSignIn200JSONResponse struct {
-	Body    UserUserSignInResponse
-	Headers PostUsersSignIn200ResponseHeaders
+// RestoreTransactionResponse Restore Transaction Response
+type RestoreTransactionResponse struct {
+	// Transaction Transaction
+	Transaction Transaction `json:"transaction"`
 }
 
-func (response PostUsersSignIn200JSONResponse) VisitPostUsersSignInResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("set-cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+// SortOrder 並び順
+type SortOrder string
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+// Tag Tag
+type Tag struct {
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-type PostUsersSignIn400JSONResponse ErrorBody
+	// Id タグID
+	Id int32 `json:"id"`
 
-func (response PostUsersSignIn400JSONResponse) VisitPostUsersSignInResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Name タグ名
+	Name string `json:"name"`
 
-	return json.NewEncoder(w).Encode(response)
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-type PostUsersSignIn401JSONResponse ErrorBody
+// TagSummaryItem Tag Summary Item
+type TagSummaryItem struct {
+	// Expense 支出合計
+	Expense int32 `json:"expense"`
 
-func (response PostUsersSignIn401JSONResponse) VisitPostUsersSignInResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// Income 収入合計
+	Income int32 `json:"income"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Tag タグ情報
+	Tag Tag `json:"tag"`
+
+	// TagId タグID
+	TagId int32 `json:"tag_id"`
+
+	// TransactionCount 取引件数
+	TransactionCount int32 `json:"transaction_count"`
 }
 
-type PostUsersSignIn500JSONResponse ErrorBody
+// Transaction Transaction
+type Transaction struct {
+	// AccountId 口座ID
+	AccountId *int32 `json:"account_id,omitempty"`
 
-func (response PostUsersSignIn500JSONResponse) VisitPostUsersSignInResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// Amount 基準通貨に換算した金額（基準通貨の補助単位）
+	Amount int32 `json:"amount"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Category カテゴリ情報
+	Category Category `json:"category"`
 
-type PostUsersSignOutRequestObject struct {
-}
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
 
-type PostUsersSignOutResponseObject interface {
-	VisitPostUsersSignOutResponse(w http.ResponseWriter) error
-}
+	// Cleared 消込済み（銀行の明細で確認済み）かどうか
+	Cleared bool `json:"cleared"`
 
-type PostUsersSignOut200ResponseHeaders struct {
-	SetCookie string
-}
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-type PostUsersSignOut200JSONResponse struct {
-	Body    UserUserSignOutResponse
-	Headers PostUsersSignOut200ResponseHeaders
-}
+	// CreditCardId クレジットカードID
+	CreditCardId *int32 `json:"credit_card_id,omitempty"`
 
-func (response PostUsersSignOut200JSONResponse) VisitPostUsersSignOutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("set-cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+	// Currency 取引の通貨（ISO 4217）
+	Currency string `json:"currency"`
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	// Date 取引日
+	Date openapi_types.Date `json:"date"`
 
-type PostUsersSignOut500JSONResponse ErrorBody
+	// Description 説明
+	Description string `json:"description"`
 
-func (response PostUsersSignOut500JSONResponse) VisitPostUsersSignOutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ExchangeRate 換算に使ったレート（1 currency あたりの基準通貨の額）。基準通貨の取引の場合は省略
+	ExchangeRate *string `json:"exchange_rate,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Id 取引ID
+	Id int32 `json:"id"`
 
-type PostUsersSignUpRequestObject struct {
-	Body *PostUsersSignUpJSONRequestBody
-}
+	// InstallmentNumber 分割払いの支払回（1から始まる）
+	InstallmentNumber *int32 `json:"installment_number,omitempty"`
 
-type PostUsersSignUpResponseObject interface {
-	VisitPostUsersSignUpResponse(w http.ResponseWriter) error
-}
+	// InstallmentPlanId 分割払いID
+	InstallmentPlanId *int32 `json:"installment_plan_id,omitempty"`
 
-type PostUsersSignUp200ResponseHeaders struct {
-	SetCookie string
-}
+	// OriginalAmount 取引の通貨での元の金額（補助単位。USDであればセント）
+	OriginalAmount int32 `json:"original_amount"`
 
-type PostUsersSignUp200JSONResponse struct {
-	Body    UserUserSignUpResponse
-	Headers PostUsersSignUp200ResponseHeaders
-}
+	// ReconciliationId 照合ID。照合済みの取引はロックされ、ロックを解除するまで更新・削除できない
+	ReconciliationId *int32 `json:"reconciliation_id,omitempty"`
 
-func (response PostUsersSignUp200JSONResponse) VisitPostUsersSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("set-cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+	// RecurringTransactionId 生成元の定期取引ID
+	RecurringTransactionId *int32 `json:"recurring_transaction_id,omitempty"`
 
-	return json.NewEncoder(w).Encode(response.Body)
+	// Splits 分割明細。分割していない場合は空
+	Splits []TransactionSplit `json:"splits"`
+
+	// Tags タグ
+	Tags []Tag `json:"tags"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-type PostUsersSignUp400JSONResponse ErrorBody
+// TransactionExportFormat 取引のエクスポート形式
+type TransactionExportFormat string
 
-func (response PostUsersSignUp400JSONResponse) VisitPostUsersSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// TransactionSortKey 取引一覧の並び替えキー
+type TransactionSortKey string
 
-	return json.NewEncoder(w).Encode(response)
+// TransactionSplit TransactionSplit
+type TransactionSplit struct {
+	// Amount 基準通貨に換算した金額
+	Amount int32 `json:"amount"`
+
+	// Category カテゴリ情報
+	Category Category `json:"category"`
+
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
+
+	// Id 分割明細ID
+	Id int32 `json:"id"`
+
+	// Memo メモ
+	Memo string `json:"memo"`
+
+	// OriginalAmount 取引の通貨での元の金額
+	OriginalAmount int32 `json:"original_amount"`
 }
 
-type PostUsersSignUp409JSONResponse ErrorBody
+// TransactionSplitInput Transaction Split Input
+type TransactionSplitInput struct {
+	// Amount 金額（取引の通貨の補助単位）
+	Amount int32 `json:"amount"`
 
-func (response PostUsersSignUp409JSONResponse) VisitPostUsersSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Memo メモ
+	Memo *string `json:"memo,omitempty"`
 }
 
-type PostUsersSignUp500JSONResponse ErrorBody
+// Transfer 口座間の振替。振替元の出金と振替先の入金の組を1件として記録し、収入・支出には含めない。取引一覧・カレンダーには含まれず、口座の残高と入出金一覧に反映される
+type Transfer struct {
+	// Amount 金額
+	Amount int32 `json:"amount"`
 
-func (response PostUsersSignUp500JSONResponse) VisitPostUsersSignUpResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// CreatedAt 作成日時
+	CreatedAt time.Time `json:"created_at"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Date 振替日
+	Date openapi_types.Date `json:"date"`
+
+	// Description 説明
+	Description string `json:"description"`
+
+	// FromAccount 振替元の口座情報
+	FromAccount Account `json:"from_account"`
+
+	// FromAccountId 振替元の口座ID
+	FromAccountId int32 `json:"from_account_id"`
+
+	// Id 振替ID
+	Id int32 `json:"id"`
+
+	// ToAccount 振替先の口座情報
+	ToAccount Account `json:"to_account"`
+
+	// ToAccountId 振替先の口座ID
+	ToAccountId int32 `json:"to_account_id"`
+
+	// UpdatedAt 更新日時
+	UpdatedAt time.Time `json:"updated_at"`
+
+	// UserId ユーザーID
+	UserId int32 `json:"user_id"`
 }
 
-// StrictServerInterface represents all server handlers.
+// TrashedBudget Trashed Budget
+type TrashedBudget struct {
+	// Budget 予算情報
+	Budget Budget `json:"budget"`
+
+	// DeletedAt ゴミ箱に移動した日時
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// PurgeAt 自動的に完全削除される日時
+	PurgeAt time.Time `json:"purge_at"`
+}
+
+// TrashedCategory Trashed Category
+type TrashedCategory struct {
+	// Category カテゴリ情報
+	Category Category `json:"category"`
+
+	// DeletedAt ゴミ箱に移動した日時
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// PurgeAt 自動的に完全削除される日時
+	PurgeAt time.Time `json:"purge_at"`
+}
+
+// TrashedTransaction Trashed Transaction
+type TrashedTransaction struct {
+	// DeletedAt ゴミ箱に移動した日時
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// PurgeAt 自動的に完全削除される日時
+	PurgeAt time.Time `json:"purge_at"`
+
+	// Transaction 取引情報
+	Transaction Transaction `json:"transaction"`
+}
+
+// TrendBucket Trend Bucket
+type TrendBucket struct {
+	// Categories カテゴリごとの集計。今回・比較対象のいずれにも取引のないカテゴリは含まない
+	Categories []TrendCategoryItem `json:"categories"`
+
+	// Comparison 比較対象の期間との比較。compare を指定した場合のみ
+	Comparison *TrendComparison `json:"comparison,omitempty"`
+
+	// EndDate 終了日
+	EndDate openapi_types.Date `json:"end_date"`
+
+	// Expense 支出合計
+	Expense int32 `json:"expense"`
+
+	// Income 収入合計
+	Income int32 `json:"income"`
+
+	// Net 収支（収入 - 支出）
+	Net int32 `json:"net"`
+
+	// StartDate 開始日
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
+// TrendCategoryItem Trend Category Item
+type TrendCategoryItem struct {
+	// CategoryId カテゴリID
+	CategoryId int32 `json:"category_id"`
+
+	// Comparison 比較対象の期間との比較。compare を指定した場合のみ
+	Comparison *TrendComparison `json:"comparison,omitempty"`
+
+	// Expense 支出合計
+	Expense int32 `json:"expense"`
+
+	// Income 収入合計
+	Income int32 `json:"income"`
+
+	// Net 収支（収入 - 支出）
+	Net int32 `json:"net"`
+}
+
+// TrendCompare 推移レポートの比較対象
+type TrendCompare string
+
+// TrendComparison Trend Comparison
+type TrendComparison struct {
+	// EndDate 比較対象の終了日
+	EndDate openapi_types.Date `json:"end_date"`
+
+	// Expense 比較対象の支出合計
+	Expense int32 `json:"expense"`
+
+	// ExpenseChangeRate 支出の増減率（%、小数第1位まで）。比較対象の支出が0の場合は省略
+	ExpenseChangeRate *float64 `json:"expense_change_rate,omitempty"`
+
+	// ExpenseDelta 支出の増減（今回 - 比較対象）
+	ExpenseDelta int32 `json:"expense_delta"`
+
+	// Income 比較対象の収入合計
+	Income int32 `json:"income"`
+
+	// IncomeChangeRate 収入の増減率（%、小数第1位まで）。比較対象の収入が0の場合は省略
+	IncomeChangeRate *float64 `json:"income_change_rate,omitempty"`
+
+	// IncomeDelta 収入の増減（今回 - 比較対象）
+	IncomeDelta int32 `json:"income_delta"`
+
+	// Net 比較対象の収支（収入 - 支出）
+	Net int32 `json:"net"`
+
+	// NetDelta 収支の増減（今回 - 比較対象）
+	NetDelta int32 `json:"net_delta"`
+
+	// StartDate 比較対象の開始日
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
+// TrendPeriod 推移レポートの集計単位
+type TrendPeriod string
+
+// UnlockTransactionResponse Unlock Transaction Response
+type UnlockTransactionResponse struct {
+	// Transaction Transaction
+	Transaction Transaction `json:"transaction"`
+}
+
+// UpdateAccountInput Update Account Input (partial update)
+type UpdateAccountInput struct {
+	// Name 口座名
+	Name *string `json:"name,omitempty"`
+
+	// OpeningBalance 開始残高
+	OpeningBalance *int32 `json:"opening_balance,omitempty"`
+
+	// Type 口座の種類
+	Type *AccountType `json:"type,omitempty"`
+}
+
+// UpdateAccountResponse Update Account Response
+type UpdateAccountResponse struct {
+	// Account Account
+	Account Account `json:"account"`
+}
+
+// UpdateBudgetInput Update Budget Input (partial update)
+type UpdateBudgetInput struct {
+	// Amount 予算額
+	Amount *int32 `json:"amount,omitempty"`
+
+	// CategoryId カテゴリID
+	CategoryId *int32 `json:"category_id,omitempty"`
+
+	// Month 対象月（YYYY-MM形式）
+	Month *string `json:"month,omitempty"`
+}
+
+// UpdateBudgetResponse Update Budget Response
+type UpdateBudgetResponse struct {
+	// Budget Budget
+	Budget Budget `json:"budget"`
+}
+
+// UpdateBudgetTemplateInput Update Budget Template Input (partial update)
+type UpdateBudgetTemplateInput struct {
+	// Items カテゴリごとの予算額（1〜100件、カテゴリの重複不可）。指定した場合はすべて置き換える
+	Items *[]BudgetTemplateItemInput `json:"items,omitempty"`
+
+	// Name テンプレート名
+	Name *string `json:"name,omitempty"`
+}
+
+// UpdateBudgetTemplateResponse Update Budget Template Response
+type UpdateBudgetTemplateResponse struct {
+	// BudgetTemplate BudgetTemplate
+	BudgetTemplate BudgetTemplate `json:"budget_template"`
+}
+
+// UpdateCategorizationRuleInput Update Categorization Rule Input (partial update)
+type UpdateCategorizationRuleInput struct {
+	// CategoryId 一致した取引に設定するカテゴリID
+	CategoryId *int32 `json:"category_id,omitempty"`
+
+	// MatchType 説明との一致方法
+	MatchType *CategorizationMatchType `json:"match_type,omitempty"`
+
+	// MaxAmount 金額（基準通貨）の上限（この金額を含む）
+	MaxAmount *int32 `json:"max_amount,omitempty"`
+
+	// MinAmount 金額（基準通貨）の下限（この金額を含む）
+	MinAmount *int32 `json:"min_amount,omitempty"`
+
+	// Pattern 説明と照合する文字列または正規表現
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Priority 優先度。小さいほど先に判定する
+	Priority *int32 `json:"priority,omitempty"`
+}
+
+// UpdateCategorizationRuleResponse Update Categorization Rule Response
+type UpdateCategorizationRuleResponse struct {
+	// CategorizationRule CategorizationRule
+	CategorizationRule CategorizationRule `json:"categorization_rule"`
+}
+
+// UpdateCategoryInput Update Category Input (partial update)
+type UpdateCategoryInput struct {
+	// BudgetRollover 予算の繰越方法
+	BudgetRollover *BudgetRollover `json:"budget_rollover,omitempty"`
+
+	// Color カテゴリの色
+	Color *string `json:"color,omitempty"`
+
+	// Name カテゴリ名
+	Name *string `json:"name,omitempty"`
+}
+
+// UpdateCategoryResponse Update Category Response
+type UpdateCategoryResponse struct {
+	// Category Category
+	Category Category `json:"category"`
+}
+
+// UpdateCreditCardInput Update Credit Card Input (partial update)
+type UpdateCreditCardInput struct {
+	// ClosingDay 締め日（1〜31）。月末締めの場合は31
+	ClosingDay *int32 `json:"closing_day,omitempty"`
+
+	// Name カード名
+	Name *string `json:"name,omitempty"`
+
+	// PaymentDay 支払日（1〜31）。月末払いの場合は31
+	PaymentDay *int32 `json:"payment_day,omitempty"`
+
+	// PaymentMonthOffset 締め日から支払日までの月数（1〜2）
+	PaymentMonthOffset *int32 `json:"payment_month_offset,omitempty"`
+}
+
+// UpdateCreditCardResponse Update Credit Card Response
+type UpdateCreditCardResponse struct {
+	// CreditCard CreditCard
+	CreditCard CreditCard `json:"credit_card"`
+}
+
+// UpdateExchangeRateInput Update Exchange Rate Input (partial update)
+type UpdateExchangeRateInput struct {
+	// Date 適用開始日
+	Date *openapi_types.Date `json:"date,omitempty"`
+
+	// Rate レート。1 currency あたりの基準通貨の額（整数部・小数部それぞれ10桁以内）
+	Rate *string `json:"rate,omitempty"`
+}
+
+// UpdateExchangeRateResponse Update Exchange Rate Response
+type UpdateExchangeRateResponse struct {
+	// ExchangeRate ExchangeRate
+	ExchangeRate ExchangeRate `json:"exchange_rate"`
+}
+
+// UpdateImportProfileInput Update Import Profile Input (partial update)
+type UpdateImportProfileInput struct {
+	// AmountColumn 金額の列番号（1始まり）
+	AmountColumn *int32 `json:"amount_column,omitempty"`
+
+	// AmountSign 金額の符号の扱い
+	AmountSign *AmountSign `json:"amount_sign,omitempty"`
+
+	// DateColumn 日付の列番号（1始まり）
+	DateColumn *int32 `json:"date_column,omitempty"`
+
+	// DateFormat 日付の形式（例: YYYY/MM/DD）
+	DateFormat *string `json:"date_format,omitempty"`
+
+	// DefaultCategoryId 支出に割り当てるカテゴリID
+	DefaultCategoryId *int32 `json:"default_category_id,omitempty"`
+
+	// DescriptionColumn 説明の列番号（1始まり）
+	DescriptionColumn *int32 `json:"description_column,omitempty"`
+
+	// Encoding 文字コード
+	Encoding *ImportEncoding `json:"encoding,omitempty"`
+
+	// HasHeader 1行目（skip_rows適用後）をヘッダー行として読み飛ばすか
+	HasHeader *bool `json:"has_header,omitempty"`
+
+	// IncomeCategoryId 収入に割り当てるカテゴリID
+	IncomeCategoryId *int32 `json:"income_category_id,omitempty"`
+
+	// Name 取込設定名
+	Name *string `json:"name,omitempty"`
+
+	// SkipRows 先頭で読み飛ばす行数
+	SkipRows *int32 `json:"skip_rows,omitempty"`
+}
+
+// UpdateImportProfileResponse Update Import Profile Response
+type UpdateImportProfileResponse struct {
+	// ImportProfile ImportProfile
+	ImportProfile ImportProfile `json:"import_profile"`
+}
+
+// UpdateRecurringTransactionInput Update Recurring Transaction Input (partial update)
+type UpdateRecurringTransactionInput struct {
+	// Amount 金額（基準通貨）
+	Amount *int32 `json:"amount,omitempty"`
+
+	// CategoryId カテゴリID
+	CategoryId *int32 `json:"category_id,omitempty"`
+
+	// DayOfMonth 日（1〜31）
+	DayOfMonth *int32 `json:"day_of_month,omitempty"`
+
+	// DayOfWeek 曜日（0=日曜〜6=土曜）
+	DayOfWeek *int32 `json:"day_of_week,omitempty"`
+
+	// Description 説明
+	Description *string `json:"description,omitempty"`
+
+	// EndDate 終了日
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Frequency 繰り返しの頻度
+	Frequency *RecurrenceFrequency `json:"frequency,omitempty"`
+
+	// Interval 繰り返しの間隔
+	Interval *int32 `json:"interval,omitempty"`
+
+	// MonthOfYear 月（1〜12）
+	MonthOfYear *int32 `json:"month_of_year,omitempty"`
+
+	// StartDate 開始日
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// UpdateRecurringTransactionResponse Update Recurring Transaction Response
+type UpdateRecurringTransactionResponse struct {
+	// RecurringTransaction RecurringTransaction
+	RecurringTransaction RecurringTransaction `json:"recurring_transaction"`
+}
+
+// UpdateTagInput Update Tag Input (partial update)
+type UpdateTagInput struct {
+	// Name タグ名
+	Name *string `json:"name,omitempty"`
+}
+
+// UpdateTagResponse Update Tag Response
+type UpdateTagResponse struct {
+	// Tag Tag
+	Tag Tag `json:"tag"`
+}
+
+// UpdateTransactionInput Update Transaction Input (partial update)
+type UpdateTransactionInput struct {
+	// AccountId 口座ID
+	AccountId *int32 `json:"account_id,omitempty"`
+
+	// Amount 金額（取引の通貨の補助単位。USDであればセント）
+	Amount *int32 `json:"amount,omitempty"`
+
+	// CategoryId カテゴリID
+	CategoryId *int32 `json:"category_id,omitempty"`
+
+	// Cleared 消込済み（銀行の明細で確認済み）かどうか
+	Cleared *bool `json:"cleared,omitempty"`
+
+	// CreditCardId クレジットカードID
+	CreditCardId *int32 `json:"credit_card_id,omitempty"`
+
+	// Currency 通貨（ISO 4217、省略時は基準通貨）。基準通貨以外の場合は取引日以前で最新の為替レートで換算する
+	Currency *string `json:"currency,omitempty"`
+
+	// Date 取引日
+	Date *openapi_types.Date `json:"date,omitempty"`
+
+	// Description 説明
+	Description *string `json:"description,omitempty"`
+
+	// Splits 分割明細（2〜20件）。金額の合計は取引の金額と一致させる。空配列を指定すると分割を解除する
+	Splits *[]TransactionSplitInput `json:"splits,omitempty"`
+
+	// Tags タグIDの一覧（20件以内）。指定した場合は置き換え、空配列を指定するとすべて外す
+	Tags *[]int32 `json:"tags,omitempty"`
+}
+
+// UpdateTransactionResponse Update Transaction Response
+type UpdateTransactionResponse struct {
+	// Transaction Transaction
+	Transaction Transaction `json:"transaction"`
+}
+
+// UploadAttachmentInput Upload Attachment Input
+type UploadAttachmentInput struct {
+	// File 添付するファイル（JPEG・PNG・WebP・PDF、10MB以内）
+	File openapi_types.File `json:"file"`
+}
+
+// UploadAttachmentResponse Upload Attachment Response
+type UploadAttachmentResponse struct {
+	// Attachment Attachment
+	Attachment Attachment `json:"attachment"`
+}
+
+// UserFetchCurrentUserResponse Fetch Current User Response
+type UserFetchCurrentUserResponse struct {
+	// User User
+	User ModelsUser `json:"user"`
+}
+
+// UserSignInInput Sign In Input
+type UserSignInInput struct {
+	// Email メールアドレス
+	Email string `json:"email"`
+
+	// Password パスワード
+	Password string `json:"password"`
+}
+
+// UserSignUpInput Sign Up Input
+type UserSignUpInput struct {
+	// BaseCurrency 基準通貨（ISO 4217、省略時はJPY）
+	BaseCurrency *string `json:"base_currency,omitempty"`
+
+	// Email メールアドレス
+	Email string `json:"email"`
+
+	// Name ユーザー名
+	Name string `json:"name"`
+
+	// Password パスワード
+	Password string `json:"password"`
+
+	// TimeZone タイムゾーン（IANAタイムゾーン名、省略時はAsia/Tokyo）
+	TimeZone *string `json:"time_zone,omitempty"`
+}
+
+// UserUpdateCurrentUserInput Update Current User Input (partial update)
+type UserUpdateCurrentUserInput struct {
+	// BaseCurrency 基準通貨（ISO 4217）
+	BaseCurrency *string `json:"base_currency,omitempty"`
+
+	// MonthStartAdjustment 月の開始日が土日に当たる場合の調整方法
+	MonthStartAdjustment *ModelsMonthStartAdjustment `json:"month_start_adjustment,omitempty"`
+
+	// MonthStartDay 月の開始日（1〜28）
+	MonthStartDay *int32 `json:"month_start_day,omitempty"`
+
+	// Name ユーザー名
+	Name *string `json:"name,omitempty"`
+
+	// TimeZone タイムゾーン（IANAタイムゾーン名。例: Asia/Tokyo）
+	TimeZone *string `json:"time_zone,omitempty"`
+}
+
+// UserUpdateCurrentUserResponse Update Current User Response
+type UserUpdateCurrentUserResponse struct {
+	// User User
+	User ModelsUser `json:"user"`
+}
+
+// UserUserCheckSignedInResponse User CheckSignedIn Response
+type UserUserCheckSignedInResponse struct {
+	// IsSignedIn ログイン状態
+	IsSignedIn bool `json:"is_signed_in"`
+}
+
+// UserUserSignInResponse User Sign In Response
+type UserUserSignInResponse = map[string]interface{}
+
+// UserUserSignOutResponse User Sign Out Response
+type UserUserSignOutResponse struct {
+	// Message メッセージ
+	Message string `json:"message"`
+}
+
+// UserUserSignUpResponse User Sign Up Response
+type UserUserSignUpResponse struct {
+	// Message メッセージ
+	Message string `json:"message"`
+}
+
+// GetAccountsBalancesParams defines parameters for GetAccountsBalances.
+type GetAccountsBalancesParams struct {
+	// StartDate 残高推移の開始日（YYYY-MM-DD形式）
+	StartDate string `form:"start_date" json:"start_date"`
+
+	// EndDate 残高推移の終了日（YYYY-MM-DD形式）。開始日から366日以内
+	EndDate string `form:"end_date" json:"end_date"`
+}
+
+// GetAccountsIdEntriesParams defines parameters for GetAccountsIdEntries.
+type GetAccountsIdEntriesParams struct {
+	// StartDate 開始日（YYYY-MM-DD形式）
+	StartDate string `form:"start_date" json:"start_date"`
+
+	// EndDate 終了日（YYYY-MM-DD形式）。開始日から366日以内
+	EndDate string `form:"end_date" json:"end_date"`
+}
+
+// GetBudgetsParams defines parameters for GetBudgets.
+type GetBudgetsParams struct {
+	// Month 対象月（YYYY-MM形式）
+	Month *string `form:"month,omitempty" json:"month,omitempty"`
+
+	// CategoryId カテゴリID
+	CategoryId *int32 `form:"category_id,omitempty" json:"category_id,omitempty"`
+
+	// Sort 並び替えキー（省略時はmonth）
+	Sort *BudgetSortKey `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order 並び順（省略時はdesc）
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit 取得件数（1〜500、省略時は100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのnext_cursor。sort・orderは前のページと同じ値を指定する
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetBudgetsAvailableParams defines parameters for GetBudgetsAvailable.
+type GetBudgetsAvailableParams struct {
+	// Month 対象月（YYYY-MM形式）
+	Month string `form:"month" json:"month"`
+}
+
+// GetBudgetsSummaryParams defines parameters for GetBudgetsSummary.
+type GetBudgetsSummaryParams struct {
+	// Month 対象月（YYYY-MM形式）
+	Month string `form:"month" json:"month"`
+}
+
+// GetCalendarParams defines parameters for GetCalendar.
+type GetCalendarParams struct {
+	// Month 対象月（YYYY-MM形式）
+	Month string `form:"month" json:"month"`
+}
+
+// GetCreditCardsWithdrawalsParams defines parameters for GetCreditCardsWithdrawals.
+type GetCreditCardsWithdrawalsParams struct {
+	// StartDate 開始日（YYYY-MM-DD形式）。省略時はユーザーのタイムゾーンでの今日
+	StartDate *string `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate 終了日（YYYY-MM-DD形式）。省略時は開始日から60日間。開始日から366日以内
+	EndDate *string `form:"end_date,omitempty" json:"end_date,omitempty"`
+}
+
+// GetCreditCardsIdStatementsParams defines parameters for GetCreditCardsIdStatements.
+type GetCreditCardsIdStatementsParams struct {
+	// StartMonth 取得する最初の締め日の月（YYYY-MM形式）
+	StartMonth string `form:"start_month" json:"start_month"`
+
+	// EndMonth 取得する最後の締め日の月（YYYY-MM形式）。開始月から24か月以内
+	EndMonth string `form:"end_month" json:"end_month"`
+}
+
+// GetExchangeRatesParams defines parameters for GetExchangeRates.
+type GetExchangeRatesParams struct {
+	// Currency 通貨（ISO 4217）
+	Currency *string `form:"currency,omitempty" json:"currency,omitempty"`
+
+	// StartDate 開始日（YYYY-MM-DD形式）
+	StartDate *string `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate 終了日（YYYY-MM-DD形式）
+	EndDate *string `form:"end_date,omitempty" json:"end_date,omitempty"`
+}
+
+// GetReportsTrendsParams defines parameters for GetReportsTrends.
+type GetReportsTrendsParams struct {
+	// StartDate 開始日（YYYY-MM-DD形式）
+	StartDate string `form:"start_date" json:"start_date"`
+
+	// EndDate 終了日（YYYY-MM-DD形式）。集計単位が日の場合は開始日から366日以内、それ以外は10年以内
+	EndDate string `form:"end_date" json:"end_date"`
+
+	// Period 集計単位（省略時は month）
+	Period *TrendPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// Compare 比較対象
+	Compare *TrendCompare `form:"compare,omitempty" json:"compare,omitempty"`
+}
+
+// GetTagsSummaryParams defines parameters for GetTagsSummary.
+type GetTagsSummaryParams struct {
+	// StartDate 開始日（YYYY-MM-DD形式）
+	StartDate string `form:"start_date" json:"start_date"`
+
+	// EndDate 終了日（YYYY-MM-DD形式）
+	EndDate string `form:"end_date" json:"end_date"`
+}
+
+// GetTransactionsParams defines parameters for GetTransactions.
+type GetTransactionsParams struct {
+	// StartDate 開始日（YYYY-MM-DD形式）
+	StartDate *string `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate 終了日（YYYY-MM-DD形式）
+	EndDate *string `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Month 予算の対象月（YYYY-MM形式）。ユーザーの月の開始日に基づく期間の取引に絞り込む。start_date・end_dateとは同時に指定できない
+	Month *string `form:"month,omitempty" json:"month,omitempty"`
+
+	// Type カテゴリタイプ（income/expense）
+	Type *CategoryType `form:"type,omitempty" json:"type,omitempty"`
+
+	// CategoryId カテゴリID
+	CategoryId *int32 `form:"category_id,omitempty" json:"category_id,omitempty"`
+
+	// AccountId 口座ID
+	AccountId *int32 `form:"account_id,omitempty" json:"account_id,omitempty"`
+
+	// CreditCardId クレジットカードID
+	CreditCardId *int32 `form:"credit_card_id,omitempty" json:"credit_card_id,omitempty"`
+
+	// Cleared 消込済みかどうか
+	Cleared *bool `form:"cleared,omitempty" json:"cleared,omitempty"`
+
+	// Tag タグID
+	Tag *int32 `form:"tag,omitempty" json:"tag,omitempty"`
+
+	// AnyTag タグIDのいずれかが付いた取引に絞り込む（カンマ区切り）
+	AnyTag *[]int32 `form:"any_tag,omitempty" json:"any_tag,omitempty"`
+
+	// AllTags タグIDのすべてが付いた取引に絞り込む（カンマ区切り）
+	AllTags *[]int32 `form:"all_tags,omitempty" json:"all_tags,omitempty"`
+
+	// Q 説明のキーワード。空白区切りで指定した場合はすべてを含む取引を検索する
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// MinAmount 金額の下限
+	MinAmount *int32 `form:"min_amount,omitempty" json:"min_amount,omitempty"`
+
+	// MaxAmount 金額の上限
+	MaxAmount *int32 `form:"max_amount,omitempty" json:"max_amount,omitempty"`
+
+	// CategoryIds カテゴリIDのいずれかに一致する取引に絞り込む（カンマ区切り）
+	CategoryIds *[]int32 `form:"category_ids,omitempty" json:"category_ids,omitempty"`
+
+	// ExcludeCategoryIds 除外するカテゴリID（カンマ区切り）
+	ExcludeCategoryIds *[]int32 `form:"exclude_category_ids,omitempty" json:"exclude_category_ids,omitempty"`
+
+	// Sort 並び替えキー（省略時はdate）
+	Sort *TransactionSortKey `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order 並び順（省略時はdesc）
+	Order *SortOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit 取得件数（1〜500、省略時は100）
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor 前のページのnext_cursor。sort・orderは前のページと同じ値を指定する
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetTransactionsDuplicatesParams defines parameters for GetTransactionsDuplicates.
+type GetTransactionsDuplicatesParams struct {
+	// StartDate 開始日（YYYY-MM-DD形式）
+	StartDate string `form:"start_date" json:"start_date"`
+
+	// EndDate 終了日（YYYY-MM-DD形式）。期間は366日以内
+	EndDate string `form:"end_date" json:"end_date"`
+
+	// WindowDays 重複とみなす日付の差（0〜31日、デフォルト: 3）
+	WindowDays *int32 `form:"window_days,omitempty" json:"window_days,omitempty"`
+}
+
+// GetTransactionsExportParams defines parameters for GetTransactionsExport.
+type GetTransactionsExportParams struct {
+	// Format 出力形式（csv/ndjson）
+	Format TransactionExportFormat `form:"format" json:"format"`
+
+	// StartDate 開始日（YYYY-MM-DD形式）
+	StartDate *string `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate 終了日（YYYY-MM-DD形式）
+	EndDate *string `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// Type カテゴリタイプ（income/expense）
+	Type *CategoryType `form:"type,omitempty" json:"type,omitempty"`
+
+	// CategoryId カテゴリID
+	CategoryId *int32 `form:"category_id,omitempty" json:"category_id,omitempty"`
+}
+
+// GetTransfersParams defines parameters for GetTransfers.
+type GetTransfersParams struct {
+	// StartDate 開始日（YYYY-MM-DD形式）
+	StartDate *string `form:"start_date,omitempty" json:"start_date,omitempty"`
+
+	// EndDate 終了日（YYYY-MM-DD形式）
+	EndDate *string `form:"end_date,omitempty" json:"end_date,omitempty"`
+
+	// AccountId 振替元または振替先の口座ID
+	AccountId *int32 `form:"account_id,omitempty" json:"account_id,omitempty"`
+}
+
+// PostAccountsJSONRequestBody defines body for PostAccounts for application/json ContentType.
+type PostAccountsJSONRequestBody = CreateAccountInput
+
+// PatchAccountsIdJSONRequestBody defines body for PatchAccountsId for application/json ContentType.
+type PatchAccountsIdJSONRequestBody = UpdateAccountInput
+
+// PostAccountsIdReconcileJSONRequestBody defines body for PostAccountsIdReconcile for application/json ContentType.
+type PostAccountsIdReconcileJSONRequestBody = ReconcileAccountInput
+
+// PostBudgetTemplatesJSONRequestBody defines body for PostBudgetTemplates for application/json ContentType.
+type PostBudgetTemplatesJSONRequestBody = CreateBudgetTemplateInput
+
+// PatchBudgetTemplatesIdJSONRequestBody defines body for PatchBudgetTemplatesId for application/json ContentType.
+type PatchBudgetTemplatesIdJSONRequestBody = UpdateBudgetTemplateInput
+
+// PostBudgetsJSONRequestBody defines body for PostBudgets for application/json ContentType.
+type PostBudgetsJSONRequestBody = CreateBudgetInput
+
+// PostBudgetsCopyJSONRequestBody defines body for PostBudgetsCopy for application/json ContentType.
+type PostBudgetsCopyJSONRequestBody = CopyBudgetsInput
+
+// PatchBudgetsIdJSONRequestBody defines body for PatchBudgetsId for application/json ContentType.
+type PatchBudgetsIdJSONRequestBody = UpdateBudgetInput
+
+// PostCategoriesJSONRequestBody defines body for PostCategories for application/json ContentType.
+type PostCategoriesJSONRequestBody = CreateCategoryInput
+
+// PatchCategoriesIdJSONRequestBody defines body for PatchCategoriesId for application/json ContentType.
+type PatchCategoriesIdJSONRequestBody = UpdateCategoryInput
+
+// PostCategorizationRulesJSONRequestBody defines body for PostCategorizationRules for application/json ContentType.
+type PostCategorizationRulesJSONRequestBody = CreateCategorizationRuleInput
+
+// PatchCategorizationRulesIdJSONRequestBody defines body for PatchCategorizationRulesId for application/json ContentType.
+type PatchCategorizationRulesIdJSONRequestBody = UpdateCategorizationRuleInput
+
+// PostCategorizationRulesIdApplyJSONRequestBody defines body for PostCategorizationRulesIdApply for application/json ContentType.
+type PostCategorizationRulesIdApplyJSONRequestBody = ApplyCategorizationRuleInput
+
+// PostCreditCardsJSONRequestBody defines body for PostCreditCards for application/json ContentType.
+type PostCreditCardsJSONRequestBody = CreateCreditCardInput
+
+// PatchCreditCardsIdJSONRequestBody defines body for PatchCreditCardsId for application/json ContentType.
+type PatchCreditCardsIdJSONRequestBody = UpdateCreditCardInput
+
+// PostExchangeRatesJSONRequestBody defines body for PostExchangeRates for application/json ContentType.
+type PostExchangeRatesJSONRequestBody = CreateExchangeRateInput
+
+// PostExchangeRatesImportMultipartRequestBody defines body for PostExchangeRatesImport for multipart/form-data ContentType.
+type PostExchangeRatesImportMultipartRequestBody = ImportExchangeRatesInput
+
+// PatchExchangeRatesIdJSONRequestBody defines body for PatchExchangeRatesId for application/json ContentType.
+type PatchExchangeRatesIdJSONRequestBody = UpdateExchangeRateInput
+
+// PostImportProfilesJSONRequestBody defines body for PostImportProfiles for application/json ContentType.
+type PostImportProfilesJSONRequestBody = CreateImportProfileInput
+
+// PatchImportProfilesIdJSONRequestBody defines body for PatchImportProfilesId for application/json ContentType.
+type PatchImportProfilesIdJSONRequestBody = UpdateImportProfileInput
+
+// PostRecurringTransactionsJSONRequestBody defines body for PostRecurringTransactions for application/json ContentType.
+type PostRecurringTransactionsJSONRequestBody = CreateRecurringTransactionInput
+
+// PostRecurringTransactionsGenerateJSONRequestBody defines body for PostRecurringTransactionsGenerate for application/json ContentType.
+type PostRecurringTransactionsGenerateJSONRequestBody = GenerateRecurringTransactionsInput
+
+// PatchRecurringTransactionsIdJSONRequestBody defines body for PatchRecurringTransactionsId for application/json ContentType.
+type PatchRecurringTransactionsIdJSONRequestBody = UpdateRecurringTransactionInput
+
+// PostTagsJSONRequestBody defines body for PostTags for application/json ContentType.
+type PostTagsJSONRequestBody = CreateTagInput
+
+// PatchTagsIdJSONRequestBody defines body for PatchTagsId for application/json ContentType.
+type PatchTagsIdJSONRequestBody = UpdateTagInput
+
+// PostTransactionsJSONRequestBody defines body for PostTransactions for application/json ContentType.
+type PostTransactionsJSONRequestBody = CreateTransactionInput
+
+// PostTransactionsBatchJSONRequestBody defines body for PostTransactionsBatch for application/json ContentType.
+type PostTransactionsBatchJSONRequestBody = BatchTransactionsInput
+
+// PostTransactionsDuplicatesMergeJSONRequestBody defines body for PostTransactionsDuplicatesMerge for application/json ContentType.
+type PostTransactionsDuplicatesMergeJSONRequestBody = MergeDuplicateTransactionsInput
+
+// PostTransactionsImportMultipartRequestBody defines body for PostTransactionsImport for multipart/form-data ContentType.
+type PostTransactionsImportMultipartRequestBody = ImportTransactionsInput
+
+// PostTransactionsRecategorizeJSONRequestBody defines body for PostTransactionsRecategorize for application/json ContentType.
+type PostTransactionsRecategorizeJSONRequestBody = RecategorizeTransactionsInput
+
+// PatchTransactionsIdJSONRequestBody defines body for PatchTransactionsId for application/json ContentType.
+type PatchTransactionsIdJSONRequestBody = UpdateTransactionInput
+
+// PostTransactionsIdAttachmentsMultipartRequestBody defines body for PostTransactionsIdAttachments for multipart/form-data ContentType.
+type PostTransactionsIdAttachmentsMultipartRequestBody = UploadAttachmentInput
+
+// PostTransactionsIdInstallmentsJSONRequestBody defines body for PostTransactionsIdInstallments for application/json ContentType.
+type PostTransactionsIdInstallmentsJSONRequestBody = CreateInstallmentPlanInput
+
+// PostTransfersJSONRequestBody defines body for PostTransfers for application/json ContentType.
+type PostTransfersJSONRequestBody = CreateTransferInput
+
+// PatchUsersMeJSONRequestBody defines body for PatchUsersMe for application/json ContentType.
+type PatchUsersMeJSONRequestBody = UserUpdateCurrentUserInput
+
+// PostUsersSignInJSONRequestBody defines body for PostUsersSignIn for application/json ContentType.
+type PostUsersSignInJSONRequestBody = UserSignInInput
+
+// PostUsersSignUpJSONRequestBody defines body for PostUsersSignUp for application/json ContentType.
+type PostUsersSignUpJSONRequestBody = UserSignUpInput
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get Accounts
+	// (GET /accounts)
+	GetAccounts(ctx echo.Context) error
+	// Create Account
+	// (POST /accounts)
+	PostAccounts(ctx echo.Context) error
+	// Get Account Balances
+	// (GET /accounts/balances)
+	GetAccountsBalances(ctx echo.Context, params GetAccountsBalancesParams) error
+	// Delete Account
+	// (DELETE /accounts/{id})
+	DeleteAccountsId(ctx echo.Context, id int32) error
+	// Get Account
+	// (GET /accounts/{id})
+	GetAccountsId(ctx echo.Context, id int32) error
+	// Update Account
+	// (PATCH /accounts/{id})
+	PatchAccountsId(ctx echo.Context, id int32) error
+	// Get Account Entries
+	// (GET /accounts/{id}/entries)
+	GetAccountsIdEntries(ctx echo.Context, id int32, params GetAccountsIdEntriesParams) error
+	// Reconcile Account
+	// (POST /accounts/{id}/reconcile)
+	PostAccountsIdReconcile(ctx echo.Context, id int32) error
+	// Get Reconciliations
+	// (GET /accounts/{id}/reconciliations)
+	GetAccountsIdReconciliations(ctx echo.Context, id int32) error
+	// Get Budget Templates
+	// (GET /budget-templates)
+	GetBudgetTemplates(ctx echo.Context) error
+	// Create Budget Template
+	// (POST /budget-templates)
+	PostBudgetTemplates(ctx echo.Context) error
+	// Delete Budget Template
+	// (DELETE /budget-templates/{id})
+	DeleteBudgetTemplatesId(ctx echo.Context, id int32) error
+	// Get Budget Template
+	// (GET /budget-templates/{id})
+	GetBudgetTemplatesId(ctx echo.Context, id int32) error
+	// Update Budget Template
+	// (PATCH /budget-templates/{id})
+	PatchBudgetTemplatesId(ctx echo.Context, id int32) error
+	// Get Budgets
+	// (GET /budgets)
+	GetBudgets(ctx echo.Context, params GetBudgetsParams) error
+	// Create Budget
+	// (POST /budgets)
+	PostBudgets(ctx echo.Context) error
+	// Get Available Budget
+	// (GET /budgets/available)
+	GetBudgetsAvailable(ctx echo.Context, params GetBudgetsAvailableParams) error
+	// Copy Budgets
+	// (POST /budgets/copy)
+	PostBudgetsCopy(ctx echo.Context) error
+	// Get Budget Summary
+	// (GET /budgets/summary)
+	GetBudgetsSummary(ctx echo.Context, params GetBudgetsSummaryParams) error
+	// Delete Budget
+	// (DELETE /budgets/{id})
+	DeleteBudgetsId(ctx echo.Context, id int32) error
+	// Get Budget
+	// (GET /budgets/{id})
+	GetBudgetsId(ctx echo.Context, id int32) error
+	// Update Budget
+	// (PATCH /budgets/{id})
+	PatchBudgetsId(ctx echo.Context, id int32) error
+	// Get Calendar
+	// (GET /calendar)
+	GetCalendar(ctx echo.Context, params GetCalendarParams) error
+	// Get Categories
+	// (GET /categories)
+	GetCategories(ctx echo.Context) error
+	// Create Category
+	// (POST /categories)
+	PostCategories(ctx echo.Context) error
+	// Delete Category
+	// (DELETE /categories/{id})
+	DeleteCategoriesId(ctx echo.Context, id int32) error
+	// Get Category
+	// (GET /categories/{id})
+	GetCategoriesId(ctx echo.Context, id int32) error
+	// Update Category
+	// (PATCH /categories/{id})
+	PatchCategoriesId(ctx echo.Context, id int32) error
+	// Get Categorization Rules
+	// (GET /categorization-rules)
+	GetCategorizationRules(ctx echo.Context) error
+	// Create Categorization Rule
+	// (POST /categorization-rules)
+	PostCategorizationRules(ctx echo.Context) error
+	// Delete Categorization Rule
+	// (DELETE /categorization-rules/{id})
+	DeleteCategorizationRulesId(ctx echo.Context, id int32) error
+	// Get Categorization Rule
+	// (GET /categorization-rules/{id})
+	GetCategorizationRulesId(ctx echo.Context, id int32) error
+	// Update Categorization Rule
+	// (PATCH /categorization-rules/{id})
+	PatchCategorizationRulesId(ctx echo.Context, id int32) error
+	// Apply Categorization Rule
+	// (POST /categorization-rules/{id}/apply)
+	PostCategorizationRulesIdApply(ctx echo.Context, id int32) error
+	// Get Credit Cards
+	// (GET /credit-cards)
+	GetCreditCards(ctx echo.Context) error
+	// Create Credit Card
+	// (POST /credit-cards)
+	PostCreditCards(ctx echo.Context) error
+	// Get Credit Card Withdrawals
+	// (GET /credit-cards/withdrawals)
+	GetCreditCardsWithdrawals(ctx echo.Context, params GetCreditCardsWithdrawalsParams) error
+	// Delete Credit Card
+	// (DELETE /credit-cards/{id})
+	DeleteCreditCardsId(ctx echo.Context, id int32) error
+	// Get Credit Card
+	// (GET /credit-cards/{id})
+	GetCreditCardsId(ctx echo.Context, id int32) error
+	// Update Credit Card
+	// (PATCH /credit-cards/{id})
+	PatchCreditCardsId(ctx echo.Context, id int32) error
+	// Get Credit Card Statements
+	// (GET /credit-cards/{id}/statements)
+	GetCreditCardsIdStatements(ctx echo.Context, id int32, params GetCreditCardsIdStatementsParams) error
+	// Get Csrf
+	// (GET /csrf)
+	GetCsrf(ctx echo.Context) error
+	// Get Exchange Rates
+	// (GET /exchange-rates)
+	GetExchangeRates(ctx echo.Context, params GetExchangeRatesParams) error
+	// Create Exchange Rate
+	// (POST /exchange-rates)
+	PostExchangeRates(ctx echo.Context) error
+	// Import Exchange Rates
+	// (POST /exchange-rates/import)
+	PostExchangeRatesImport(ctx echo.Context) error
+	// Delete Exchange Rate
+	// (DELETE /exchange-rates/{id})
+	DeleteExchangeRatesId(ctx echo.Context, id int32) error
+	// Update Exchange Rate
+	// (PATCH /exchange-rates/{id})
+	PatchExchangeRatesId(ctx echo.Context, id int32) error
+	// Get Import Profiles
+	// (GET /import-profiles)
+	GetImportProfiles(ctx echo.Context) error
+	// Create Import Profile
+	// (POST /import-profiles)
+	PostImportProfiles(ctx echo.Context) error
+	// Delete Import Profile
+	// (DELETE /import-profiles/{id})
+	DeleteImportProfilesId(ctx echo.Context, id int32) error
+	// Get Import Profile
+	// (GET /import-profiles/{id})
+	GetImportProfilesId(ctx echo.Context, id int32) error
+	// Update Import Profile
+	// (PATCH /import-profiles/{id})
+	PatchImportProfilesId(ctx echo.Context, id int32) error
+	// Get Installment Plans
+	// (GET /installment-plans)
+	GetInstallmentPlans(ctx echo.Context) error
+	// Delete Installment Plan
+	// (DELETE /installment-plans/{id})
+	DeleteInstallmentPlansId(ctx echo.Context, id int32) error
+	// Get Installment Plan
+	// (GET /installment-plans/{id})
+	GetInstallmentPlansId(ctx echo.Context, id int32) error
+	// Get Recurring Transactions
+	// (GET /recurring-transactions)
+	GetRecurringTransactions(ctx echo.Context) error
+	// Create Recurring Transaction
+	// (POST /recurring-transactions)
+	PostRecurringTransactions(ctx echo.Context) error
+	// Generate Recurring Transactions
+	// (POST /recurring-transactions/generate)
+	PostRecurringTransactionsGenerate(ctx echo.Context) error
+	// Delete Recurring Transaction
+	// (DELETE /recurring-transactions/{id})
+	DeleteRecurringTransactionsId(ctx echo.Context, id int32) error
+	// Get Recurring Transaction
+	// (GET /recurring-transactions/{id})
+	GetRecurringTransactionsId(ctx echo.Context, id int32) error
+	// Update Recurring Transaction
+	// (PATCH /recurring-transactions/{id})
+	PatchRecurringTransactionsId(ctx echo.Context, id int32) error
+	// Get Trend Report
+	// (GET /reports/trends)
+	GetReportsTrends(ctx echo.Context, params GetReportsTrendsParams) error
+	// Get Tags
+	// (GET /tags)
+	GetTags(ctx echo.Context) error
+	// Create Tag
+	// (POST /tags)
+	PostTags(ctx echo.Context) error
+	// Get Tag Summary
+	// (GET /tags/summary)
+	GetTagsSummary(ctx echo.Context, params GetTagsSummaryParams) error
+	// Delete Tag
+	// (DELETE /tags/{id})
+	DeleteTagsId(ctx echo.Context, id int32) error
+	// Get Tag
+	// (GET /tags/{id})
+	GetTagsId(ctx echo.Context, id int32) error
+	// Update Tag
+	// (PATCH /tags/{id})
+	PatchTagsId(ctx echo.Context, id int32) error
+	// Get Transactions
+	// (GET /transactions)
+	GetTransactions(ctx echo.Context, params GetTransactionsParams) error
+	// Create Transaction
+	// (POST /transactions)
+	PostTransactions(ctx echo.Context) error
+	// Batch Transactions
+	// (POST /transactions/batch)
+	PostTransactionsBatch(ctx echo.Context) error
+	// Get Duplicate Transactions
+	// (GET /transactions/duplicates)
+	GetTransactionsDuplicates(ctx echo.Context, params GetTransactionsDuplicatesParams) error
+	// Merge Duplicate Transactions
+	// (POST /transactions/duplicates/merge)
+	PostTransactionsDuplicatesMerge(ctx echo.Context) error
+	// Export Transactions
+	// (GET /transactions/export)
+	GetTransactionsExport(ctx echo.Context, params GetTransactionsExportParams) error
+	// Import Transactions
+	// (POST /transactions/import)
+	PostTransactionsImport(ctx echo.Context) error
+	// Recategorize Transactions
+	// (POST /transactions/recategorize)
+	PostTransactionsRecategorize(ctx echo.Context) error
+	// Delete Transaction
+	// (DELETE /transactions/{id})
+	DeleteTransactionsId(ctx echo.Context, id int32) error
+	// Get Transaction
+	// (GET /transactions/{id})
+	GetTransactionsId(ctx echo.Context, id int32) error
+	// Update Transaction
+	// (PATCH /transactions/{id})
+	PatchTransactionsId(ctx echo.Context, id int32) error
+	// Get Attachments
+	// (GET /transactions/{id}/attachments)
+	GetTransactionsIdAttachments(ctx echo.Context, id int32) error
+	// Upload Attachment
+	// (POST /transactions/{id}/attachments)
+	PostTransactionsIdAttachments(ctx echo.Context, id int32) error
+	// Delete Attachment
+	// (DELETE /transactions/{id}/attachments/{attachment_id})
+	DeleteTransactionsIdAttachmentsAttachmentId(ctx echo.Context, id int32, attachmentId int32) error
+	// Download Attachment
+	// (GET /transactions/{id}/attachments/{attachment_id})
+	GetTransactionsIdAttachmentsAttachmentId(ctx echo.Context, id int32, attachmentId int32) error
+	// Get Transaction History
+	// (GET /transactions/{id}/history)
+	GetTransactionsIdHistory(ctx echo.Context, id int32) error
+	// Create Installment Plan
+	// (POST /transactions/{id}/installments)
+	PostTransactionsIdInstallments(ctx echo.Context, id int32) error
+	// Unlock Transaction
+	// (POST /transactions/{id}/unlock)
+	PostTransactionsIdUnlock(ctx echo.Context, id int32) error
+	// Get Transfers
+	// (GET /transfers)
+	GetTransfers(ctx echo.Context, params GetTransfersParams) error
+	// Create Transfer
+	// (POST /transfers)
+	PostTransfers(ctx echo.Context) error
+	// Delete Transfer
+	// (DELETE /transfers/{id})
+	DeleteTransfersId(ctx echo.Context, id int32) error
+	// Get Transfer
+	// (GET /transfers/{id})
+	GetTransfersId(ctx echo.Context, id int32) error
+	// Get Trash
+	// (GET /trash)
+	GetTrash(ctx echo.Context) error
+	// Purge Budget
+	// (DELETE /trash/budgets/{id})
+	DeleteTrashBudgetsId(ctx echo.Context, id int32) error
+	// Restore Budget
+	// (POST /trash/budgets/{id}/restore)
+	PostTrashBudgetsIdRestore(ctx echo.Context, id int32) error
+	// Purge Category
+	// (DELETE /trash/categories/{id})
+	DeleteTrashCategoriesId(ctx echo.Context, id int32) error
+	// Restore Category
+	// (POST /trash/categories/{id}/restore)
+	PostTrashCategoriesIdRestore(ctx echo.Context, id int32) error
+	// Purge Transaction
+	// (DELETE /trash/transactions/{id})
+	DeleteTrashTransactionsId(ctx echo.Context, id int32) error
+	// Restore Transaction
+	// (POST /trash/transactions/{id}/restore)
+	PostTrashTransactionsIdRestore(ctx echo.Context, id int32) error
+	// User CheckSignedIn
+	// (GET /users/checkSignedIn)
+	GetUsersCheckSignedIn(ctx echo.Context) error
+	// Get Current User
+	// (GET /users/me)
+	GetUsersMe(ctx echo.Context) error
+	// Update Current User
+	// (PATCH /users/me)
+	PatchUsersMe(ctx echo.Context) error
+	// User SignIn
+	// (POST /users/signIn)
+	PostUsersSignIn(ctx echo.Context) error
+	// User SignOut
+	// (POST /users/signOut)
+	PostUsersSignOut(ctx echo.Context) error
+	// User SignUp
+	// (POST /users/signUp)
+	PostUsersSignUp(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccounts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccounts(ctx)
+	return err
+}
+
+// PostAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAccounts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAccounts(ctx)
+	return err
+}
+
+// GetAccountsBalances converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountsBalances(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountsBalancesParams
+	// ------------- Required query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Required query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountsBalances(ctx, params)
+	return err
+}
+
+// DeleteAccountsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAccountsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAccountsId(ctx, id)
+	return err
+}
+
+// GetAccountsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountsId(ctx, id)
+	return err
+}
+
+// PatchAccountsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchAccountsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchAccountsId(ctx, id)
+	return err
+}
+
+// GetAccountsIdEntries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountsIdEntries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAccountsIdEntriesParams
+	// ------------- Required query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Required query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountsIdEntries(ctx, id, params)
+	return err
+}
+
+// PostAccountsIdReconcile converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAccountsIdReconcile(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAccountsIdReconcile(ctx, id)
+	return err
+}
+
+// GetAccountsIdReconciliations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountsIdReconciliations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountsIdReconciliations(ctx, id)
+	return err
+}
+
+// GetBudgetTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBudgetTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBudgetTemplates(ctx)
+	return err
+}
+
+// PostBudgetTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBudgetTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBudgetTemplates(ctx)
+	return err
+}
+
+// DeleteBudgetTemplatesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBudgetTemplatesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBudgetTemplatesId(ctx, id)
+	return err
+}
+
+// GetBudgetTemplatesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBudgetTemplatesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBudgetTemplatesId(ctx, id)
+	return err
+}
+
+// PatchBudgetTemplatesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchBudgetTemplatesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchBudgetTemplatesId(ctx, id)
+	return err
+}
+
+// GetBudgets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBudgets(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBudgetsParams
+	// ------------- Optional query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "month", ctx.QueryParams(), &params.Month)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter month: %s", err))
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "category_id", ctx.QueryParams(), &params.CategoryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBudgets(ctx, params)
+	return err
+}
+
+// PostBudgets converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBudgets(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBudgets(ctx)
+	return err
+}
+
+// GetBudgetsAvailable converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBudgetsAvailable(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBudgetsAvailableParams
+	// ------------- Required query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "month", ctx.QueryParams(), &params.Month)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter month: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBudgetsAvailable(ctx, params)
+	return err
+}
+
+// PostBudgetsCopy converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBudgetsCopy(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostBudgetsCopy(ctx)
+	return err
+}
+
+// GetBudgetsSummary converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBudgetsSummary(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBudgetsSummaryParams
+	// ------------- Required query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "month", ctx.QueryParams(), &params.Month)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter month: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBudgetsSummary(ctx, params)
+	return err
+}
+
+// DeleteBudgetsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBudgetsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteBudgetsId(ctx, id)
+	return err
+}
+
+// GetBudgetsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBudgetsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBudgetsId(ctx, id)
+	return err
+}
+
+// PatchBudgetsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchBudgetsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchBudgetsId(ctx, id)
+	return err
+}
+
+// GetCalendar converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendar(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarParams
+	// ------------- Required query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "month", ctx.QueryParams(), &params.Month)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter month: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendar(ctx, params)
+	return err
+}
+
+// GetCategories converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCategories(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCategories(ctx)
+	return err
+}
+
+// PostCategories converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCategories(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCategories(ctx)
+	return err
+}
+
+// DeleteCategoriesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCategoriesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCategoriesId(ctx, id)
+	return err
+}
+
+// GetCategoriesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCategoriesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCategoriesId(ctx, id)
+	return err
+}
+
+// PatchCategoriesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCategoriesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchCategoriesId(ctx, id)
+	return err
+}
+
+// GetCategorizationRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCategorizationRules(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCategorizationRules(ctx)
+	return err
+}
+
+// PostCategorizationRules converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCategorizationRules(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCategorizationRules(ctx)
+	return err
+}
+
+// DeleteCategorizationRulesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCategorizationRulesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCategorizationRulesId(ctx, id)
+	return err
+}
+
+// GetCategorizationRulesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCategorizationRulesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCategorizationRulesId(ctx, id)
+	return err
+}
+
+// PatchCategorizationRulesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCategorizationRulesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchCategorizationRulesId(ctx, id)
+	return err
+}
+
+// PostCategorizationRulesIdApply converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCategorizationRulesIdApply(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCategorizationRulesIdApply(ctx, id)
+	return err
+}
+
+// GetCreditCards converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCreditCards(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCreditCards(ctx)
+	return err
+}
+
+// PostCreditCards converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCreditCards(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCreditCards(ctx)
+	return err
+}
+
+// GetCreditCardsWithdrawals converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCreditCardsWithdrawals(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCreditCardsWithdrawalsParams
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCreditCardsWithdrawals(ctx, params)
+	return err
+}
+
+// DeleteCreditCardsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCreditCardsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCreditCardsId(ctx, id)
+	return err
+}
+
+// GetCreditCardsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCreditCardsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCreditCardsId(ctx, id)
+	return err
+}
+
+// PatchCreditCardsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCreditCardsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchCreditCardsId(ctx, id)
+	return err
+}
+
+// GetCreditCardsIdStatements converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCreditCardsIdStatements(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCreditCardsIdStatementsParams
+	// ------------- Required query parameter "start_month" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "start_month", ctx.QueryParams(), &params.StartMonth)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_month: %s", err))
+	}
+
+	// ------------- Required query parameter "end_month" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "end_month", ctx.QueryParams(), &params.EndMonth)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_month: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCreditCardsIdStatements(ctx, id, params)
+	return err
+}
+
+// GetCsrf converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCsrf(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCsrf(ctx)
+	return err
+}
+
+// GetExchangeRates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetExchangeRates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExchangeRatesParams
+	// ------------- Optional query parameter "currency" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "currency", ctx.QueryParams(), &params.Currency)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter currency: %s", err))
+	}
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetExchangeRates(ctx, params)
+	return err
+}
+
+// PostExchangeRates converts echo context to params.
+func (w *ServerInterfaceWrapper) PostExchangeRates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostExchangeRates(ctx)
+	return err
+}
+
+// PostExchangeRatesImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostExchangeRatesImport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostExchangeRatesImport(ctx)
+	return err
+}
+
+// DeleteExchangeRatesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteExchangeRatesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteExchangeRatesId(ctx, id)
+	return err
+}
+
+// PatchExchangeRatesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchExchangeRatesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchExchangeRatesId(ctx, id)
+	return err
+}
+
+// GetImportProfiles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetImportProfiles(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetImportProfiles(ctx)
+	return err
+}
+
+// PostImportProfiles converts echo context to params.
+func (w *ServerInterfaceWrapper) PostImportProfiles(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostImportProfiles(ctx)
+	return err
+}
+
+// DeleteImportProfilesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteImportProfilesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteImportProfilesId(ctx, id)
+	return err
+}
+
+// GetImportProfilesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetImportProfilesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetImportProfilesId(ctx, id)
+	return err
+}
+
+// PatchImportProfilesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchImportProfilesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchImportProfilesId(ctx, id)
+	return err
+}
+
+// GetInstallmentPlans converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstallmentPlans(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInstallmentPlans(ctx)
+	return err
+}
+
+// DeleteInstallmentPlansId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteInstallmentPlansId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteInstallmentPlansId(ctx, id)
+	return err
+}
+
+// GetInstallmentPlansId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInstallmentPlansId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInstallmentPlansId(ctx, id)
+	return err
+}
+
+// GetRecurringTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurringTransactions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRecurringTransactions(ctx)
+	return err
+}
+
+// PostRecurringTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRecurringTransactions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRecurringTransactions(ctx)
+	return err
+}
+
+// PostRecurringTransactionsGenerate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRecurringTransactionsGenerate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRecurringTransactionsGenerate(ctx)
+	return err
+}
+
+// DeleteRecurringTransactionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRecurringTransactionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRecurringTransactionsId(ctx, id)
+	return err
+}
+
+// GetRecurringTransactionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurringTransactionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRecurringTransactionsId(ctx, id)
+	return err
+}
+
+// PatchRecurringTransactionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchRecurringTransactionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchRecurringTransactionsId(ctx, id)
+	return err
+}
+
+// GetReportsTrends converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsTrends(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsTrendsParams
+	// ------------- Required query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Required query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "period", ctx.QueryParams(), &params.Period)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter period: %s", err))
+	}
+
+	// ------------- Optional query parameter "compare" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "compare", ctx.QueryParams(), &params.Compare)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter compare: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsTrends(ctx, params)
+	return err
+}
+
+// GetTags converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTags(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTags(ctx)
+	return err
+}
+
+// PostTags converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTags(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTags(ctx)
+	return err
+}
+
+// GetTagsSummary converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTagsSummary(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTagsSummaryParams
+	// ------------- Required query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Required query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTagsSummary(ctx, params)
+	return err
+}
+
+// DeleteTagsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTagsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTagsId(ctx, id)
+	return err
+}
+
+// GetTagsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTagsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTagsId(ctx, id)
+	return err
+}
+
+// PatchTagsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTagsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTagsId(ctx, id)
+	return err
+}
+
+// GetTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionsParams
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "month" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "month", ctx.QueryParams(), &params.Month)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter month: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "category_id", ctx.QueryParams(), &params.CategoryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "account_id", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "credit_card_id" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "credit_card_id", ctx.QueryParams(), &params.CreditCardId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter credit_card_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "cleared" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cleared", ctx.QueryParams(), &params.Cleared)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleared: %s", err))
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "tag", ctx.QueryParams(), &params.Tag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter tag: %s", err))
+	}
+
+	// ------------- Optional query parameter "any_tag" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "any_tag", ctx.QueryParams(), &params.AnyTag)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter any_tag: %s", err))
+	}
+
+	// ------------- Optional query parameter "all_tags" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "all_tags", ctx.QueryParams(), &params.AllTags)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter all_tags: %s", err))
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "q", ctx.QueryParams(), &params.Q)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter q: %s", err))
+	}
+
+	// ------------- Optional query parameter "min_amount" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "min_amount", ctx.QueryParams(), &params.MinAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min_amount: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_amount" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "max_amount", ctx.QueryParams(), &params.MaxAmount)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_amount: %s", err))
+	}
+
+	// ------------- Optional query parameter "category_ids" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "category_ids", ctx.QueryParams(), &params.CategoryIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "exclude_category_ids" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "exclude_category_ids", ctx.QueryParams(), &params.ExcludeCategoryIds)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude_category_ids: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactions(ctx, params)
+	return err
+}
+
+// PostTransactions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTransactions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTransactions(ctx)
+	return err
+}
+
+// PostTransactionsBatch converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTransactionsBatch(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTransactionsBatch(ctx)
+	return err
+}
+
+// GetTransactionsDuplicates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionsDuplicates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionsDuplicatesParams
+	// ------------- Required query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Required query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "window_days" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "window_days", ctx.QueryParams(), &params.WindowDays)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter window_days: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactionsDuplicates(ctx, params)
+	return err
+}
+
+// PostTransactionsDuplicatesMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTransactionsDuplicatesMerge(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTransactionsDuplicatesMerge(ctx)
+	return err
+}
+
+// GetTransactionsExport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionsExport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionsExportParams
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", false, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "category_id" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "category_id", ctx.QueryParams(), &params.CategoryId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter category_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactionsExport(ctx, params)
+	return err
+}
+
+// PostTransactionsImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTransactionsImport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTransactionsImport(ctx)
+	return err
+}
+
+// PostTransactionsRecategorize converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTransactionsRecategorize(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTransactionsRecategorize(ctx)
+	return err
+}
+
+// DeleteTransactionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTransactionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTransactionsId(ctx, id)
+	return err
+}
+
+// GetTransactionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactionsId(ctx, id)
+	return err
+}
+
+// PatchTransactionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTransactionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTransactionsId(ctx, id)
+	return err
+}
+
+// GetTransactionsIdAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionsIdAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactionsIdAttachments(ctx, id)
+	return err
+}
+
+// PostTransactionsIdAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTransactionsIdAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTransactionsIdAttachments(ctx, id)
+	return err
+}
+
+// DeleteTransactionsIdAttachmentsAttachmentId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTransactionsIdAttachmentsAttachmentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", ctx.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachment_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTransactionsIdAttachmentsAttachmentId(ctx, id, attachmentId)
+	return err
+}
+
+// GetTransactionsIdAttachmentsAttachmentId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionsIdAttachmentsAttachmentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", ctx.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachment_id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactionsIdAttachmentsAttachmentId(ctx, id, attachmentId)
+	return err
+}
+
+// GetTransactionsIdHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionsIdHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransactionsIdHistory(ctx, id)
+	return err
+}
+
+// PostTransactionsIdInstallments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTransactionsIdInstallments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTransactionsIdInstallments(ctx, id)
+	return err
+}
+
+// PostTransactionsIdUnlock converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTransactionsIdUnlock(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTransactionsIdUnlock(ctx, id)
+	return err
+}
+
+// GetTransfers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransfers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransfersParams
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "start_date", ctx.QueryParams(), &params.StartDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter start_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "end_date", ctx.QueryParams(), &params.EndDate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter end_date: %s", err))
+	}
+
+	// ------------- Optional query parameter "account_id" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "account_id", ctx.QueryParams(), &params.AccountId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter account_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransfers(ctx, params)
+	return err
+}
+
+// PostTransfers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTransfers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTransfers(ctx)
+	return err
+}
+
+// DeleteTransfersId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTransfersId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTransfersId(ctx, id)
+	return err
+}
+
+// GetTransfersId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransfersId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTransfersId(ctx, id)
+	return err
+}
+
+// GetTrash converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTrash(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTrash(ctx)
+	return err
+}
+
+// DeleteTrashBudgetsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTrashBudgetsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTrashBudgetsId(ctx, id)
+	return err
+}
+
+// PostTrashBudgetsIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTrashBudgetsIdRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTrashBudgetsIdRestore(ctx, id)
+	return err
+}
+
+// DeleteTrashCategoriesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTrashCategoriesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTrashCategoriesId(ctx, id)
+	return err
+}
+
+// PostTrashCategoriesIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTrashCategoriesIdRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTrashCategoriesIdRestore(ctx, id)
+	return err
+}
+
+// DeleteTrashTransactionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTrashTransactionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTrashTransactionsId(ctx, id)
+	return err
+}
+
+// PostTrashTransactionsIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTrashTransactionsIdRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTrashTransactionsIdRestore(ctx, id)
+	return err
+}
+
+// GetUsersCheckSignedIn converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersCheckSignedIn(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersCheckSignedIn(ctx)
+	return err
+}
+
+// GetUsersMe converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersMe(ctx)
+	return err
+}
+
+// PatchUsersMe converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchUsersMe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchUsersMe(ctx)
+	return err
+}
+
+// PostUsersSignIn converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSignIn(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSignIn(ctx)
+	return err
+}
+
+// PostUsersSignOut converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSignOut(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSignOut(ctx)
+	return err
+}
+
+// PostUsersSignUp converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSignUp(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSignUp(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/accounts", wrapper.GetAccounts)
+	router.POST(baseURL+"/accounts", wrapper.PostAccounts)
+	router.GET(baseURL+"/accounts/balances", wrapper.GetAccountsBalances)
+	router.DELETE(baseURL+"/accounts/:id", wrapper.DeleteAccountsId)
+	router.GET(baseURL+"/accounts/:id", wrapper.GetAccountsId)
+	router.PATCH(baseURL+"/accounts/:id", wrapper.PatchAccountsId)
+	router.GET(baseURL+"/accounts/:id/entries", wrapper.GetAccountsIdEntries)
+	router.POST(baseURL+"/accounts/:id/reconcile", wrapper.PostAccountsIdReconcile)
+	router.GET(baseURL+"/accounts/:id/reconciliations", wrapper.GetAccountsIdReconciliations)
+	router.GET(baseURL+"/budget-templates", wrapper.GetBudgetTemplates)
+	router.POST(baseURL+"/budget-templates", wrapper.PostBudgetTemplates)
+	router.DELETE(baseURL+"/budget-templates/:id", wrapper.DeleteBudgetTemplatesId)
+	router.GET(baseURL+"/budget-templates/:id", wrapper.GetBudgetTemplatesId)
+	router.PATCH(baseURL+"/budget-templates/:id", wrapper.PatchBudgetTemplatesId)
+	router.GET(baseURL+"/budgets", wrapper.GetBudgets)
+	router.POST(baseURL+"/budgets", wrapper.PostBudgets)
+	router.GET(baseURL+"/budgets/available", wrapper.GetBudgetsAvailable)
+	router.POST(baseURL+"/budgets/copy", wrapper.PostBudgetsCopy)
+	router.GET(baseURL+"/budgets/summary", wrapper.GetBudgetsSummary)
+	router.DELETE(baseURL+"/budgets/:id", wrapper.DeleteBudgetsId)
+	router.GET(baseURL+"/budgets/:id", wrapper.GetBudgetsId)
+	router.PATCH(baseURL+"/budgets/:id", wrapper.PatchBudgetsId)
+	router.GET(baseURL+"/calendar", wrapper.GetCalendar)
+	router.GET(baseURL+"/categories", wrapper.GetCategories)
+	router.POST(baseURL+"/categories", wrapper.PostCategories)
+	router.DELETE(baseURL+"/categories/:id", wrapper.DeleteCategoriesId)
+	router.GET(baseURL+"/categories/:id", wrapper.GetCategoriesId)
+	router.PATCH(baseURL+"/categories/:id", wrapper.PatchCategoriesId)
+	router.GET(baseURL+"/categorization-rules", wrapper.GetCategorizationRules)
+	router.POST(baseURL+"/categorization-rules", wrapper.PostCategorizationRules)
+	router.DELETE(baseURL+"/categorization-rules/:id", wrapper.DeleteCategorizationRulesId)
+	router.GET(baseURL+"/categorization-rules/:id", wrapper.GetCategorizationRulesId)
+	router.PATCH(baseURL+"/categorization-rules/:id", wrapper.PatchCategorizationRulesId)
+	router.POST(baseURL+"/categorization-rules/:id/apply", wrapper.PostCategorizationRulesIdApply)
+	router.GET(baseURL+"/credit-cards", wrapper.GetCreditCards)
+	router.POST(baseURL+"/credit-cards", wrapper.PostCreditCards)
+	router.GET(baseURL+"/credit-cards/withdrawals", wrapper.GetCreditCardsWithdrawals)
+	router.DELETE(baseURL+"/credit-cards/:id", wrapper.DeleteCreditCardsId)
+	router.GET(baseURL+"/credit-cards/:id", wrapper.GetCreditCardsId)
+	router.PATCH(baseURL+"/credit-cards/:id", wrapper.PatchCreditCardsId)
+	router.GET(baseURL+"/credit-cards/:id/statements", wrapper.GetCreditCardsIdStatements)
+	router.GET(baseURL+"/csrf", wrapper.GetCsrf)
+	router.GET(baseURL+"/exchange-rates", wrapper.GetExchangeRates)
+	router.POST(baseURL+"/exchange-rates", wrapper.PostExchangeRates)
+	router.POST(baseURL+"/exchange-rates/import", wrapper.PostExchangeRatesImport)
+	router.DELETE(baseURL+"/exchange-rates/:id", wrapper.DeleteExchangeRatesId)
+	router.PATCH(baseURL+"/exchange-rates/:id", wrapper.PatchExchangeRatesId)
+	router.GET(baseURL+"/import-profiles", wrapper.GetImportProfiles)
+	router.POST(baseURL+"/import-profiles", wrapper.PostImportProfiles)
+	router.DELETE(baseURL+"/import-profiles/:id", wrapper.DeleteImportProfilesId)
+	router.GET(baseURL+"/import-profiles/:id", wrapper.GetImportProfilesId)
+	router.PATCH(baseURL+"/import-profiles/:id", wrapper.PatchImportProfilesId)
+	router.GET(baseURL+"/installment-plans", wrapper.GetInstallmentPlans)
+	router.DELETE(baseURL+"/installment-plans/:id", wrapper.DeleteInstallmentPlansId)
+	router.GET(baseURL+"/installment-plans/:id", wrapper.GetInstallmentPlansId)
+	router.GET(baseURL+"/recurring-transactions", wrapper.GetRecurringTransactions)
+	router.POST(baseURL+"/recurring-transactions", wrapper.PostRecurringTransactions)
+	router.POST(baseURL+"/recurring-transactions/generate", wrapper.PostRecurringTransactionsGenerate)
+	router.DELETE(baseURL+"/recurring-transactions/:id", wrapper.DeleteRecurringTransactionsId)
+	router.GET(baseURL+"/recurring-transactions/:id", wrapper.GetRecurringTransactionsId)
+	router.PATCH(baseURL+"/recurring-transactions/:id", wrapper.PatchRecurringTransactionsId)
+	router.GET(baseURL+"/reports/trends", wrapper.GetReportsTrends)
+	router.GET(baseURL+"/tags", wrapper.GetTags)
+	router.POST(baseURL+"/tags", wrapper.PostTags)
+	router.GET(baseURL+"/tags/summary", wrapper.GetTagsSummary)
+	router.DELETE(baseURL+"/tags/:id", wrapper.DeleteTagsId)
+	router.GET(baseURL+"/tags/:id", wrapper.GetTagsId)
+	router.PATCH(baseURL+"/tags/:id", wrapper.PatchTagsId)
+	router.GET(baseURL+"/transactions", wrapper.GetTransactions)
+	router.POST(baseURL+"/transactions", wrapper.PostTransactions)
+	router.POST(baseURL+"/transactions/batch", wrapper.PostTransactionsBatch)
+	router.GET(baseURL+"/transactions/duplicates", wrapper.GetTransactionsDuplicates)
+	router.POST(baseURL+"/transactions/duplicates/merge", wrapper.PostTransactionsDuplicatesMerge)
+	router.GET(baseURL+"/transactions/export", wrapper.GetTransactionsExport)
+	router.POST(baseURL+"/transactions/import", wrapper.PostTransactionsImport)
+	router.POST(baseURL+"/transactions/recategorize", wrapper.PostTransactionsRecategorize)
+	router.DELETE(baseURL+"/transactions/:id", wrapper.DeleteTransactionsId)
+	router.GET(baseURL+"/transactions/:id", wrapper.GetTransactionsId)
+	router.PATCH(baseURL+"/transactions/:id", wrapper.PatchTransactionsId)
+	router.GET(baseURL+"/transactions/:id/attachments", wrapper.GetTransactionsIdAttachments)
+	router.POST(baseURL+"/transactions/:id/attachments", wrapper.PostTransactionsIdAttachments)
+	router.DELETE(baseURL+"/transactions/:id/attachments/:attachment_id", wrapper.DeleteTransactionsIdAttachmentsAttachmentId)
+	router.GET(baseURL+"/transactions/:id/attachments/:attachment_id", wrapper.GetTransactionsIdAttachmentsAttachmentId)
+	router.GET(baseURL+"/transactions/:id/history", wrapper.GetTransactionsIdHistory)
+	router.POST(baseURL+"/transactions/:id/installments", wrapper.PostTransactionsIdInstallments)
+	router.POST(baseURL+"/transactions/:id/unlock", wrapper.PostTransactionsIdUnlock)
+	router.GET(baseURL+"/transfers", wrapper.GetTransfers)
+	router.POST(baseURL+"/transfers", wrapper.PostTransfers)
+	router.DELETE(baseURL+"/transfers/:id", wrapper.DeleteTransfersId)
+	router.GET(baseURL+"/transfers/:id", wrapper.GetTransfersId)
+	router.GET(baseURL+"/trash", wrapper.GetTrash)
+	router.DELETE(baseURL+"/trash/budgets/:id", wrapper.DeleteTrashBudgetsId)
+	router.POST(baseURL+"/trash/budgets/:id/restore", wrapper.PostTrashBudgetsIdRestore)
+	router.DELETE(baseURL+"/trash/categories/:id", wrapper.DeleteTrashCategoriesId)
+	router.POST(baseURL+"/trash/categories/:id/restore", wrapper.PostTrashCategoriesIdRestore)
+	router.DELETE(baseURL+"/trash/transactions/:id", wrapper.DeleteTrashTransactionsId)
+	router.POST(baseURL+"/trash/transactions/:id/restore", wrapper.PostTrashTransactionsIdRestore)
+	router.GET(baseURL+"/users/checkSignedIn", wrapper.GetUsersCheckSignedIn)
+	router.GET(baseURL+"/users/me", wrapper.GetUsersMe)
+	router.PATCH(baseURL+"/users/me", wrapper.PatchUsersMe)
+	router.POST(baseURL+"/users/signIn", wrapper.PostUsersSignIn)
+	router.POST(baseURL+"/users/signOut", wrapper.PostUsersSignOut)
+	router.POST(baseURL+"/users/signUp", wrapper.PostUsersSignUp)
+
+}
+
+type GetAccountsRequestObject struct {
+}
+
+type GetAccountsResponseObject interface {
+	VisitGetAccountsResponse(w http.ResponseWriter) error
+}
+
+type GetAccounts200JSONResponse FetchAccountListResponse
+
+func (response GetAccounts200JSONResponse) VisitGetAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccounts500JSONResponse ErrorBody
+
+func (response GetAccounts500JSONResponse) VisitGetAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAccountsRequestObject struct {
+	Body *PostAccountsJSONRequestBody
+}
+
+type PostAccountsResponseObject interface {
+	VisitPostAccountsResponse(w http.ResponseWriter) error
+}
+
+type PostAccounts201JSONResponse CreateAccountResponse
+
+func (response PostAccounts201JSONResponse) VisitPostAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAccounts400JSONResponse ErrorBody
+
+func (response PostAccounts400JSONResponse) VisitPostAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAccounts500JSONResponse ErrorBody
+
+func (response PostAccounts500JSONResponse) VisitPostAccountsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsBalancesRequestObject struct {
+	Params GetAccountsBalancesParams
+}
+
+type GetAccountsBalancesResponseObject interface {
+	VisitGetAccountsBalancesResponse(w http.ResponseWriter) error
+}
+
+type GetAccountsBalances200JSONResponse FetchAccountBalancesResponse
+
+func (response GetAccountsBalances200JSONResponse) VisitGetAccountsBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsBalances400JSONResponse ErrorBody
+
+func (response GetAccountsBalances400JSONResponse) VisitGetAccountsBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsBalances500JSONResponse ErrorBody
+
+func (response GetAccountsBalances500JSONResponse) VisitGetAccountsBalancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccountsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteAccountsIdResponseObject interface {
+	VisitDeleteAccountsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteAccountsId204Response struct {
+}
+
+func (response DeleteAccountsId204Response) VisitDeleteAccountsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteAccountsId400JSONResponse ErrorBody
+
+func (response DeleteAccountsId400JSONResponse) VisitDeleteAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccountsId404JSONResponse ErrorBody
+
+func (response DeleteAccountsId404JSONResponse) VisitDeleteAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAccountsId500JSONResponse ErrorBody
+
+func (response DeleteAccountsId500JSONResponse) VisitDeleteAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetAccountsIdResponseObject interface {
+	VisitGetAccountsIdResponse(w http.ResponseWriter) error
+}
+
+type GetAccountsId200JSONResponse FetchAccountResponse
+
+func (response GetAccountsId200JSONResponse) VisitGetAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsId400JSONResponse ErrorBody
+
+func (response GetAccountsId400JSONResponse) VisitGetAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsId404JSONResponse ErrorBody
+
+func (response GetAccountsId404JSONResponse) VisitGetAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsId500JSONResponse ErrorBody
+
+func (response GetAccountsId500JSONResponse) VisitGetAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAccountsIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchAccountsIdJSONRequestBody
+}
+
+type PatchAccountsIdResponseObject interface {
+	VisitPatchAccountsIdResponse(w http.ResponseWriter) error
+}
+
+type PatchAccountsId200JSONResponse UpdateAccountResponse
+
+func (response PatchAccountsId200JSONResponse) VisitPatchAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAccountsId400JSONResponse ErrorBody
+
+func (response PatchAccountsId400JSONResponse) VisitPatchAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAccountsId404JSONResponse ErrorBody
+
+func (response PatchAccountsId404JSONResponse) VisitPatchAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAccountsId500JSONResponse ErrorBody
+
+func (response PatchAccountsId500JSONResponse) VisitPatchAccountsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsIdEntriesRequestObject struct {
+	Id     int32 `json:"id"`
+	Params GetAccountsIdEntriesParams
+}
+
+type GetAccountsIdEntriesResponseObject interface {
+	VisitGetAccountsIdEntriesResponse(w http.ResponseWriter) error
+}
+
+type GetAccountsIdEntries200JSONResponse FetchAccountEntryListResponse
+
+func (response GetAccountsIdEntries200JSONResponse) VisitGetAccountsIdEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsIdEntries400JSONResponse ErrorBody
+
+func (response GetAccountsIdEntries400JSONResponse) VisitGetAccountsIdEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsIdEntries404JSONResponse ErrorBody
+
+func (response GetAccountsIdEntries404JSONResponse) VisitGetAccountsIdEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsIdEntries500JSONResponse ErrorBody
+
+func (response GetAccountsIdEntries500JSONResponse) VisitGetAccountsIdEntriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAccountsIdReconcileRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PostAccountsIdReconcileJSONRequestBody
+}
+
+type PostAccountsIdReconcileResponseObject interface {
+	VisitPostAccountsIdReconcileResponse(w http.ResponseWriter) error
+}
+
+type PostAccountsIdReconcile200JSONResponse ReconcileAccountResponse
+
+func (response PostAccountsIdReconcile200JSONResponse) VisitPostAccountsIdReconcileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAccountsIdReconcile400JSONResponse ErrorBody
+
+func (response PostAccountsIdReconcile400JSONResponse) VisitPostAccountsIdReconcileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAccountsIdReconcile404JSONResponse ErrorBody
+
+func (response PostAccountsIdReconcile404JSONResponse) VisitPostAccountsIdReconcileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAccountsIdReconcile500JSONResponse ErrorBody
+
+func (response PostAccountsIdReconcile500JSONResponse) VisitPostAccountsIdReconcileResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsIdReconciliationsRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetAccountsIdReconciliationsResponseObject interface {
+	VisitGetAccountsIdReconciliationsResponse(w http.ResponseWriter) error
+}
+
+type GetAccountsIdReconciliations200JSONResponse FetchReconciliationListResponse
+
+func (response GetAccountsIdReconciliations200JSONResponse) VisitGetAccountsIdReconciliationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsIdReconciliations404JSONResponse ErrorBody
+
+func (response GetAccountsIdReconciliations404JSONResponse) VisitGetAccountsIdReconciliationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAccountsIdReconciliations500JSONResponse ErrorBody
+
+func (response GetAccountsIdReconciliations500JSONResponse) VisitGetAccountsIdReconciliationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetTemplatesRequestObject struct {
+}
+
+type GetBudgetTemplatesResponseObject interface {
+	VisitGetBudgetTemplatesResponse(w http.ResponseWriter) error
+}
+
+type GetBudgetTemplates200JSONResponse FetchBudgetTemplateListResponse
+
+func (response GetBudgetTemplates200JSONResponse) VisitGetBudgetTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetTemplates500JSONResponse ErrorBody
+
+func (response GetBudgetTemplates500JSONResponse) VisitGetBudgetTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetTemplatesRequestObject struct {
+	Body *PostBudgetTemplatesJSONRequestBody
+}
+
+type PostBudgetTemplatesResponseObject interface {
+	VisitPostBudgetTemplatesResponse(w http.ResponseWriter) error
+}
+
+type PostBudgetTemplates201JSONResponse CreateBudgetTemplateResponse
+
+func (response PostBudgetTemplates201JSONResponse) VisitPostBudgetTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetTemplates400JSONResponse ErrorBody
+
+func (response PostBudgetTemplates400JSONResponse) VisitPostBudgetTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetTemplates409JSONResponse ErrorBody
+
+func (response PostBudgetTemplates409JSONResponse) VisitPostBudgetTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetTemplates500JSONResponse ErrorBody
+
+func (response PostBudgetTemplates500JSONResponse) VisitPostBudgetTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBudgetTemplatesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteBudgetTemplatesIdResponseObject interface {
+	VisitDeleteBudgetTemplatesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteBudgetTemplatesId204Response struct {
+}
+
+func (response DeleteBudgetTemplatesId204Response) VisitDeleteBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBudgetTemplatesId404JSONResponse ErrorBody
+
+func (response DeleteBudgetTemplatesId404JSONResponse) VisitDeleteBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBudgetTemplatesId500JSONResponse ErrorBody
+
+func (response DeleteBudgetTemplatesId500JSONResponse) VisitDeleteBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetTemplatesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetBudgetTemplatesIdResponseObject interface {
+	VisitGetBudgetTemplatesIdResponse(w http.ResponseWriter) error
+}
+
+type GetBudgetTemplatesId200JSONResponse FetchBudgetTemplateResponse
+
+func (response GetBudgetTemplatesId200JSONResponse) VisitGetBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetTemplatesId400JSONResponse ErrorBody
+
+func (response GetBudgetTemplatesId400JSONResponse) VisitGetBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetTemplatesId404JSONResponse ErrorBody
+
+func (response GetBudgetTemplatesId404JSONResponse) VisitGetBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetTemplatesId500JSONResponse ErrorBody
+
+func (response GetBudgetTemplatesId500JSONResponse) VisitGetBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetTemplatesIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchBudgetTemplatesIdJSONRequestBody
+}
+
+type PatchBudgetTemplatesIdResponseObject interface {
+	VisitPatchBudgetTemplatesIdResponse(w http.ResponseWriter) error
+}
+
+type PatchBudgetTemplatesId200JSONResponse UpdateBudgetTemplateResponse
+
+func (response PatchBudgetTemplatesId200JSONResponse) VisitPatchBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetTemplatesId400JSONResponse ErrorBody
+
+func (response PatchBudgetTemplatesId400JSONResponse) VisitPatchBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetTemplatesId404JSONResponse ErrorBody
+
+func (response PatchBudgetTemplatesId404JSONResponse) VisitPatchBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetTemplatesId409JSONResponse ErrorBody
+
+func (response PatchBudgetTemplatesId409JSONResponse) VisitPatchBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetTemplatesId500JSONResponse ErrorBody
+
+func (response PatchBudgetTemplatesId500JSONResponse) VisitPatchBudgetTemplatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsRequestObject struct {
+	Params GetBudgetsParams
+}
+
+type GetBudgetsResponseObject interface {
+	VisitGetBudgetsResponse(w http.ResponseWriter) error
+}
+
+type GetBudgets200JSONResponse FetchBudgetListResponse
+
+func (response GetBudgets200JSONResponse) VisitGetBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgets400JSONResponse ErrorBody
+
+func (response GetBudgets400JSONResponse) VisitGetBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgets500JSONResponse ErrorBody
+
+func (response GetBudgets500JSONResponse) VisitGetBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetsRequestObject struct {
+	Body *PostBudgetsJSONRequestBody
+}
+
+type PostBudgetsResponseObject interface {
+	VisitPostBudgetsResponse(w http.ResponseWriter) error
+}
+
+type PostBudgets201JSONResponse CreateBudgetResponse
+
+func (response PostBudgets201JSONResponse) VisitPostBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgets400JSONResponse ErrorBody
+
+func (response PostBudgets400JSONResponse) VisitPostBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgets409JSONResponse ErrorBody
+
+func (response PostBudgets409JSONResponse) VisitPostBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgets500JSONResponse ErrorBody
+
+func (response PostBudgets500JSONResponse) VisitPostBudgetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsAvailableRequestObject struct {
+	Params GetBudgetsAvailableParams
+}
+
+type GetBudgetsAvailableResponseObject interface {
+	VisitGetBudgetsAvailableResponse(w http.ResponseWriter) error
+}
+
+type GetBudgetsAvailable200JSONResponse FetchBudgetAvailableResponse
+
+func (response GetBudgetsAvailable200JSONResponse) VisitGetBudgetsAvailableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsAvailable400JSONResponse ErrorBody
+
+func (response GetBudgetsAvailable400JSONResponse) VisitGetBudgetsAvailableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsAvailable500JSONResponse ErrorBody
+
+func (response GetBudgetsAvailable500JSONResponse) VisitGetBudgetsAvailableResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetsCopyRequestObject struct {
+	Body *PostBudgetsCopyJSONRequestBody
+}
+
+type PostBudgetsCopyResponseObject interface {
+	VisitPostBudgetsCopyResponse(w http.ResponseWriter) error
+}
+
+type PostBudgetsCopy200JSONResponse CopyBudgetsResponse
+
+func (response PostBudgetsCopy200JSONResponse) VisitPostBudgetsCopyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetsCopy400JSONResponse ErrorBody
+
+func (response PostBudgetsCopy400JSONResponse) VisitPostBudgetsCopyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetsCopy404JSONResponse ErrorBody
+
+func (response PostBudgetsCopy404JSONResponse) VisitPostBudgetsCopyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetsCopy409JSONResponse ErrorBody
+
+func (response PostBudgetsCopy409JSONResponse) VisitPostBudgetsCopyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostBudgetsCopy500JSONResponse ErrorBody
+
+func (response PostBudgetsCopy500JSONResponse) VisitPostBudgetsCopyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsSummaryRequestObject struct {
+	Params GetBudgetsSummaryParams
+}
+
+type GetBudgetsSummaryResponseObject interface {
+	VisitGetBudgetsSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetBudgetsSummary200JSONResponse FetchBudgetSummaryResponse
+
+func (response GetBudgetsSummary200JSONResponse) VisitGetBudgetsSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsSummary400JSONResponse ErrorBody
+
+func (response GetBudgetsSummary400JSONResponse) VisitGetBudgetsSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsSummary500JSONResponse ErrorBody
+
+func (response GetBudgetsSummary500JSONResponse) VisitGetBudgetsSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBudgetsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteBudgetsIdResponseObject interface {
+	VisitDeleteBudgetsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteBudgetsId204Response struct {
+}
+
+func (response DeleteBudgetsId204Response) VisitDeleteBudgetsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteBudgetsId404JSONResponse ErrorBody
+
+func (response DeleteBudgetsId404JSONResponse) VisitDeleteBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteBudgetsId500JSONResponse ErrorBody
+
+func (response DeleteBudgetsId500JSONResponse) VisitDeleteBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetBudgetsIdResponseObject interface {
+	VisitGetBudgetsIdResponse(w http.ResponseWriter) error
+}
+
+type GetBudgetsId200JSONResponse FetchBudgetResponse
+
+func (response GetBudgetsId200JSONResponse) VisitGetBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsId400JSONResponse ErrorBody
+
+func (response GetBudgetsId400JSONResponse) VisitGetBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsId404JSONResponse ErrorBody
+
+func (response GetBudgetsId404JSONResponse) VisitGetBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBudgetsId500JSONResponse ErrorBody
+
+func (response GetBudgetsId500JSONResponse) VisitGetBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetsIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchBudgetsIdJSONRequestBody
+}
+
+type PatchBudgetsIdResponseObject interface {
+	VisitPatchBudgetsIdResponse(w http.ResponseWriter) error
+}
+
+type PatchBudgetsId200JSONResponse UpdateBudgetResponse
+
+func (response PatchBudgetsId200JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetsId400JSONResponse ErrorBody
+
+func (response PatchBudgetsId400JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetsId404JSONResponse ErrorBody
+
+func (response PatchBudgetsId404JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetsId409JSONResponse ErrorBody
+
+func (response PatchBudgetsId409JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchBudgetsId500JSONResponse ErrorBody
+
+func (response PatchBudgetsId500JSONResponse) VisitPatchBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendarRequestObject struct {
+	Params GetCalendarParams
+}
+
+type GetCalendarResponseObject interface {
+	VisitGetCalendarResponse(w http.ResponseWriter) error
+}
+
+type GetCalendar200JSONResponse FetchCalendarResponse
+
+func (response GetCalendar200JSONResponse) VisitGetCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendar400JSONResponse ErrorBody
+
+func (response GetCalendar400JSONResponse) VisitGetCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCalendar500JSONResponse ErrorBody
+
+func (response GetCalendar500JSONResponse) VisitGetCalendarResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoriesRequestObject struct {
+}
+
+type GetCategoriesResponseObject interface {
+	VisitGetCategoriesResponse(w http.ResponseWriter) error
+}
+
+type GetCategories200JSONResponse FetchCategoryListsResponse
+
+func (response GetCategories200JSONResponse) VisitGetCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategories500JSONResponse ErrorBody
+
+func (response GetCategories500JSONResponse) VisitGetCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategoriesRequestObject struct {
+	Body *PostCategoriesJSONRequestBody
+}
+
+type PostCategoriesResponseObject interface {
+	VisitPostCategoriesResponse(w http.ResponseWriter) error
+}
+
+type PostCategories201JSONResponse CreateCategoryResponse
+
+func (response PostCategories201JSONResponse) VisitPostCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategories400JSONResponse ErrorBody
+
+func (response PostCategories400JSONResponse) VisitPostCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategories500JSONResponse ErrorBody
+
+func (response PostCategories500JSONResponse) VisitPostCategoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoriesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteCategoriesIdResponseObject interface {
+	VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteCategoriesId204Response struct {
+}
+
+func (response DeleteCategoriesId204Response) VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCategoriesId400JSONResponse ErrorBody
+
+func (response DeleteCategoriesId400JSONResponse) VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoriesId404JSONResponse ErrorBody
+
+func (response DeleteCategoriesId404JSONResponse) VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategoriesId500JSONResponse ErrorBody
+
+func (response DeleteCategoriesId500JSONResponse) VisitDeleteCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoriesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetCategoriesIdResponseObject interface {
+	VisitGetCategoriesIdResponse(w http.ResponseWriter) error
+}
+
+type GetCategoriesId200JSONResponse FetchCategoryResponse
+
+func (response GetCategoriesId200JSONResponse) VisitGetCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoriesId400JSONResponse ErrorBody
+
+func (response GetCategoriesId400JSONResponse) VisitGetCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoriesId404JSONResponse ErrorBody
+
+func (response GetCategoriesId404JSONResponse) VisitGetCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategoriesId500JSONResponse ErrorBody
+
+func (response GetCategoriesId500JSONResponse) VisitGetCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCategoriesIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchCategoriesIdJSONRequestBody
+}
+
+type PatchCategoriesIdResponseObject interface {
+	VisitPatchCategoriesIdResponse(w http.ResponseWriter) error
+}
+
+type PatchCategoriesId200JSONResponse UpdateCategoryResponse
+
+func (response PatchCategoriesId200JSONResponse) VisitPatchCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCategoriesId400JSONResponse ErrorBody
+
+func (response PatchCategoriesId400JSONResponse) VisitPatchCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCategoriesId404JSONResponse ErrorBody
+
+func (response PatchCategoriesId404JSONResponse) VisitPatchCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCategoriesId500JSONResponse ErrorBody
+
+func (response PatchCategoriesId500JSONResponse) VisitPatchCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategorizationRulesRequestObject struct {
+}
+
+type GetCategorizationRulesResponseObject interface {
+	VisitGetCategorizationRulesResponse(w http.ResponseWriter) error
+}
+
+type GetCategorizationRules200JSONResponse FetchCategorizationRuleListResponse
+
+func (response GetCategorizationRules200JSONResponse) VisitGetCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategorizationRules500JSONResponse ErrorBody
+
+func (response GetCategorizationRules500JSONResponse) VisitGetCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategorizationRulesRequestObject struct {
+	Body *PostCategorizationRulesJSONRequestBody
+}
+
+type PostCategorizationRulesResponseObject interface {
+	VisitPostCategorizationRulesResponse(w http.ResponseWriter) error
+}
+
+type PostCategorizationRules201JSONResponse CreateCategorizationRuleResponse
+
+func (response PostCategorizationRules201JSONResponse) VisitPostCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategorizationRules400JSONResponse ErrorBody
+
+func (response PostCategorizationRules400JSONResponse) VisitPostCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategorizationRules500JSONResponse ErrorBody
+
+func (response PostCategorizationRules500JSONResponse) VisitPostCategorizationRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategorizationRulesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteCategorizationRulesIdResponseObject interface {
+	VisitDeleteCategorizationRulesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteCategorizationRulesId204Response struct {
+}
+
+func (response DeleteCategorizationRulesId204Response) VisitDeleteCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCategorizationRulesId404JSONResponse ErrorBody
+
+func (response DeleteCategorizationRulesId404JSONResponse) VisitDeleteCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCategorizationRulesId500JSONResponse ErrorBody
+
+func (response DeleteCategorizationRulesId500JSONResponse) VisitDeleteCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategorizationRulesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetCategorizationRulesIdResponseObject interface {
+	VisitGetCategorizationRulesIdResponse(w http.ResponseWriter) error
+}
+
+type GetCategorizationRulesId200JSONResponse FetchCategorizationRuleResponse
+
+func (response GetCategorizationRulesId200JSONResponse) VisitGetCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategorizationRulesId400JSONResponse ErrorBody
+
+func (response GetCategorizationRulesId400JSONResponse) VisitGetCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategorizationRulesId404JSONResponse ErrorBody
+
+func (response GetCategorizationRulesId404JSONResponse) VisitGetCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCategorizationRulesId500JSONResponse ErrorBody
+
+func (response GetCategorizationRulesId500JSONResponse) VisitGetCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCategorizationRulesIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchCategorizationRulesIdJSONRequestBody
+}
+
+type PatchCategorizationRulesIdResponseObject interface {
+	VisitPatchCategorizationRulesIdResponse(w http.ResponseWriter) error
+}
+
+type PatchCategorizationRulesId200JSONResponse UpdateCategorizationRuleResponse
+
+func (response PatchCategorizationRulesId200JSONResponse) VisitPatchCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCategorizationRulesId400JSONResponse ErrorBody
+
+func (response PatchCategorizationRulesId400JSONResponse) VisitPatchCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCategorizationRulesId404JSONResponse ErrorBody
+
+func (response PatchCategorizationRulesId404JSONResponse) VisitPatchCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCategorizationRulesId500JSONResponse ErrorBody
+
+func (response PatchCategorizationRulesId500JSONResponse) VisitPatchCategorizationRulesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategorizationRulesIdApplyRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PostCategorizationRulesIdApplyJSONRequestBody
+}
+
+type PostCategorizationRulesIdApplyResponseObject interface {
+	VisitPostCategorizationRulesIdApplyResponse(w http.ResponseWriter) error
+}
+
+type PostCategorizationRulesIdApply200JSONResponse ApplyCategorizationRuleResponse
+
+func (response PostCategorizationRulesIdApply200JSONResponse) VisitPostCategorizationRulesIdApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategorizationRulesIdApply400JSONResponse ErrorBody
+
+func (response PostCategorizationRulesIdApply400JSONResponse) VisitPostCategorizationRulesIdApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategorizationRulesIdApply404JSONResponse ErrorBody
+
+func (response PostCategorizationRulesIdApply404JSONResponse) VisitPostCategorizationRulesIdApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCategorizationRulesIdApply500JSONResponse ErrorBody
+
+func (response PostCategorizationRulesIdApply500JSONResponse) VisitPostCategorizationRulesIdApplyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsRequestObject struct {
+}
+
+type GetCreditCardsResponseObject interface {
+	VisitGetCreditCardsResponse(w http.ResponseWriter) error
+}
+
+type GetCreditCards200JSONResponse FetchCreditCardListResponse
+
+func (response GetCreditCards200JSONResponse) VisitGetCreditCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCards500JSONResponse ErrorBody
+
+func (response GetCreditCards500JSONResponse) VisitGetCreditCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCreditCardsRequestObject struct {
+	Body *PostCreditCardsJSONRequestBody
+}
+
+type PostCreditCardsResponseObject interface {
+	VisitPostCreditCardsResponse(w http.ResponseWriter) error
+}
+
+type PostCreditCards201JSONResponse CreateCreditCardResponse
+
+func (response PostCreditCards201JSONResponse) VisitPostCreditCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCreditCards400JSONResponse ErrorBody
+
+func (response PostCreditCards400JSONResponse) VisitPostCreditCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCreditCards500JSONResponse ErrorBody
+
+func (response PostCreditCards500JSONResponse) VisitPostCreditCardsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsWithdrawalsRequestObject struct {
+	Params GetCreditCardsWithdrawalsParams
+}
+
+type GetCreditCardsWithdrawalsResponseObject interface {
+	VisitGetCreditCardsWithdrawalsResponse(w http.ResponseWriter) error
+}
+
+type GetCreditCardsWithdrawals200JSONResponse FetchCreditCardWithdrawalsResponse
+
+func (response GetCreditCardsWithdrawals200JSONResponse) VisitGetCreditCardsWithdrawalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsWithdrawals400JSONResponse ErrorBody
+
+func (response GetCreditCardsWithdrawals400JSONResponse) VisitGetCreditCardsWithdrawalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsWithdrawals500JSONResponse ErrorBody
+
+func (response GetCreditCardsWithdrawals500JSONResponse) VisitGetCreditCardsWithdrawalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCreditCardsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteCreditCardsIdResponseObject interface {
+	VisitDeleteCreditCardsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteCreditCardsId204Response struct {
+}
+
+func (response DeleteCreditCardsId204Response) VisitDeleteCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCreditCardsId400JSONResponse ErrorBody
+
+func (response DeleteCreditCardsId400JSONResponse) VisitDeleteCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCreditCardsId404JSONResponse ErrorBody
+
+func (response DeleteCreditCardsId404JSONResponse) VisitDeleteCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCreditCardsId500JSONResponse ErrorBody
+
+func (response DeleteCreditCardsId500JSONResponse) VisitDeleteCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetCreditCardsIdResponseObject interface {
+	VisitGetCreditCardsIdResponse(w http.ResponseWriter) error
+}
+
+type GetCreditCardsId200JSONResponse FetchCreditCardResponse
+
+func (response GetCreditCardsId200JSONResponse) VisitGetCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsId400JSONResponse ErrorBody
+
+func (response GetCreditCardsId400JSONResponse) VisitGetCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsId404JSONResponse ErrorBody
+
+func (response GetCreditCardsId404JSONResponse) VisitGetCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsId500JSONResponse ErrorBody
+
+func (response GetCreditCardsId500JSONResponse) VisitGetCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCreditCardsIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchCreditCardsIdJSONRequestBody
+}
+
+type PatchCreditCardsIdResponseObject interface {
+	VisitPatchCreditCardsIdResponse(w http.ResponseWriter) error
+}
+
+type PatchCreditCardsId200JSONResponse UpdateCreditCardResponse
+
+func (response PatchCreditCardsId200JSONResponse) VisitPatchCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCreditCardsId400JSONResponse ErrorBody
+
+func (response PatchCreditCardsId400JSONResponse) VisitPatchCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCreditCardsId404JSONResponse ErrorBody
+
+func (response PatchCreditCardsId404JSONResponse) VisitPatchCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCreditCardsId500JSONResponse ErrorBody
+
+func (response PatchCreditCardsId500JSONResponse) VisitPatchCreditCardsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsIdStatementsRequestObject struct {
+	Id     int32 `json:"id"`
+	Params GetCreditCardsIdStatementsParams
+}
+
+type GetCreditCardsIdStatementsResponseObject interface {
+	VisitGetCreditCardsIdStatementsResponse(w http.ResponseWriter) error
+}
+
+type GetCreditCardsIdStatements200JSONResponse FetchCreditCardStatementsResponse
+
+func (response GetCreditCardsIdStatements200JSONResponse) VisitGetCreditCardsIdStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsIdStatements400JSONResponse ErrorBody
+
+func (response GetCreditCardsIdStatements400JSONResponse) VisitGetCreditCardsIdStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsIdStatements404JSONResponse ErrorBody
+
+func (response GetCreditCardsIdStatements404JSONResponse) VisitGetCreditCardsIdStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCreditCardsIdStatements500JSONResponse ErrorBody
+
+func (response GetCreditCardsIdStatements500JSONResponse) VisitGetCreditCardsIdStatementsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCsrfRequestObject struct {
+}
+
+type GetCsrfResponseObject interface {
+	VisitGetCsrfResponse(w http.ResponseWriter) error
+}
+
+type GetCsrf200JSONResponse CsrfResponse
+
+func (response GetCsrf200JSONResponse) VisitGetCsrfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCsrf500JSONResponse ErrorBody
+
+func (response GetCsrf500JSONResponse) VisitGetCsrfResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchangeRatesRequestObject struct {
+	Params GetExchangeRatesParams
+}
+
+type GetExchangeRatesResponseObject interface {
+	VisitGetExchangeRatesResponse(w http.ResponseWriter) error
+}
+
+type GetExchangeRates200JSONResponse FetchExchangeRateListResponse
+
+func (response GetExchangeRates200JSONResponse) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchangeRates400JSONResponse ErrorBody
+
+func (response GetExchangeRates400JSONResponse) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExchangeRates500JSONResponse ErrorBody
+
+func (response GetExchangeRates500JSONResponse) VisitGetExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExchangeRatesRequestObject struct {
+	Body *PostExchangeRatesJSONRequestBody
+}
+
+type PostExchangeRatesResponseObject interface {
+	VisitPostExchangeRatesResponse(w http.ResponseWriter) error
+}
+
+type PostExchangeRates201JSONResponse CreateExchangeRateResponse
+
+func (response PostExchangeRates201JSONResponse) VisitPostExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExchangeRates400JSONResponse ErrorBody
+
+func (response PostExchangeRates400JSONResponse) VisitPostExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExchangeRates409JSONResponse ErrorBody
+
+func (response PostExchangeRates409JSONResponse) VisitPostExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExchangeRates500JSONResponse ErrorBody
+
+func (response PostExchangeRates500JSONResponse) VisitPostExchangeRatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExchangeRatesImportRequestObject struct {
+	Body *multipart.Reader
+}
+
+type PostExchangeRatesImportResponseObject interface {
+	VisitPostExchangeRatesImportResponse(w http.ResponseWriter) error
+}
+
+type PostExchangeRatesImport200JSONResponse ImportExchangeRatesResponse
+
+func (response PostExchangeRatesImport200JSONResponse) VisitPostExchangeRatesImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExchangeRatesImport400JSONResponse ErrorBody
+
+func (response PostExchangeRatesImport400JSONResponse) VisitPostExchangeRatesImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostExchangeRatesImport500JSONResponse ErrorBody
+
+func (response PostExchangeRatesImport500JSONResponse) VisitPostExchangeRatesImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteExchangeRatesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteExchangeRatesIdResponseObject interface {
+	VisitDeleteExchangeRatesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteExchangeRatesId204Response struct {
+}
+
+func (response DeleteExchangeRatesId204Response) VisitDeleteExchangeRatesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteExchangeRatesId404JSONResponse ErrorBody
+
+func (response DeleteExchangeRatesId404JSONResponse) VisitDeleteExchangeRatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteExchangeRatesId500JSONResponse ErrorBody
+
+func (response DeleteExchangeRatesId500JSONResponse) VisitDeleteExchangeRatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchExchangeRatesIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchExchangeRatesIdJSONRequestBody
+}
+
+type PatchExchangeRatesIdResponseObject interface {
+	VisitPatchExchangeRatesIdResponse(w http.ResponseWriter) error
+}
+
+type PatchExchangeRatesId200JSONResponse UpdateExchangeRateResponse
+
+func (response PatchExchangeRatesId200JSONResponse) VisitPatchExchangeRatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchExchangeRatesId400JSONResponse ErrorBody
+
+func (response PatchExchangeRatesId400JSONResponse) VisitPatchExchangeRatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchExchangeRatesId404JSONResponse ErrorBody
+
+func (response PatchExchangeRatesId404JSONResponse) VisitPatchExchangeRatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchExchangeRatesId409JSONResponse ErrorBody
+
+func (response PatchExchangeRatesId409JSONResponse) VisitPatchExchangeRatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchExchangeRatesId500JSONResponse ErrorBody
+
+func (response PatchExchangeRatesId500JSONResponse) VisitPatchExchangeRatesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportProfilesRequestObject struct {
+}
+
+type GetImportProfilesResponseObject interface {
+	VisitGetImportProfilesResponse(w http.ResponseWriter) error
+}
+
+type GetImportProfiles200JSONResponse FetchImportProfileListResponse
+
+func (response GetImportProfiles200JSONResponse) VisitGetImportProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportProfiles500JSONResponse ErrorBody
+
+func (response GetImportProfiles500JSONResponse) VisitGetImportProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImportProfilesRequestObject struct {
+	Body *PostImportProfilesJSONRequestBody
+}
+
+type PostImportProfilesResponseObject interface {
+	VisitPostImportProfilesResponse(w http.ResponseWriter) error
+}
+
+type PostImportProfiles201JSONResponse CreateImportProfileResponse
+
+func (response PostImportProfiles201JSONResponse) VisitPostImportProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImportProfiles400JSONResponse ErrorBody
+
+func (response PostImportProfiles400JSONResponse) VisitPostImportProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostImportProfiles500JSONResponse ErrorBody
+
+func (response PostImportProfiles500JSONResponse) VisitPostImportProfilesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteImportProfilesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteImportProfilesIdResponseObject interface {
+	VisitDeleteImportProfilesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteImportProfilesId204Response struct {
+}
+
+func (response DeleteImportProfilesId204Response) VisitDeleteImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteImportProfilesId404JSONResponse ErrorBody
+
+func (response DeleteImportProfilesId404JSONResponse) VisitDeleteImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteImportProfilesId500JSONResponse ErrorBody
+
+func (response DeleteImportProfilesId500JSONResponse) VisitDeleteImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportProfilesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetImportProfilesIdResponseObject interface {
+	VisitGetImportProfilesIdResponse(w http.ResponseWriter) error
+}
+
+type GetImportProfilesId200JSONResponse FetchImportProfileResponse
+
+func (response GetImportProfilesId200JSONResponse) VisitGetImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportProfilesId400JSONResponse ErrorBody
+
+func (response GetImportProfilesId400JSONResponse) VisitGetImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportProfilesId404JSONResponse ErrorBody
+
+func (response GetImportProfilesId404JSONResponse) VisitGetImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetImportProfilesId500JSONResponse ErrorBody
+
+func (response GetImportProfilesId500JSONResponse) VisitGetImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchImportProfilesIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchImportProfilesIdJSONRequestBody
+}
+
+type PatchImportProfilesIdResponseObject interface {
+	VisitPatchImportProfilesIdResponse(w http.ResponseWriter) error
+}
+
+type PatchImportProfilesId200JSONResponse UpdateImportProfileResponse
+
+func (response PatchImportProfilesId200JSONResponse) VisitPatchImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchImportProfilesId400JSONResponse ErrorBody
+
+func (response PatchImportProfilesId400JSONResponse) VisitPatchImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchImportProfilesId404JSONResponse ErrorBody
+
+func (response PatchImportProfilesId404JSONResponse) VisitPatchImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchImportProfilesId500JSONResponse ErrorBody
+
+func (response PatchImportProfilesId500JSONResponse) VisitPatchImportProfilesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstallmentPlansRequestObject struct {
+}
+
+type GetInstallmentPlansResponseObject interface {
+	VisitGetInstallmentPlansResponse(w http.ResponseWriter) error
+}
+
+type GetInstallmentPlans200JSONResponse FetchInstallmentPlanListResponse
+
+func (response GetInstallmentPlans200JSONResponse) VisitGetInstallmentPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstallmentPlans500JSONResponse ErrorBody
+
+func (response GetInstallmentPlans500JSONResponse) VisitGetInstallmentPlansResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInstallmentPlansIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteInstallmentPlansIdResponseObject interface {
+	VisitDeleteInstallmentPlansIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteInstallmentPlansId204Response struct {
+}
+
+func (response DeleteInstallmentPlansId204Response) VisitDeleteInstallmentPlansIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteInstallmentPlansId400JSONResponse ErrorBody
+
+func (response DeleteInstallmentPlansId400JSONResponse) VisitDeleteInstallmentPlansIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInstallmentPlansId404JSONResponse ErrorBody
+
+func (response DeleteInstallmentPlansId404JSONResponse) VisitDeleteInstallmentPlansIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteInstallmentPlansId500JSONResponse ErrorBody
+
+func (response DeleteInstallmentPlansId500JSONResponse) VisitDeleteInstallmentPlansIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstallmentPlansIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetInstallmentPlansIdResponseObject interface {
+	VisitGetInstallmentPlansIdResponse(w http.ResponseWriter) error
+}
+
+type GetInstallmentPlansId200JSONResponse FetchInstallmentPlanResponse
+
+func (response GetInstallmentPlansId200JSONResponse) VisitGetInstallmentPlansIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstallmentPlansId400JSONResponse ErrorBody
+
+func (response GetInstallmentPlansId400JSONResponse) VisitGetInstallmentPlansIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstallmentPlansId404JSONResponse ErrorBody
+
+func (response GetInstallmentPlansId404JSONResponse) VisitGetInstallmentPlansIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstallmentPlansId500JSONResponse ErrorBody
+
+func (response GetInstallmentPlansId500JSONResponse) VisitGetInstallmentPlansIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecurringTransactionsRequestObject struct {
+}
+
+type GetRecurringTransactionsResponseObject interface {
+	VisitGetRecurringTransactionsResponse(w http.ResponseWriter) error
+}
+
+type GetRecurringTransactions200JSONResponse FetchRecurringTransactionListResponse
+
+func (response GetRecurringTransactions200JSONResponse) VisitGetRecurringTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecurringTransactions500JSONResponse ErrorBody
+
+func (response GetRecurringTransactions500JSONResponse) VisitGetRecurringTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRecurringTransactionsRequestObject struct {
+	Body *PostRecurringTransactionsJSONRequestBody
+}
+
+type PostRecurringTransactionsResponseObject interface {
+	VisitPostRecurringTransactionsResponse(w http.ResponseWriter) error
+}
+
+type PostRecurringTransactions201JSONResponse CreateRecurringTransactionResponse
+
+func (response PostRecurringTransactions201JSONResponse) VisitPostRecurringTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRecurringTransactions400JSONResponse ErrorBody
+
+func (response PostRecurringTransactions400JSONResponse) VisitPostRecurringTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRecurringTransactions500JSONResponse ErrorBody
+
+func (response PostRecurringTransactions500JSONResponse) VisitPostRecurringTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRecurringTransactionsGenerateRequestObject struct {
+	Body *PostRecurringTransactionsGenerateJSONRequestBody
+}
+
+type PostRecurringTransactionsGenerateResponseObject interface {
+	VisitPostRecurringTransactionsGenerateResponse(w http.ResponseWriter) error
+}
+
+type PostRecurringTransactionsGenerate200JSONResponse GenerateRecurringTransactionsResponse
+
+func (response PostRecurringTransactionsGenerate200JSONResponse) VisitPostRecurringTransactionsGenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRecurringTransactionsGenerate400JSONResponse ErrorBody
+
+func (response PostRecurringTransactionsGenerate400JSONResponse) VisitPostRecurringTransactionsGenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRecurringTransactionsGenerate500JSONResponse ErrorBody
+
+func (response PostRecurringTransactionsGenerate500JSONResponse) VisitPostRecurringTransactionsGenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRecurringTransactionsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteRecurringTransactionsIdResponseObject interface {
+	VisitDeleteRecurringTransactionsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteRecurringTransactionsId204Response struct {
+}
+
+func (response DeleteRecurringTransactionsId204Response) VisitDeleteRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRecurringTransactionsId404JSONResponse ErrorBody
+
+func (response DeleteRecurringTransactionsId404JSONResponse) VisitDeleteRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRecurringTransactionsId500JSONResponse ErrorBody
+
+func (response DeleteRecurringTransactionsId500JSONResponse) VisitDeleteRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecurringTransactionsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetRecurringTransactionsIdResponseObject interface {
+	VisitGetRecurringTransactionsIdResponse(w http.ResponseWriter) error
+}
+
+type GetRecurringTransactionsId200JSONResponse FetchRecurringTransactionResponse
+
+func (response GetRecurringTransactionsId200JSONResponse) VisitGetRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecurringTransactionsId400JSONResponse ErrorBody
+
+func (response GetRecurringTransactionsId400JSONResponse) VisitGetRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecurringTransactionsId404JSONResponse ErrorBody
+
+func (response GetRecurringTransactionsId404JSONResponse) VisitGetRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRecurringTransactionsId500JSONResponse ErrorBody
+
+func (response GetRecurringTransactionsId500JSONResponse) VisitGetRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRecurringTransactionsIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchRecurringTransactionsIdJSONRequestBody
+}
+
+type PatchRecurringTransactionsIdResponseObject interface {
+	VisitPatchRecurringTransactionsIdResponse(w http.ResponseWriter) error
+}
+
+type PatchRecurringTransactionsId200JSONResponse UpdateRecurringTransactionResponse
+
+func (response PatchRecurringTransactionsId200JSONResponse) VisitPatchRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRecurringTransactionsId400JSONResponse ErrorBody
+
+func (response PatchRecurringTransactionsId400JSONResponse) VisitPatchRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRecurringTransactionsId404JSONResponse ErrorBody
+
+func (response PatchRecurringTransactionsId404JSONResponse) VisitPatchRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchRecurringTransactionsId500JSONResponse ErrorBody
+
+func (response PatchRecurringTransactionsId500JSONResponse) VisitPatchRecurringTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsTrendsRequestObject struct {
+	Params GetReportsTrendsParams
+}
+
+type GetReportsTrendsResponseObject interface {
+	VisitGetReportsTrendsResponse(w http.ResponseWriter) error
+}
+
+type GetReportsTrends200JSONResponse FetchTrendReportResponse
+
+func (response GetReportsTrends200JSONResponse) VisitGetReportsTrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsTrends400JSONResponse ErrorBody
+
+func (response GetReportsTrends400JSONResponse) VisitGetReportsTrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReportsTrends500JSONResponse ErrorBody
+
+func (response GetReportsTrends500JSONResponse) VisitGetReportsTrendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagsRequestObject struct {
+}
+
+type GetTagsResponseObject interface {
+	VisitGetTagsResponse(w http.ResponseWriter) error
+}
+
+type GetTags200JSONResponse FetchTagListResponse
+
+func (response GetTags200JSONResponse) VisitGetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTags500JSONResponse ErrorBody
+
+func (response GetTags500JSONResponse) VisitGetTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTagsRequestObject struct {
+	Body *PostTagsJSONRequestBody
+}
+
+type PostTagsResponseObject interface {
+	VisitPostTagsResponse(w http.ResponseWriter) error
+}
+
+type PostTags201JSONResponse CreateTagResponse
+
+func (response PostTags201JSONResponse) VisitPostTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTags400JSONResponse ErrorBody
+
+func (response PostTags400JSONResponse) VisitPostTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTags409JSONResponse ErrorBody
+
+func (response PostTags409JSONResponse) VisitPostTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTags500JSONResponse ErrorBody
+
+func (response PostTags500JSONResponse) VisitPostTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagsSummaryRequestObject struct {
+	Params GetTagsSummaryParams
+}
+
+type GetTagsSummaryResponseObject interface {
+	VisitGetTagsSummaryResponse(w http.ResponseWriter) error
+}
+
+type GetTagsSummary200JSONResponse FetchTagSummaryResponse
+
+func (response GetTagsSummary200JSONResponse) VisitGetTagsSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagsSummary400JSONResponse ErrorBody
+
+func (response GetTagsSummary400JSONResponse) VisitGetTagsSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagsSummary500JSONResponse ErrorBody
+
+func (response GetTagsSummary500JSONResponse) VisitGetTagsSummaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTagsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteTagsIdResponseObject interface {
+	VisitDeleteTagsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTagsId204Response struct {
+}
+
+func (response DeleteTagsId204Response) VisitDeleteTagsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTagsId404JSONResponse ErrorBody
+
+func (response DeleteTagsId404JSONResponse) VisitDeleteTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTagsId500JSONResponse ErrorBody
+
+func (response DeleteTagsId500JSONResponse) VisitDeleteTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetTagsIdResponseObject interface {
+	VisitGetTagsIdResponse(w http.ResponseWriter) error
+}
+
+type GetTagsId200JSONResponse FetchTagResponse
+
+func (response GetTagsId200JSONResponse) VisitGetTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagsId400JSONResponse ErrorBody
+
+func (response GetTagsId400JSONResponse) VisitGetTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagsId404JSONResponse ErrorBody
+
+func (response GetTagsId404JSONResponse) VisitGetTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTagsId500JSONResponse ErrorBody
+
+func (response GetTagsId500JSONResponse) VisitGetTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTagsIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchTagsIdJSONRequestBody
+}
+
+type PatchTagsIdResponseObject interface {
+	VisitPatchTagsIdResponse(w http.ResponseWriter) error
+}
+
+type PatchTagsId200JSONResponse UpdateTagResponse
+
+func (response PatchTagsId200JSONResponse) VisitPatchTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTagsId400JSONResponse ErrorBody
+
+func (response PatchTagsId400JSONResponse) VisitPatchTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTagsId404JSONResponse ErrorBody
+
+func (response PatchTagsId404JSONResponse) VisitPatchTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTagsId409JSONResponse ErrorBody
+
+func (response PatchTagsId409JSONResponse) VisitPatchTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTagsId500JSONResponse ErrorBody
+
+func (response PatchTagsId500JSONResponse) VisitPatchTagsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsRequestObject struct {
+	Params GetTransactionsParams
+}
+
+type GetTransactionsResponseObject interface {
+	VisitGetTransactionsResponse(w http.ResponseWriter) error
+}
+
+type GetTransactions200JSONResponse FetchTransactionListResponse
+
+func (response GetTransactions200JSONResponse) VisitGetTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactions400JSONResponse ErrorBody
+
+func (response GetTransactions400JSONResponse) VisitGetTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactions500JSONResponse ErrorBody
+
+func (response GetTransactions500JSONResponse) VisitGetTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsRequestObject struct {
+	Body *PostTransactionsJSONRequestBody
+}
+
+type PostTransactionsResponseObject interface {
+	VisitPostTransactionsResponse(w http.ResponseWriter) error
+}
+
+type PostTransactions201JSONResponse CreateTransactionResponse
+
+func (response PostTransactions201JSONResponse) VisitPostTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactions400JSONResponse ErrorBody
+
+func (response PostTransactions400JSONResponse) VisitPostTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactions409JSONResponse ErrorBody
+
+func (response PostTransactions409JSONResponse) VisitPostTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactions500JSONResponse ErrorBody
+
+func (response PostTransactions500JSONResponse) VisitPostTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsBatchRequestObject struct {
+	Body *PostTransactionsBatchJSONRequestBody
+}
+
+type PostTransactionsBatchResponseObject interface {
+	VisitPostTransactionsBatchResponse(w http.ResponseWriter) error
+}
+
+type PostTransactionsBatch200JSONResponse BatchTransactionsResponse
+
+func (response PostTransactionsBatch200JSONResponse) VisitPostTransactionsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsBatch400JSONResponse ErrorBody
+
+func (response PostTransactionsBatch400JSONResponse) VisitPostTransactionsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsBatch500JSONResponse ErrorBody
+
+func (response PostTransactionsBatch500JSONResponse) VisitPostTransactionsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsDuplicatesRequestObject struct {
+	Params GetTransactionsDuplicatesParams
+}
+
+type GetTransactionsDuplicatesResponseObject interface {
+	VisitGetTransactionsDuplicatesResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionsDuplicates200JSONResponse FetchDuplicateTransactionsResponse
+
+func (response GetTransactionsDuplicates200JSONResponse) VisitGetTransactionsDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsDuplicates400JSONResponse ErrorBody
+
+func (response GetTransactionsDuplicates400JSONResponse) VisitGetTransactionsDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsDuplicates500JSONResponse ErrorBody
+
+func (response GetTransactionsDuplicates500JSONResponse) VisitGetTransactionsDuplicatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsDuplicatesMergeRequestObject struct {
+	Body *PostTransactionsDuplicatesMergeJSONRequestBody
+}
+
+type PostTransactionsDuplicatesMergeResponseObject interface {
+	VisitPostTransactionsDuplicatesMergeResponse(w http.ResponseWriter) error
+}
+
+type PostTransactionsDuplicatesMerge200JSONResponse MergeDuplicateTransactionsResponse
+
+func (response PostTransactionsDuplicatesMerge200JSONResponse) VisitPostTransactionsDuplicatesMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsDuplicatesMerge400JSONResponse ErrorBody
+
+func (response PostTransactionsDuplicatesMerge400JSONResponse) VisitPostTransactionsDuplicatesMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsDuplicatesMerge404JSONResponse ErrorBody
+
+func (response PostTransactionsDuplicatesMerge404JSONResponse) VisitPostTransactionsDuplicatesMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsDuplicatesMerge500JSONResponse ErrorBody
+
+func (response PostTransactionsDuplicatesMerge500JSONResponse) VisitPostTransactionsDuplicatesMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsExportRequestObject struct {
+	Params GetTransactionsExportParams
+}
+
+type GetTransactionsExportResponseObject interface {
+	VisitGetTransactionsExportResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionsExport200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetTransactionsExport200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       GetTransactionsExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTransactionsExport200ApplicationxNdjsonResponse) VisitGetTransactionsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTransactionsExport200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetTransactionsExport200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTransactionsExport200TextcsvResponse) VisitGetTransactionsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTransactionsExport400JSONResponse ErrorBody
+
+func (response GetTransactionsExport400JSONResponse) VisitGetTransactionsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsExport500JSONResponse ErrorBody
+
+func (response GetTransactionsExport500JSONResponse) VisitGetTransactionsExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsImportRequestObject struct {
+	Body *multipart.Reader
+}
+
+type PostTransactionsImportResponseObject interface {
+	VisitPostTransactionsImportResponse(w http.ResponseWriter) error
+}
+
+type PostTransactionsImport200JSONResponse ImportTransactionsResponse
+
+func (response PostTransactionsImport200JSONResponse) VisitPostTransactionsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsImport400JSONResponse ErrorBody
+
+func (response PostTransactionsImport400JSONResponse) VisitPostTransactionsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsImport500JSONResponse ErrorBody
+
+func (response PostTransactionsImport500JSONResponse) VisitPostTransactionsImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsRecategorizeRequestObject struct {
+	Body *PostTransactionsRecategorizeJSONRequestBody
+}
+
+type PostTransactionsRecategorizeResponseObject interface {
+	VisitPostTransactionsRecategorizeResponse(w http.ResponseWriter) error
+}
+
+type PostTransactionsRecategorize200JSONResponse RecategorizeTransactionsResponse
+
+func (response PostTransactionsRecategorize200JSONResponse) VisitPostTransactionsRecategorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsRecategorize400JSONResponse ErrorBody
+
+func (response PostTransactionsRecategorize400JSONResponse) VisitPostTransactionsRecategorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsRecategorize500JSONResponse ErrorBody
+
+func (response PostTransactionsRecategorize500JSONResponse) VisitPostTransactionsRecategorizeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransactionsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteTransactionsIdResponseObject interface {
+	VisitDeleteTransactionsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTransactionsId204Response struct {
+}
+
+func (response DeleteTransactionsId204Response) VisitDeleteTransactionsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTransactionsId400JSONResponse ErrorBody
+
+func (response DeleteTransactionsId400JSONResponse) VisitDeleteTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransactionsId404JSONResponse ErrorBody
+
+func (response DeleteTransactionsId404JSONResponse) VisitDeleteTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransactionsId500JSONResponse ErrorBody
+
+func (response DeleteTransactionsId500JSONResponse) VisitDeleteTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetTransactionsIdResponseObject interface {
+	VisitGetTransactionsIdResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionsId200JSONResponse FetchTransactionResponse
+
+func (response GetTransactionsId200JSONResponse) VisitGetTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsId400JSONResponse ErrorBody
+
+func (response GetTransactionsId400JSONResponse) VisitGetTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsId404JSONResponse ErrorBody
+
+func (response GetTransactionsId404JSONResponse) VisitGetTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsId500JSONResponse ErrorBody
+
+func (response GetTransactionsId500JSONResponse) VisitGetTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTransactionsIdRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PatchTransactionsIdJSONRequestBody
+}
+
+type PatchTransactionsIdResponseObject interface {
+	VisitPatchTransactionsIdResponse(w http.ResponseWriter) error
+}
+
+type PatchTransactionsId200JSONResponse UpdateTransactionResponse
+
+func (response PatchTransactionsId200JSONResponse) VisitPatchTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTransactionsId400JSONResponse ErrorBody
+
+func (response PatchTransactionsId400JSONResponse) VisitPatchTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTransactionsId404JSONResponse ErrorBody
+
+func (response PatchTransactionsId404JSONResponse) VisitPatchTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchTransactionsId500JSONResponse ErrorBody
+
+func (response PatchTransactionsId500JSONResponse) VisitPatchTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdAttachmentsRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetTransactionsIdAttachmentsResponseObject interface {
+	VisitGetTransactionsIdAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionsIdAttachments200JSONResponse FetchAttachmentListResponse
+
+func (response GetTransactionsIdAttachments200JSONResponse) VisitGetTransactionsIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdAttachments400JSONResponse ErrorBody
+
+func (response GetTransactionsIdAttachments400JSONResponse) VisitGetTransactionsIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdAttachments404JSONResponse ErrorBody
+
+func (response GetTransactionsIdAttachments404JSONResponse) VisitGetTransactionsIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdAttachments500JSONResponse ErrorBody
+
+func (response GetTransactionsIdAttachments500JSONResponse) VisitGetTransactionsIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdAttachmentsRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *multipart.Reader
+}
+
+type PostTransactionsIdAttachmentsResponseObject interface {
+	VisitPostTransactionsIdAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type PostTransactionsIdAttachments201JSONResponse UploadAttachmentResponse
+
+func (response PostTransactionsIdAttachments201JSONResponse) VisitPostTransactionsIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdAttachments400JSONResponse ErrorBody
+
+func (response PostTransactionsIdAttachments400JSONResponse) VisitPostTransactionsIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdAttachments404JSONResponse ErrorBody
+
+func (response PostTransactionsIdAttachments404JSONResponse) VisitPostTransactionsIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdAttachments500JSONResponse ErrorBody
+
+func (response PostTransactionsIdAttachments500JSONResponse) VisitPostTransactionsIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransactionsIdAttachmentsAttachmentIdRequestObject struct {
+	Id           int32 `json:"id"`
+	AttachmentId int32 `json:"attachment_id"`
+}
+
+type DeleteTransactionsIdAttachmentsAttachmentIdResponseObject interface {
+	VisitDeleteTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTransactionsIdAttachmentsAttachmentId204Response struct {
+}
+
+func (response DeleteTransactionsIdAttachmentsAttachmentId204Response) VisitDeleteTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTransactionsIdAttachmentsAttachmentId404JSONResponse ErrorBody
+
+func (response DeleteTransactionsIdAttachmentsAttachmentId404JSONResponse) VisitDeleteTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransactionsIdAttachmentsAttachmentId500JSONResponse ErrorBody
+
+func (response DeleteTransactionsIdAttachmentsAttachmentId500JSONResponse) VisitDeleteTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdAttachmentsAttachmentIdRequestObject struct {
+	Id           int32 `json:"id"`
+	AttachmentId int32 `json:"attachment_id"`
+}
+
+type GetTransactionsIdAttachmentsAttachmentIdResponseObject interface {
+	VisitGetTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionsIdAttachmentsAttachmentId200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type GetTransactionsIdAttachmentsAttachmentId200ApplicationpdfResponse struct {
+	Body          io.Reader
+	Headers       GetTransactionsIdAttachmentsAttachmentId200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTransactionsIdAttachmentsAttachmentId200ApplicationpdfResponse) VisitGetTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/pdf")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTransactionsIdAttachmentsAttachmentId200ImagejpegResponse struct {
+	Body          io.Reader
+	Headers       GetTransactionsIdAttachmentsAttachmentId200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTransactionsIdAttachmentsAttachmentId200ImagejpegResponse) VisitGetTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/jpeg")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTransactionsIdAttachmentsAttachmentId200ImagepngResponse struct {
+	Body          io.Reader
+	Headers       GetTransactionsIdAttachmentsAttachmentId200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTransactionsIdAttachmentsAttachmentId200ImagepngResponse) VisitGetTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/png")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTransactionsIdAttachmentsAttachmentId200ImagewebpResponse struct {
+	Body          io.Reader
+	Headers       GetTransactionsIdAttachmentsAttachmentId200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetTransactionsIdAttachmentsAttachmentId200ImagewebpResponse) VisitGetTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "image/webp")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetTransactionsIdAttachmentsAttachmentId400JSONResponse ErrorBody
+
+func (response GetTransactionsIdAttachmentsAttachmentId400JSONResponse) VisitGetTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdAttachmentsAttachmentId404JSONResponse ErrorBody
+
+func (response GetTransactionsIdAttachmentsAttachmentId404JSONResponse) VisitGetTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdAttachmentsAttachmentId500JSONResponse ErrorBody
+
+func (response GetTransactionsIdAttachmentsAttachmentId500JSONResponse) VisitGetTransactionsIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdHistoryRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetTransactionsIdHistoryResponseObject interface {
+	VisitGetTransactionsIdHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionsIdHistory200JSONResponse FetchTransactionHistoryResponse
+
+func (response GetTransactionsIdHistory200JSONResponse) VisitGetTransactionsIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdHistory404JSONResponse ErrorBody
+
+func (response GetTransactionsIdHistory404JSONResponse) VisitGetTransactionsIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransactionsIdHistory500JSONResponse ErrorBody
+
+func (response GetTransactionsIdHistory500JSONResponse) VisitGetTransactionsIdHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdInstallmentsRequestObject struct {
+	Id   int32 `json:"id"`
+	Body *PostTransactionsIdInstallmentsJSONRequestBody
+}
+
+type PostTransactionsIdInstallmentsResponseObject interface {
+	VisitPostTransactionsIdInstallmentsResponse(w http.ResponseWriter) error
+}
+
+type PostTransactionsIdInstallments201JSONResponse CreateInstallmentPlanResponse
+
+func (response PostTransactionsIdInstallments201JSONResponse) VisitPostTransactionsIdInstallmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdInstallments400JSONResponse ErrorBody
+
+func (response PostTransactionsIdInstallments400JSONResponse) VisitPostTransactionsIdInstallmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdInstallments404JSONResponse ErrorBody
+
+func (response PostTransactionsIdInstallments404JSONResponse) VisitPostTransactionsIdInstallmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdInstallments500JSONResponse ErrorBody
+
+func (response PostTransactionsIdInstallments500JSONResponse) VisitPostTransactionsIdInstallmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdUnlockRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type PostTransactionsIdUnlockResponseObject interface {
+	VisitPostTransactionsIdUnlockResponse(w http.ResponseWriter) error
+}
+
+type PostTransactionsIdUnlock200JSONResponse UnlockTransactionResponse
+
+func (response PostTransactionsIdUnlock200JSONResponse) VisitPostTransactionsIdUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdUnlock404JSONResponse ErrorBody
+
+func (response PostTransactionsIdUnlock404JSONResponse) VisitPostTransactionsIdUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransactionsIdUnlock500JSONResponse ErrorBody
+
+func (response PostTransactionsIdUnlock500JSONResponse) VisitPostTransactionsIdUnlockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersRequestObject struct {
+	Params GetTransfersParams
+}
+
+type GetTransfersResponseObject interface {
+	VisitGetTransfersResponse(w http.ResponseWriter) error
+}
+
+type GetTransfers200JSONResponse FetchTransferListResponse
+
+func (response GetTransfers200JSONResponse) VisitGetTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfers400JSONResponse ErrorBody
+
+func (response GetTransfers400JSONResponse) VisitGetTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfers500JSONResponse ErrorBody
+
+func (response GetTransfers500JSONResponse) VisitGetTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersRequestObject struct {
+	Body *PostTransfersJSONRequestBody
+}
+
+type PostTransfersResponseObject interface {
+	VisitPostTransfersResponse(w http.ResponseWriter) error
+}
+
+type PostTransfers201JSONResponse CreateTransferResponse
+
+func (response PostTransfers201JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfers400JSONResponse ErrorBody
+
+func (response PostTransfers400JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfers500JSONResponse ErrorBody
+
+func (response PostTransfers500JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransfersIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteTransfersIdResponseObject interface {
+	VisitDeleteTransfersIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTransfersId204Response struct {
+}
+
+func (response DeleteTransfersId204Response) VisitDeleteTransfersIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTransfersId404JSONResponse ErrorBody
+
+func (response DeleteTransfersId404JSONResponse) VisitDeleteTransfersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTransfersId500JSONResponse ErrorBody
+
+func (response DeleteTransfersId500JSONResponse) VisitDeleteTransfersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type GetTransfersIdResponseObject interface {
+	VisitGetTransfersIdResponse(w http.ResponseWriter) error
+}
+
+type GetTransfersId200JSONResponse FetchTransferResponse
+
+func (response GetTransfersId200JSONResponse) VisitGetTransfersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersId400JSONResponse ErrorBody
+
+func (response GetTransfersId400JSONResponse) VisitGetTransfersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersId404JSONResponse ErrorBody
+
+func (response GetTransfersId404JSONResponse) VisitGetTransfersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersId500JSONResponse ErrorBody
+
+func (response GetTransfersId500JSONResponse) VisitGetTransfersIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrashRequestObject struct {
+}
+
+type GetTrashResponseObject interface {
+	VisitGetTrashResponse(w http.ResponseWriter) error
+}
+
+type GetTrash200JSONResponse FetchTrashResponse
+
+func (response GetTrash200JSONResponse) VisitGetTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTrash500JSONResponse ErrorBody
+
+func (response GetTrash500JSONResponse) VisitGetTrashResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrashBudgetsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteTrashBudgetsIdResponseObject interface {
+	VisitDeleteTrashBudgetsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTrashBudgetsId204Response struct {
+}
+
+func (response DeleteTrashBudgetsId204Response) VisitDeleteTrashBudgetsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTrashBudgetsId404JSONResponse ErrorBody
+
+func (response DeleteTrashBudgetsId404JSONResponse) VisitDeleteTrashBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrashBudgetsId500JSONResponse ErrorBody
+
+func (response DeleteTrashBudgetsId500JSONResponse) VisitDeleteTrashBudgetsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashBudgetsIdRestoreRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type PostTrashBudgetsIdRestoreResponseObject interface {
+	VisitPostTrashBudgetsIdRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostTrashBudgetsIdRestore200JSONResponse RestoreBudgetResponse
+
+func (response PostTrashBudgetsIdRestore200JSONResponse) VisitPostTrashBudgetsIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashBudgetsIdRestore400JSONResponse ErrorBody
+
+func (response PostTrashBudgetsIdRestore400JSONResponse) VisitPostTrashBudgetsIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashBudgetsIdRestore404JSONResponse ErrorBody
+
+func (response PostTrashBudgetsIdRestore404JSONResponse) VisitPostTrashBudgetsIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashBudgetsIdRestore409JSONResponse ErrorBody
+
+func (response PostTrashBudgetsIdRestore409JSONResponse) VisitPostTrashBudgetsIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashBudgetsIdRestore500JSONResponse ErrorBody
+
+func (response PostTrashBudgetsIdRestore500JSONResponse) VisitPostTrashBudgetsIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrashCategoriesIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteTrashCategoriesIdResponseObject interface {
+	VisitDeleteTrashCategoriesIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTrashCategoriesId204Response struct {
+}
+
+func (response DeleteTrashCategoriesId204Response) VisitDeleteTrashCategoriesIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTrashCategoriesId400JSONResponse ErrorBody
+
+func (response DeleteTrashCategoriesId400JSONResponse) VisitDeleteTrashCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrashCategoriesId404JSONResponse ErrorBody
+
+func (response DeleteTrashCategoriesId404JSONResponse) VisitDeleteTrashCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrashCategoriesId500JSONResponse ErrorBody
+
+func (response DeleteTrashCategoriesId500JSONResponse) VisitDeleteTrashCategoriesIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashCategoriesIdRestoreRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type PostTrashCategoriesIdRestoreResponseObject interface {
+	VisitPostTrashCategoriesIdRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostTrashCategoriesIdRestore200JSONResponse RestoreCategoryResponse
+
+func (response PostTrashCategoriesIdRestore200JSONResponse) VisitPostTrashCategoriesIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashCategoriesIdRestore404JSONResponse ErrorBody
+
+func (response PostTrashCategoriesIdRestore404JSONResponse) VisitPostTrashCategoriesIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashCategoriesIdRestore500JSONResponse ErrorBody
+
+func (response PostTrashCategoriesIdRestore500JSONResponse) VisitPostTrashCategoriesIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrashTransactionsIdRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type DeleteTrashTransactionsIdResponseObject interface {
+	VisitDeleteTrashTransactionsIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteTrashTransactionsId204Response struct {
+}
+
+func (response DeleteTrashTransactionsId204Response) VisitDeleteTrashTransactionsIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTrashTransactionsId404JSONResponse ErrorBody
+
+func (response DeleteTrashTransactionsId404JSONResponse) VisitDeleteTrashTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteTrashTransactionsId500JSONResponse ErrorBody
+
+func (response DeleteTrashTransactionsId500JSONResponse) VisitDeleteTrashTransactionsIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashTransactionsIdRestoreRequestObject struct {
+	Id int32 `json:"id"`
+}
+
+type PostTrashTransactionsIdRestoreResponseObject interface {
+	VisitPostTrashTransactionsIdRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostTrashTransactionsIdRestore200JSONResponse RestoreTransactionResponse
+
+func (response PostTrashTransactionsIdRestore200JSONResponse) VisitPostTrashTransactionsIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashTransactionsIdRestore400JSONResponse ErrorBody
+
+func (response PostTrashTransactionsIdRestore400JSONResponse) VisitPostTrashTransactionsIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashTransactionsIdRestore404JSONResponse ErrorBody
+
+func (response PostTrashTransactionsIdRestore404JSONResponse) VisitPostTrashTransactionsIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTrashTransactionsIdRestore500JSONResponse ErrorBody
+
+func (response PostTrashTransactionsIdRestore500JSONResponse) VisitPostTrashTransactionsIdRestoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersCheckSignedInRequestObject struct {
+}
+
+type GetUsersCheckSignedInResponseObject interface {
+	VisitGetUsersCheckSignedInResponse(w http.ResponseWriter) error
+}
+
+type GetUsersCheckSignedIn200JSONResponse UserUserCheckSignedInResponse
+
+func (response GetUsersCheckSignedIn200JSONResponse) VisitGetUsersCheckSignedInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMeRequestObject struct {
+}
+
+type GetUsersMeResponseObject interface {
+	VisitGetUsersMeResponse(w http.ResponseWriter) error
+}
+
+type GetUsersMe200JSONResponse UserFetchCurrentUserResponse
+
+func (response GetUsersMe200JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMe404JSONResponse ErrorBody
+
+func (response GetUsersMe404JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersMe500JSONResponse ErrorBody
+
+func (response GetUsersMe500JSONResponse) VisitGetUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMeRequestObject struct {
+	Body *PatchUsersMeJSONRequestBody
+}
+
+type PatchUsersMeResponseObject interface {
+	VisitPatchUsersMeResponse(w http.ResponseWriter) error
+}
+
+type PatchUsersMe200JSONResponse UserUpdateCurrentUserResponse
+
+func (response PatchUsersMe200JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe400JSONResponse ErrorBody
+
+func (response PatchUsersMe400JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe404JSONResponse ErrorBody
+
+func (response PatchUsersMe404JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersMe500JSONResponse ErrorBody
+
+func (response PatchUsersMe500JSONResponse) VisitPatchUsersMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSignInRequestObject struct {
+	Body *PostUsersSignInJSONRequestBody
+}
+
+type PostUsersSignInResponseObject interface {
+	VisitPostUsersSignInResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSignIn200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostUsersSignIn200JSONResponse struct {
+	Body    UserUserSignInResponse
+	Headers PostUsersSignIn200ResponseHeaders
+}
+
+func (response PostUsersSignIn200JSONResponse) VisitPostUsersSignInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("set-cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSignIn400JSONResponse ErrorBody
+
+func (response PostUsersSignIn400JSONResponse) VisitPostUsersSignInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSignIn401JSONResponse ErrorBody
+
+func (response PostUsersSignIn401JSONResponse) VisitPostUsersSignInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSignIn500JSONResponse ErrorBody
+
+func (response PostUsersSignIn500JSONResponse) VisitPostUsersSignInResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSignOutRequestObject struct {
+}
+
+type PostUsersSignOutResponseObject interface {
+	VisitPostUsersSignOutResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSignOut200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostUsersSignOut200JSONResponse struct {
+	Body    UserUserSignOutResponse
+	Headers PostUsersSignOut200ResponseHeaders
+}
+
+func (response PostUsersSignOut200JSONResponse) VisitPostUsersSignOutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("set-cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSignOut500JSONResponse ErrorBody
+
+func (response PostUsersSignOut500JSONResponse) VisitPostUsersSignOutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSignUpRequestObject struct {
+	Body *PostUsersSignUpJSONRequestBody
+}
+
+type PostUsersSignUpResponseObject interface {
+	VisitPostUsersSignUpResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSignUp200ResponseHeaders struct {
+	SetCookie string
+}
+
+type PostUsersSignUp200JSONResponse struct {
+	Body    UserUserSignUpResponse
+	Headers PostUsersSignUp200ResponseHeaders
+}
+
+func (response PostUsersSignUp200JSONResponse) VisitPostUsersSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("set-cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostUsersSignUp400JSONResponse ErrorBody
+
+func (response PostUsersSignUp400JSONResponse) VisitPostUsersSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSignUp409JSONResponse ErrorBody
+
+func (response PostUsersSignUp409JSONResponse) VisitPostUsersSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSignUp500JSONResponse ErrorBody
+
+func (response PostUsersSignUp500JSONResponse) VisitPostUsersSignUpResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Get Accounts
+	// (GET /accounts)
+	GetAccounts(ctx context.Context, request GetAccountsRequestObject) (GetAccountsResponseObject, error)
+	// Create Account
+	// (POST /accounts)
+	PostAccounts(ctx context.Context, request PostAccountsRequestObject) (PostAccountsResponseObject, error)
+	// Get Account Balances
+	// (GET /accounts/balances)
+	GetAccountsBalances(ctx context.Context, request GetAccountsBalancesRequestObject) (GetAccountsBalancesResponseObject, error)
+	// Delete Account
+	// (DELETE /accounts/{id})
+	DeleteAccountsId(ctx context.Context, request DeleteAccountsIdRequestObject) (DeleteAccountsIdResponseObject, error)
+	// Get Account
+	// (GET /accounts/{id})
+	GetAccountsId(ctx context.Context, request GetAccountsIdRequestObject) (GetAccountsIdResponseObject, error)
+	// Update Account
+	// (PATCH /accounts/{id})
+	PatchAccountsId(ctx context.Context, request PatchAccountsIdRequestObject) (PatchAccountsIdResponseObject, error)
+	// Get Account Entries
+	// (GET /accounts/{id}/entries)
+	GetAccountsIdEntries(ctx context.Context, request GetAccountsIdEntriesRequestObject) (GetAccountsIdEntriesResponseObject, error)
+	// Reconcile Account
+	// (POST /accounts/{id}/reconcile)
+	PostAccountsIdReconcile(ctx context.Context, request PostAccountsIdReconcileRequestObject) (PostAccountsIdReconcileResponseObject, error)
+	// Get Reconciliations
+	// (GET /accounts/{id}/reconciliations)
+	GetAccountsIdReconciliations(ctx context.Context, request GetAccountsIdReconciliationsRequestObject) (GetAccountsIdReconciliationsResponseObject, error)
+	// Get Budget Templates
+	// (GET /budget-templates)
+	GetBudgetTemplates(ctx context.Context, request GetBudgetTemplatesRequestObject) (GetBudgetTemplatesResponseObject, error)
+	// Create Budget Template
+	// (POST /budget-templates)
+	PostBudgetTemplates(ctx context.Context, request PostBudgetTemplatesRequestObject) (PostBudgetTemplatesResponseObject, error)
+	// Delete Budget Template
+	// (DELETE /budget-templates/{id})
+	DeleteBudgetTemplatesId(ctx context.Context, request DeleteBudgetTemplatesIdRequestObject) (DeleteBudgetTemplatesIdResponseObject, error)
+	// Get Budget Template
+	// (GET /budget-templates/{id})
+	GetBudgetTemplatesId(ctx context.Context, request GetBudgetTemplatesIdRequestObject) (GetBudgetTemplatesIdResponseObject, error)
+	// Update Budget Template
+	// (PATCH /budget-templates/{id})
+	PatchBudgetTemplatesId(ctx context.Context, request PatchBudgetTemplatesIdRequestObject) (PatchBudgetTemplatesIdResponseObject, error)
 	// Get Budgets
 	// (GET /budgets)
 	GetBudgets(ctx context.Context, request GetBudgetsRequestObject) (GetBudgetsResponseObject, error)
 	// Create Budget
 	// (POST /budgets)
 	PostBudgets(ctx context.Context, request PostBudgetsRequestObject) (PostBudgetsResponseObject, error)
+	// Get Available Budget
+	// (GET /budgets/available)
+	GetBudgetsAvailable(ctx context.Context, request GetBudgetsAvailableRequestObject) (GetBudgetsAvailableResponseObject, error)
+	// Copy Budgets
+	// (POST /budgets/copy)
+	PostBudgetsCopy(ctx context.Context, request PostBudgetsCopyRequestObject) (PostBudgetsCopyResponseObject, error)
+	// Get Budget Summary
+	// (GET /budgets/summary)
+	GetBudgetsSummary(ctx context.Context, request GetBudgetsSummaryRequestObject) (GetBudgetsSummaryResponseObject, error)
 	// Delete Budget
 	// (DELETE /budgets/{id})
 	DeleteBudgetsId(ctx context.Context, request DeleteBudgetsIdRequestObject) (DeleteBudgetsIdResponseObject, error)
@@ -1673,6 +9428,9 @@ type StrictServerInterface interface {
 	// Update Budget
 	// (PATCH /budgets/{id})
 	PatchBudgetsId(ctx context.Context, request PatchBudgetsIdRequestObject) (PatchBudgetsIdResponseObject, error)
+	// Get Calendar
+	// (GET /calendar)
+	GetCalendar(ctx context.Context, request GetCalendarRequestObject) (GetCalendarResponseObject, error)
 	// Get Categories
 	// (GET /categories)
 	GetCategories(ctx context.Context, request GetCategoriesRequestObject) (GetCategoriesResponseObject, error)
@@ -1688,15 +9446,150 @@ type StrictServerInterface interface {
 	// Update Category
 	// (PATCH /categories/{id})
 	PatchCategoriesId(ctx context.Context, request PatchCategoriesIdRequestObject) (PatchCategoriesIdResponseObject, error)
+	// Get Categorization Rules
+	// (GET /categorization-rules)
+	GetCategorizationRules(ctx context.Context, request GetCategorizationRulesRequestObject) (GetCategorizationRulesResponseObject, error)
+	// Create Categorization Rule
+	// (POST /categorization-rules)
+	PostCategorizationRules(ctx context.Context, request PostCategorizationRulesRequestObject) (PostCategorizationRulesResponseObject, error)
+	// Delete Categorization Rule
+	// (DELETE /categorization-rules/{id})
+	DeleteCategorizationRulesId(ctx context.Context, request DeleteCategorizationRulesIdRequestObject) (DeleteCategorizationRulesIdResponseObject, error)
+	// Get Categorization Rule
+	// (GET /categorization-rules/{id})
+	GetCategorizationRulesId(ctx context.Context, request GetCategorizationRulesIdRequestObject) (GetCategorizationRulesIdResponseObject, error)
+	// Update Categorization Rule
+	// (PATCH /categorization-rules/{id})
+	PatchCategorizationRulesId(ctx context.Context, request PatchCategorizationRulesIdRequestObject) (PatchCategorizationRulesIdResponseObject, error)
+	// Apply Categorization Rule
+	// (POST /categorization-rules/{id}/apply)
+	PostCategorizationRulesIdApply(ctx context.Context, request PostCategorizationRulesIdApplyRequestObject) (PostCategorizationRulesIdApplyResponseObject, error)
+	// Get Credit Cards
+	// (GET /credit-cards)
+	GetCreditCards(ctx context.Context, request GetCreditCardsRequestObject) (GetCreditCardsResponseObject, error)
+	// Create Credit Card
+	// (POST /credit-cards)
+	PostCreditCards(ctx context.Context, request PostCreditCardsRequestObject) (PostCreditCardsResponseObject, error)
+	// Get Credit Card Withdrawals
+	// (GET /credit-cards/withdrawals)
+	GetCreditCardsWithdrawals(ctx context.Context, request GetCreditCardsWithdrawalsRequestObject) (GetCreditCardsWithdrawalsResponseObject, error)
+	// Delete Credit Card
+	// (DELETE /credit-cards/{id})
+	DeleteCreditCardsId(ctx context.Context, request DeleteCreditCardsIdRequestObject) (DeleteCreditCardsIdResponseObject, error)
+	// Get Credit Card
+	// (GET /credit-cards/{id})
+	GetCreditCardsId(ctx context.Context, request GetCreditCardsIdRequestObject) (GetCreditCardsIdResponseObject, error)
+	// Update Credit Card
+	// (PATCH /credit-cards/{id})
+	PatchCreditCardsId(ctx context.Context, request PatchCreditCardsIdRequestObject) (PatchCreditCardsIdResponseObject, error)
+	// Get Credit Card Statements
+	// (GET /credit-cards/{id}/statements)
+	GetCreditCardsIdStatements(ctx context.Context, request GetCreditCardsIdStatementsRequestObject) (GetCreditCardsIdStatementsResponseObject, error)
 	// Get Csrf
 	// (GET /csrf)
 	GetCsrf(ctx context.Context, request GetCsrfRequestObject) (GetCsrfResponseObject, error)
+	// Get Exchange Rates
+	// (GET /exchange-rates)
+	GetExchangeRates(ctx context.Context, request GetExchangeRatesRequestObject) (GetExchangeRatesResponseObject, error)
+	// Create Exchange Rate
+	// (POST /exchange-rates)
+	PostExchangeRates(ctx context.Context, request PostExchangeRatesRequestObject) (PostExchangeRatesResponseObject, error)
+	// Import Exchange Rates
+	// (POST /exchange-rates/import)
+	PostExchangeRatesImport(ctx context.Context, request PostExchangeRatesImportRequestObject) (PostExchangeRatesImportResponseObject, error)
+	// Delete Exchange Rate
+	// (DELETE /exchange-rates/{id})
+	DeleteExchangeRatesId(ctx context.Context, request DeleteExchangeRatesIdRequestObject) (DeleteExchangeRatesIdResponseObject, error)
+	// Update Exchange Rate
+	// (PATCH /exchange-rates/{id})
+	PatchExchangeRatesId(ctx context.Context, request PatchExchangeRatesIdRequestObject) (PatchExchangeRatesIdResponseObject, error)
+	// Get Import Profiles
+	// (GET /import-profiles)
+	GetImportProfiles(ctx context.Context, request GetImportProfilesRequestObject) (GetImportProfilesResponseObject, error)
+	// Create Import Profile
+	// (POST /import-profiles)
+	PostImportProfiles(ctx context.Context, request PostImportProfilesRequestObject) (PostImportProfilesResponseObject, error)
+	// Delete Import Profile
+	// (DELETE /import-profiles/{id})
+	DeleteImportProfilesId(ctx context.Context, request DeleteImportProfilesIdRequestObject) (DeleteImportProfilesIdResponseObject, error)
+	// Get Import Profile
+	// (GET /import-profiles/{id})
+	GetImportProfilesId(ctx context.Context, request GetImportProfilesIdRequestObject) (GetImportProfilesIdResponseObject, error)
+	// Update Import Profile
+	// (PATCH /import-profiles/{id})
+	PatchImportProfilesId(ctx context.Context, request PatchImportProfilesIdRequestObject) (PatchImportProfilesIdResponseObject, error)
+	// Get Installment Plans
+	// (GET /installment-plans)
+	GetInstallmentPlans(ctx context.Context, request GetInstallmentPlansRequestObject) (GetInstallmentPlansResponseObject, error)
+	// Delete Installment Plan
+	// (DELETE /installment-plans/{id})
+	DeleteInstallmentPlansId(ctx context.Context, request DeleteInstallmentPlansIdRequestObject) (DeleteInstallmentPlansIdResponseObject, error)
+	// Get Installment Plan
+	// (GET /installment-plans/{id})
+	GetInstallmentPlansId(ctx context.Context, request GetInstallmentPlansIdRequestObject) (GetInstallmentPlansIdResponseObject, error)
+	// Get Recurring Transactions
+	// (GET /recurring-transactions)
+	GetRecurringTransactions(ctx context.Context, request GetRecurringTransactionsRequestObject) (GetRecurringTransactionsResponseObject, error)
+	// Create Recurring Transaction
+	// (POST /recurring-transactions)
+	PostRecurringTransactions(ctx context.Context, request PostRecurringTransactionsRequestObject) (PostRecurringTransactionsResponseObject, error)
+	// Generate Recurring Transactions
+	// (POST /recurring-transactions/generate)
+	PostRecurringTransactionsGenerate(ctx context.Context, request PostRecurringTransactionsGenerateRequestObject) (PostRecurringTransactionsGenerateResponseObject, error)
+	// Delete Recurring Transaction
+	// (DELETE /recurring-transactions/{id})
+	DeleteRecurringTransactionsId(ctx context.Context, request DeleteRecurringTransactionsIdRequestObject) (DeleteRecurringTransactionsIdResponseObject, error)
+	// Get Recurring Transaction
+	// (GET /recurring-transactions/{id})
+	GetRecurringTransactionsId(ctx context.Context, request GetRecurringTransactionsIdRequestObject) (GetRecurringTransactionsIdResponseObject, error)
+	// Update Recurring Transaction
+	// (PATCH /recurring-transactions/{id})
+	PatchRecurringTransactionsId(ctx context.Context, request PatchRecurringTransactionsIdRequestObject) (PatchRecurringTransactionsIdResponseObject, error)
+	// Get Trend Report
+	// (GET /reports/trends)
+	GetReportsTrends(ctx context.Context, request GetReportsTrendsRequestObject) (GetReportsTrendsResponseObject, error)
+	// Get Tags
+	// (GET /tags)
+	GetTags(ctx context.Context, request GetTagsRequestObject) (GetTagsResponseObject, error)
+	// Create Tag
+	// (POST /tags)
+	PostTags(ctx context.Context, request PostTagsRequestObject) (PostTagsResponseObject, error)
+	// Get Tag Summary
+	// (GET /tags/summary)
+	GetTagsSummary(ctx context.Context, request GetTagsSummaryRequestObject) (GetTagsSummaryResponseObject, error)
+	// Delete Tag
+	// (DELETE /tags/{id})
+	DeleteTagsId(ctx context.Context, request DeleteTagsIdRequestObject) (DeleteTagsIdResponseObject, error)
+	// Get Tag
+	// (GET /tags/{id})
+	GetTagsId(ctx context.Context, request GetTagsIdRequestObject) (GetTagsIdResponseObject, error)
+	// Update Tag
+	// (PATCH /tags/{id})
+	PatchTagsId(ctx context.Context, request PatchTagsIdRequestObject) (PatchTagsIdResponseObject, error)
 	// Get Transactions
 	// (GET /transactions)
 	GetTransactions(ctx context.Context, request GetTransactionsRequestObject) (GetTransactionsResponseObject, error)
 	// Create Transaction
 	// (POST /transactions)
 	PostTransactions(ctx context.Context, request PostTransactionsRequestObject) (PostTransactionsResponseObject, error)
+	// Batch Transactions
+	// (POST /transactions/batch)
+	PostTransactionsBatch(ctx context.Context, request PostTransactionsBatchRequestObject) (PostTransactionsBatchResponseObject, error)
+	// Get Duplicate Transactions
+	// (GET /transactions/duplicates)
+	GetTransactionsDuplicates(ctx context.Context, request GetTransactionsDuplicatesRequestObject) (GetTransactionsDuplicatesResponseObject, error)
+	// Merge Duplicate Transactions
+	// (POST /transactions/duplicates/merge)
+	PostTransactionsDuplicatesMerge(ctx context.Context, request PostTransactionsDuplicatesMergeRequestObject) (PostTransactionsDuplicatesMergeResponseObject, error)
+	// Export Transactions
+	// (GET /transactions/export)
+	GetTransactionsExport(ctx context.Context, request GetTransactionsExportRequestObject) (GetTransactionsExportResponseObject, error)
+	// Import Transactions
+	// (POST /transactions/import)
+	PostTransactionsImport(ctx context.Context, request PostTransactionsImportRequestObject) (PostTransactionsImportResponseObject, error)
+	// Recategorize Transactions
+	// (POST /transactions/recategorize)
+	PostTransactionsRecategorize(ctx context.Context, request PostTransactionsRecategorizeRequestObject) (PostTransactionsRecategorizeResponseObject, error)
 	// Delete Transaction
 	// (DELETE /transactions/{id})
 	DeleteTransactionsId(ctx context.Context, request DeleteTransactionsIdRequestObject) (DeleteTransactionsIdResponseObject, error)
@@ -1706,9 +9599,69 @@ type StrictServerInterface interface {
 	// Update Transaction
 	// (PATCH /transactions/{id})
 	PatchTransactionsId(ctx context.Context, request PatchTransactionsIdRequestObject) (PatchTransactionsIdResponseObject, error)
+	// Get Attachments
+	// (GET /transactions/{id}/attachments)
+	GetTransactionsIdAttachments(ctx context.Context, request GetTransactionsIdAttachmentsRequestObject) (GetTransactionsIdAttachmentsResponseObject, error)
+	// Upload Attachment
+	// (POST /transactions/{id}/attachments)
+	PostTransactionsIdAttachments(ctx context.Context, request PostTransactionsIdAttachmentsRequestObject) (PostTransactionsIdAttachmentsResponseObject, error)
+	// Delete Attachment
+	// (DELETE /transactions/{id}/attachments/{attachment_id})
+	DeleteTransactionsIdAttachmentsAttachmentId(ctx context.Context, request DeleteTransactionsIdAttachmentsAttachmentIdRequestObject) (DeleteTransactionsIdAttachmentsAttachmentIdResponseObject, error)
+	// Download Attachment
+	// (GET /transactions/{id}/attachments/{attachment_id})
+	GetTransactionsIdAttachmentsAttachmentId(ctx context.Context, request GetTransactionsIdAttachmentsAttachmentIdRequestObject) (GetTransactionsIdAttachmentsAttachmentIdResponseObject, error)
+	// Get Transaction History
+	// (GET /transactions/{id}/history)
+	GetTransactionsIdHistory(ctx context.Context, request GetTransactionsIdHistoryRequestObject) (GetTransactionsIdHistoryResponseObject, error)
+	// Create Installment Plan
+	// (POST /transactions/{id}/installments)
+	PostTransactionsIdInstallments(ctx context.Context, request PostTransactionsIdInstallmentsRequestObject) (PostTransactionsIdInstallmentsResponseObject, error)
+	// Unlock Transaction
+	// (POST /transactions/{id}/unlock)
+	PostTransactionsIdUnlock(ctx context.Context, request PostTransactionsIdUnlockRequestObject) (PostTransactionsIdUnlockResponseObject, error)
+	// Get Transfers
+	// (GET /transfers)
+	GetTransfers(ctx context.Context, request GetTransfersRequestObject) (GetTransfersResponseObject, error)
+	// Create Transfer
+	// (POST /transfers)
+	PostTransfers(ctx context.Context, request PostTransfersRequestObject) (PostTransfersResponseObject, error)
+	// Delete Transfer
+	// (DELETE /transfers/{id})
+	DeleteTransfersId(ctx context.Context, request DeleteTransfersIdRequestObject) (DeleteTransfersIdResponseObject, error)
+	// Get Transfer
+	// (GET /transfers/{id})
+	GetTransfersId(ctx context.Context, request GetTransfersIdRequestObject) (GetTransfersIdResponseObject, error)
+	// Get Trash
+	// (GET /trash)
+	GetTrash(ctx context.Context, request GetTrashRequestObject) (GetTrashResponseObject, error)
+	// Purge Budget
+	// (DELETE /trash/budgets/{id})
+	DeleteTrashBudgetsId(ctx context.Context, request DeleteTrashBudgetsIdRequestObject) (DeleteTrashBudgetsIdResponseObject, error)
+	// Restore Budget
+	// (POST /trash/budgets/{id}/restore)
+	PostTrashBudgetsIdRestore(ctx context.Context, request PostTrashBudgetsIdRestoreRequestObject) (PostTrashBudgetsIdRestoreResponseObject, error)
+	// Purge Category
+	// (DELETE /trash/categories/{id})
+	DeleteTrashCategoriesId(ctx context.Context, request DeleteTrashCategoriesIdRequestObject) (DeleteTrashCategoriesIdResponseObject, error)
+	// Restore Category
+	// (POST /trash/categories/{id}/restore)
+	PostTrashCategoriesIdRestore(ctx context.Context, request PostTrashCategoriesIdRestoreRequestObject) (PostTrashCategoriesIdRestoreResponseObject, error)
+	// Purge Transaction
+	// (DELETE /trash/transactions/{id})
+	DeleteTrashTransactionsId(ctx context.Context, request DeleteTrashTransactionsIdRequestObject) (DeleteTrashTransactionsIdResponseObject, error)
+	// Restore Transaction
+	// (POST /trash/transactions/{id}/restore)
+	PostTrashTransactionsIdRestore(ctx context.Context, request PostTrashTransactionsIdRestoreRequestObject) (PostTrashTransactionsIdRestoreResponseObject, error)
 	// User CheckSignedIn
 	// (GET /users/checkSignedIn)
 	GetUsersCheckSignedIn(ctx context.Context, request GetUsersCheckSignedInRequestObject) (GetUsersCheckSignedInResponseObject, error)
+	// Get Current User
+	// (GET /users/me)
+	GetUsersMe(ctx context.Context, request GetUsersMeRequestObject) (GetUsersMeResponseObject, error)
+	// Update Current User
+	// (PATCH /users/me)
+	PatchUsersMe(ctx context.Context, request PatchUsersMeRequestObject) (PatchUsersMeResponseObject, error)
 	// User SignIn
 	// (POST /users/signIn)
 	PostUsersSignIn(ctx context.Context, request PostUsersSignInRequestObject) (PostUsersSignInResponseObject, error)
@@ -1720,438 +9673,2585 @@ type StrictServerInterface interface {
 	PostUsersSignUp(ctx context.Context, request PostUsersSignUpRequestObject) (PostUsersSignUpResponseObject, error)
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAccounts operation middleware
+func (sh *strictHandler) GetAccounts(ctx echo.Context) error {
+	var request GetAccountsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAccounts(ctx.Request().Context(), request.(GetAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAccounts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAccountsResponseObject); ok {
+		return validResponse.VisitGetAccountsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAccounts operation middleware
+func (sh *strictHandler) PostAccounts(ctx echo.Context) error {
+	var request PostAccountsRequestObject
+
+	var body PostAccountsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAccounts(ctx.Request().Context(), request.(PostAccountsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAccounts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAccountsResponseObject); ok {
+		return validResponse.VisitPostAccountsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAccountsBalances operation middleware
+func (sh *strictHandler) GetAccountsBalances(ctx echo.Context, params GetAccountsBalancesParams) error {
+	var request GetAccountsBalancesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAccountsBalances(ctx.Request().Context(), request.(GetAccountsBalancesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAccountsBalances")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAccountsBalancesResponseObject); ok {
+		return validResponse.VisitGetAccountsBalancesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteAccountsId operation middleware
+func (sh *strictHandler) DeleteAccountsId(ctx echo.Context, id int32) error {
+	var request DeleteAccountsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAccountsId(ctx.Request().Context(), request.(DeleteAccountsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAccountsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteAccountsIdResponseObject); ok {
+		return validResponse.VisitDeleteAccountsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAccountsId operation middleware
+func (sh *strictHandler) GetAccountsId(ctx echo.Context, id int32) error {
+	var request GetAccountsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAccountsId(ctx.Request().Context(), request.(GetAccountsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAccountsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAccountsIdResponseObject); ok {
+		return validResponse.VisitGetAccountsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchAccountsId operation middleware
+func (sh *strictHandler) PatchAccountsId(ctx echo.Context, id int32) error {
+	var request PatchAccountsIdRequestObject
+
+	request.Id = id
+
+	var body PatchAccountsIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchAccountsId(ctx.Request().Context(), request.(PatchAccountsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchAccountsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchAccountsIdResponseObject); ok {
+		return validResponse.VisitPatchAccountsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAccountsIdEntries operation middleware
+func (sh *strictHandler) GetAccountsIdEntries(ctx echo.Context, id int32, params GetAccountsIdEntriesParams) error {
+	var request GetAccountsIdEntriesRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAccountsIdEntries(ctx.Request().Context(), request.(GetAccountsIdEntriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAccountsIdEntries")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAccountsIdEntriesResponseObject); ok {
+		return validResponse.VisitGetAccountsIdEntriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAccountsIdReconcile operation middleware
+func (sh *strictHandler) PostAccountsIdReconcile(ctx echo.Context, id int32) error {
+	var request PostAccountsIdReconcileRequestObject
+
+	request.Id = id
+
+	var body PostAccountsIdReconcileJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAccountsIdReconcile(ctx.Request().Context(), request.(PostAccountsIdReconcileRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAccountsIdReconcile")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAccountsIdReconcileResponseObject); ok {
+		return validResponse.VisitPostAccountsIdReconcileResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAccountsIdReconciliations operation middleware
+func (sh *strictHandler) GetAccountsIdReconciliations(ctx echo.Context, id int32) error {
+	var request GetAccountsIdReconciliationsRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAccountsIdReconciliations(ctx.Request().Context(), request.(GetAccountsIdReconciliationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAccountsIdReconciliations")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAccountsIdReconciliationsResponseObject); ok {
+		return validResponse.VisitGetAccountsIdReconciliationsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBudgetTemplates operation middleware
+func (sh *strictHandler) GetBudgetTemplates(ctx echo.Context) error {
+	var request GetBudgetTemplatesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetTemplates(ctx.Request().Context(), request.(GetBudgetTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBudgetTemplatesResponseObject); ok {
+		return validResponse.VisitGetBudgetTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostBudgetTemplates operation middleware
+func (sh *strictHandler) PostBudgetTemplates(ctx echo.Context) error {
+	var request PostBudgetTemplatesRequestObject
+
+	var body PostBudgetTemplatesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostBudgetTemplates(ctx.Request().Context(), request.(PostBudgetTemplatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostBudgetTemplates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostBudgetTemplatesResponseObject); ok {
+		return validResponse.VisitPostBudgetTemplatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteBudgetTemplatesId operation middleware
+func (sh *strictHandler) DeleteBudgetTemplatesId(ctx echo.Context, id int32) error {
+	var request DeleteBudgetTemplatesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBudgetTemplatesId(ctx.Request().Context(), request.(DeleteBudgetTemplatesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBudgetTemplatesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteBudgetTemplatesIdResponseObject); ok {
+		return validResponse.VisitDeleteBudgetTemplatesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBudgetTemplatesId operation middleware
+func (sh *strictHandler) GetBudgetTemplatesId(ctx echo.Context, id int32) error {
+	var request GetBudgetTemplatesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetTemplatesId(ctx.Request().Context(), request.(GetBudgetTemplatesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetTemplatesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBudgetTemplatesIdResponseObject); ok {
+		return validResponse.VisitGetBudgetTemplatesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchBudgetTemplatesId operation middleware
+func (sh *strictHandler) PatchBudgetTemplatesId(ctx echo.Context, id int32) error {
+	var request PatchBudgetTemplatesIdRequestObject
+
+	request.Id = id
+
+	var body PatchBudgetTemplatesIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchBudgetTemplatesId(ctx.Request().Context(), request.(PatchBudgetTemplatesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchBudgetTemplatesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchBudgetTemplatesIdResponseObject); ok {
+		return validResponse.VisitPatchBudgetTemplatesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBudgets operation middleware
+func (sh *strictHandler) GetBudgets(ctx echo.Context, params GetBudgetsParams) error {
+	var request GetBudgetsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgets(ctx.Request().Context(), request.(GetBudgetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgets")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBudgetsResponseObject); ok {
+		return validResponse.VisitGetBudgetsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostBudgets operation middleware
+func (sh *strictHandler) PostBudgets(ctx echo.Context) error {
+	var request PostBudgetsRequestObject
+
+	var body PostBudgetsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostBudgets(ctx.Request().Context(), request.(PostBudgetsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostBudgets")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostBudgetsResponseObject); ok {
+		return validResponse.VisitPostBudgetsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBudgetsAvailable operation middleware
+func (sh *strictHandler) GetBudgetsAvailable(ctx echo.Context, params GetBudgetsAvailableParams) error {
+	var request GetBudgetsAvailableRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetsAvailable(ctx.Request().Context(), request.(GetBudgetsAvailableRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetsAvailable")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBudgetsAvailableResponseObject); ok {
+		return validResponse.VisitGetBudgetsAvailableResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostBudgetsCopy operation middleware
+func (sh *strictHandler) PostBudgetsCopy(ctx echo.Context) error {
+	var request PostBudgetsCopyRequestObject
+
+	var body PostBudgetsCopyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostBudgetsCopy(ctx.Request().Context(), request.(PostBudgetsCopyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostBudgetsCopy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostBudgetsCopyResponseObject); ok {
+		return validResponse.VisitPostBudgetsCopyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBudgetsSummary operation middleware
+func (sh *strictHandler) GetBudgetsSummary(ctx echo.Context, params GetBudgetsSummaryParams) error {
+	var request GetBudgetsSummaryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetsSummary(ctx.Request().Context(), request.(GetBudgetsSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetsSummary")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBudgetsSummaryResponseObject); ok {
+		return validResponse.VisitGetBudgetsSummaryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteBudgetsId operation middleware
+func (sh *strictHandler) DeleteBudgetsId(ctx echo.Context, id int32) error {
+	var request DeleteBudgetsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteBudgetsId(ctx.Request().Context(), request.(DeleteBudgetsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteBudgetsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteBudgetsIdResponseObject); ok {
+		return validResponse.VisitDeleteBudgetsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetBudgetsId operation middleware
+func (sh *strictHandler) GetBudgetsId(ctx echo.Context, id int32) error {
+	var request GetBudgetsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBudgetsId(ctx.Request().Context(), request.(GetBudgetsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBudgetsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetBudgetsIdResponseObject); ok {
+		return validResponse.VisitGetBudgetsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchBudgetsId operation middleware
+func (sh *strictHandler) PatchBudgetsId(ctx echo.Context, id int32) error {
+	var request PatchBudgetsIdRequestObject
+
+	request.Id = id
+
+	var body PatchBudgetsIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchBudgetsId(ctx.Request().Context(), request.(PatchBudgetsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchBudgetsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchBudgetsIdResponseObject); ok {
+		return validResponse.VisitPatchBudgetsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCalendar operation middleware
+func (sh *strictHandler) GetCalendar(ctx echo.Context, params GetCalendarParams) error {
+	var request GetCalendarRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalendar(ctx.Request().Context(), request.(GetCalendarRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalendar")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCalendarResponseObject); ok {
+		return validResponse.VisitGetCalendarResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCategories operation middleware
+func (sh *strictHandler) GetCategories(ctx echo.Context) error {
+	var request GetCategoriesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategories(ctx.Request().Context(), request.(GetCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategories")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCategoriesResponseObject); ok {
+		return validResponse.VisitGetCategoriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCategories operation middleware
+func (sh *strictHandler) PostCategories(ctx echo.Context) error {
+	var request PostCategoriesRequestObject
+
+	var body PostCategoriesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCategories(ctx.Request().Context(), request.(PostCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCategories")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCategoriesResponseObject); ok {
+		return validResponse.VisitPostCategoriesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCategoriesId operation middleware
+func (sh *strictHandler) DeleteCategoriesId(ctx echo.Context, id int32) error {
+	var request DeleteCategoriesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategoriesId(ctx.Request().Context(), request.(DeleteCategoriesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategoriesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCategoriesIdResponseObject); ok {
+		return validResponse.VisitDeleteCategoriesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCategoriesId operation middleware
+func (sh *strictHandler) GetCategoriesId(ctx echo.Context, id int32) error {
+	var request GetCategoriesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategoriesId(ctx.Request().Context(), request.(GetCategoriesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategoriesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCategoriesIdResponseObject); ok {
+		return validResponse.VisitGetCategoriesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchCategoriesId operation middleware
+func (sh *strictHandler) PatchCategoriesId(ctx echo.Context, id int32) error {
+	var request PatchCategoriesIdRequestObject
+
+	request.Id = id
+
+	var body PatchCategoriesIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCategoriesId(ctx.Request().Context(), request.(PatchCategoriesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCategoriesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCategoriesIdResponseObject); ok {
+		return validResponse.VisitPatchCategoriesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCategorizationRules operation middleware
+func (sh *strictHandler) GetCategorizationRules(ctx echo.Context) error {
+	var request GetCategorizationRulesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategorizationRules(ctx.Request().Context(), request.(GetCategorizationRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategorizationRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCategorizationRulesResponseObject); ok {
+		return validResponse.VisitGetCategorizationRulesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCategorizationRules operation middleware
+func (sh *strictHandler) PostCategorizationRules(ctx echo.Context) error {
+	var request PostCategorizationRulesRequestObject
+
+	var body PostCategorizationRulesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCategorizationRules(ctx.Request().Context(), request.(PostCategorizationRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCategorizationRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCategorizationRulesResponseObject); ok {
+		return validResponse.VisitPostCategorizationRulesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCategorizationRulesId operation middleware
+func (sh *strictHandler) DeleteCategorizationRulesId(ctx echo.Context, id int32) error {
+	var request DeleteCategorizationRulesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCategorizationRulesId(ctx.Request().Context(), request.(DeleteCategorizationRulesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCategorizationRulesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCategorizationRulesIdResponseObject); ok {
+		return validResponse.VisitDeleteCategorizationRulesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCategorizationRulesId operation middleware
+func (sh *strictHandler) GetCategorizationRulesId(ctx echo.Context, id int32) error {
+	var request GetCategorizationRulesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCategorizationRulesId(ctx.Request().Context(), request.(GetCategorizationRulesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCategorizationRulesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCategorizationRulesIdResponseObject); ok {
+		return validResponse.VisitGetCategorizationRulesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchCategorizationRulesId operation middleware
+func (sh *strictHandler) PatchCategorizationRulesId(ctx echo.Context, id int32) error {
+	var request PatchCategorizationRulesIdRequestObject
+
+	request.Id = id
+
+	var body PatchCategorizationRulesIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCategorizationRulesId(ctx.Request().Context(), request.(PatchCategorizationRulesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCategorizationRulesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCategorizationRulesIdResponseObject); ok {
+		return validResponse.VisitPatchCategorizationRulesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCategorizationRulesIdApply operation middleware
+func (sh *strictHandler) PostCategorizationRulesIdApply(ctx echo.Context, id int32) error {
+	var request PostCategorizationRulesIdApplyRequestObject
+
+	request.Id = id
+
+	var body PostCategorizationRulesIdApplyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCategorizationRulesIdApply(ctx.Request().Context(), request.(PostCategorizationRulesIdApplyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCategorizationRulesIdApply")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCategorizationRulesIdApplyResponseObject); ok {
+		return validResponse.VisitPostCategorizationRulesIdApplyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCreditCards operation middleware
+func (sh *strictHandler) GetCreditCards(ctx echo.Context) error {
+	var request GetCreditCardsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCreditCards(ctx.Request().Context(), request.(GetCreditCardsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCreditCards")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCreditCardsResponseObject); ok {
+		return validResponse.VisitGetCreditCardsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostCreditCards operation middleware
+func (sh *strictHandler) PostCreditCards(ctx echo.Context) error {
+	var request PostCreditCardsRequestObject
+
+	var body PostCreditCardsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCreditCards(ctx.Request().Context(), request.(PostCreditCardsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCreditCards")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostCreditCardsResponseObject); ok {
+		return validResponse.VisitPostCreditCardsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCreditCardsWithdrawals operation middleware
+func (sh *strictHandler) GetCreditCardsWithdrawals(ctx echo.Context, params GetCreditCardsWithdrawalsParams) error {
+	var request GetCreditCardsWithdrawalsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCreditCardsWithdrawals(ctx.Request().Context(), request.(GetCreditCardsWithdrawalsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCreditCardsWithdrawals")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCreditCardsWithdrawalsResponseObject); ok {
+		return validResponse.VisitGetCreditCardsWithdrawalsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteCreditCardsId operation middleware
+func (sh *strictHandler) DeleteCreditCardsId(ctx echo.Context, id int32) error {
+	var request DeleteCreditCardsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCreditCardsId(ctx.Request().Context(), request.(DeleteCreditCardsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCreditCardsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteCreditCardsIdResponseObject); ok {
+		return validResponse.VisitDeleteCreditCardsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCreditCardsId operation middleware
+func (sh *strictHandler) GetCreditCardsId(ctx echo.Context, id int32) error {
+	var request GetCreditCardsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCreditCardsId(ctx.Request().Context(), request.(GetCreditCardsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCreditCardsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCreditCardsIdResponseObject); ok {
+		return validResponse.VisitGetCreditCardsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchCreditCardsId operation middleware
+func (sh *strictHandler) PatchCreditCardsId(ctx echo.Context, id int32) error {
+	var request PatchCreditCardsIdRequestObject
+
+	request.Id = id
+
+	var body PatchCreditCardsIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCreditCardsId(ctx.Request().Context(), request.(PatchCreditCardsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCreditCardsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchCreditCardsIdResponseObject); ok {
+		return validResponse.VisitPatchCreditCardsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCreditCardsIdStatements operation middleware
+func (sh *strictHandler) GetCreditCardsIdStatements(ctx echo.Context, id int32, params GetCreditCardsIdStatementsParams) error {
+	var request GetCreditCardsIdStatementsRequestObject
+
+	request.Id = id
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCreditCardsIdStatements(ctx.Request().Context(), request.(GetCreditCardsIdStatementsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCreditCardsIdStatements")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCreditCardsIdStatementsResponseObject); ok {
+		return validResponse.VisitGetCreditCardsIdStatementsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCsrf operation middleware
+func (sh *strictHandler) GetCsrf(ctx echo.Context) error {
+	var request GetCsrfRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCsrf(ctx.Request().Context(), request.(GetCsrfRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCsrf")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCsrfResponseObject); ok {
+		return validResponse.VisitGetCsrfResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetExchangeRates operation middleware
+func (sh *strictHandler) GetExchangeRates(ctx echo.Context, params GetExchangeRatesParams) error {
+	var request GetExchangeRatesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExchangeRates(ctx.Request().Context(), request.(GetExchangeRatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExchangeRates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetExchangeRatesResponseObject); ok {
+		return validResponse.VisitGetExchangeRatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostExchangeRates operation middleware
+func (sh *strictHandler) PostExchangeRates(ctx echo.Context) error {
+	var request PostExchangeRatesRequestObject
+
+	var body PostExchangeRatesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostExchangeRates(ctx.Request().Context(), request.(PostExchangeRatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostExchangeRates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostExchangeRatesResponseObject); ok {
+		return validResponse.VisitPostExchangeRatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostExchangeRatesImport operation middleware
+func (sh *strictHandler) PostExchangeRatesImport(ctx echo.Context) error {
+	var request PostExchangeRatesImportRequestObject
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostExchangeRatesImport(ctx.Request().Context(), request.(PostExchangeRatesImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostExchangeRatesImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostExchangeRatesImportResponseObject); ok {
+		return validResponse.VisitPostExchangeRatesImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteExchangeRatesId operation middleware
+func (sh *strictHandler) DeleteExchangeRatesId(ctx echo.Context, id int32) error {
+	var request DeleteExchangeRatesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteExchangeRatesId(ctx.Request().Context(), request.(DeleteExchangeRatesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteExchangeRatesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteExchangeRatesIdResponseObject); ok {
+		return validResponse.VisitDeleteExchangeRatesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchExchangeRatesId operation middleware
+func (sh *strictHandler) PatchExchangeRatesId(ctx echo.Context, id int32) error {
+	var request PatchExchangeRatesIdRequestObject
+
+	request.Id = id
+
+	var body PatchExchangeRatesIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchExchangeRatesId(ctx.Request().Context(), request.(PatchExchangeRatesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchExchangeRatesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchExchangeRatesIdResponseObject); ok {
+		return validResponse.VisitPatchExchangeRatesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetImportProfiles operation middleware
+func (sh *strictHandler) GetImportProfiles(ctx echo.Context) error {
+	var request GetImportProfilesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetImportProfiles(ctx.Request().Context(), request.(GetImportProfilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetImportProfiles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetImportProfilesResponseObject); ok {
+		return validResponse.VisitGetImportProfilesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostImportProfiles operation middleware
+func (sh *strictHandler) PostImportProfiles(ctx echo.Context) error {
+	var request PostImportProfilesRequestObject
+
+	var body PostImportProfilesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostImportProfiles(ctx.Request().Context(), request.(PostImportProfilesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostImportProfiles")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostImportProfilesResponseObject); ok {
+		return validResponse.VisitPostImportProfilesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteImportProfilesId operation middleware
+func (sh *strictHandler) DeleteImportProfilesId(ctx echo.Context, id int32) error {
+	var request DeleteImportProfilesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteImportProfilesId(ctx.Request().Context(), request.(DeleteImportProfilesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteImportProfilesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteImportProfilesIdResponseObject); ok {
+		return validResponse.VisitDeleteImportProfilesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetImportProfilesId operation middleware
+func (sh *strictHandler) GetImportProfilesId(ctx echo.Context, id int32) error {
+	var request GetImportProfilesIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetImportProfilesId(ctx.Request().Context(), request.(GetImportProfilesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetImportProfilesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetImportProfilesIdResponseObject); ok {
+		return validResponse.VisitGetImportProfilesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchImportProfilesId operation middleware
+func (sh *strictHandler) PatchImportProfilesId(ctx echo.Context, id int32) error {
+	var request PatchImportProfilesIdRequestObject
+
+	request.Id = id
+
+	var body PatchImportProfilesIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchImportProfilesId(ctx.Request().Context(), request.(PatchImportProfilesIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchImportProfilesId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchImportProfilesIdResponseObject); ok {
+		return validResponse.VisitPatchImportProfilesIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetInstallmentPlans operation middleware
+func (sh *strictHandler) GetInstallmentPlans(ctx echo.Context) error {
+	var request GetInstallmentPlansRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInstallmentPlans(ctx.Request().Context(), request.(GetInstallmentPlansRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInstallmentPlans")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetInstallmentPlansResponseObject); ok {
+		return validResponse.VisitGetInstallmentPlansResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteInstallmentPlansId operation middleware
+func (sh *strictHandler) DeleteInstallmentPlansId(ctx echo.Context, id int32) error {
+	var request DeleteInstallmentPlansIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteInstallmentPlansId(ctx.Request().Context(), request.(DeleteInstallmentPlansIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteInstallmentPlansId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteInstallmentPlansIdResponseObject); ok {
+		return validResponse.VisitDeleteInstallmentPlansIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetInstallmentPlansId operation middleware
+func (sh *strictHandler) GetInstallmentPlansId(ctx echo.Context, id int32) error {
+	var request GetInstallmentPlansIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetInstallmentPlansId(ctx.Request().Context(), request.(GetInstallmentPlansIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetInstallmentPlansId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetInstallmentPlansIdResponseObject); ok {
+		return validResponse.VisitGetInstallmentPlansIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetRecurringTransactions operation middleware
+func (sh *strictHandler) GetRecurringTransactions(ctx echo.Context) error {
+	var request GetRecurringTransactionsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecurringTransactions(ctx.Request().Context(), request.(GetRecurringTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecurringTransactions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetRecurringTransactionsResponseObject); ok {
+		return validResponse.VisitGetRecurringTransactionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRecurringTransactions operation middleware
+func (sh *strictHandler) PostRecurringTransactions(ctx echo.Context) error {
+	var request PostRecurringTransactionsRequestObject
+
+	var body PostRecurringTransactionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRecurringTransactions(ctx.Request().Context(), request.(PostRecurringTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRecurringTransactions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostRecurringTransactionsResponseObject); ok {
+		return validResponse.VisitPostRecurringTransactionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostRecurringTransactionsGenerate operation middleware
+func (sh *strictHandler) PostRecurringTransactionsGenerate(ctx echo.Context) error {
+	var request PostRecurringTransactionsGenerateRequestObject
+
+	var body PostRecurringTransactionsGenerateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRecurringTransactionsGenerate(ctx.Request().Context(), request.(PostRecurringTransactionsGenerateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRecurringTransactionsGenerate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostRecurringTransactionsGenerateResponseObject); ok {
+		return validResponse.VisitPostRecurringTransactionsGenerateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteRecurringTransactionsId operation middleware
+func (sh *strictHandler) DeleteRecurringTransactionsId(ctx echo.Context, id int32) error {
+	var request DeleteRecurringTransactionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteRecurringTransactionsId(ctx.Request().Context(), request.(DeleteRecurringTransactionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteRecurringTransactionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteRecurringTransactionsIdResponseObject); ok {
+		return validResponse.VisitDeleteRecurringTransactionsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetRecurringTransactionsId operation middleware
+func (sh *strictHandler) GetRecurringTransactionsId(ctx echo.Context, id int32) error {
+	var request GetRecurringTransactionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRecurringTransactionsId(ctx.Request().Context(), request.(GetRecurringTransactionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRecurringTransactionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetRecurringTransactionsIdResponseObject); ok {
+		return validResponse.VisitGetRecurringTransactionsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchRecurringTransactionsId operation middleware
+func (sh *strictHandler) PatchRecurringTransactionsId(ctx echo.Context, id int32) error {
+	var request PatchRecurringTransactionsIdRequestObject
+
+	request.Id = id
+
+	var body PatchRecurringTransactionsIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchRecurringTransactionsId(ctx.Request().Context(), request.(PatchRecurringTransactionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchRecurringTransactionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchRecurringTransactionsIdResponseObject); ok {
+		return validResponse.VisitPatchRecurringTransactionsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetReportsTrends operation middleware
+func (sh *strictHandler) GetReportsTrends(ctx echo.Context, params GetReportsTrendsParams) error {
+	var request GetReportsTrendsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReportsTrends(ctx.Request().Context(), request.(GetReportsTrendsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReportsTrends")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetReportsTrendsResponseObject); ok {
+		return validResponse.VisitGetReportsTrendsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTags operation middleware
+func (sh *strictHandler) GetTags(ctx echo.Context) error {
+	var request GetTagsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTags(ctx.Request().Context(), request.(GetTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTags")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTagsResponseObject); ok {
+		return validResponse.VisitGetTagsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTags operation middleware
+func (sh *strictHandler) PostTags(ctx echo.Context) error {
+	var request PostTagsRequestObject
+
+	var body PostTagsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTags(ctx.Request().Context(), request.(PostTagsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTags")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTagsResponseObject); ok {
+		return validResponse.VisitPostTagsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTagsSummary operation middleware
+func (sh *strictHandler) GetTagsSummary(ctx echo.Context, params GetTagsSummaryParams) error {
+	var request GetTagsSummaryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTagsSummary(ctx.Request().Context(), request.(GetTagsSummaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTagsSummary")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTagsSummaryResponseObject); ok {
+		return validResponse.VisitGetTagsSummaryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTagsId operation middleware
+func (sh *strictHandler) DeleteTagsId(ctx echo.Context, id int32) error {
+	var request DeleteTagsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTagsId(ctx.Request().Context(), request.(DeleteTagsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTagsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTagsIdResponseObject); ok {
+		return validResponse.VisitDeleteTagsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTagsId operation middleware
+func (sh *strictHandler) GetTagsId(ctx echo.Context, id int32) error {
+	var request GetTagsIdRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTagsId(ctx.Request().Context(), request.(GetTagsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTagsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTagsIdResponseObject); ok {
+		return validResponse.VisitGetTagsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+// PatchTagsId operation middleware
+func (sh *strictHandler) PatchTagsId(ctx echo.Context, id int32) error {
+	var request PatchTagsIdRequestObject
+
+	request.Id = id
+
+	var body PatchTagsIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchTagsId(ctx.Request().Context(), request.(PatchTagsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchTagsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchTagsIdResponseObject); ok {
+		return validResponse.VisitPatchTagsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// GetBudgets operation middleware
-func (sh *strictHandler) GetBudgets(ctx echo.Context, params GetBudgetsParams) error {
-	var request GetBudgetsRequestObject
+// GetTransactions operation middleware
+func (sh *strictHandler) GetTransactions(ctx echo.Context, params GetTransactionsParams) error {
+	var request GetTransactionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTransactions(ctx.Request().Context(), request.(GetTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTransactions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTransactionsResponseObject); ok {
+		return validResponse.VisitGetTransactionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTransactions operation middleware
+func (sh *strictHandler) PostTransactions(ctx echo.Context) error {
+	var request PostTransactionsRequestObject
+
+	var body PostTransactionsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTransactions(ctx.Request().Context(), request.(PostTransactionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTransactions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTransactionsResponseObject); ok {
+		return validResponse.VisitPostTransactionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTransactionsBatch operation middleware
+func (sh *strictHandler) PostTransactionsBatch(ctx echo.Context) error {
+	var request PostTransactionsBatchRequestObject
+
+	var body PostTransactionsBatchJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTransactionsBatch(ctx.Request().Context(), request.(PostTransactionsBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTransactionsBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTransactionsBatchResponseObject); ok {
+		return validResponse.VisitPostTransactionsBatchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTransactionsDuplicates operation middleware
+func (sh *strictHandler) GetTransactionsDuplicates(ctx echo.Context, params GetTransactionsDuplicatesParams) error {
+	var request GetTransactionsDuplicatesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTransactionsDuplicates(ctx.Request().Context(), request.(GetTransactionsDuplicatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTransactionsDuplicates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTransactionsDuplicatesResponseObject); ok {
+		return validResponse.VisitGetTransactionsDuplicatesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTransactionsDuplicatesMerge operation middleware
+func (sh *strictHandler) PostTransactionsDuplicatesMerge(ctx echo.Context) error {
+	var request PostTransactionsDuplicatesMergeRequestObject
+
+	var body PostTransactionsDuplicatesMergeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTransactionsDuplicatesMerge(ctx.Request().Context(), request.(PostTransactionsDuplicatesMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTransactionsDuplicatesMerge")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTransactionsDuplicatesMergeResponseObject); ok {
+		return validResponse.VisitPostTransactionsDuplicatesMergeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTransactionsExport operation middleware
+func (sh *strictHandler) GetTransactionsExport(ctx echo.Context, params GetTransactionsExportParams) error {
+	var request GetTransactionsExportRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTransactionsExport(ctx.Request().Context(), request.(GetTransactionsExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTransactionsExport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTransactionsExportResponseObject); ok {
+		return validResponse.VisitGetTransactionsExportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTransactionsImport operation middleware
+func (sh *strictHandler) PostTransactionsImport(ctx echo.Context) error {
+	var request PostTransactionsImportRequestObject
+
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTransactionsImport(ctx.Request().Context(), request.(PostTransactionsImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTransactionsImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTransactionsImportResponseObject); ok {
+		return validResponse.VisitPostTransactionsImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTransactionsRecategorize operation middleware
+func (sh *strictHandler) PostTransactionsRecategorize(ctx echo.Context) error {
+	var request PostTransactionsRecategorizeRequestObject
+
+	var body PostTransactionsRecategorizeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTransactionsRecategorize(ctx.Request().Context(), request.(PostTransactionsRecategorizeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTransactionsRecategorize")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTransactionsRecategorizeResponseObject); ok {
+		return validResponse.VisitPostTransactionsRecategorizeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteTransactionsId operation middleware
+func (sh *strictHandler) DeleteTransactionsId(ctx echo.Context, id int32) error {
+	var request DeleteTransactionsIdRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTransactionsId(ctx.Request().Context(), request.(DeleteTransactionsIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTransactionsId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteTransactionsIdResponseObject); ok {
+		return validResponse.VisitDeleteTransactionsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
 
-	request.Params = params
+// GetTransactionsId operation middleware
+func (sh *strictHandler) GetTransactionsId(ctx echo.Context, id int32) error {
+	var request GetTransactionsIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBudgets(ctx.Request().Context(), request.(GetBudgetsRequestObject))
+		return sh.ssi.GetTransactionsId(ctx.Request().Context(), request.(GetTransactionsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBudgets")
+		handler = middleware(handler, "GetTransactionsId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetBudgetsResponseObject); ok {
-		return validResponse.VisitGetBudgetsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTransactionsIdResponseObject); ok {
+		return validResponse.VisitGetTransactionsIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostBudgets operation middleware
-func (sh *strictHandler) PostBudgets(ctx echo.Context) error {
-	var request PostBudgetsRequestObject
+// PatchTransactionsId operation middleware
+func (sh *strictHandler) PatchTransactionsId(ctx echo.Context, id int32) error {
+	var request PatchTransactionsIdRequestObject
 
-	var body PostBudgetsJSONRequestBody
+	request.Id = id
+
+	var body PatchTransactionsIdJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostBudgets(ctx.Request().Context(), request.(PostBudgetsRequestObject))
+		return sh.ssi.PatchTransactionsId(ctx.Request().Context(), request.(PatchTransactionsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostBudgets")
+		handler = middleware(handler, "PatchTransactionsId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostBudgetsResponseObject); ok {
-		return validResponse.VisitPostBudgetsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PatchTransactionsIdResponseObject); ok {
+		return validResponse.VisitPatchTransactionsIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteBudgetsId operation middleware
-func (sh *strictHandler) DeleteBudgetsId(ctx echo.Context, id int32) error {
-	var request DeleteBudgetsIdRequestObject
+// GetTransactionsIdAttachments operation middleware
+func (sh *strictHandler) GetTransactionsIdAttachments(ctx echo.Context, id int32) error {
+	var request GetTransactionsIdAttachmentsRequestObject
 
 	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteBudgetsId(ctx.Request().Context(), request.(DeleteBudgetsIdRequestObject))
+		return sh.ssi.GetTransactionsIdAttachments(ctx.Request().Context(), request.(GetTransactionsIdAttachmentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteBudgetsId")
+		handler = middleware(handler, "GetTransactionsIdAttachments")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteBudgetsIdResponseObject); ok {
-		return validResponse.VisitDeleteBudgetsIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTransactionsIdAttachmentsResponseObject); ok {
+		return validResponse.VisitGetTransactionsIdAttachmentsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetBudgetsId operation middleware
-func (sh *strictHandler) GetBudgetsId(ctx echo.Context, id int32) error {
-	var request GetBudgetsIdRequestObject
+// PostTransactionsIdAttachments operation middleware
+func (sh *strictHandler) PostTransactionsIdAttachments(ctx echo.Context, id int32) error {
+	var request PostTransactionsIdAttachmentsRequestObject
 
 	request.Id = id
 
+	if reader, err := ctx.Request().MultipartReader(); err != nil {
+		return err
+	} else {
+		request.Body = reader
+	}
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBudgetsId(ctx.Request().Context(), request.(GetBudgetsIdRequestObject))
+		return sh.ssi.PostTransactionsIdAttachments(ctx.Request().Context(), request.(PostTransactionsIdAttachmentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBudgetsId")
+		handler = middleware(handler, "PostTransactionsIdAttachments")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetBudgetsIdResponseObject); ok {
-		return validResponse.VisitGetBudgetsIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTransactionsIdAttachmentsResponseObject); ok {
+		return validResponse.VisitPostTransactionsIdAttachmentsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchBudgetsId operation middleware
-func (sh *strictHandler) PatchBudgetsId(ctx echo.Context, id int32) error {
-	var request PatchBudgetsIdRequestObject
+// DeleteTransactionsIdAttachmentsAttachmentId operation middleware
+func (sh *strictHandler) DeleteTransactionsIdAttachmentsAttachmentId(ctx echo.Context, id int32, attachmentId int32) error {
+	var request DeleteTransactionsIdAttachmentsAttachmentIdRequestObject
 
 	request.Id = id
+	request.AttachmentId = attachmentId
 
-	var body PatchBudgetsIdJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteTransactionsIdAttachmentsAttachmentId(ctx.Request().Context(), request.(DeleteTransactionsIdAttachmentsAttachmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteTransactionsIdAttachmentsAttachmentId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(DeleteTransactionsIdAttachmentsAttachmentIdResponseObject); ok {
+		return validResponse.VisitDeleteTransactionsIdAttachmentsAttachmentIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// GetTransactionsIdAttachmentsAttachmentId operation middleware
+func (sh *strictHandler) GetTransactionsIdAttachmentsAttachmentId(ctx echo.Context, id int32, attachmentId int32) error {
+	var request GetTransactionsIdAttachmentsAttachmentIdRequestObject
+
+	request.Id = id
+	request.AttachmentId = attachmentId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchBudgetsId(ctx.Request().Context(), request.(PatchBudgetsIdRequestObject))
+		return sh.ssi.GetTransactionsIdAttachmentsAttachmentId(ctx.Request().Context(), request.(GetTransactionsIdAttachmentsAttachmentIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchBudgetsId")
+		handler = middleware(handler, "GetTransactionsIdAttachmentsAttachmentId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchBudgetsIdResponseObject); ok {
-		return validResponse.VisitPatchBudgetsIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTransactionsIdAttachmentsAttachmentIdResponseObject); ok {
+		return validResponse.VisitGetTransactionsIdAttachmentsAttachmentIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCategories operation middleware
-func (sh *strictHandler) GetCategories(ctx echo.Context) error {
-	var request GetCategoriesRequestObject
+// GetTransactionsIdHistory operation middleware
+func (sh *strictHandler) GetTransactionsIdHistory(ctx echo.Context, id int32) error {
+	var request GetTransactionsIdHistoryRequestObject
+
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCategories(ctx.Request().Context(), request.(GetCategoriesRequestObject))
+		return sh.ssi.GetTransactionsIdHistory(ctx.Request().Context(), request.(GetTransactionsIdHistoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCategories")
+		handler = middleware(handler, "GetTransactionsIdHistory")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCategoriesResponseObject); ok {
-		return validResponse.VisitGetCategoriesResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTransactionsIdHistoryResponseObject); ok {
+		return validResponse.VisitGetTransactionsIdHistoryResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostCategories operation middleware
-func (sh *strictHandler) PostCategories(ctx echo.Context) error {
-	var request PostCategoriesRequestObject
+// PostTransactionsIdInstallments operation middleware
+func (sh *strictHandler) PostTransactionsIdInstallments(ctx echo.Context, id int32) error {
+	var request PostTransactionsIdInstallmentsRequestObject
 
-	var body PostCategoriesJSONRequestBody
+	request.Id = id
+
+	var body PostTransactionsIdInstallmentsJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostCategories(ctx.Request().Context(), request.(PostCategoriesRequestObject))
+		return sh.ssi.PostTransactionsIdInstallments(ctx.Request().Context(), request.(PostTransactionsIdInstallmentsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostCategories")
+		handler = middleware(handler, "PostTransactionsIdInstallments")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostCategoriesResponseObject); ok {
-		return validResponse.VisitPostCategoriesResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTransactionsIdInstallmentsResponseObject); ok {
+		return validResponse.VisitPostTransactionsIdInstallmentsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteCategoriesId operation middleware
-func (sh *strictHandler) DeleteCategoriesId(ctx echo.Context, id int32) error {
-	var request DeleteCategoriesIdRequestObject
+// PostTransactionsIdUnlock operation middleware
+func (sh *strictHandler) PostTransactionsIdUnlock(ctx echo.Context, id int32) error {
+	var request PostTransactionsIdUnlockRequestObject
 
 	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCategoriesId(ctx.Request().Context(), request.(DeleteCategoriesIdRequestObject))
+		return sh.ssi.PostTransactionsIdUnlock(ctx.Request().Context(), request.(PostTransactionsIdUnlockRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCategoriesId")
+		handler = middleware(handler, "PostTransactionsIdUnlock")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteCategoriesIdResponseObject); ok {
-		return validResponse.VisitDeleteCategoriesIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTransactionsIdUnlockResponseObject); ok {
+		return validResponse.VisitPostTransactionsIdUnlockResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCategoriesId operation middleware
-func (sh *strictHandler) GetCategoriesId(ctx echo.Context, id int32) error {
-	var request GetCategoriesIdRequestObject
+// GetTransfers operation middleware
+func (sh *strictHandler) GetTransfers(ctx echo.Context, params GetTransfersParams) error {
+	var request GetTransfersRequestObject
 
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCategoriesId(ctx.Request().Context(), request.(GetCategoriesIdRequestObject))
+		return sh.ssi.GetTransfers(ctx.Request().Context(), request.(GetTransfersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCategoriesId")
+		handler = middleware(handler, "GetTransfers")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCategoriesIdResponseObject); ok {
-		return validResponse.VisitGetCategoriesIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTransfersResponseObject); ok {
+		return validResponse.VisitGetTransfersResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchCategoriesId operation middleware
-func (sh *strictHandler) PatchCategoriesId(ctx echo.Context, id int32) error {
-	var request PatchCategoriesIdRequestObject
-
-	request.Id = id
+// PostTransfers operation middleware
+func (sh *strictHandler) PostTransfers(ctx echo.Context) error {
+	var request PostTransfersRequestObject
 
-	var body PatchCategoriesIdJSONRequestBody
+	var body PostTransfersJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchCategoriesId(ctx.Request().Context(), request.(PatchCategoriesIdRequestObject))
+		return sh.ssi.PostTransfers(ctx.Request().Context(), request.(PostTransfersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchCategoriesId")
+		handler = middleware(handler, "PostTransfers")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchCategoriesIdResponseObject); ok {
-		return validResponse.VisitPatchCategoriesIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTransfersResponseObject); ok {
+		return validResponse.VisitPostTransfersResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetCsrf operation middleware
-func (sh *strictHandler) GetCsrf(ctx echo.Context) error {
-	var request GetCsrfRequestObject
+// DeleteTransfersId operation middleware
+func (sh *strictHandler) DeleteTransfersId(ctx echo.Context, id int32) error {
+	var request DeleteTransfersIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCsrf(ctx.Request().Context(), request.(GetCsrfRequestObject))
+		return sh.ssi.DeleteTransfersId(ctx.Request().Context(), request.(DeleteTransfersIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCsrf")
+		handler = middleware(handler, "DeleteTransfersId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetCsrfResponseObject); ok {
-		return validResponse.VisitGetCsrfResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTransfersIdResponseObject); ok {
+		return validResponse.VisitDeleteTransfersIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTransactions operation middleware
-func (sh *strictHandler) GetTransactions(ctx echo.Context, params GetTransactionsParams) error {
-	var request GetTransactionsRequestObject
+// GetTransfersId operation middleware
+func (sh *strictHandler) GetTransfersId(ctx echo.Context, id int32) error {
+	var request GetTransfersIdRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTransactions(ctx.Request().Context(), request.(GetTransactionsRequestObject))
+		return sh.ssi.GetTransfersId(ctx.Request().Context(), request.(GetTransfersIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTransactions")
+		handler = middleware(handler, "GetTransfersId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTransactionsResponseObject); ok {
-		return validResponse.VisitGetTransactionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTransfersIdResponseObject); ok {
+		return validResponse.VisitGetTransfersIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostTransactions operation middleware
-func (sh *strictHandler) PostTransactions(ctx echo.Context) error {
-	var request PostTransactionsRequestObject
+// GetTrash operation middleware
+func (sh *strictHandler) GetTrash(ctx echo.Context) error {
+	var request GetTrashRequestObject
 
-	var body PostTransactionsJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTrash(ctx.Request().Context(), request.(GetTrashRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTrash")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(GetTrashResponseObject); ok {
+		return validResponse.VisitGetTrashResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// DeleteTrashBudgetsId operation middleware
+func (sh *strictHandler) DeleteTrashBudgetsId(ctx echo.Context, id int32) error {
+	var request DeleteTrashBudgetsIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTransactions(ctx.Request().Context(), request.(PostTransactionsRequestObject))
+		return sh.ssi.DeleteTrashBudgetsId(ctx.Request().Context(), request.(DeleteTrashBudgetsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTransactions")
+		handler = middleware(handler, "DeleteTrashBudgetsId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostTransactionsResponseObject); ok {
-		return validResponse.VisitPostTransactionsResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTrashBudgetsIdResponseObject); ok {
+		return validResponse.VisitDeleteTrashBudgetsIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteTransactionsId operation middleware
-func (sh *strictHandler) DeleteTransactionsId(ctx echo.Context, id int32) error {
-	var request DeleteTransactionsIdRequestObject
+// PostTrashBudgetsIdRestore operation middleware
+func (sh *strictHandler) PostTrashBudgetsIdRestore(ctx echo.Context, id int32) error {
+	var request PostTrashBudgetsIdRestoreRequestObject
 
 	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteTransactionsId(ctx.Request().Context(), request.(DeleteTransactionsIdRequestObject))
+		return sh.ssi.PostTrashBudgetsIdRestore(ctx.Request().Context(), request.(PostTrashBudgetsIdRestoreRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteTransactionsId")
+		handler = middleware(handler, "PostTrashBudgetsIdRestore")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteTransactionsIdResponseObject); ok {
-		return validResponse.VisitDeleteTransactionsIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTrashBudgetsIdRestoreResponseObject); ok {
+		return validResponse.VisitPostTrashBudgetsIdRestoreResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTransactionsId operation middleware
-func (sh *strictHandler) GetTransactionsId(ctx echo.Context, id int32) error {
-	var request GetTransactionsIdRequestObject
+// DeleteTrashCategoriesId operation middleware
+func (sh *strictHandler) DeleteTrashCategoriesId(ctx echo.Context, id int32) error {
+	var request DeleteTrashCategoriesIdRequestObject
 
 	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTransactionsId(ctx.Request().Context(), request.(GetTransactionsIdRequestObject))
+		return sh.ssi.DeleteTrashCategoriesId(ctx.Request().Context(), request.(DeleteTrashCategoriesIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTransactionsId")
+		handler = middleware(handler, "DeleteTrashCategoriesId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTransactionsIdResponseObject); ok {
-		return validResponse.VisitGetTransactionsIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTrashCategoriesIdResponseObject); ok {
+		return validResponse.VisitDeleteTrashCategoriesIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PatchTransactionsId operation middleware
-func (sh *strictHandler) PatchTransactionsId(ctx echo.Context, id int32) error {
-	var request PatchTransactionsIdRequestObject
+// PostTrashCategoriesIdRestore operation middleware
+func (sh *strictHandler) PostTrashCategoriesIdRestore(ctx echo.Context, id int32) error {
+	var request PostTrashCategoriesIdRestoreRequestObject
 
 	request.Id = id
 
-	var body PatchTransactionsIdJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTrashCategoriesIdRestore(ctx.Request().Context(), request.(PostTrashCategoriesIdRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTrashCategoriesIdRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(PostTrashCategoriesIdRestoreResponseObject); ok {
+		return validResponse.VisitPostTrashCategoriesIdRestoreResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// DeleteTrashTransactionsId operation middleware
+func (sh *strictHandler) DeleteTrashTransactionsId(ctx echo.Context, id int32) error {
+	var request DeleteTrashTransactionsIdRequestObject
+
+	request.Id = id
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PatchTransactionsId(ctx.Request().Context(), request.(PatchTransactionsIdRequestObject))
+		return sh.ssi.DeleteTrashTransactionsId(ctx.Request().Context(), request.(DeleteTrashTransactionsIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PatchTransactionsId")
+		handler = middleware(handler, "DeleteTrashTransactionsId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PatchTransactionsIdResponseObject); ok {
-		return validResponse.VisitPatchTransactionsIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteTrashTransactionsIdResponseObject); ok {
+		return validResponse.VisitDeleteTrashTransactionsIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTrashTransactionsIdRestore operation middleware
+func (sh *strictHandler) PostTrashTransactionsIdRestore(ctx echo.Context, id int32) error {
+	var request PostTrashTransactionsIdRestoreRequestObject
+
+	request.Id = id
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTrashTransactionsIdRestore(ctx.Request().Context(), request.(PostTrashTransactionsIdRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTrashTransactionsIdRestore")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTrashTransactionsIdRestoreResponseObject); ok {
+		return validResponse.VisitPostTrashTransactionsIdRestoreResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -2181,6 +12281,58 @@ func (sh *strictHandler) GetUsersCheckSignedIn(ctx echo.Context) error {
 	return nil
 }
 
+// GetUsersMe operation middleware
+func (sh *strictHandler) GetUsersMe(ctx echo.Context) error {
+	var request GetUsersMeRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersMe(ctx.Request().Context(), request.(GetUsersMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersMe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersMeResponseObject); ok {
+		return validResponse.VisitGetUsersMeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PatchUsersMe operation middleware
+func (sh *strictHandler) PatchUsersMe(ctx echo.Context) error {
+	var request PatchUsersMeRequestObject
+
+	var body PatchUsersMeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchUsersMe(ctx.Request().Context(), request.(PatchUsersMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchUsersMe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PatchUsersMeResponseObject); ok {
+		return validResponse.VisitPatchUsersMeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostUsersSignIn operation middleware
 func (sh *strictHandler) PostUsersSignIn(ctx echo.Context) error {
 	var request PostUsersSignInRequestObject
@@ -2265,64 +12417,380 @@ func (sh *strictHandler) PostUsersSignUp(ctx echo.Context) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcb3PbxLr/Khrd+4LOuIkL7VzIK1zbLR4SO+M/l5thOpmttYkFtmQkGcjteAZLh+IS",
-	"SgMHEnoO50ALaUtK00ILhxNS+mFUO86rfIUzuyvLK3n1xyFO3NRvMpG82n322ef5Pc/+9s8lvihXqrIE",
-	"JU3lpy7xarEEKwD/e7YmLEIN/SdAtaiIVU2UJX6q+z7CVxW5ChVNhLg4qMg1iVH86VZzZ3Nt98ZVPsIv",
-	"yEoFaPwUL0raSy/yEV5bqkLyCBehwtcjfBFocFFWlnCV5XJmgZ968xL/3wpc4Kf4/5rsSTtpiToZ735R",
-	"vxBxtW3qd03jsqk/Mo2NtvFh69uf6CbmRaFfXPqTVCKkzAoEGhTmAav7j79uN1faa+vt6zpdmwA0eFIT",
-	"K7BXo6oporSIKmQJRvQYVqSKLGml/kpa9//o/HSj/XVzb7s5Nzc3d3JmpvX4Zmv72t72FT7CV8D701Ba",
-	"RF/+T4SviBL11CdkrSp49rr990ft1QcD9rqmQoU9JsYt09g29V9NYzucAuoRXoHv1EQFCvzUm0ifveqd",
-	"BkBZXKRrw131OUbW0eELdpPyxbdgUUPixynLdcof77Xg9JmiXJYVfxs0G5udKz87x+bFKEN7h2KE+/EO",
-	"CVSgf02tlavODp6KsnpIXgwKC3n0lT80mPoTU//eNNb2tputa5+2PlyfbH9xv/XRFvKKZ9rSse6tjyKW",
-	"ue3PqvOW8kNokY/wUKpVsDhSUcYSwPerUFIh1URPG3EsDgkrKalaY2iZFOFIGY4UOpAAVBElsYJkPeUX",
-	"jA4sUgwdll3m4EQ6F7wxh5saiyxUqzIatIDhsMu5R+SinUH4OaqVT7hFtz72FrJrmf4m0y3lYTQHicDP",
-	"OtC5BoCFHcGjEWg09oB4mg2dAoZL/Dys3kfcvAIkFRSRaP72QxUcEHd2P/psNEAH4TsDc66ttra/bK+t",
-	"u6MWK2A5PnXX1Nn4sf3Vpy4HOXNmn9iERQg1cIGmRo+dp7VpvUJBBkfV19cbuhqm9KqyQAvsMnlVWcjL",
-	"b0MsQoDa7KKoGVEro7KO2hmtJxVFVs7KwhLLou6Yxg8o6zB+NPV/m8Y/TOMh/udr0/jI1L/r0xhElQXp",
-	"Crdoi+TuBKnigpekKWlB9pG088PDnUcPrAmdW7pXNWam0vrncufeV63meuveCpWg9JpjZSaCXAGi5Kez",
-	"xic717d2vvjGbKyZjW9M44pp3MAQ+5DlRhWoAQFoAMOGIIioOlCeddqjr+/xnSePWx9/azY2cUNP0BCh",
-	"hPHJ3nbT1O/hYfzdNFYQ2BvrprFNMog+NSsQqMTgwwUZazjxR6wYY+tjc2fl8s4XP/WN+KtWHLEatnXr",
-	"aQNZW0KPtkhDZmOztfm39pcP6EGdiaWm52PT2WQsMTef/L9ULp/jI3wq/b+x6VRiHv9MPc/Gcrk3MlmE",
-	"poVcMjufzuTnz2UK6QRVJp5NJpLpfCo2jWqKx/LJ85nsnKOo/TKVni/kkvTHdvHYDPN9PDOdyfIRPp+N",
-	"pXOxeD6VSTOloH/Pz83SdcVmMoV0nnqRiOXR72cLifPJPLO2mUw6/xr1bBW1a+o+u/WYiOVjZ2O55Hwy",
-	"m8ViF9KvpzNvpO1nXF0MS0lesXzLCRChYYmRwQmMz1/L52fxZ5eJe6D/9Ye4uishoybUgFhWGeEOo4/Z",
-	"2LBFtJFI1GBFDYWMGHLsVI8HigKWCEKoKliErFnM1u7qX83GJ52Ne6beMPVlSkM3TMMw9d9xV39jAY+q",
-	"Aa2mDujuOfJRv7u371xvb6322nfquT9ioRHqdc2WxtP1c7a0g7S7t908L8uLZcjFZlNcTgOSABShvbXa",
-	"Xv6WoGAXH2yfyZ4vzCSxrZ+LpaaTifnZbDKeSSdSyHb5CN9n+rQbzSazM6lcDll5IplOJTF+pGOF/GsI",
-	"KJBvE2fLJ7Pp2DTTB85BrVgi859pUfWZcOGC3fkWKho06cL/hjLH7vTLbYvM6Rh70KhuhOzCoU8Zcevd",
-	"CQNSoBokqT1PwaUDZyvWUyiV9yYuAUqn6g7sVOj+HNG8C0tBZdBhDJ5O4P2tnkq/w4+DI58PGApHA2H6",
-	"N0jfjnpyknc24xSX/vHPz33HKz7sCfaIzNHZtD+RI6xyjvPCkKV8WozB+PQC/tmX7CZFHGQ390IVKJoI",
-	"yhyp/sSY/fZgv30V7o3JTp0fenpCmg9gtC0ZnYx2sGWMIMVdD1RB4EgddTpDxAimkS1x+2jk/Xv0mFcO",
-	"bU2h0jHGCB11PlZQoTKRExellJdZoR+5lNeSBKwAscwKlzcwXXDX1G9iqvJHQqj0DUsVqOp7ssKMuJ/h",
-	"afd9m0rxZ6uJJFSNvt0tVP26W6gOqbseqEYlFwTVhqgna7EtvLrQn3gJFt9GqoFCys/AVahwjrLeBi6q",
-	"8youNM/kvY17pv6A8Ns7H//a/nC517GLslyGoN/WHTX6docYfEA/uobvt9rhqDFT08JUman5hH1PVi6Q",
-	"enMpo1tRoB4K1TBCF6pHKnM9wquwWFNEbSmHUI80HKuKr8OlWI2kesiM+KIsvy3C7n6UKV7D61e9GTf+",
-	"gq+jCkXm0o+Vl8VBGUoCULjYbIrvrX65f81B5V2xiNp7FyoqqeHURBQpWa5CCVRFfop/aSI6EcWuppWw",
-	"3JMUc8bc+EgDgtm4u/NoxWysm41rJMV++tsHnVu3Tf1zFAD/WDM/0E39PuYqNxAYGD9YcKQ/MRu3TeNL",
-	"U/8OQ9MTXOChqT9oXbvfMR7zWEgF4JxC4Kf481A7a0mGpFVABWpQUfEseaBEGr5fLWOyfAGUVRghQ/NO",
-	"DeIZjjUy3Y1vJIoxVyMDU4cw7ThnWr3WgidwF5B1EpvHQ/ViNEqyXEmDJF0C1WpZLGIFTr5lrSH1WvCL",
-	"2V58LLZMFytSghzyEqhqXAmonForFiEUoDCBzOz0AQrVW7tliHE6GuXOAoHLWqKc5LqLf2Rd8F+mcRtb",
-	"l8WZcy88/WN5inMshUU490rYCdSHM4fVhzPRKJeSNKhIoIx9Fyoc/gD1Rv8FR9AV3BtnJ5zrUBHOsQx1",
-	"wgFP2FloYHrzAjIktVapADRDQE7G9bxMA4uqg/RGsV5WWXzG6gO89PsXAgKm/jmhf/q8eFZWKTe2LKe7",
-	"In8gOu7fRld3Qrmm1GC9z3tODUWAfbkOBySBA5wE3+MUqMo1pQhxgYsQSpxFsnBA5QD6uVbWjo2rnY6+",
-	"clh9eIWLy9JCWSySDmyY+mOylLZz99fWStMtPWsp/flDB8d+RyY+1CN2/jB5SRTqBCnKUINelBzKFK58",
-	"vHv9+z6oSODvLLBICUFRnzoYgCMtSmh6gRbHVycG/Nl4e5qxRlCCCuRElZNkzjIJTpM5FUoCtyArnFYS",
-	"1a7PR7iLNY3TSpArQSBAReUqYIm7CLmaChdq5QmOeMTpwzEvBEYqsaoikCRZ4xZEScDiWfJCwUajiefO",
-	"8okt+ll+hJ0sW0be2LS2S3TzYp/0dgRNfSip5fOdVo49e9QyXq+EF2jFkk/0Ikt5e9vNXeNOq3m5+3il",
-	"P/UFtumPiI8ffPLdv6wXKvmODkWAMcCMKMCM8/zRxkTHKrBnnu/c8TUQVUhzZS7CkJUYxXstDTs1Ye+N",
-	"GxhAnrsQ6hiirr3Q+/aCqSPnCS0/AsllD8PikJz7EI6ERerbBzDmkcaULZOUoQ7XM93PidiB5IzLGX0p",
-	"mp47Bqe1faskx4mrGSeN41npgfJNQU7tQTq597QFU0/PgAsPKdEbTxLH/j5SKbSPs3tQUa5YPRghNZqe",
-	"Pyxmah8pfXRIIoyBZww8I0P1hJo9qMqCJ9MTz2XPmUYTS3sf24SVbextNzsbVzt3tp/+drVzq8HCIZR/",
-	"oLqH6HqOiyGeTzbHGWuIvu2xRo9klN0nCAfi9cim92FuAczT4gWEq93V5dbt5fbaem8f4MlEYtCtgKoG",
-	"FG3e2rk/wH7AnV/0p1uX/2zrUBL20bbXZUfkHrJJ6xay8FJYl1aE9DXHRUzHf6Ok12He8W7J5yFrdwFS",
-	"F1Gd56SDyW+CnP60t6up4RHffce5joT7Zp1aGtPfYx9k0t/O0/keXujObwJZcNsrfflv2i+DJ9HUIfLx",
-	"PsXxdG4ovHEob/Agjy2bD0Ubj7jlDy/RG5M3Y28fufzTN/1kU8d2gBuMNB49vx8WYby/VDg6PCnGyDNG",
-	"npGhjUNn3TUVKupkkT5870Muuk/X79zc6mxcZSUgBVSv40z/MFnkgBsHBvTIgfTdd3cBpW+sXIeiVXyF",
-	"AL5fmUk7OPhbSt1M2gHrmFxKMCTWoe+ej8MGWY+rFwYb0AhvTZBww1A7aR33d4jhpm7rxwaYTx1WH05x",
-	"BQnUtJKsiP8PBe4kR9aXvKSmriw+cfwWc+xLMEIgQoZcKRMeEm6a+i3TaPqjQgbfRHM4vklfYnJIznlM",
-	"zYWMmr+9FKrhzGXn+u+7n/zsbyWF6rBjR/fSpKOMHdR1NePYMT72/8yhAvbSPlDAiSpqmUzua0qZn+JL",
-	"mladmpwsy0VQLsmqNvVy9OUovonW+v6SvXSrKgt8PXLJtZQrQpV+S1qjXjhmEdT77iGk+oX6fwIAAP//",
-	"5SS9HbZxAAA=",
+	"H4sIAAAAAAAC/+y9bVPbyLY/+lVcvvdW/U9dsoHMnn3OSdWpOgwwszk7DxSQvc++p6Zcii3Ae4ztI8kz",
+	"YU+lypJDMAGGDJOEEMjThAQCE5NMMpkESPJhhGzzKl/hVj9IakndUsvYQILeJNiWuld3r169ej381vfx",
+	"ZG4sn8uKWUWOn/o+LidHxTEB/tmVTOYKWQX8mRLlpJTOK+lcNn7K+qEtnpdyeVFS0iJ8ISmJgiKmEgLl",
+	"nd23y9XyterCo+qiFm+LD+ekMfBYPCUo4gklPSbG2+LKeF6Mn4rLipTOjsQvtcXTKW9DxtxDY2u1r4ds",
+	"JJ1VPjtpN5DOKuKIKIEWssKYyGrDuDYbb4uPCRdPi9kRZTR+qrOjg0JELi9m09mRxAUhI2STlNb2bk4b",
+	"q9PVyvTexi0+qtA338eFTObccPzU/3wf/78lcTh+Kv5/tdur0Y6Xoh1P9xB46dLXbdSx6GqltlbZe3AX",
+	"NF/Ip5jrUF16Wb35LOQ6FGRRStAWQy891ks7uvZKL+3wLcmltrgk/m8hLYmp+Kn/AStsN4+XC7/knfk2",
+	"ksMcw/za6ih34R9iUgFE42n7grVsrt/dzCzY3B9qmZhLVC1NGPefA8qYnFSbe2csr33YKVeXf6kuPNLV",
+	"d7q6+mFnSlcrYdhrNC0rOWmcsvjL9/Zu/mRcmQANLjwyyo+slqs/rNVWt+Nt8bQijsEJ4Bgrnrv+XDqr",
+	"2HwdFyRJGPcstWBJDXs9TUqD1w/1EbCI6CH3SjKnW1fvoIkAu+c3TdfmdO1qyLkGLEiZ6IVHu9u33PvL",
+	"u7VcU4QfMgn2mZXerEJbYMevHo4eo4tzY+KRMbm1N/nj3oPZDztlY+LR3uSPurpZffpQL6roJ13drP96",
+	"X1crRnHlw84U39z4TPxPoCmzX12bN+Zmq7fu6+qCrt4z3s2EXIRkRhTgFHrW4VW5/m6n+rqsq+91dVpX",
+	"n+jqFV2dBltsZrO69F5XN43Xr3V1Q5EKIhoYbv5CLpcRhez+19glDdzN1Nd/qd76gfbaN+lsKrT4gUv/",
+	"F/AmRQ5ZE06eFookZGUhCZ5J0A/cm8bOjb6eDztlQJGuzhBvgGW8/9K4VuZmCvjyMOM8QYvi6Qs+noXi",
+	"8J6ublrf5QpKWAJcOw7OcZu5cniHOOfNZi/+nfkXvHSMo5qyEG1xMVsYAyQRsxsnp8vxKVcgDz2bZ0hd",
+	"gd2/p8+kII/C4WW/QadsKq0kkoIExiwmxnJZcZzeHZywwfQIhbGRQAG9/fLYmPsdbOmp57p6meg2K44I",
+	"SvpbMZGWE+LFvJiV4Tmck9Pub6md5/OZ8W5BEUdyUvqfAuh2oJAR+7L5Au2kAE/HnI/HwPMx9IJbWqak",
+	"8YRUoIwLSAqL64D8WJmqLr3U1SVdvQ3kJf54Q9dmdG0a7R5drQAJpM3X31/X1cUPO2W9NKmXbujaE720",
+	"oZfKp2LDQkZmSiAxm0rQpZCx+a7+/IGurunqItld7Tdtd+tKdeERj4CSFUFSQnaAtV6eDi7Rdgt98QZE",
+	"OZ/LyhQ62OtnvcO9hI4lUzdqP2/V12fRGtUfzOjqQ3AKmesL1pu6KGOCkhwVUwnGLQku7A74V93YfV2s",
+	"T74Ex1tR1bUNvXRF117qpXVdnXHxC2AN9Ld678POlDXdu9uvqjeehRCxSILIFLoa6L+oodMObOKbz+Ax",
+	"fXnv/hVd3aguF42V1c6Ojt3tV7za45BNn1drtPV61ryS9GvzJv1Qc3DO1oedMmYBcr92NHZS4Jbi7nV3",
+	"0+uafupJoShCcnRMpCq09m+e63Uuq4hZJaFQhTuUJg90bQVyXMV4+7OxM/dhp5weE0bE9n/kxRG9qKIP",
+	"+az993fihbxeVIV8PpNOwk3Vnk8NO+SQLSeafsEfTmfEBP2OTo4H3dS5zAPV37cBnxIv85oK5PQ/g6ZV",
+	"+w38oW1B+X0NfhlS8eHQsxq9RbvaJye3zck7eKyO9aTx6ReA0YnN2pWkq7C7r4vV6V+qP83uvl2mahew",
+	"G2unQP0qIyr0U93d6bm8KAn0fuGjMeLZmP2w9zpvtsGnTjPG7lWq3cO2tgl/X93weaIzpJF4+0J7zLgy",
+	"YVTefNgpo34cusj7ib37kCVZ1jN8mlcIrR6til7aRqtCb4+LxfH6cg/8fD7FN3BktbIGjimmD9xjdoBt",
+	"8PD3gCgXMgoPn+EnD5PJREnKSajXVCoNnhMy/Q5q/O+hcV1b00tP9NIOkGbaU720cyq2d3+itlQxrs0C",
+	"Rba4ciqmlx7opZKubUMz32tgiypqw0I6I6aI6QeKU5wywelsSrzonc+cuUtlZIrafTtbe1v5sFPuMFan",
+	"wS1Pu8rNcrIiKAW58SkfRO97p7z227Xq3WWX4Obvx6HjMDayXtrGjP1uxtqSH3bKciGZFMWUZ4r1oubd",
+	"orVltXbjEY3z0ey3xa27JJ4rnq0waE2rv6hHk2SLeot0sHqQTUC/36TzeTHFJe5lxvXNswdlxr3NZi6K",
+	"cmDSDfTxx6sfdsqdenEZKa+Itaszk0blNtImkYJrVO7BOwG4/PAquOwzDF4bLvahRqC/YSydNT8GGFCJ",
+	"kfEsocy+S1Emk3mJgpohzbYGpkR9o6uPwaUAT+yMOVv4BgFsbkWtc3f7la6u6ppmrDyv3ljAyrrJwVZD",
+	"xtxNXbtafVWGDzyGN2Lrzky9gklQDvus9HV4cbX5tKHlw9I+0MCNZ8omi7pMhdSISGNw9D2v0XZ3q1yr",
+	"LOw9mOW0kKJ783gIpcR8wyu9yBuY7dUwu6A7iohXeBXyA3EnonnkJWksl1VGvY0graq6XP6wU/773//+",
+	"9xNnzpj3rymni/Ff4YYnPnl9bR+t845kAPsTaVZF0xfOjYf2Rde3QjojXMiIQFayNk/MeioGH/PsJfNn",
+	"ygqWn9Surxlzm/XSW+QGsTZY7P+N1d48q7+6Cr/ndX1AghJ+HAdcLSbfgCMJfq2rM9Xl9fraU3gK0U55",
+	"zu0uSWkxlch9K0qUwU7Nwj6BdxEIR3N0elGrv5rYU38wyld0bb725pmuXa2/uuqS15Yj6NMVPfmMkM2K",
+	"KZ+dbq0YyS3UpevgXjVJHBPSwONN2fmVadSRi1FjJ2JG5V7t9bswzCnnqaYnq6EGRAFz75sz6eLJNmIz",
+	"mgSRE8AhCYZyipDhEAXoucZlAVjLa+X6WrkZW8/ea+FaZTKkxYQhGwzktZDtBfFUqOZczNV0HurO5ce7",
+	"c9nhTDqp9Ocy6eQ4a2LBxUt7oZeug5NSXYXme/zRmABCAIqCDePajK7eclrWCYm+8LOubuiqBvwn5n3O",
+	"4wwDt6R4WxwM7TspzbKOQfoHcpkMnb8sqhGjVW++qb64QXSSzWXhZBWkfKYg238lBOBhEofTybTi0/Fg",
+	"TlL+IjJnC12r4P3qsa6+gP51bFsgaDC1AEstoJohPV0XxsYEadz3/MfP0E9/viPZR5CHPoM/wnNPlJLA",
+	"TFyQ2bKm+qpszNys/TD5Yaf8/6Bbc+CkOZTVXAHtWNx9tjB2gVfIHeRJayuAH88ZG0YI4s3if4yaO4p+",
+	"iO6LXXR1BhoGNE1X13X1cotY5lici2HWfUgcy2eorn7X74cS3ot5o3RFL73QSwt66RfoRC/zSjDLwOPn",
+	"9MZWIVKsOKUlOJ9vTe7dv4L2O5/VyDF78AiiOLdZzk73eDmDkz/6UF80tY2YBBwzHcDLdHPAcTWt8Z4r",
+	"eIL4loBlt0fniPkkVM0YlvuG1mMsnU2PFcZI6zllbQ5i4nxmq1vIiNmUIPUIFNXZ/DEGfm0kdtk047zc",
+	"rK+VUdgsMBTM/WBMPIqdiFWvbxqTW+jkRZaf3e1HxtSs5XECQTTXNnSteBARz21xM6TP2wQkNMzhms4m",
+	"c/T8DjD2MC2RwROMuCM0WfxxWPTYbkyyPQ20vv0DTZ0xcGegs4IaElSfXDembxjlK3sP7hLRaBUUcozP",
+	"QBiZ5rknJnNZRUhnZciQ4nD6IlQuRsSL1BuaN46PxuaeZzwKxiFLUjtKzw4l2zCvGcD/d2S9GNSV5nZq",
+	"AA5KhMtJYvGgdx2YzIZcoQmW1LfzEe5tVbdu7hVv139dQ7kwu6+v7i1eg5GKII8APWlJMe4r2lg621jv",
+	"083oPS8oiigxEwJ0da02sQovRIDzqjcnjacLRnnBCoKvPn1YfzxXf7BWm3vn1BRPfv45hW3yUjonpRXK",
+	"AWRcXjcmysbWY3BAPJsDzlv1sq5u6+oTaOPaMMor1hYAUwKNXdZ1DWrL1nuWzswdLvRJubqIvWQvMTH5",
+	"4RTdbkIgUqXpOMvKJREWQs7AGKdlkRKzQjUtAgmXy+SkoCtXpT71q4tNOw4iwrRZljHGvY1oifPG1pCc",
+	"HWcIV2cs9HsYkbpgKX/tlur3KWWFInZr83B6Y1uLrjlR55VQjzxaHFUrGhWyIyIraBbFrRvPH1WfviQi",
+	"aEJEz4I5k5Wc5Nf7n1mpoOjnmPn7fiMZHWPlil8UhhVR8glfvNRGnTArtAiFKoKjB00kCqErruhFDa7b",
+	"vVrlOcjtWN02pm+4PNiWhdHDFhfEYTChzSNratYiq7a4vTfzq17aJuib1rUpY6IEEinK29xU+klJ1G8z",
+	"Eu0JBuWVkpKYzEmphG/kcci2QspLyIcD8FVWwrxJiMmLNOFDdk4Oi4imDAie91ASJAHcdLEyApHco+/5",
+	"XH4cneOsMErwRAw/wgqgBLdQ5BsNqz1Q/Kq0M6uZDtQPO2W0T6qLmq5uAicqPu/kXEFKwuRFWsgWQUUJ",
+	"UUGJ3dKLGtmKrq4p2I6VSKdgTO4TXX2ga1O6Og0iY2++0bV5M4QUB4yGCv5SBAmcacE0l5k0h+7SHlHg",
+	"LLGN882YqbBagmOyqFvQ3g7sWFjHjmBGwaJNJ/vNEDyAaMyNpy2cH4HmO8ASh6K+4Zy8ORxXDs8RS18P",
+	"kzpohmpT7Hs/G09v2TzgSLxbh7n1IGsyTF9YQ/Pz3Wnzu6+vVpde6+psw4NyG23xJNr926Nus9aZyk7w",
+	"TZxhzZKv8JkYfoghYQ8alMYlI/nd5C3FqXGtC6nkB86+z3Z2LgA7rN2Gd+ECdWGAmbApRdvYn02wc+Rj",
+	"dYccQDw0j8fFJCNoLQKZBi9HwCHAK79dpHvUNjqRlkuNh3FsrxqVgxr1hdtJMZ509cre5Gx9ZXL39awx",
+	"t7kv5zgiudUecrqQQSTzLgUv31irEcBACYWIwOCfNAZH2a2xx8MNkoEHxI+ScfD+lMhbEXkrwngrPEAr",
+	"DeI/OI8eqp0/zAYMFCqhkE6SjocTEvbA8u8J0DxryI5WA8c4ziVaxhny5IDdFy7NOJvLith60Eynxsfu",
+	"O/DR1M2JCmYLXoYfD+TycU7WHmfuYT9yIfZVtyClAvgYPhcDD7KOxkxOBle0FC3op/b7fV1TqwuPsLr1",
+	"WSfOP14uV5c30K9kaPNnnVRtX7iItP3POoNUfx8e3NFLU5wMmBfGx0DAL3VM1eub1akbrDFVp5CgbuKY",
+	"TGqg+p/IDQ/LtMRaa6qRtd2i0gqcAvTdeIaJPqkXVVIkdDJOC4vMk/5U0vcOyRvOWeXhzOCtRDAnezcR",
+	"KG+nAgFKcNc0c4rVCpv23otJZA4PvNmYT8YG2PeaZEGSxCwtacfUfMp9g+difzzZ+a847I1Qi4Aygu2O",
+	"q9CstI7ScLxoGdQ4tz0VJIeFAD9ri0vUhqx7DEiQj5lDigEjt3oP4ZA6ya4gFa9642X1xrO90ppe2jae",
+	"zeG/1TvQ/XRX12Y6O6oPVBDid2WCCuPkXkBzMi0URMn/MkGuZSAnOpeTyYsifiwhcVyLSAI8w3G2xB5F",
+	"31g+Jyn9Ug6gJPmzJHo0hp/1tdYkkrlMYcwPDNEoL9RurBtzvwN5EwR34i/+cJcyRl/kNNLZiI1elYAJ",
+	"2Yi3A3N4FjpcE4cHOzTfYXdo4pwBO1Nb7MyZttiZtlhPT1usR1fXjJktozwJQCXgjQQiQVzW1ffgANLm",
+	"AABgUdt9N30qBt5uP3OmvaeHAX2WEoeFQkZJmCoEHb4U6kzgDjL1q65dNd7+BOAxGrvnEi0zp928fDVz",
+	"2sVsMpfC+S98LIX2R6/5HsX7jyZfe4F0DdDLqCAnRkUhRcth7Kw/mKktATwgYJdPSLnvZCR1jXczQJ5r",
+	"83rpFkAlKhX10g6EG1lDiCH19ae6+n7v4ZKuPoOoItMuBd8XZBMFdfivMFKOm7PCLDfAzfq7HWQl4dTK",
+	"rGmiYTuX9+4/1dVV19TUH8wgnYfHMWAxTAevfmMxkVNuODd1m0toOiUafctxCvTAc8kl05kHUxo+l8ij",
+	"54JOJgcNnrlxteUzlKysCJkM0An7M0I24HSyH46Bp1nnUzZbEDIJsG6SKCsJulJSnZqu3nhWvbkIZErl",
+	"Xv3FuvHmJc7d04tqB9DqO5BORTIO8dq6ri7QkR4lWUlcSGcyQO9l+Q3Kd4ylu+DYQUo6yylff7FjTDxC",
+	"wO0on2FvcdbrUGZdX2TW3cVYuot2xUm9uPwnPFCjfMWY+tW8vmy4YXDhkbm7/Wj39bSuru7TpW0RSJ8w",
+	"bpYJ5n8317B3gP1kAiQcBu4BJyXeXeBujz2oAREop+nsiAchkDUs6w0HWl84/xrLmHoE3G0pYTyRG2bt",
+	"Hu/NGz6YGW8fFwUpM+7FS9zn/RuT850ofkMLKV1GBHX8BwhGW1oGW+o/jOV71aVlRB14LzRVf/I/jnjB",
+	"9gMN0GzE7VDI2sOA9c27Kp86hXhYzCbFL62XKfCECJwHQKMBtXbv/rax9RhpMYoofUtL6Xa/cvOnvdvX",
+	"XSpAZ0OMbhpgEoDRaGVHyqZX8SRa+oYYsvNkEB1+OObh8Mo5nM720jo6DifOAgU1XaIxpbVkPp5woVYG",
+	"c5yTMM8s0Ftmj3ZIGPGX1UPCSKj4GGijfuZRiT/nc/r6Ehq4CoBW5pwrwkgg4rkwQglfG/GjivfI4zjo",
+	"UKxKYv/VtIKPTEstMo1W9YfLxtUnxuyt3bcAX+z8YA+0u2nQXPUMAsu+YKNoBxhACkouYTmpxOBSDfSE",
+	"SG3e9imqFYz6iZ2IALAfQNyX7zhQ/NV7ZD6lC5He4eEuauZb8KP91owL9YKQMiDs7e17UKnG0j5v/VB7",
+	"+YzQcIlAce2yE5R1FUhTi3zYSeh6E83HcuSp0PNhp7x3tQgv9BU8YnUVFWcwH5giq/jQSbcN0gzqN4Hp",
+	"VXsNLQhlywPCPRJ+47PLleB2zjvt0sBiu3LTcSTCzQQtXShfe7W6XIShnpWatgXAlSwTsrpanVuC7k3W",
+	"1YdR3MPsonlljAI1q+GclOTYqyjQCIwVMgCw+lj1OlAiBbHBXOyP39Xm8bsN7wM5n0nTrovkrsTXxZM2",
+	"iLBt7IUZ6NZS2vEX6popFW6AUfEjC5MQzYA2ZhSVIozIrDMUZmxaEMiQcNJfYBHCHSHKAMRlKE4BalIY",
+	"7YhLJwqhCfkpQJxqD67QxHFoD4tSQ1fTxkzprGJbB7z5pdxYwk8hQUShdINwyomS42q4HLJhFxe4B+Du",
+	"NySfD4sSH5MDZvHn8GFR4mLvYcqorAYY5JreXxqJ5m/Nin9AsCbVG0Ddqb+agAiCVtbPJg4mAKY/M0yC",
+	"z9J2YLnF+1MvDjdOg3/uUSO6eoU3frDJoRqnYrX3M+AjsssW1ZPwG704YX15DHAIAsNJGDMfMlfa2uSg",
+	"LIRIL1xFe4glEhgGTGL52cltnslmwfVAFF9U8daCOgqTnpQqiAxTksWXPAdnXpTSuVRCzKYCabQsi8Ao",
+	"ZwvIqRDdQENUYEeWLQxc2admkc+FnH2wt7i7VugIkvWN6epzjUSttvo3/eSWggzToKFr9cNOuf7+OiyN",
+	"ua6rT1AQM35Mm4dK9GUYIKPpaqXBSF4HF7qmzrFgBBOYwzT5zX+f/C2tjKYk4TvavFCf8pQupF/XwPBn",
+	"69feIo83Jwf6pYGAM8XKAUErxnsVoY2DhXrIYBGyALNrbPuExnStmW/CB30UXOtGxRV0BdZx1wCzQuy+",
+	"buNUKmxcK5kUzGF7tAW2t2vnsuAikZhR/GIBSZKo8y5Lw6Tm65pCWRoeyn0jZil1rNydWo+CbtIK8NI7",
+	"W6f03lNA5Q7Jy+ZXUq6Q9y679ajjsoke9rlpyrTbm2nRuPkjlGIzOKPdrPuEg5pK2whSaW6FxFPaf2VN",
+	"9n2WvjV6JSknfZFLjdNkB64dBtnyjV66A8y44I9lYGDRHnqmBpYrC4zsAw9Z6+YmGDXBpLQvO5zzobT+",
+	"5EXt5TO8Y9zU/Se9pqZxd7r+9JZRfmQ8vUbgMdjd0UAYUjmAwes3Z+pMbXGrdv2eaUmeAlXWQPj9C5r4",
+	"HhMVISUowj5KvdXfvzWugqIdsKP30Aa2o2vvrcpvAJSkdA2Yr0uPYEW4KdqukURBDgMKg5cTvkQTatZ8",
+	"VGrXrtSuP/es+H9a+BuwDWtumTwwYFHI6At1BKNbbiNN0FrUM119pxNdpwd6u3r+nuj9777BocF4W7zv",
+	"7F+7Tvf1JODPxOf+rsHBv50bADr8+cHegcTZc0OJL8+dP9tDPNM90NvTe3aor+s0aKm7a6j3q3MDf3c8",
+	"an3ZdzZxfrDX9c3QQNfgn8kGzd/Odp3ppX3ffe70uYF4W3xooOvsYFf3UN+5s1TKyN+H/t5PttV15tz5",
+	"s0PEFz1dQ72uJk+f6/5LL2iv/9zgYN8Xp3sTPef7T/cBMsim423xL873fNU7RKXhzLmzQ+Tg8KNW/+Zn",
+	"94rg74d6z/SfBh2Sbbt/87w80Nt9fmCg7+xXCeYUnek/NzCU6B8492Xf6V76ug7+Nd4W7+ruBpQ6njC/",
+	"s1YT9vKliz+Gur7yfPZQ2jU01NX95zO9rh56/7v7z11nv+pNDLjH7vzF0x7mkb7/rwsOeeC8Z3CDQ12n",
+	"T8MO+093nWX+CL7vOn363N8gBwAe7xtKdHcN9DgZm/jemo0vugZ7E2ABes92Eyxvzev5gUHIvj1dQ13w",
+	"2d6BAfjF+bN/OXvub2etz/B5NBD0FU0QO08T7jOMUnc5RXn9z0ND/fC1K0iWgr+tCFfOCF9FSGcoigI6",
+	"qsAFyCTROra4tAD7fKKo4mOiLAsjIg1XYAvezmZAqCg0qxEz5KgCyighH6oOJ6SRWXyzurZY3bpp9++c",
+	"Z68OCFbIHppvpUuy41Pfh+r3w075q1xuJCPGuvr7YoOKAGCbU9Wtm9Xp++jINA8TS5gOfHUebJp4W/zL",
+	"rr7TvT2J/oHe7nNne/qwfPRsU3IP9fcOnOkbHARc3tN7tg/ut/Nnu84P/RmcKmBDo7051Dtwtus0fQ+Q",
+	"uRKe4Tp+9UJPy2KC7e10ejPJhJsDqRweLgeo4fwegCYHE6eRGxaEmRbV6i8PoC5leV8r7rdMe6Xl9zPd",
+	"sxvIsc91bacYjFx+X24otaZmIMGEic7PO/9w8nPG1H68BlUn07exsqPC2U+/FJXkKEaS+QIB9vgAUsGn",
+	"LQAb83kfUAn8hAPxgwPTBrcceE+02g8aWm9WkcZPp2WFd2zwhRh4gz06MatI+E93UCEwJqIC0gDsfXJr",
+	"b/JH+xLdQAEPchi0kzMQc8lrX9W1MsgSgSCQFhYTCc0UFonBWxLYQVKbNV9BixVmnfxXCDs/Q/NfIONZ",
+	"DQeNhXcchwEGhehUFCE5CmxhXNNuPR0089aDISbfeid4/onmmUNzFWUMGpunOqPPxmcFYO8tXQGFLjxe",
+	"k/3awt2QSJ46kGuoalM4zCNn5VqaQt4QhhZNAZcUvhkLlb1sme7D4HC4inRSFPzlsm3edzOe6ZghhkQE",
+	"5Ns1gxBpAazJs+MwV/rvNgICcp84jlnxogKUDJmGMGIql7fRXQscEHM3jXc4tA9qZqoZc7qja29BHFtR",
+	"q726DZPZZ1i11PyN6H6wh8Rccs7jgQOoESTignWclOKnj7IUMnFrGhI+ZNHMYyJ6HGUND1PwmLhtIQSQ",
+	"+QqXJLKA38KKJBtQLkjzd/fDOeKwoz1CeHmQQLMiV9A4zOcagA321hHfPzxwShiXqVmBRhk42NFOBDck",
+	"tAUm1uBPYW5IZB2z1okTuLsS7DJhy2UrhCRUiS/YLKtkGJ6UkIXDGPLD0ZV7QHih2oIPXS9EG48soQHH",
+	"+csTCs4bv0yh4cjxxYU7+wsxC43MwBGFziNHCG0nMufgkN1EDh5X+KUc515AjmUb5x7PIcG+IWKtWBiu",
+	"HUbAawXsLDsiJsQyEKFAQQtBts8xujAjO2TgMBflVoySHGYM9lvs4VhxSo2sEBE6FbBURDccw7VDzEKN",
+	"l3iNPeDv7IcaGLHdReCQyY6YY6aFYgWOmRqU5TPkERCuxT9adnhY0JBxR8zRkh43HlHjxE8LMJWT0Gf8",
+	"g3XCqQWMz9UHc5wOJByegboAefxH6kTS4R+qC58nYKzuXvgGG3KgRwV5CA3Eid3CtW5uIJmAlXOhv4RY",
+	"OzeuTNDqeXriHXboIR8t7BxIJKg7lU2mM2mof/IspPONgGWUHA/zL6Kzk8A1dHfjN1wPggXnoCnoGoFj",
+	"9wJhhJoCCthG8ETQ+gw1H43NxZFGGoGEDwkjPEsNIDz8F9ZMmeaLtBZGAlcNNuhHNw/NBwU7YtLEaUAH",
+	"pO3Det4cczlIZrcM5ci+xR0pL4wEGMdbAybkb1pmL4y9E3DJzsAFIjYxfoW9UKPwgVC2Akd50aCdYLfP",
+	"M0Ku7cwtr4+At60t3tBR0dRsDvckh5ngw4ZWsGkfFiVu7gAp8wESHz8WckmGObAv7KYDB8Q9mEPK/zeJ",
+	"lUc5KJVHm+dBh82JKZ+CiOFtnLhNtqkTTIwiZqHZlu5RIcr3Vnbf36nOqMi9iYLPYH46b329BqUCGEHD",
+	"wsF2PDgm0DNuH1YQs6kBEVwpgxlCzKZi6Fk/vkh+Q/WSmW5jxwkPYcYaSY0Ts6kvYE/BrETzYcMqtRsQ",
+	"WXvGhfjM7z9jcx14XJBClHeBA+rGb1Ec3ZvX6zslXFS4qBHAYXa1TvfB1TzcS5REHXIw/egl71jQAiAQ",
+	"uYNXzfBYXLvFZFraNvlKzIoSA/GRVZnZfId+D2MVay5klTQ1sfonM7HajESv3K4um1XYtPna9XuwUCwu",
+	"kuWAKXv7E2/u/6WwY2fLi6Dhs027+MVUggEEARHT7gG4GDxmXKndis1vuJSsu2saK7jA8b1Z5YN/BSvl",
+	"Qsi3M0kKyvCJf4u3xeXR9LCS+EdapqZ54F4ISy6LzbD50WFXZjGXaXj0UgwQ1FDK6gYAY+k48wUJIGbN",
+	"44V0VpDGAzce0yhJGRabg+gjC7CvstnGqtbvrkDcKLe4OmSPt982+dJGaP58IBVIDrvqSNPzhlpQxmTf",
+	"pUuOXQWST73qCL28SMq/2AfvsjSvTAnE/Fo3NUMTerOomm9X4Cg3iUT9DZiUOv2x1Dc5JvBfRLUVgsPJ",
+	"eWt2GZZw+W9oq5K2p9x3zPPbYXfKfdcEbMwDKcxwQOi6ntcgKIq8D2yQ6spyfW3H2uMWJMip2N79idpS",
+	"xbgGUl6N4sqpmCsNHKEGkrAmyByKpIbbFmqzg5T7LpEtjF2gSeHuwb/uvr6KRE/j54nboWR3yMWeAboz",
+	"x7UsJY0npEI2GOXYhDLGyMZoMWq/XaveXQZmZ/U9AGIEFRsWw1Sy4lLceRR1OC7QWqI5pxdN8Xf0wbc+",
+	"gZcAvqujkEyKeb+bI94a83vF23vqD0j/D3OyMNkAtwxXGCws5gJQPAki3q2C4ksPZlCPTIWC7wajLngv",
+	"ux92ypg2khk7uNU1SQSLw+6axPaB+eQPQ84c/bSHU4KNgeRW4bW/UQ+iIOOpuYhtbn7xTINnSfA4qEzt",
+	"CvXwcrLrgeYX9MKor/YT/O6spl/LmlcejBdKgSzrxXvM89UP42xLSmeT6TwVOROWN6PWoXJV1ODesPmC",
+	"lBz1B/RAvbrEBaugLjF5yGcKamy+guX/1TXLyojMkCblG9Xytq4uUk8Zkzy6ImXVe4NQorRybDDD3qIa",
+	"XbERqZ3G0t3aUsXiGlyE2vkoLJnhZi5HIj/t6sM0fpuj8ZS15ZhxJ1LIQwgQh3E6gCoU86xkIGCHtwKy",
+	"PXyOvW51mJPSI+mskEmwVHAfDsJGaAjrbjF2Q5xs5tYgsRcg8WAiT5O2kD+4o3HtMsk+Di4rapZ4QAVm",
+	"mgLn+DFfXJ37nRSGNFHlw4NtQSUTPQzT5vaGhrnJnhGlEZEasc64MsAXWOHqjLuD+XAinfL3Pm/UVreN",
+	"6RuuipR9PWYpe1yRZF8lPdri34hinm5rrEzr6iLRbwN8YTbe5hp2uMln3wd85583rIXXhUls1q/baJNl",
+	"C8bQITBncikxI//hDGDqQUWQlK7UPwqyQsdi9xxeM6DuIjzFoCnwHoHqX6mvvwc15W++qb64QTiesrks",
+	"mhjx23SuIMMqjwhRHgZUmR9p3ihM63mZdsOH3zYNawxU20Heee0yhniHdW2IUjubbi0EH7AYy/hA1Ftx",
+	"TKC6aYFBBdUg+xnCwP6C8Af5Kk2ElslmoUbk6BYcDMTH435cyMq1b4QNXaTSy1g4WzeF3r8hrjBhEypW",
+	"9jG+KACGKKoYedxNIMChR3UlnN9XjKlZZx0KFJjSBHs4uYzIHu5ZfcBViX/msrS3QQzsil66r2vvICu9",
+	"APuj62yX9wfjGtYF9eLM7vZVCG43q2uX8cflMvgIQfcJtbhi/Fyq3ZixdpG3WWCswNXmWLXHmqyn0FQL",
+	"0xIO95kXxs2eQS9jMXdFONVgQLQrEZKnzJfpjEKTg+TzzmMJv8FKg21eTT67QTmoRWjHuQyMlCB2Ypoo",
+	"hkjoHto8DjdS19D3+9Q8OGKRbAPAiZ4eXxuAeDGZKaQcfjNaqNfiCqzCt0jzme5nLGPCxYS/6wLWZLu6",
+	"t3iNU5SnszwNTnM3+L8+3l0TJXwTV4woarUnW7XFt8bMllGehFfPVUppSiA1FnX1DXQ+YqA7H14JByxD",
+	"CH5OFkBfcNdHwIwyBN6iwZeTRT+RVFz4sFNG7tl2jPYAKKGGSbHEBeMewZYW9EuEr7BA1wZUCq0RwTFs",
+	"iTTuitZ+otE7tyZrEGaaOw92t18BQ8YzVBlmDjCOpnXq6oqj5j7FxYCEKTkjYeQ3+27BXhPmxcI8Q5hm",
+	"c0chWWNlqrr0kmrAb+Cq5eybMQUw3U7EQIdsXkSPWcCK+3SCGb8DQxWsnFu2iphi95e6WptYhY/hGp5U",
+	"VwjYbdAQz8bmxPVkN+prt+o7U1j9V+8hGE4+vrdS+Fl1oXAfWHtqJACVbJ8yLJ5V82VY18L5ZPfJhYwS",
+	"Lp1zAL3jTeeDX/uRjt5nE4x/b11h60C2wUtaXdRq2hsLzPUI1R1Eu4R3vE3nZIdVjemQhDSiUs7w7tSg",
+	"YDtAQ6Wjsqdnf9qwu97RO9Y9mPkHrA3ntwXwU966erC6doKXje17LFF9mwlRrG4AzHPnk7YDAcWKlbbN",
+	"yMM1W+VTK6joKonQDL1Fs9Vb97FvO0wdN6uMONNhzzXM1eryunmoVLyOcfMndAiteZh2nSjZxEl2Kj08",
+	"LEpiwNLg2V6jLgog9XcMwO4+FmInYi4O4KaM/+AMJfIkj2Dn1hTJ97yaIaEMWJeMCvoSR80UNU9kg3YZ",
+	"axhWxS1v3ksLJGIh2yi7Lq8jHvDyZwMSLViraPMIEAfLuredd2QM8YbsL+KXgBq6Ybf25pmuXYUBTgvQ",
+	"NbZtbD0mjNDAypyxTDTwr3FRkDJ0uzMVXYAmUb1PhYsydHkU+cUXgWQW7hIacAG1CwE230rU/Aj88URu",
+	"mBXjQS1LXF3eoBYkJq24qDIxb7gmJAFwF80iuYyI6PgPMLKlZb24/Kf/AJbrpWV+id+cculNysYbJncg",
+	"tyT2bF+KOKbu30ttRGoUI0PNlYlmn3bLxdpvGue4qIo/kejGH1KviNK3tFAc9wBv/rR3+7qZsmFNq67O",
+	"YAGlq6ufQRVh6jO99ApyJohXY/CNf9V+s2JzAog8qv8D75TOk9x82aTMyY859sAVx44/OQr3E/2Tm4dg",
+	"FBdGdDhHgazkJDEIUR0/dmiY6rj/YBhPk9DDBvLEdHBBXJg0HyWQi8GcpJyTqLlJu68f6+oLFDhkqkaC",
+	"nMSsStWFhgRKsin4koLm2XrrBILq4RXHDEcpbMOTMvR5xyclncyK/iEEigvKiLbqFlQTtWA1G2o7NMg2",
+	"C147LLB2mwlvxRl7I4xQNWTAMbZurAgjif1zJ4ehC0eiNHpjw3SiObDmtC1uI4h7aaAyht81yPf20zTD",
+	"Kuse5QzItGJywLW+qXHPH+WNC92tKduRMAwBI93VIkyIqJh2hNXaz1v19VnzgSnoqH+iq1dYGSTNr8po",
+	"gzozpoNeyJ57atghYn4R6/zlH1uQshd8yfOPEg8KCD+oOHBGBlioa5YNX8pKAHSG91eswGl41YHxWWYy",
+	"4DS3EHDDpiaalQ8SGBEfNgpeL2rnB3vgYxr0Tz6D2ZYv4FpPNWJ7Tfg5i0Ach8O8bTHEpl56CnM9N7Gn",
+	"tKja32jz9dWHe4srpo0cmC2RVqWXto2pq/CnVYiYgT22fGR7UT/p1EOLAZrFRm77cj6TVmQmByBhaua4",
+	"oBR/yIzO3KgnWw1E8Q+CrmnhQSb2KE0xCQFp+WllCTBv6uYJSdzZiWA/b44AFuDOez1mAzz3IVVue0l7",
+	"L+ZzkvIlA+zDEgAgIxJsHVRnHAhvFKpE3OiS8rfxtng29Q/ZcUMk7nQEI+Uk5S8i8xy0gqDRzRH64HBe",
+	"N9EhnhZ7DmkOS3r3kI/9dEr0BK9ZPVAd/HS1vXTKXxLxtjMmjuUYEeY/82gh+z/LGt3wwQY5746Gow3Y",
+	"mZAFGTFMxGMx+BwjhinYD+SeFZ57ir/dt+kstj/WYNjg0Grh+WGuxLAosa6QqCAgjhAoaugPfKqjUAF1",
+	"zfwSFSx7BL+s1H4DOaedIB7QguBZu4VT3YuqJyJhA4SYgdhTFZ3h4GwnpWRpG07qL1DPKpo4N5s22KN6",
+	"GzYLiCb99FZMgyltN8zIBju1pAXYJa1AxKJoBnDmD/YiNCzlxhJELWZObDH8AiX/hGQpuHy2HCf7ome3",
+	"eV7ej0RHrfG2oORaMQ1lyjQoOa5JKIeehI9X63RzhvObuHvOyM8OJxJN5wypYxLox7QTDPyM/UM+bqEw",
+	"xVMp3IMSqWyOSYkZkbWwtNxUoMOFXOp8QRoRqR3UJ9eN6Ru12yDz36jMGBNr5nUTi9z9JBJdMGeSGCJB",
+	"jM8adRN6J32Vum2thu0Ta5HK+umuGKErhl6zIOM4XDY/I/knNK1trch4xqZUzIY+ztBwi2ejeFNWDaCN",
+	"41/962FyFN7GecVFbXf7KkBuKG07ILUdCXEbuqbZV32kZTraNLVJ0yDGD1lubndWmRKEG56Wwy2cBR2e",
+	"lqmL5xqqme8KgZfgT3pRQz2LMV2bp+V+gfSNJsYwHUknZVakXpR/qF7fhHdD0F7sRAyReNBBOryVZ7zu",
+	"xSzcPwFVXr3cydiQ5jN053Pz3WdHfkMcF072tRww2I7NaXZ9BNes/bBWW92G93dsWbXWBC0XYfG00Cws",
+	"TH/rGxhkRzd7OnmDxeX2E/xlsFxc1SSB6GLV0FyFW074uyWxdaVi/LxQfb2EMeWAoeTZXPXGs9ovv3QC",
+	"hxZ0DuFAXhpdujrTQfU/2uPPFS5kiBnAnkOCzpSYUYRACkHUJjzJARsTpIRwIzJKxzvHFX7vmYjOftNt",
+	"YTI3Ot1mA41PNyaTMdsuAvc921SB5B3T/k7arKj4jKd6fbN54/E71F3DOsAz3rGm7h1Fzg9TLvdblV44",
+	"xLKjkAvpiAJXOBiOj8OecaIHVSCfz2ZyyW+44jzRo0cqzPM8tPr4Z1ijZ5zp1bH/kxckJS1kYshu9C+e",
+	"AbDw1oHZjhNpPZcXs8AFz8wGIxMDOW2ZoUAX8JAZmAuWDb62Vtl7cJeOrOCYYB/WcM6xH0iwaYjlMr+6",
+	"2MF8nc0KyPLmzwnoGV5GYLkZkCmP4Wk4aJ8UA+LVRGuiI7sSDPyvkGTiE0dtInLCAznjsALvSSKHxLF8",
+	"RlBELu4wH+ZlE2ZZVYoxxGIdM+WkowPicajOpyt7k7P1lcnd17PG3CZWQ3xhYWpvK7o6W51bQpldvJYR",
+	"1+wo4hiaIYp9hIW6dQW6+xasWDouCXmJc714mctasgAuSyj4wXATw2A7uzU2/3Wb0CYo77yQCeBB5/Mx",
+	"8AIvH/rKFhNmioQK2ECQ8wyUJh7hIyjJ0URDcEB4gGdAC4xTykRNgvsGkk+g2gXCQHlzSy1gKFCbAWEZ",
+	"wictSCVuHTQYM4rR+3Qzes8LiiJKPkWE1sjUe1S+xygvwDvNPV3drD59WH88V3+wVpt7x+NOzkvpnJRW",
+	"aEFSl9eNibKx9RgCCs0Bozmw5W7r6hPo69xwIdrxWDq491GgbKBtpaCcKvxwQipkRM70KoIklqnG0Wqg",
+	"rBjnkhDjvGIByyopl8nkvg0DN4XPd/M9lksTqJFvntVfXbV3ZzKXoRWJdp1x9alfXexHU6VZSUx2U/s8",
+	"cYIz89wTf0iJeZhcmBrQLUipADaBz8XAg9wHSCYng1sLFSa09vt9XVNZaeXoV9Ii8lknVTsWLiLt+LPO",
+	"IFXZZ+FBqgPnTQzDWtPHhCHqGWOy4cebNiaTGjMleVimWWesqUaB+haVBLBFGWGsQKRWVnCcSdZJf6ou",
+	"cbBa8N4guI29PeyslsAdYnXt3SNEK+xtQha79N8ojkKXvFuF4VSChe5C5X/TrZSWNg0KL/BnppQB8u+N",
+	"Z3ulNZBA8GwO/63egc7Vu7o209lRfaCSNUa5xSQ5oYHs4JxTJkN4Mnb8WIIkwMMUzpbYbOEo/enPF+jR",
+	"GH42nM2gydVD/YXKwVYSbUHhT//hRUVA9zN7x6EgaNMKeX7M9TgtNugIc8Q75GGgWHeJxICa0Im8XYA5",
+	"mOFwo4xyz1ZbbNFOw6Pyl/DUEun7NQ6HQbY6YFtxKMSofWq6zYaGsrr+kz+rR5BRIUGYDgNPyVrMzpNB",
+	"nTUvhiuE2AgUhHTJ4YMCTMnJ5QAF9hBGAQWmtcwWkkPCiL9MBCAn+/KT8sPLXPKjMnAJAKHMCcegJ4FZ",
+	"vl7AEL+54z1XGjlNWo4UwplpFz5z/oAPsQNF9TgkEA4P9oZeVMmixW7XghOPAlzvV26SdisLjQP8BEo8",
+	"rlaXiwBmQK3UtC2QN2jZG9RVM2mYVUzi6AB+8AAQfNgpnyRquIE6V9YVHIaWWdNDlLpaM71lN0BFaW0a",
+	"1enYm5gFbhQ7KBUjG6PuXGgOjYIaMP2vfsgGsK4Myp4E4wVjtWw8LNexw2NcVH3GZzmZUUWXfRVwucQj",
+	"XYNl/5GKQ8rkhFSXogjJUWDbZR4N4LGY/RwjUZpecrz6+zY0c8AVIWqPf9gp/1d/71d6abv/LPj3b+KF",
+	"fvCh50u9qHZ2nPmCNPUFVimnVRXnGbLferlHzVwuwXomMETIftJNMtEIlXBZlP7wpagkR7uh7FXAF2zq",
+	"4ZMx/GgMPMsmv4CL9HFUfQMNeSiH7zNpBna6PpbWAX6M9WUZDLXfknl5QZa/y0nUhNEfIQ4HLmYUyE9m",
+	"UTGrRd/hns/7Dfd8njHcxiogus7X/+r/O6v61T5ns+HadftYhiYWvnPNU5ecFtqHct+M5z7sBK+/q7Zc",
+	"MBtgL5S9VQM8nuRG5XWON1owkzbNx6Qq5MdSpRGkHAJnQDCXcrJfsBf0CBwV4J/uUTH5DZCTYqrPT5sC",
+	"ZDqeZROdlqGDSUwl0lnasj7VtWdwCV7Urr6qTtBuVK5hOFr0HQ46/QLGYZ6CxAj8WzxXUHiaPFfwUVrG",
+	"RFkWRkTGgVCCV+cdXXsdKBvNhgLn4Xyeh+jz+UOlGdzNgGEqrYwPAoZGHXfl038Rx7sKyPgM2CiezOW+",
+	"SYsmXO+puJL7RszaHQvwjfilS9CsOUwB38Hxn91CRsymBCnW1d8HXk8rGZHy66AofZtOgv6+FSUZu6f+",
+	"0GFG7Av5dPxU/LM/dPyhA55Pyiikux0bZ+AHKoYEKdVApvrLa7r6SFfnkOUGw9qAgj03jXcLcdiZBCPC",
+	"+lLxU/GvRKXL7AHMMFo32NvJjg7wXzKXVcyTJI9KcKdz2fZ/4DQ6JDSCRArUZnFHp9Oyzfxwel2peKNi",
+	"DCy1KCuxUUGOyYVkUhRTYuoPYK4+byJVvZKUk77IpcZpZHze0RHryyqilBUycPFEKQZfiJ2I6dpvUNJf",
+	"gzO/ppee6KWd2P+BEr+na6jri67B3kTvwMC5gbbY+bN/OXvub2fRx39x8Cc8lEnO/J+vwVErI5xntDox",
+	"YnnQNdzKR5DjXwPlLCfTfNPAwLIAwA5RuoU2j3CFPAzQn5OdHAAnHk5Ks6a5GwKmOLJlLjn3syIVxEse",
+	"9utsDQUN8V5MyKZiQiwrfheTRDlXkJIifOCCKGZjGBEmJsgxIYZK5UFe/eNB8eofOzpiXwgg+gmRfiIG",
+	"eXNdL01CDv1dL60CO6qLVfvO/rXrdF9PovdMV9/pNutjf9fg4N/ODfT8y7Hbb4hPzC1H33GX2myh3I5z",
+	"rNjS2cx2wikYtbl3xvIaCUAGyrJBk5eZjQ4KSRnlR9YzOAfPlODApIbf3fRWeDO7I48BGHygzRtX7yNr",
+	"G44s0eah3XEWEsBV4c3v8PjCnAhwdknCmKiIkgzn2yWXyDE5dXx6AWDxYj6TS4nxU8NCRhbb0NH9vwVR",
+	"GrdPbkfGpFOqtBGs6dEoAogLKFANzLnOqvOf/elP2M59ZYKTdCK/k5/wrw/opDYXtfHTOpKAH6/GESP2",
+	"dJAc/D6dumTjKTHFoDaPEY6KFtKOmReCRRAASK9dXzNL+0LQZqJYGBWY2imUeiAJplzqSwVJJMLDCTco",
+	"UL3t/QlhLtg7MzipxLtX/0hBnxgVJTGWlmPZXAzzWUzJxWSASTGck2LKaFo2d1lb7EJBiSmjYgwFvcmx",
+	"MWE8dkGMFWRxuJD5Q+xT2Xd/7PjjwYwBiDAZbbekkM3mlNhwOpuCU4znXExZWt/xu4SgDeWvFLX5az+V",
+	"+pMXwA/Ocw09gju2Nafr8T5Vo9195A58poUB5Mn6nOkIChaEvZRAPIL5ccpraxBs7j8i+7z51g4KNgiX",
+	"taOjNRREUiaSMocvZZxALZwXinYxq5hYn/7qhcuCQpoxoFEEXTUwEj34Elw71mDS6GMri6d6a3Lv/hVd",
+	"XbUNLeh5O0hrDfwNbDMbJlg9RmqFNhLV6su0ul/mAaWHCfk/geA3XvR8nKKCYgrxZUib9ohbh1LVi+fy",
+	"cGVuGzOM+kgYgI69xQdwyfj+HDTRsREdG62wRtkCjOvsMCuoQWMU3UdmKbCecG0HiAk4CPD3FfOwMHPh",
+	"ibBv0qhvP4+/qRi/VxDeCs6AKar4GxeWI2dPduV1R+m3DVzpzK77tkg7GEifX19qwJqpT1Ift4Z3mCq5",
+	"m4hIvEbi9fDFq8WVYRVzZ3lKDgXdlKcV4/mj6tOXwGjgFnQVK2QBauEbPJbCARcdn7jh0Dnc/app0R48",
+	"KiqOl40ZuxDBaZ0wof/CB4Vh1CwPcKIdJnZtFiZpVYI2oROksPXBY87+ohiyUAzmQqokOczDUhwxZSwu",
+	"8o8yo3FMq4LNaMirhxJzxoAUjULPuNTEfz+oMfx7rDuXHc6kk2gA67r2FlL/prbxCiguLuoh1Ymu0wO9",
+	"XT1/T/T+d9/g0OCxjZZzCRd/2UI7xQKjR3zkjRVPAiGBrusl+46M30Im17fP9+69h9LLJ2jEJaGCPVQs",
+	"wj65aJJIVzxCkRihNhwjMoO5pbhiNT7GjdJSfTSy50Ty4Uiq+oGaPj22w+fEDRft8ZFIilaFgTR8D+lo",
+	"KSGRuDqi4iq68nwUcSwNXnkatde5kjjhbWcTDnMdADCUnmAICO09cBYCiJaHEA7iPXzgBYBEm9usl94C",
+	"KKFXt2Ho/AyE2LID6xFqBCzz9QL8oVay4kUFoBPIOUnX5vEf6gaB5/O4+gssoVu6jZJ2bQLpbkdLcQw2",
+	"1PtXEOIJszArkIWI/vAggvH04yyIua9YmN3Xj3X1BYw4KuvaU72082GnTEJewDHxT4Gck5R4G+fWQysz",
+	"mJMUlPXMoG7v/hUXUeApfppyUkqUuIkC5JyDb1AIQry2u/3KhqP/vKPDhRLS2dHBT1wmPZZW9ruKyHxu",
+	"bwrnTipqYFX00jacCJjR4np8zbg2o6u3jOKKCw2LlyFhR/FDjSZCzBTFER3T24fXvxDGrcDhRDgI58Gh",
+	"Ow0iZ0HkLPhEnQVU+UDoye3Ct0I6I1xA0XtUjdkM+S4Tlv6KUYbY/lDZReF2tEKIG/U1+HxRc/7qKCUF",
+	"nN/vfgLppyBC+w44o50PV5eLRvkO2TskBkQFG1Oz8G8cu2fVXQSHe+Ve7fU7jAH6ewVE8CFg7FdXkU5N",
+	"PLyBU/ZRCKI5XhNGdcPsd6YTVHHUNOTacFJpxprTvR62Qt5lzffBaeZHKPoZzYI1CZHScrwijM1155VO",
+	"yVx+nB1WbHkiUSaHKQlAlruP18UCurUlCkxeIVorm61ZIgcngpDpLLXFbZiYgiOX0WXCJbosyVFd+Bn6",
+	"Rx2mAFgkBp1vphC8AmQljcBOXV2B15cyXKEXwIKhbdrsp66a9ICQ573i9d3XoLCItaKw93VdfUiiA+++",
+	"vaFrmv0ikmtAWr/US/dqlecWzYxxbVqTxvb4EspkN1jOFimUufw47uVQjL9E/5HNN7L5RpprI5prLj/u",
+	"e7EljwbrtRBqK1YKrfIICBsel6ei6a97S1cAeHxRQy1Ul9fNYsqrZtmwCkVAahoq9guOBdgjtPuShueK",
+	"B9d1w7i3hUzRdnalmXuCyQgy9g7iKTnGmiWegkivPI6ueJv//UUHZxScNk/qQbXVbWP6BhAF7+9UZ1S0",
+	"SY0rE1AJsh6Dl1KgjW5Uy9uorIRvCBy3nz4KdIsCWVod6MYwJvuFtYUIYjuKrN6SMyjS/6OdfZTORZaP",
+	"yDcgrbHwsyOyx1sbZHbowWWRgIkMDJGBYb9BZcxrQhIXEuAyLVgY0hj+ubSNDAO47JyFUwSDZ0DIzMvN",
+	"+lqZBKVGPi+HocJPmTLLHBzPW745+uiCf6wUGYLpzT1r7VJz08LowHQDqdqk6Y6jike33VPruR12BXGi",
+	"5CgLOwS7EEtkM4z1JUeIlNOe6xco5eKHVsVKmbxwiNFSJglRvFQkrP2Dj0xOYW0/p8QONMi6NmNTzLIe",
+	"N/c6rOEDPS6lbVMRu4zQLq3vjbmb9Xc72AlU2q5PrhvTN4zylb0Hd0EiAKoOCbtrEry+LV2Cr9aesPoI",
+	"Zj+6uEaWMYbNO0hGMQzfrkgYDvP3R7CFW6S3RoaqaL8fqRuBz2ZnmMNdqkc4o/jR3Pmtso43cEPpaBEJ",
+	"keCJBM+RMTeHuQz9E07ECamQacCQRb2N2Bat8oqV4YiSS43L68ZE2dgCgcbGszlwV4GYoCAgzg+RsNtB",
+	"7ACk9YBUCqLPCJmwIZsYmsCYuWpujnQwIIedjH4D5jKYudmnxZYzor/Dt6ERxETWtMiaxmVNI7du8M5l",
+	"nSqBxjbWjrYLSsKf3Aj57rS9TWNlStfmdG2Ky75FCINgXZlKYhQhGSlxLbcWhduDDBMSw2ocxpb0UW2X",
+	"lquC0XUvkhRHVsvmUrLpxifWUdyYFeqoy4wW26Ua0/87Wk5MJLwi4XXUbFVNvGq0g0nzSeKvLvxsPL1l",
+	"3SM+7JRp2ecavF/MQ5/5HLJPkQJxT30Cne0g9x0YvCZfgr/Ve/S7iTZvrExVl15aufu72zfBM4QqRpjG",
+	"NuvFUnWiQubHp6TxhFTIkuXEzBZxmUordRNWIVsErYPL0qr5mN0W8Y1FLs4+RT9ZhXzMSpjMSmNUQd8F",
+	"p/84SHs40iMi7Bm0RLI+kvWHL+shc+5D1EtiKq2cSApSqpFgW4SA+hrUTQQw10jYTPEE3sJ+u2G3Lb9j",
+	"Wn1FboZwFyA4cTFzlSx2InmGK/yWziYBngUXh7TMo2D1c5ieBIuIyIMQeRACPAj2rmRvSrdsb/8urYym",
+	"JOE7IcOW80TA7Ub1+mZ16gZAMwF67w8YUY+1k9VKfWO6+lzDpeOB3jtbv/YWYW2BRkw1mLOGvK3rQ9Ua",
+	"N46A+TQVfRlwrPyNGHCAxhxQTh0AgBPgyC7wFwAYrq3opfu69g7OxguMX7h9tbrwqJFS7E0tvU5S7irD",
+	"/qeO6sIjgE7T5ALth5lSZq0/sfxRftlx1VpiTiHAKyuDUxiYCo3tWMUSbKNZuQO2ZOOJP6QTGCUSRPfo",
+	"6B7t6xrmUa6Y2QRs7YjDG/yRbfCWndyRXS2SB0dOk/A1f7CyDZhaQkif79GXDC1z9zZinOloGRGRaIpE",
+	"09Fx7zZkB4JuXFkRFHEMjJppC6r9fl/XVGgRQHg2yIBj23lsWw0sH4FsRyARYWrWWLoL6kIQLdTez1jG",
+	"BeJ7UOoBO4BtZWkVmZ4wvLkbNhd4hW3bFCBJA5Gp6pKuPkb+2UDlatAe/VETpoxyXchPbNXOcK1N46BA",
+	"yOwUHhookMx3M1xk2pYnswjIyT/q6jSu1xHK9nQU8Y0sprM5LjpBohPk6JnJHBLR7ySRpWHmidE9OPAl",
+	"LGmxA8XmC+uM+LBTrq/P1td2dl/P1h+rND0XiGjQdiuLOsjS8DH3/TrXH823tdrgI1pl8WJyVMiOiCck",
+	"WIuVLyqgYtzbqm7d3Cverv8K450236HjoKZtgeKcRNEWKzwARXoRvofK3uIs6Qmi8Ukvpm4AEhfk2YHk",
+	"fNgp9w2ei/3xZOe/8p+NyYIkidnkeDiHTIAr6XDdQR+VK4dc56gm5rE7nszlj5n73BRULunEjj9pUD5p",
+	"86iGEjUoxS18WheWQvZ0iIEpJBlRaEpUWvOTi6ZxyBk/MePVjNrTY/mcpLDD4cEh2mbqEW3gnRgMTb8F",
+	"zQlFvbQD419muwf/CuvPP4CBJBhzziuXjLmboOblux1cjQgWqcMCrbTtVabsd9XN3ddXq0uvoZPZjJN/",
+	"PVt9+lBX1+sPZryV7XF1TFc5uWCZ2IemxE8yjhUySjovSEo7sIecSAmKwM9WqH1nl4dhGabQESkox0Z2",
+	"oNUPoaNQhEdQkAll/5uxJWhXepP29yZ/hCVwN40rs7haL3PnIn+3cyMFOpdcNEVZ+pF1qnWhGNwnM9MD",
+	"691CLMdrkzYVdNce5T3VKj9tg7eVjhaSEVnao8Iq0cWocfdyqIsRugmdyEu54XQjaHfdg38l0bg5EsiQ",
+	"CtZvdthqa6SjuyiNLJQpD2vLxFqZvORmG45kMhen+OeQUZikVfY6R1eHaLBz0BFZ7KIrrL/5y7k3fbcm",
+	"Rc4HXmK9uxVdYhnXUed+Ddadybajy2h0GW3dZTTEPmljhUc4twJXUsBHsiFaqWxF97hIDBxFPTZIjaWb",
+	"pLwHYrhcgKMrEFplWmpUse5oJR2RUIqE0pGx1ITV4bOyImQyINzzRD4jZMNba4zyFWPqVxijf9ky1dRf",
+	"7BgTj3C0t3lbh8F8PtUH+mxa+iEpLVctnB1GlpxwJ6A9ezFzvSxm87AVg90CL40ke1lhD9VXZRC6UNQ6",
+	"jaW7taUK4DKcJ3LZdtdYbLio2a6b0rYVJFGdWwIOHMsjVNo2QUhQMUGQXXISdbC7/WhvcZbVDaVsoRlY",
+	"UZtYNa6VLUcS5XVPtIVeegpCQrRNMIDVhxARALYGM2SICVjy9ea6dxOHekBMdQQOEB200UHrawRwib8A",
+	"6ccwBDjEG58V4GPZ1609rCOlO5IFR1MP4lCDJNA+yJ04oUhCVhaSYEgNqN5ECWMOL+mA2esQ2Wmr9y2t",
+	"10jTDsVh1hTGXCtn8hmDnzg8qCQL+btP2dzTKi8qrcdDdKbSyIl8qpFP1d+nSt27PFuXfU60j4hZsDtF",
+	"H+z9mUmws01YBWB2KT+r3nmE0eidmx7/AeE5dfVx7fq9avkauLvCP+y7K74cg9hH/IxvKgBVYHxlkt4a",
+	"wWE2T+37UOzFvhRFWQLH6BxHfLCPw9xHIgRa0Vw73koecG5xUxJsVivTtFoU6OpL31zB11CChig+I7qO",
+	"tc400/CZyzLSkLuHy0jzcW2RA7jxReaaSD4c4cs0512aHsbhOlx90op07Y2uPYcQYo/s8rtEySrLCTQM",
+	"lyubHNfVNeP9RP0xwLnfuz8BnF3avAM4H6r6rOJRAmM/Hilh1KpokX0aEDoOgJxILkZy8cjEjuzTVgHi",
+	"S+R2RRKzPuWjbEyC4rKFUEQ60xG2ozF7a/ft7IedMvgV+OyfA/f8MnDSG29eQpjICkKZxGCUpW1nlXJc",
+	"YoREijTmfjAmQGvV65vG5BZoau6H6vVNEr/SbLNiTJT37j+FnW4Yz945CmaUtgnKV42ZLaM8idz9YPEF",
+	"SYyBYwDLZWhwsRH+ZwnC3+nqPfSl8eYl6BOCOJi/AoBN4+eF6uslXdPYaJYDaN6H0LTvr7hJI4hU+wCK",
+	"DC5YQjKDrs5gVjFn06dQiV5UdfWOrs2Ajys3AYhFh/HmZaNVTPYxSBc/kyVYYhCnkn/e86KUzqXibZxi",
+	"B7JEP3qHQlh183p9p2Rsvqs/f8BJAGbvcBR045cO4OYB+0MbIjKtHSutHq58DC2948QCX5hHFPo2dF3D",
+	"97r2jMO9OgRabzmHCyOR8zQcZ6BlMTkC/s9VpxAsu79L1FrxVnlAh4SRQ3R4DgkjkX8zQnn75FyyQ8KI",
+	"VyCY50O79TirLiJyrVr3BOelwrhWhveNNRyVs/2qeuMZDBGG4sR1LfHUNjSljgZrG0JgNxi8Z1YMs4zQ",
+	"m+j76q0fai+tZl3F0FEpwgVdXTVvQVQbET66BvG4P6UbRCsV/a8P4KjHaxLpssdNY4nZu5Elp4LrEmIF",
+	"xlOFENyVgYhZuQlkCtPNC4QCT4kh0E3kz43skq3z51IPbGbdPXTQ8nhpjyiHN/8cibwM0W4+Mmcb9TLO",
+	"qpqHz7BwefFHZ1+3yrMZzjDQ0fzeI4kSASpGNojGXa1sG8S+EoJoqUAw7mQTjmldL/0IR/YAvv4ehIYD",
+	"rPmHMBLlPXzgha49M+Y266W3ID701W0IE+/NUf4FxLKU7sDn3+hqJSteVBLJgiTnJF2bx3+oG4QX9HH1",
+	"lwcQiP427Py1TSDbLuGMkD1ow8TRLLbj6Xt3CwERV5Avj1ldz1UDBZbZqxA+1A3j3hbiI8K6hQvX1367",
+	"S5YbsKdJL22bVEMb1KZxbQZ6N62ld1Sz55kFs3pfiCmgGr4+7JTT2WRuTGwXL+bFrCzyLwTskNfL2S0o",
+	"4khOGh8CLwUQ19fDSUESN5pIp+LhFA9KPcaHxtYqd89CMpkrZJUmdOxbMZNrEmDVuQSoOtcEcqqvyvV3",
+	"O2aw+7SuPtHVK7o6zUtMRhQk0UkF7vRCLpcRhSx9Diwtk4vxhBFHBw3NOuoRGpUv6+ptaDme1tUZmL5z",
+	"mbAiO7b1hx20Qi/00l0zpuUq/5YRsuMJN/VpRRyTuYZhfSNIkjAeOKxFXX2jq49bPqZMJqEgJ19rBlVf",
+	"/6V66wdouX8K98Ym2iHg6H2yVVt8axENSuHSIorsudDmkdPADkRdWa69/Nk6XXkG/L8hC9xhOPzK7uvp",
+	"vcVrvPI9nU0IY0DG7JfVif6vhuhfuNik/p2S3bPhNoAmNmmG9raAP4lDonU8ure4AiOowCCcA94n9eLF",
+	"ZKaQEhMHMord14919QUorqCW0WZzBWMBDSaEnojiTHjDoCw1djAnKX8RfSjcu3/FTZgoJ/kJy0kpUeKm",
+	"DJBzDr7BrOOMXJgfdsqdenH5844OvaiS1HV2dPATl0mPpfe96VAMpX2NcN49ihpYGr20DSfCCrkkHl9D",
+	"YZZGcYWI0gwhI1FHh1sPs2lYCpGD8KMMdqMmjobGfnCiPoBwW3iegrr9RH1MGGw9gzYN0EJwGnjF+L2i",
+	"qzOfkSG3pjozs7uzA9Wzy8TB57YhmOXklsCJqW78sePfP+yU+88NDvZ9cbo30XO+/3Rfd9dQb2JooOvs",
+	"YFf3UN+5s3pRHRMVAVSJ09VKUsim0kBsg4MDBFVMztZXJgFlxcX6w2Xr+trXAwMw3AcVCN2GChMYoDaP",
+	"Iqz1ojack5Kirs4AWyoZbVz7eau+PmvRa5JPz7sB0WEHhZNxNOAxIlSMKGrsk44ao6bEUBJhyK/aL5iO",
+	"LbostsK/sAgubSPXFshNQWEbzmQ/o3IPVujE6JLERbhS/Wl29+0yuvbV13bQNREaOMq6utqpqyu6Wun5",
+	"AgJdPoGs+gqahU3OVVfNxkGuDCz5qa7CMJHn1RsLrFsniccZKAW/gJPRGlEI2z50tA8PFZFmdmzkBFx7",
+	"Tt3MIydSBTQvPuWyLPWmdvNHaGcwFSobhvYZ8OOAsS5YkaaWD6gB3e67dDaV+y6REsZlXi1Pm8eNOWhZ",
+	"syWW4/vN6nJR1zS3RmpjDTnBo4sq+ToghoQsQa+UtqEhxphbQS8FuZV67In/xHLnTCfOJpkXd7Cpb5hj",
+	"16DBfV1XF0kG+7BT7tCLy591wmRQFYqbG7r2BC5w+VTsM/5JJRg1fsSiryz+ik6FY3tft3hg/6dD+5go",
+	"jfgAv5lbbsH2iGjzSJnbsBVJKIqtRtH12S5ya0VdfSOK+UQ6RfqB6+/fGlfvIx2R0YAvHnr1920opckC",
+	"95u05xdIlw6Co/LCqVvCPwAzHSFslLaJjoDd0OxrhnRSA61ZU2H+sDVdU9Ciz0S6o58nZ+BKtUbbhW1T",
+	"RcuhqL1scqI4sSjy9PCFMOTP/Yth8SJML2Zmht15gAQtxe2nzZOuM+ParBuwwgxagerRrK6udg/+1UKI",
+	"+K/Bc2djp9NZUUYKHpBpk1vG1SVLrrrVYLVSvTUJC66sAuSLiTVAmTZfX3+qq++B71F9h8yYnfDKf1tX",
+	"V8gWg7Tm3os40dofQQi2aOqk5aT8bXs2BViLX7PDGpyfGsrpeUMkf4naoymrxzZ+LAqeavQ+cPEE4men",
+	"qPRMeFwRLyrtSflb/+fCHJBtcZy+BBrqRtSd6EnL+ZycRm9/H+Lmdim6WXx8hxoSaI2eZagcGPsiAY4f",
+	"UkvX5snqgcCw9O4nXX2ITMAkfDSyCFuRoilpPCEVsjR/mtP/B88hnMeMTNi1365V7y7DqJr3pIMOvYjC",
+	"D9CTADaqeHtP/QHdG0BT2jyPyZu0rYdy6KEya77a/Vgho6TzgqS0A7FzAjgt+bkPtX/oir2XjMh0cWwE",
+	"TN/YfgSMJOJTOP1PP6B6ms5MurXsaEYi+tEJ9bZBImj6QCY436rQ1XUHrIILUMEB1Qmw6XAL2DZAiclU",
+	"K2SDyC7LsmGYjS9wWhoGyAlujZmB7OLQZRGLmEgiHRuJRLJAo3IpECrfx4pZ1Hbf36nOqMi1Ah1QpPES",
+	"ACsYEyWzfuTSvmyWyP3vzJ6hwjSEA/X9NOH3I1NhZCpsLuQET7QPq1xAiEIBR3z3ti5sOXIMRLv9yEVT",
+	"+wZTM8D/OWD/G9MAUAu+GkC/J+jtSIiQlqFeHAkY/0iIRULsSEJKNBSgDK5D7YKiCMnRMTBspnfTDsZA",
+	"IRymecUZy1HhAUp2CKwuou9PXP2xhxolbUXC4+hoQM4taEoOUiiws8kssQCRHn6HNJehuvIEpl06XEim",
+	"6DCDgZ2iw4xt2DSuTBiVN9ieUl7BZt+i+l/9vV/ppe3+s+Dfv4kX+sGHni+Rh8iYW9DVH2HzP3J5cI6a",
+	"5GmJH+l8PpMTiJEeSoKYm4goPyySlB+jmgW4mBCWTFkZqGS1f29/SATYoykRsyamL5dF2KZXtv889Hti",
+	"W/AwWX07pi6CF4529H5tvRw7mmHppe5NvVTUtccw2fwpRvPhiqmMtmoTb2f51LCTUa0OLqSzGFjdE6aX",
+	"HhNGxPZ/5MWRRt/NZxt+9TvxQj7su1HEYKRcfSKiOPdddn/q1WhaVnI+dVssrxyO8yNS7elpQaVt490T",
+	"6M6vGM8fVZ++hEYtnFZKZtaSgQKOlFz7RQ0/jIz5PGfBn/Fwjo9HEI94P2axaC8fQadazGblUEbpdFZW",
+	"hEzGskr72p+0eRxoN3UD5vBtuDNjtPlOY+kuKJ+tVqrXN83ncNAyBhIsbeP0GTvUD9idTqI3d7cf7S3O",
+	"Ot63092JOMHSNkJchZKFjoCql7ZxFn1pG6db2n5BTBMZjwzGce2ysXTXSb2VJ7+JvkSQvoE4v8A8p01b",
+	"iY71FzughJUj5dJsWdPQr1aRVaN8hyTD27ixMqVrcyhAknh307k+lVi+ICVHBVmECSsxM8nzKqzTa2OK",
+	"WJW0CJLmiWZRpNUiznifmq7eeFa9uQi6mygZ5SfGncna06nqzTcoaaq+hpCSYTVg53yC5Vc3ICCUSoaR",
+	"2qGgpGQvqo6i73BmatfvAQgXV9hpUa0u/Axadg7fXcfLZlsiE7Xh2DF2mxxm0T5y332S3mSE6EOMsz8j",
+	"HCZ0louSyDwaafAfLUwWwcsxwMzEqU8c6CfyGcHn6C9kM7nkN+xDnyEWKzSxCGS9G1TLVMN1rQwRsmxt",
+	"wYkRXqldfVWdmG4gOL8vdR6N4dPV39EAmxQNE+3hI+HigEsaMpJkGNuUQlYqARoyKjJRndmsLr23Akes",
+	"DPa9xVmUwb7POibMyzakPCorwugbLQs0gGAYAvObMpS5B1NU4mAsEMOiFIXkHE8jBRYCDikHv/OLeXEL",
+	"LxJEmdg3FWNya2/yR11dc2ydiUfwy0rtN9sCUV+7BW/78GrqrCkN9ZNNfDUNUD/waFoMNjwsSoeNNDws",
+	"StE9KdrdHJi9w6LE2N8OFSYwMdDa6cHBF6C9YL8tajAqnRyp8i3OY2PvAFZcA2J1/gy2I8rwLVIVo8jt",
+	"aIcfLQ3W/4CTR9n3c5bvurSNy0q6cermWWid1QVUEohybyez5gFWDwDq+QH6nzToWdmsT64b0zdqt4G7",
+	"wqjMGBNrpqUOelOYDnMZwcm3eNPLo43v+OPIkvKokx/lUZIX2y8UUiOiEqxweXkTs6Q2j3gEMEuALiaP",
+	"foF6Cz6dUOOROhYJ6xbsjP4CQCBFvMi9OdolUVZykg98kt8WsYFIFqHplAQ/mqHIfYRepF2GvnFKUANy",
+	"9eP21Rnk1nbVdHKgn/iaKoiNOYBHedT2ZzOhi+AI0Ygj7TEqnR8VYmoI/QnuIh4pasJEiY1oGW7QN6eu",
+	"4RfwaSnNKFRqDsTvmAFBZp0US1SGAHiSR7utAQUrMh7I3AjpKZKAkUpGV8lM/Okw4qQRvcwtVAjtjK0i",
+	"kbueU0s6pM3fdF3JXJcoqORTOba5dlooqEbmOUw7tB3hvHbUNYCbNqNSfgS3HEpKn2ae1dQ0PuKUjrAY",
+	"o113pE43ZiRX0LZr5IizN5/T9GApx37gyj4miXBmBece5Dw1P+IwTDzCCJUsUq+P5LEfLIIKsijJ7clR",
+	"MfnNYHokK6b6sj7xpE9hbboVvfQCBWejsuw0/9B50G63o9lWhkPLovQH8I+jx0b3YqioXVmUYu5xmhMN",
+	"J9cx0WMi1+zuvn7qyiWrliaM+899gwHglJ8RWz7P0DPXXZAkMQv7jG4Jn4LrEC9oDKwohYWZGKycbOuD",
+	"0ErWRAb6hscHbv5xBeJ6LICkToR0Vtp2ZAKWtnHsTGkbJanYag9Kxytto1xTVDvCrPZWQQ5wo3xl78Fd",
+	"s8LxBnz3TnX5XrUyvbdxi0hChOmluLCnppmZogvYyuioRgGx0XD2Civ7HOLIknu3BQiuUDxCpEpi3x4O",
+	"jCuVlEhrisTgkcFyDZCE9mEup0eyfVmfWxqZf0OISer9CfQmD6IWWygFUA+HuPVlUUI0NLjnHVA6sqic",
+	"SOZy36TFgOp+n4yk6DyoMXTGzmeFgjIK672kYidi9fVZWO2NTnX3QG9P79mhvq7Tn5Bz1KnlW5vTXyKc",
+	"KyjhRMLPELGs7C8VQKsHtTfPFZSD3pyfKLugVfPnl/N5PnZBiqY/l5zPt/rsOJ8//LPjfD46Oxo5O6II",
+	"m6MhFc7naUIBPAt7lqGiWpAy8VPxUUXJn2pvz+SSQmY0Jyun/q3j3zril7623v/eKrssS8PxS23fu8ow",
+	"p0WZ/Bb1RnzhSCwnvsehkM4WM2I2JUjkdxLQrcFGOcFqCNW6PZGXcsPpjJMYnJfspWfYTaQw4vhMQtIR",
+	"X4sXk6NCdkQ8IQmK6G5VHnXSDaiSaRP2T8hdJ6SCi1ovggb5siSm0sqJpCClKNN4QhHH8hlE1NeX/v8B",
+	"AL1hjZPvDAMA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
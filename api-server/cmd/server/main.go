@@ -7,8 +7,14 @@ import (
 	"apps/internal/middlewares"
 	"apps/internal/repositories"
 	"apps/internal/services"
+	"apps/storage"
+	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"time"
+	// NOTE: ユーザーのタイムゾーンを扱うため、タイムゾーンデータベースのない環境（alpine等）でも使えるよう埋め込む
+	_ "time/tzdata"
 
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
@@ -21,18 +27,46 @@ func main() {
 	}
 
 	dbCon := database.Init()
+	fileStorage := storage.Init()
 
 	// NOTE: repository層のインスタンス
 	userRepo := repositories.NewUserRepository(dbCon)
 	categoryRepo := repositories.NewCategoryRepository(dbCon)
 	transactionRepo := repositories.NewTransactionRepository(dbCon)
 	budgetRepo := repositories.NewBudgetRepository(dbCon)
+	recurringTransactionRepo := repositories.NewRecurringTransactionRepository(dbCon)
+	importProfileRepo := repositories.NewImportProfileRepository(dbCon)
+	accountRepo := repositories.NewAccountRepository(dbCon)
+	transferRepo := repositories.NewTransferRepository(dbCon)
+	tagRepo := repositories.NewTagRepository(dbCon)
+	attachmentRepo := repositories.NewAttachmentRepository(dbCon)
+	exchangeRateRepo := repositories.NewExchangeRateRepository(dbCon)
+	changeHistoryRepo := repositories.NewChangeHistoryRepository(dbCon)
+	categorizationRuleRepo := repositories.NewCategorizationRuleRepository(dbCon)
+	installmentPlanRepo := repositories.NewInstallmentPlanRepository(dbCon)
+	creditCardRepo := repositories.NewCreditCardRepository(dbCon)
+	reconciliationRepo := repositories.NewReconciliationRepository(dbCon)
+	budgetTemplateRepo := repositories.NewBudgetTemplateRepository(dbCon)
 
 	// NOTE: service層のインスタンス
-	userService := services.NewUserService(userRepo)
+	userService := services.NewUserService(userRepo, fileStorage)
 	categoryService := services.NewCategoryService(categoryRepo)
-	transactionService := services.NewTransactionService(transactionRepo)
-	budgetService := services.NewBudgetService(budgetRepo)
+	transactionService := services.NewTransactionService(transactionRepo, accountRepo, creditCardRepo, tagRepo, userRepo, exchangeRateRepo, changeHistoryRepo, categorizationRuleRepo)
+	budgetService := services.NewBudgetService(budgetRepo, userRepo, budgetTemplateRepo)
+	calendarService := services.NewCalendarService(transactionRepo, budgetRepo)
+	recurringTransactionService := services.NewRecurringTransactionService(recurringTransactionRepo, userRepo)
+	importService := services.NewImportService(importProfileRepo, transactionRepo, userRepo)
+	accountService := services.NewAccountService(accountRepo, userRepo, reconciliationRepo)
+	transferService := services.NewTransferService(transferRepo, accountRepo)
+	tagService := services.NewTagService(tagRepo)
+	attachmentService := services.NewAttachmentService(attachmentRepo, transactionRepo, fileStorage)
+	exchangeRateService := services.NewExchangeRateService(exchangeRateRepo, userRepo)
+	reportService := services.NewReportService(transactionRepo, categoryRepo)
+	categorizationRuleService := services.NewCategorizationRuleService(categorizationRuleRepo, transactionRepo)
+	installmentPlanService := services.NewInstallmentPlanService(installmentPlanRepo, transactionRepo, userRepo)
+	creditCardService := services.NewCreditCardService(creditCardRepo, userRepo)
+	budgetTemplateService := services.NewBudgetTemplateService(budgetTemplateRepo)
+	trashService := services.NewTrashService(transactionRepo, budgetRepo, categoryRepo, fileStorage, trashRetention())
 
 	// NOTE: Handlerのインスタンス
 	csrfHandler := handlers.NewCsrfHandler()
@@ -40,6 +74,20 @@ func main() {
 	categoriesHandler := handlers.NewCategoriesHandler(categoryService)
 	transactionsHandler := handlers.NewTransactionsHandler(transactionService)
 	budgetsHandler := handlers.NewBudgetsHandler(budgetService)
+	calendarHandler := handlers.NewCalendarHandler(calendarService)
+	recurringTransactionsHandler := handlers.NewRecurringTransactionsHandler(recurringTransactionService)
+	importHandler := handlers.NewImportHandler(importService)
+	accountsHandler := handlers.NewAccountsHandler(accountService)
+	transfersHandler := handlers.NewTransfersHandler(transferService)
+	tagsHandler := handlers.NewTagsHandler(tagService)
+	attachmentsHandler := handlers.NewAttachmentsHandler(attachmentService)
+	exchangeRatesHandler := handlers.NewExchangeRatesHandler(exchangeRateService)
+	trashHandler := handlers.NewTrashHandler(trashService)
+	reportsHandler := handlers.NewReportsHandler(reportService)
+	categorizationRulesHandler := handlers.NewCategorizationRulesHandler(categorizationRuleService)
+	installmentPlansHandler := handlers.NewInstallmentPlansHandler(installmentPlanService)
+	creditCardsHandler := handlers.NewCreditCardsHandler(creditCardService)
+	budgetTemplatesHandler := handlers.NewBudgetTemplatesHandler(budgetTemplateService)
 
 	// NOTE: Echoインスタンスとミドルウェアの設定
 	e := echo.New()
@@ -50,10 +98,13 @@ func main() {
 	})
 
 	// NOTE: Handlerをルーティングに追加
-	mainHandler := handlers.NewMainHandler(csrfHandler, usersHandler, categoriesHandler, transactionsHandler, budgetsHandler)
+	mainHandler := handlers.NewMainHandler(csrfHandler, usersHandler, categoriesHandler, transactionsHandler, budgetsHandler, calendarHandler, recurringTransactionsHandler, importHandler, accountsHandler, transfersHandler, tagsHandler, attachmentsHandler, exchangeRatesHandler, trashHandler, reportsHandler, categorizationRulesHandler, installmentPlansHandler, creditCardsHandler, budgetTemplatesHandler)
 	mainStrictHandler := api.NewStrictHandler(mainHandler, []api.StrictMiddlewareFunc{middlewares.AuthMiddleware})
 	api.RegisterHandlers(e, mainStrictHandler)
 
+	// NOTE: 保持期間を過ぎたゴミ箱の取引・予算・カテゴリを定期的に完全削除する
+	go purgeTrashPeriodically(trashService, time.Hour)
+
 	if err := e.Start(":8080"); err != nil && err != http.ErrServerClosed {
 		e.Logger.Errorf("Echo server error: %v", err)
 	}
@@ -66,3 +117,32 @@ func loadEnv() {
 	}
 	godotenv.Load(envFilePath)
 }
+
+// trashRetention はゴミ箱の保持期間を環境変数 TRASH_RETENTION_DAYS（日数）から取得する。未指定の場合は30日
+func trashRetention() time.Duration {
+	days := 30
+	if v := os.Getenv("TRASH_RETENTION_DAYS"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			log.Fatalf("Invalid TRASH_RETENTION_DAYS: %q", v)
+		}
+		days = n
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// purgeTrashPeriodically は起動時と interval ごとに保持期間を過ぎたゴミ箱の行を完全に削除する
+func purgeTrashPeriodically(service services.TrashService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := service.PurgeExpired()
+		if err != nil {
+			log.Printf("failed to purge trash: %v", err)
+		} else if purged > 0 {
+			log.Printf("purged %d items from trash", purged)
+		}
+		<-ticker.C
+	}
+}
@@ -14,7 +14,9 @@ var DB *gorm.DB
 func Init() *gorm.DB {
 	var err error
 
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+	// NOTE: 日時はサーバーのタイムゾーンに依存しないようUTCで保存する
+	// 取引日などの日付はその日のUTCの0時として保存し、ユーザーのタイムゾーンでの「今日」はサービス層で判定する
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=UTC",
 		os.Getenv("DB_USER"),
 		os.Getenv("DB_PASSWORD"),
 		os.Getenv("DB_HOST"),
@@ -0,0 +1,609 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type AccountsHandler interface {
+	// Get accounts
+	// (GET /accounts)
+	GetAccounts(ctx context.Context, request api.GetAccountsRequestObject) (api.GetAccountsResponseObject, error)
+	// Create account
+	// (POST /accounts)
+	PostAccounts(ctx context.Context, request api.PostAccountsRequestObject) (api.PostAccountsResponseObject, error)
+	// Get account balances
+	// (GET /accounts/balances)
+	GetAccountsBalances(ctx context.Context, request api.GetAccountsBalancesRequestObject) (api.GetAccountsBalancesResponseObject, error)
+	// Get account by ID
+	// (GET /accounts/{id})
+	GetAccountsId(ctx context.Context, request api.GetAccountsIdRequestObject) (api.GetAccountsIdResponseObject, error)
+	// Update account
+	// (PATCH /accounts/{id})
+	PatchAccountsId(ctx context.Context, request api.PatchAccountsIdRequestObject) (api.PatchAccountsIdResponseObject, error)
+	// Delete account
+	// (DELETE /accounts/{id})
+	DeleteAccountsId(ctx context.Context, request api.DeleteAccountsIdRequestObject) (api.DeleteAccountsIdResponseObject, error)
+	// Get account entries
+	// (GET /accounts/{id}/entries)
+	GetAccountsIdEntries(ctx context.Context, request api.GetAccountsIdEntriesRequestObject) (api.GetAccountsIdEntriesResponseObject, error)
+	// Reconcile account with statement
+	// (POST /accounts/{id}/reconcile)
+	PostAccountsIdReconcile(ctx context.Context, request api.PostAccountsIdReconcileRequestObject) (api.PostAccountsIdReconcileResponseObject, error)
+	// Get account reconciliations
+	// (GET /accounts/{id}/reconciliations)
+	GetAccountsIdReconciliations(ctx context.Context, request api.GetAccountsIdReconciliationsRequestObject) (api.GetAccountsIdReconciliationsResponseObject, error)
+}
+
+type accountsHandler struct {
+	service services.AccountService
+}
+
+func NewAccountsHandler(service services.AccountService) AccountsHandler {
+	return &accountsHandler{service: service}
+}
+
+// GetAccounts implements api.StrictServerInterface
+func (h *accountsHandler) GetAccounts(ctx context.Context, request api.GetAccountsRequestObject) (api.GetAccountsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	accounts, err := h.service.FetchAccounts(userID)
+	if err != nil {
+		return api.GetAccounts500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiAccounts := make([]api.Account, len(accounts))
+	for i := range accounts {
+		apiAccounts[i] = toAPIAccount(&accounts[i])
+	}
+
+	return api.GetAccounts200JSONResponse{
+		Accounts: apiAccounts,
+	}, nil
+}
+
+// PostAccounts implements api.StrictServerInterface
+func (h *accountsHandler) PostAccounts(ctx context.Context, request api.PostAccountsRequestObject) (api.PostAccountsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	account, err := h.service.CreateAccount(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostAccounts400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostAccounts500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostAccounts201JSONResponse{
+		Account: toAPIAccount(account),
+	}, nil
+}
+
+// GetAccountsBalances implements api.StrictServerInterface
+func (h *accountsHandler) GetAccountsBalances(ctx context.Context, request api.GetAccountsBalancesRequestObject) (api.GetAccountsBalancesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	balances, err := h.service.FetchAccountBalances(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetAccountsBalances400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetAccountsBalances500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiBalances := make([]api.AccountBalance, len(balances))
+	for i := range balances {
+		history := make([]api.AccountBalancePoint, len(balances[i].History))
+		for j, point := range balances[i].History {
+			history[j] = api.AccountBalancePoint{
+				Date:    types.Date{Time: point.Date},
+				Balance: int32(point.Balance),
+			}
+		}
+		apiBalances[i] = api.AccountBalance{
+			Account: toAPIAccount(&balances[i].Account),
+			Balance: int32(balances[i].Balance),
+			History: history,
+		}
+	}
+
+	return api.GetAccountsBalances200JSONResponse{
+		Balances: apiBalances,
+	}, nil
+}
+
+// GetAccountsId implements api.StrictServerInterface
+func (h *accountsHandler) GetAccountsId(ctx context.Context, request api.GetAccountsIdRequestObject) (api.GetAccountsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	account, err := h.service.FetchAccountByID(uint(request.Id), userID)
+	if err != nil {
+		// 口座が見つからない場合
+		if errors.Is(err, services.ErrAccountNotFound) {
+			return api.GetAccountsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "口座が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetAccountsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetAccountsId200JSONResponse{
+		Account: toAPIAccount(account),
+	}, nil
+}
+
+// PatchAccountsId implements api.StrictServerInterface
+func (h *accountsHandler) PatchAccountsId(ctx context.Context, request api.PatchAccountsIdRequestObject) (api.PatchAccountsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	account, err := h.service.UpdateAccount(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PatchAccountsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 口座が見つからない場合
+		if errors.Is(err, services.ErrAccountNotFound) {
+			return api.PatchAccountsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "口座が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PatchAccountsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PatchAccountsId200JSONResponse{
+		Account: toAPIAccount(account),
+	}, nil
+}
+
+// DeleteAccountsId implements api.StrictServerInterface
+func (h *accountsHandler) DeleteAccountsId(ctx context.Context, request api.DeleteAccountsIdRequestObject) (api.DeleteAccountsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.DeleteAccount(uint(request.Id), userID); err != nil {
+		// 口座が見つからない場合
+		if errors.Is(err, services.ErrAccountNotFound) {
+			return api.DeleteAccountsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "口座が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 口座が使用中の場合
+		if errors.Is(err, services.ErrAccountInUse) {
+			return api.DeleteAccountsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "この口座は取引または振替に使用されているため削除できません",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTINUSE,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteAccountsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteAccountsId204Response{}, nil
+}
+
+// GetAccountsIdEntries implements api.StrictServerInterface
+func (h *accountsHandler) GetAccountsIdEntries(ctx context.Context, request api.GetAccountsIdEntriesRequestObject) (api.GetAccountsIdEntriesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	list, err := h.service.FetchAccountEntries(uint(request.Id), userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetAccountsIdEntries400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 口座が見つからない場合
+		if errors.Is(err, services.ErrAccountNotFound) {
+			return api.GetAccountsIdEntries404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "口座が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetAccountsIdEntries500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	entries := make([]api.AccountEntry, len(list.Entries))
+	for i, entry := range list.Entries {
+		entries[i] = api.AccountEntry{
+			Kind:        api.AccountEntryKind(entry.Kind),
+			Date:        types.Date{Time: entry.Date},
+			Amount:      int32(entry.Amount),
+			Description: entry.Description,
+			Cleared:     entry.Cleared,
+			Balance:     int32(entry.Balance),
+		}
+		recordID := int32(entry.RecordID)
+		if entries[i].Kind == api.AccountEntryKindTransaction {
+			entries[i].TransactionId = &recordID
+		} else {
+			entries[i].TransferId = &recordID
+		}
+	}
+
+	return api.GetAccountsIdEntries200JSONResponse{
+		OpeningBalance: int32(list.OpeningBalance),
+		Entries:        entries,
+	}, nil
+}
+
+// PostAccountsIdReconcile implements api.StrictServerInterface
+func (h *accountsHandler) PostAccountsIdReconcile(ctx context.Context, request api.PostAccountsIdReconcileRequestObject) (api.PostAccountsIdReconcileResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	result, err := h.service.ReconcileAccount(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostAccountsIdReconcile400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 口座が見つからない場合
+		if errors.Is(err, services.ErrAccountNotFound) {
+			return api.PostAccountsIdReconcile404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "口座が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostAccountsIdReconcile500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiResult := api.ReconciliationResult{
+		StatementDate:   types.Date{Time: result.StatementDate},
+		ExpectedBalance: int32(result.ExpectedBalance),
+		ClearedBalance:  int32(result.ClearedBalance),
+		Difference:      int32(result.Difference()),
+		ClearedCount:    int32(result.ClearedCount),
+		UnclearedCount:  int32(result.UnclearedCount),
+	}
+	if result.Reconciliation != nil {
+		reconciliation := toAPIReconciliation(result.Reconciliation)
+		apiResult.Reconciliation = &reconciliation
+	}
+
+	return api.PostAccountsIdReconcile200JSONResponse{
+		Result: apiResult,
+	}, nil
+}
+
+// GetAccountsIdReconciliations implements api.StrictServerInterface
+func (h *accountsHandler) GetAccountsIdReconciliations(ctx context.Context, request api.GetAccountsIdReconciliationsRequestObject) (api.GetAccountsIdReconciliationsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	reconciliations, err := h.service.FetchReconciliations(uint(request.Id), userID)
+	if err != nil {
+		// 口座が見つからない場合
+		if errors.Is(err, services.ErrAccountNotFound) {
+			return api.GetAccountsIdReconciliations404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "口座が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetAccountsIdReconciliations500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiReconciliations := make([]api.Reconciliation, len(reconciliations))
+	for i := range reconciliations {
+		apiReconciliations[i] = toAPIReconciliation(&reconciliations[i])
+	}
+
+	return api.GetAccountsIdReconciliations200JSONResponse{
+		Reconciliations: apiReconciliations,
+	}, nil
+}
+
+// toAPIAccount converts models.Account to api.Account
+func toAPIAccount(a *models.Account) api.Account {
+	return api.Account{
+		Id:             int32(a.ID),
+		UserId:         int32(a.UserID),
+		Name:           a.Name,
+		Type:           api.AccountType(a.Type),
+		OpeningBalance: int32(a.OpeningBalance),
+		CreatedAt:      a.CreatedAt,
+		UpdatedAt:      a.UpdatedAt,
+	}
+}
+
+// toAPIReconciliation converts models.Reconciliation to api.Reconciliation
+func toAPIReconciliation(r *models.Reconciliation) api.Reconciliation {
+	return api.Reconciliation{
+		Id:               int32(r.ID),
+		UserId:           int32(r.UserID),
+		AccountId:        int32(r.AccountID),
+		StatementDate:    types.Date{Time: r.StatementDate},
+		Balance:          int32(r.Balance),
+		TransactionCount: int32(r.TransactionCount),
+		CreatedAt:        r.CreatedAt,
+	}
+}
@@ -0,0 +1,331 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+	"apps/internal/validators"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+type AttachmentsHandler interface {
+	// Get attachments
+	// (GET /transactions/{id}/attachments)
+	GetTransactionsIdAttachments(ctx context.Context, request api.GetTransactionsIdAttachmentsRequestObject) (api.GetTransactionsIdAttachmentsResponseObject, error)
+	// Upload attachment
+	// (POST /transactions/{id}/attachments)
+	PostTransactionsIdAttachments(ctx context.Context, request api.PostTransactionsIdAttachmentsRequestObject) (api.PostTransactionsIdAttachmentsResponseObject, error)
+	// Download attachment
+	// (GET /transactions/{id}/attachments/{attachment_id})
+	GetTransactionsIdAttachmentsAttachmentId(ctx context.Context, request api.GetTransactionsIdAttachmentsAttachmentIdRequestObject) (api.GetTransactionsIdAttachmentsAttachmentIdResponseObject, error)
+	// Delete attachment
+	// (DELETE /transactions/{id}/attachments/{attachment_id})
+	DeleteTransactionsIdAttachmentsAttachmentId(ctx context.Context, request api.DeleteTransactionsIdAttachmentsAttachmentIdRequestObject) (api.DeleteTransactionsIdAttachmentsAttachmentIdResponseObject, error)
+}
+
+type attachmentsHandler struct {
+	service services.AttachmentService
+}
+
+func NewAttachmentsHandler(service services.AttachmentService) AttachmentsHandler {
+	return &attachmentsHandler{service: service}
+}
+
+// GetTransactionsIdAttachments implements api.StrictServerInterface
+func (h *attachmentsHandler) GetTransactionsIdAttachments(ctx context.Context, request api.GetTransactionsIdAttachmentsRequestObject) (api.GetTransactionsIdAttachmentsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	attachments, err := h.service.FetchAttachments(uint(request.Id), userID)
+	if err != nil {
+		// 取引が見つからない場合
+		if errors.Is(err, services.ErrTransactionNotFound) {
+			return api.GetTransactionsIdAttachments404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetTransactionsIdAttachments500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiAttachments := make([]api.Attachment, len(attachments))
+	for i := range attachments {
+		apiAttachments[i] = toAPIAttachment(&attachments[i])
+	}
+
+	return api.GetTransactionsIdAttachments200JSONResponse{
+		Attachments: apiAttachments,
+	}, nil
+}
+
+// PostTransactionsIdAttachments implements api.StrictServerInterface
+func (h *attachmentsHandler) PostTransactionsIdAttachments(ctx context.Context, request api.PostTransactionsIdAttachmentsRequestObject) (api.PostTransactionsIdAttachmentsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	input, err := readUploadAttachmentInput(request.Body)
+	var attachment *models.Attachment
+	if err == nil {
+		attachment, err = h.service.UploadAttachment(uint(request.Id), userID, input)
+	}
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostTransactionsIdAttachments400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 取引が見つからない場合
+		if errors.Is(err, services.ErrTransactionNotFound) {
+			return api.PostTransactionsIdAttachments404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベース・ストレージのエラーなど）
+		return api.PostTransactionsIdAttachments500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTransactionsIdAttachments201JSONResponse{
+		Attachment: toAPIAttachment(attachment),
+	}, nil
+}
+
+// GetTransactionsIdAttachmentsAttachmentId implements api.StrictServerInterface
+func (h *attachmentsHandler) GetTransactionsIdAttachmentsAttachmentId(ctx context.Context, request api.GetTransactionsIdAttachmentsAttachmentIdRequestObject) (api.GetTransactionsIdAttachmentsAttachmentIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	attachment, body, err := h.service.DownloadAttachment(uint(request.AttachmentId), uint(request.Id), userID)
+	if err != nil {
+		// 添付ファイルが見つからない場合
+		if errors.Is(err, services.ErrAttachmentNotFound) {
+			return api.GetTransactionsIdAttachmentsAttachmentId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "添付ファイルが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ATTACHMENTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベース・ストレージのエラーなど）
+		return api.GetTransactionsIdAttachmentsAttachmentId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	headers := api.GetTransactionsIdAttachmentsAttachmentId200ResponseHeaders{
+		ContentDisposition: attachmentContentDisposition(attachment.FileName),
+	}
+	size := int64(attachment.Size)
+
+	switch attachment.ContentType {
+	case "image/jpeg":
+		return api.GetTransactionsIdAttachmentsAttachmentId200ImagejpegResponse{Body: body, Headers: headers, ContentLength: size}, nil
+	case "image/png":
+		return api.GetTransactionsIdAttachmentsAttachmentId200ImagepngResponse{Body: body, Headers: headers, ContentLength: size}, nil
+	case "image/webp":
+		return api.GetTransactionsIdAttachmentsAttachmentId200ImagewebpResponse{Body: body, Headers: headers, ContentLength: size}, nil
+	case "application/pdf":
+		return api.GetTransactionsIdAttachmentsAttachmentId200ApplicationpdfResponse{Body: body, Headers: headers, ContentLength: size}, nil
+	}
+
+	// 添付可能な形式以外のファイルが保存されている場合
+	body.Close()
+	return api.GetTransactionsIdAttachmentsAttachmentId500JSONResponse{
+		Error: api.ErrorResponse{
+			Code:    500,
+			Message: "エラーが発生しました",
+			Status:  api.INTERNAL,
+			Details: &[]api.ErrorInfo{
+				{
+					Type:   api.ErrorInfoTypeErrorInfo,
+					Reason: api.UNKNOWNERROR,
+					Domain: "budget-calendar.example.com",
+				},
+			},
+		},
+	}, nil
+}
+
+// DeleteTransactionsIdAttachmentsAttachmentId implements api.StrictServerInterface
+func (h *attachmentsHandler) DeleteTransactionsIdAttachmentsAttachmentId(ctx context.Context, request api.DeleteTransactionsIdAttachmentsAttachmentIdRequestObject) (api.DeleteTransactionsIdAttachmentsAttachmentIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.DeleteAttachment(uint(request.AttachmentId), uint(request.Id), userID); err != nil {
+		// 添付ファイルが見つからない場合
+		if errors.Is(err, services.ErrAttachmentNotFound) {
+			return api.DeleteTransactionsIdAttachmentsAttachmentId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "添付ファイルが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ATTACHMENTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteTransactionsIdAttachmentsAttachmentId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteTransactionsIdAttachmentsAttachmentId204Response{}, nil
+}
+
+// readUploadAttachmentInput はmultipart/form-dataのリクエストボディを読み込む
+// ファイルは上限サイズを1バイト超えた時点で読み込みを打ち切り、サイズの検証はバリデータで行う
+func readUploadAttachmentInput(reader *multipart.Reader) (*api.UploadAttachmentInput, error) {
+	input := &api.UploadAttachmentInput{}
+	if reader == nil {
+		return input, nil
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, validation.Errors{"file": validation.NewError("invalid_body", "リクエストの形式が正しくありません")}
+		}
+
+		if part.FormName() == "file" {
+			data, err := io.ReadAll(io.LimitReader(part, validators.MaxAttachmentFileSize+1))
+			if err != nil {
+				part.Close()
+				return nil, validation.Errors{"file": validation.NewError("invalid_body", "リクエストの形式が正しくありません")}
+			}
+			input.File.InitFromBytes(data, part.FileName())
+		}
+		part.Close()
+	}
+
+	return input, nil
+}
+
+// attachmentContentDisposition はファイル名付きの Content-Disposition ヘッダーの値を返す
+// NOTE: 日本語などASCII以外のファイル名は RFC 2231 の形式でエンコードされる
+func attachmentContentDisposition(fileName string) string {
+	if value := mime.FormatMediaType("attachment", map[string]string{"filename": fileName}); value != "" {
+		return value
+	}
+	return "attachment"
+}
+
+// toAPIAttachment converts models.Attachment to api.Attachment
+func toAPIAttachment(a *models.Attachment) api.Attachment {
+	return api.Attachment{
+		Id:            int32(a.ID),
+		TransactionId: int32(a.TransactionID),
+		FileName:      a.FileName,
+		ContentType:   a.ContentType,
+		Size:          int32(a.Size),
+		CreatedAt:     a.CreatedAt,
+	}
+}
@@ -0,0 +1,365 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+)
+
+type BudgetTemplatesHandler interface {
+	// Get budget templates
+	// (GET /budget-templates)
+	GetBudgetTemplates(ctx context.Context, request api.GetBudgetTemplatesRequestObject) (api.GetBudgetTemplatesResponseObject, error)
+	// Create budget template
+	// (POST /budget-templates)
+	PostBudgetTemplates(ctx context.Context, request api.PostBudgetTemplatesRequestObject) (api.PostBudgetTemplatesResponseObject, error)
+	// Get budget template by ID
+	// (GET /budget-templates/{id})
+	GetBudgetTemplatesId(ctx context.Context, request api.GetBudgetTemplatesIdRequestObject) (api.GetBudgetTemplatesIdResponseObject, error)
+	// Update budget template
+	// (PATCH /budget-templates/{id})
+	PatchBudgetTemplatesId(ctx context.Context, request api.PatchBudgetTemplatesIdRequestObject) (api.PatchBudgetTemplatesIdResponseObject, error)
+	// Delete budget template
+	// (DELETE /budget-templates/{id})
+	DeleteBudgetTemplatesId(ctx context.Context, request api.DeleteBudgetTemplatesIdRequestObject) (api.DeleteBudgetTemplatesIdResponseObject, error)
+}
+
+type budgetTemplatesHandler struct {
+	service services.BudgetTemplateService
+}
+
+func NewBudgetTemplatesHandler(service services.BudgetTemplateService) BudgetTemplatesHandler {
+	return &budgetTemplatesHandler{service: service}
+}
+
+// GetBudgetTemplates implements api.StrictServerInterface
+func (h *budgetTemplatesHandler) GetBudgetTemplates(ctx context.Context, request api.GetBudgetTemplatesRequestObject) (api.GetBudgetTemplatesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	templates, err := h.service.FetchBudgetTemplates(userID)
+	if err != nil {
+		return api.GetBudgetTemplates500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiTemplates := make([]api.BudgetTemplate, len(templates))
+	for i := range templates {
+		apiTemplates[i] = toAPIBudgetTemplate(&templates[i])
+	}
+
+	return api.GetBudgetTemplates200JSONResponse{
+		BudgetTemplates: apiTemplates,
+	}, nil
+}
+
+// PostBudgetTemplates implements api.StrictServerInterface
+func (h *budgetTemplatesHandler) PostBudgetTemplates(ctx context.Context, request api.PostBudgetTemplatesRequestObject) (api.PostBudgetTemplatesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	template, err := h.service.CreateBudgetTemplate(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostBudgetTemplates400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PostBudgetTemplates400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 同じ名前の予算テンプレートが既に存在する場合
+		if errors.Is(err, services.ErrBudgetTemplateAlreadyExists) {
+			return api.PostBudgetTemplates409JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    409,
+					Message: "同じ名前の予算テンプレートが既に存在します",
+					Status:  api.ALREADYEXISTS,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETTEMPLATEALREADYEXISTS,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostBudgetTemplates500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostBudgetTemplates201JSONResponse{
+		BudgetTemplate: toAPIBudgetTemplate(template),
+	}, nil
+}
+
+// GetBudgetTemplatesId implements api.StrictServerInterface
+func (h *budgetTemplatesHandler) GetBudgetTemplatesId(ctx context.Context, request api.GetBudgetTemplatesIdRequestObject) (api.GetBudgetTemplatesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	template, err := h.service.FetchBudgetTemplateByID(uint(request.Id), userID)
+	if err != nil {
+		// 予算テンプレートが見つからない場合
+		if errors.Is(err, services.ErrBudgetTemplateNotFound) {
+			return api.GetBudgetTemplatesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "予算テンプレートが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETTEMPLATENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetBudgetTemplatesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetBudgetTemplatesId200JSONResponse{
+		BudgetTemplate: toAPIBudgetTemplate(template),
+	}, nil
+}
+
+// PatchBudgetTemplatesId implements api.StrictServerInterface
+func (h *budgetTemplatesHandler) PatchBudgetTemplatesId(ctx context.Context, request api.PatchBudgetTemplatesIdRequestObject) (api.PatchBudgetTemplatesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	template, err := h.service.UpdateBudgetTemplate(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PatchBudgetTemplatesId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 予算テンプレートが見つからない場合
+		if errors.Is(err, services.ErrBudgetTemplateNotFound) {
+			return api.PatchBudgetTemplatesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "予算テンプレートが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETTEMPLATENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PatchBudgetTemplatesId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 同じ名前の予算テンプレートが既に存在する場合
+		if errors.Is(err, services.ErrBudgetTemplateAlreadyExists) {
+			return api.PatchBudgetTemplatesId409JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    409,
+					Message: "同じ名前の予算テンプレートが既に存在します",
+					Status:  api.ALREADYEXISTS,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETTEMPLATEALREADYEXISTS,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PatchBudgetTemplatesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PatchBudgetTemplatesId200JSONResponse{
+		BudgetTemplate: toAPIBudgetTemplate(template),
+	}, nil
+}
+
+// DeleteBudgetTemplatesId implements api.StrictServerInterface
+func (h *budgetTemplatesHandler) DeleteBudgetTemplatesId(ctx context.Context, request api.DeleteBudgetTemplatesIdRequestObject) (api.DeleteBudgetTemplatesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.DeleteBudgetTemplate(uint(request.Id), userID); err != nil {
+		// 予算テンプレートが見つからない場合
+		if errors.Is(err, services.ErrBudgetTemplateNotFound) {
+			return api.DeleteBudgetTemplatesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "予算テンプレートが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETTEMPLATENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteBudgetTemplatesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteBudgetTemplatesId204Response{}, nil
+}
+
+// toAPIBudgetTemplate converts models.BudgetTemplate to api.BudgetTemplate
+func toAPIBudgetTemplate(t *models.BudgetTemplate) api.BudgetTemplate {
+	items := make([]api.BudgetTemplateItem, len(t.Items))
+	for i := range t.Items {
+		items[i] = api.BudgetTemplateItem{
+			CategoryId: int32(t.Items[i].CategoryID),
+			Category:   toAPICategory(&t.Items[i].Category),
+			Amount:     int32(t.Items[i].Amount),
+		}
+	}
+
+	return api.BudgetTemplate{
+		Id:        int32(t.ID),
+		UserId:    int32(t.UserID),
+		Name:      t.Name,
+		Items:     items,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	api "apps/apis"
+	"apps/internal/repositories"
+	"apps/internal/services"
+)
+
+// testBudgetTemplateID はテスト用のユーザーの予算テンプレート
+const testBudgetTemplateID int32 = 40
+
+func newTestBudgetTemplatesHandler(t *testing.T) (BudgetTemplatesHandler, func(string) int) {
+	db, fake := newTestDB(t)
+	fake.Returns("FROM `budget_templates`",
+		[]string{"id", "user_id", "name"},
+		[]driver.Value{int64(testBudgetTemplateID), int64(testUserID), "通常月"},
+	)
+	service := services.NewBudgetTemplateService(repositories.NewBudgetTemplateRepository(db))
+	return NewBudgetTemplatesHandler(service), func(table string) int {
+		return len(fake.Statements("(INSERT INTO|UPDATE|DELETE FROM) `" + table + "`"))
+	}
+}
+
+func TestBudgetTemplatesForeignCategory(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryID int32
+	}{
+		{"他のユーザーのカテゴリ", foreignCategoryID},
+		{"存在しないカテゴリ", missingCategoryID},
+	}
+
+	for _, tt := range tests {
+		items := []api.BudgetTemplateItemInput{
+			{CategoryId: ownCategoryID, Amount: 30000},
+			{CategoryId: tt.categoryID, Amount: 10000},
+		}
+
+		t.Run(tt.name+"で登録", func(t *testing.T) {
+			handler, writes := newTestBudgetTemplatesHandler(t)
+			res, err := handler.PostBudgetTemplates(userContext(), api.PostBudgetTemplatesRequestObject{Body: &api.CreateBudgetTemplateInput{
+				Name:  "通常月",
+				Items: items,
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PostBudgetTemplates400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PostBudgetTemplates400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.CATEGORYNOTFOUND {
+				t.Errorf("reason = %s, want %s", reason, api.CATEGORYNOTFOUND)
+			}
+			if n := writes("budget_templates") + writes("budget_template_items"); n > 0 {
+				t.Errorf("%d budget template rows written", n)
+			}
+		})
+
+		t.Run(tt.name+"に更新", func(t *testing.T) {
+			handler, writes := newTestBudgetTemplatesHandler(t)
+			res, err := handler.PatchBudgetTemplatesId(userContext(), api.PatchBudgetTemplatesIdRequestObject{Id: testBudgetTemplateID, Body: &api.UpdateBudgetTemplateInput{
+				Items: &items,
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PatchBudgetTemplatesId400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PatchBudgetTemplatesId400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.CATEGORYNOTFOUND {
+				t.Errorf("reason = %s, want %s", reason, api.CATEGORYNOTFOUND)
+			}
+			if n := writes("budget_templates") + writes("budget_template_items"); n > 0 {
+				t.Errorf("%d budget template rows written", n)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"apps/internal/helpers"
 	"apps/internal/models"
 	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
 )
 
 type BudgetsHandler interface {
@@ -17,6 +19,15 @@ type BudgetsHandler interface {
 	// Create budget
 	// (POST /budgets)
 	PostBudgets(ctx context.Context, request api.PostBudgetsRequestObject) (api.PostBudgetsResponseObject, error)
+	// Copy budgets
+	// (POST /budgets/copy)
+	PostBudgetsCopy(ctx context.Context, request api.PostBudgetsCopyRequestObject) (api.PostBudgetsCopyResponseObject, error)
+	// Get budget summary
+	// (GET /budgets/summary)
+	GetBudgetsSummary(ctx context.Context, request api.GetBudgetsSummaryRequestObject) (api.GetBudgetsSummaryResponseObject, error)
+	// Get available budget
+	// (GET /budgets/available)
+	GetBudgetsAvailable(ctx context.Context, request api.GetBudgetsAvailableRequestObject) (api.GetBudgetsAvailableResponseObject, error)
 	// Get budget by ID
 	// (GET /budgets/{id})
 	GetBudgetsId(ctx context.Context, request api.GetBudgetsIdRequestObject) (api.GetBudgetsIdResponseObject, error)
@@ -40,8 +51,47 @@ func NewBudgetsHandler(service services.BudgetService) BudgetsHandler {
 func (h *budgetsHandler) GetBudgets(ctx context.Context, request api.GetBudgetsRequestObject) (api.GetBudgetsResponseObject, error) {
 	userID, _ := helpers.ExtractUserID(ctx)
 
-	budgets, err := h.service.FetchBudgets(userID, &request.Params)
+	budgets, nextCursor, err := h.service.FetchBudgets(userID, &request.Params)
 	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetBudgets400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カーソルが不正な場合
+		if errors.Is(err, services.ErrInvalidCursor) {
+			return api.GetBudgets400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "ページの指定が正しくありません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.INVALIDCURSOR,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
 		return api.GetBudgets500JSONResponse{
 			Error: api.ErrorResponse{
 				Code:    500,
@@ -64,7 +114,154 @@ func (h *budgetsHandler) GetBudgets(ctx context.Context, request api.GetBudgetsR
 	}
 
 	return api.GetBudgets200JSONResponse{
-		Budgets: apiBudgets,
+		Budgets:    apiBudgets,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetBudgetsSummary implements api.StrictServerInterface
+func (h *budgetsHandler) GetBudgetsSummary(ctx context.Context, request api.GetBudgetsSummaryRequestObject) (api.GetBudgetsSummaryResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	summary, err := h.service.FetchBudgetSummary(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetBudgetsSummary400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		return api.GetBudgetsSummary500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	items := make([]api.BudgetSummaryItem, len(summary.Items))
+	for i, item := range summary.Items {
+		items[i] = api.BudgetSummaryItem{
+			CategoryId:  int32(item.Category.ID),
+			Category:    toAPICategory(&item.Category),
+			Planned:     int32(item.Planned),
+			Spent:       int32(item.Spent),
+			Remaining:   int32(item.Remaining),
+			PercentUsed: item.PercentUsed,
+		}
+		if item.BudgetID != nil {
+			budgetID := int32(*item.BudgetID)
+			items[i].BudgetId = &budgetID
+		}
+	}
+
+	return api.GetBudgetsSummary200JSONResponse{
+		Month:     summary.Month,
+		StartDate: types.Date{Time: summary.StartDate},
+		EndDate:   types.Date{Time: summary.EndDate},
+		Items:     items,
+		Total: api.BudgetSummaryTotal{
+			Planned:     int32(summary.TotalPlanned),
+			Spent:       int32(summary.TotalSpent),
+			Remaining:   int32(summary.TotalRemaining),
+			PercentUsed: summary.TotalPercentUsed,
+		},
+	}, nil
+}
+
+// GetBudgetsAvailable implements api.StrictServerInterface
+func (h *budgetsHandler) GetBudgetsAvailable(ctx context.Context, request api.GetBudgetsAvailableRequestObject) (api.GetBudgetsAvailableResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	available, err := h.service.FetchBudgetAvailable(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetBudgetsAvailable400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		return api.GetBudgetsAvailable500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	items := make([]api.BudgetAvailableItem, len(available.Items))
+	for i, item := range available.Items {
+		items[i] = api.BudgetAvailableItem{
+			CategoryId:  int32(item.Category.ID),
+			Category:    toAPICategory(&item.Category),
+			Planned:     int32(item.Planned),
+			CarriedOver: int32(item.CarriedOver),
+			Available:   int32(item.Available),
+			Spent:       int32(item.Spent),
+			Remaining:   int32(item.Remaining),
+		}
+		if item.BudgetID != nil {
+			budgetID := int32(*item.BudgetID)
+			items[i].BudgetId = &budgetID
+		}
+	}
+
+	return api.GetBudgetsAvailable200JSONResponse{
+		Month:     available.Month,
+		StartDate: types.Date{Time: available.StartDate},
+		EndDate:   types.Date{Time: available.EndDate},
+		Items:     items,
+		Total: api.BudgetAvailableTotal{
+			Planned:     int32(available.TotalPlanned),
+			CarriedOver: int32(available.TotalCarriedOver),
+			Available:   int32(available.TotalAvailable),
+			Spent:       int32(available.TotalSpent),
+			Remaining:   int32(available.TotalRemaining),
+		},
 	}, nil
 }
 
@@ -151,6 +348,98 @@ func (h *budgetsHandler) PostBudgets(ctx context.Context, request api.PostBudget
 	}, nil
 }
 
+// PostBudgetsCopy implements api.StrictServerInterface
+func (h *budgetsHandler) PostBudgetsCopy(ctx context.Context, request api.PostBudgetsCopyRequestObject) (api.PostBudgetsCopyResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	result, err := h.service.CopyBudgets(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostBudgetsCopy400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 予算テンプレートが見つからない場合
+		if errors.Is(err, services.ErrBudgetTemplateNotFound) {
+			return api.PostBudgetsCopy404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "予算テンプレートが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETTEMPLATENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// コピー中に同じカテゴリの予算が登録された場合
+		if errors.Is(err, services.ErrBudgetAlreadyExists) {
+			return api.PostBudgetsCopy409JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    409,
+					Message: "この月のこのカテゴリの予算は既に存在します",
+					Status:  api.ALREADYEXISTS,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETALREADYEXISTS,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostBudgetsCopy500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	budgets := make([]api.Budget, len(result.Budgets))
+	for i := range result.Budgets {
+		budgets[i] = toAPIBudget(&result.Budgets[i])
+	}
+
+	return api.PostBudgetsCopy200JSONResponse{
+		Created: int32(result.Created),
+		Updated: int32(result.Updated),
+		Skipped: int32(result.Skipped),
+		Budgets: budgets,
+	}, nil
+}
+
 // GetBudgetsId implements api.StrictServerInterface
 func (h *budgetsHandler) GetBudgetsId(ctx context.Context, request api.GetBudgetsIdRequestObject) (api.GetBudgetsIdResponseObject, error) {
 	userID, _ := helpers.ExtractUserID(ctx)
@@ -347,12 +636,13 @@ func toAPIBudget(b *models.Budget) api.Budget {
 		UserId:     int32(b.UserID),
 		CategoryId: int32(b.CategoryID),
 		Category: api.Category{
-			Id:        int32(b.Category.ID),
-			UserId:    int32(b.Category.UserID),
-			Name:      b.Category.Name,
-			Color:     b.Category.Color,
-			CreatedAt: b.Category.CreatedAt,
-			UpdatedAt: b.Category.UpdatedAt,
+			Id:             int32(b.Category.ID),
+			UserId:         int32(b.Category.UserID),
+			Name:           b.Category.Name,
+			Color:          b.Category.Color,
+			BudgetRollover: api.BudgetRollover(b.Category.BudgetRollover),
+			CreatedAt:      b.Category.CreatedAt,
+			UpdatedAt:      b.Category.UpdatedAt,
 		},
 		Amount:    int32(b.Amount),
 		Month:     b.Month,
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type CalendarHandler interface {
+	// Get calendar
+	// (GET /calendar)
+	GetCalendar(ctx context.Context, request api.GetCalendarRequestObject) (api.GetCalendarResponseObject, error)
+}
+
+type calendarHandler struct {
+	service services.CalendarService
+}
+
+func NewCalendarHandler(service services.CalendarService) CalendarHandler {
+	return &calendarHandler{service: service}
+}
+
+// GetCalendar implements api.StrictServerInterface
+func (h *calendarHandler) GetCalendar(ctx context.Context, request api.GetCalendarRequestObject) (api.GetCalendarResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	calendar, err := h.service.FetchCalendar(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetCalendar400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		return api.GetCalendar500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	days := make([]api.CalendarDay, len(calendar.Days))
+	for i, d := range calendar.Days {
+		days[i] = api.CalendarDay{
+			Date:             types.Date{Time: d.Date},
+			Income:           int32(d.Income),
+			Expense:          int32(d.Expense),
+			TransactionCount: int32(d.TransactionCount),
+			Balance:          int32(d.Balance),
+		}
+	}
+
+	budgets := make([]api.Budget, len(calendar.Budgets))
+	for i, b := range calendar.Budgets {
+		budgets[i] = toAPIBudget(&b)
+	}
+
+	return api.GetCalendar200JSONResponse{
+		Month:        calendar.Month,
+		TotalIncome:  int32(calendar.TotalIncome),
+		TotalExpense: int32(calendar.TotalExpense),
+		Days:         days,
+		Budgets:      budgets,
+	}, nil
+}
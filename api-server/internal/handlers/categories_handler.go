@@ -289,13 +289,14 @@ func (h *categoriesHandler) DeleteCategoriesId(ctx context.Context, request api.
 // toAPICategory converts models.Category to api.Category
 func toAPICategory(c *models.Category) api.Category {
 	return api.Category{
-		Id:        int32(c.ID),
-		UserId:    int32(c.UserID),
-		Name:      c.Name,
-		Type:      api.CategoryType(c.Type),
-		Color:     c.Color,
-		CreatedAt: c.CreatedAt,
-		UpdatedAt: c.UpdatedAt,
+		Id:             int32(c.ID),
+		UserId:         int32(c.UserID),
+		Name:           c.Name,
+		Type:           api.CategoryType(c.Type),
+		Color:          c.Color,
+		BudgetRollover: api.BudgetRollover(c.BudgetRollover),
+		CreatedAt:      c.CreatedAt,
+		UpdatedAt:      c.UpdatedAt,
 	}
 }
 
@@ -0,0 +1,421 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+)
+
+type CategorizationRulesHandler interface {
+	// Get categorization rules
+	// (GET /categorization-rules)
+	GetCategorizationRules(ctx context.Context, request api.GetCategorizationRulesRequestObject) (api.GetCategorizationRulesResponseObject, error)
+	// Create categorization rule
+	// (POST /categorization-rules)
+	PostCategorizationRules(ctx context.Context, request api.PostCategorizationRulesRequestObject) (api.PostCategorizationRulesResponseObject, error)
+	// Get categorization rule by ID
+	// (GET /categorization-rules/{id})
+	GetCategorizationRulesId(ctx context.Context, request api.GetCategorizationRulesIdRequestObject) (api.GetCategorizationRulesIdResponseObject, error)
+	// Update categorization rule
+	// (PATCH /categorization-rules/{id})
+	PatchCategorizationRulesId(ctx context.Context, request api.PatchCategorizationRulesIdRequestObject) (api.PatchCategorizationRulesIdResponseObject, error)
+	// Delete categorization rule
+	// (DELETE /categorization-rules/{id})
+	DeleteCategorizationRulesId(ctx context.Context, request api.DeleteCategorizationRulesIdRequestObject) (api.DeleteCategorizationRulesIdResponseObject, error)
+	// Apply categorization rule to existing transactions
+	// (POST /categorization-rules/{id}/apply)
+	PostCategorizationRulesIdApply(ctx context.Context, request api.PostCategorizationRulesIdApplyRequestObject) (api.PostCategorizationRulesIdApplyResponseObject, error)
+}
+
+type categorizationRulesHandler struct {
+	service services.CategorizationRuleService
+}
+
+func NewCategorizationRulesHandler(service services.CategorizationRuleService) CategorizationRulesHandler {
+	return &categorizationRulesHandler{service: service}
+}
+
+// GetCategorizationRules implements api.StrictServerInterface
+func (h *categorizationRulesHandler) GetCategorizationRules(ctx context.Context, request api.GetCategorizationRulesRequestObject) (api.GetCategorizationRulesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	rules, err := h.service.FetchCategorizationRules(userID)
+	if err != nil {
+		return api.GetCategorizationRules500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiRules := make([]api.CategorizationRule, len(rules))
+	for i, rule := range rules {
+		apiRules[i] = toAPICategorizationRule(&rule)
+	}
+
+	return api.GetCategorizationRules200JSONResponse{
+		CategorizationRules: apiRules,
+	}, nil
+}
+
+// PostCategorizationRules implements api.StrictServerInterface
+func (h *categorizationRulesHandler) PostCategorizationRules(ctx context.Context, request api.PostCategorizationRulesRequestObject) (api.PostCategorizationRulesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	rule, err := h.service.CreateCategorizationRule(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostCategorizationRules400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PostCategorizationRules400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostCategorizationRules500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostCategorizationRules201JSONResponse{
+		CategorizationRule: toAPICategorizationRule(rule),
+	}, nil
+}
+
+// GetCategorizationRulesId implements api.StrictServerInterface
+func (h *categorizationRulesHandler) GetCategorizationRulesId(ctx context.Context, request api.GetCategorizationRulesIdRequestObject) (api.GetCategorizationRulesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	rule, err := h.service.FetchCategorizationRuleByID(uint(request.Id), userID)
+	if err != nil {
+		// 自動分類ルールが見つからない場合
+		if errors.Is(err, services.ErrCategorizationRuleNotFound) {
+			return api.GetCategorizationRulesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "自動分類ルールが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORIZATIONRULENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetCategorizationRulesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetCategorizationRulesId200JSONResponse{
+		CategorizationRule: toAPICategorizationRule(rule),
+	}, nil
+}
+
+// PatchCategorizationRulesId implements api.StrictServerInterface
+func (h *categorizationRulesHandler) PatchCategorizationRulesId(ctx context.Context, request api.PatchCategorizationRulesIdRequestObject) (api.PatchCategorizationRulesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	rule, err := h.service.UpdateCategorizationRule(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PatchCategorizationRulesId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 自動分類ルールが見つからない場合
+		if errors.Is(err, services.ErrCategorizationRuleNotFound) {
+			return api.PatchCategorizationRulesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "自動分類ルールが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORIZATIONRULENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PatchCategorizationRulesId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PatchCategorizationRulesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PatchCategorizationRulesId200JSONResponse{
+		CategorizationRule: toAPICategorizationRule(rule),
+	}, nil
+}
+
+// DeleteCategorizationRulesId implements api.StrictServerInterface
+func (h *categorizationRulesHandler) DeleteCategorizationRulesId(ctx context.Context, request api.DeleteCategorizationRulesIdRequestObject) (api.DeleteCategorizationRulesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	err := h.service.DeleteCategorizationRule(uint(request.Id), userID)
+	if err != nil {
+		// 自動分類ルールが見つからない場合
+		if errors.Is(err, services.ErrCategorizationRuleNotFound) {
+			return api.DeleteCategorizationRulesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "自動分類ルールが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORIZATIONRULENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteCategorizationRulesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteCategorizationRulesId204Response{}, nil
+}
+
+// PostCategorizationRulesIdApply implements api.StrictServerInterface
+func (h *categorizationRulesHandler) PostCategorizationRulesIdApply(ctx context.Context, request api.PostCategorizationRulesIdApplyRequestObject) (api.PostCategorizationRulesIdApplyResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	result, err := h.service.ApplyCategorizationRule(ctx, uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostCategorizationRulesIdApply400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 自動分類ルールが見つからない場合
+		if errors.Is(err, services.ErrCategorizationRuleNotFound) {
+			return api.PostCategorizationRulesIdApply404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "自動分類ルールが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORIZATIONRULENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PostCategorizationRulesIdApply400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostCategorizationRulesIdApply500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	transactions := make([]api.Transaction, len(result.Transactions))
+	for i, t := range result.Transactions {
+		transactions[i] = toAPITransaction(&t)
+	}
+
+	return api.PostCategorizationRulesIdApply200JSONResponse{
+		DryRun:       result.DryRun,
+		MatchedCount: int32(result.MatchedCount),
+		UpdatedCount: int32(result.UpdatedCount),
+		Transactions: transactions,
+	}, nil
+}
+
+// toAPICategorizationRule converts models.CategorizationRule to api.CategorizationRule
+func toAPICategorizationRule(rule *models.CategorizationRule) api.CategorizationRule {
+	return api.CategorizationRule{
+		Id:         int32(rule.ID),
+		UserId:     int32(rule.UserID),
+		CategoryId: int32(rule.CategoryID),
+		Category:   toAPICategory(&rule.Category),
+		MatchType:  api.CategorizationMatchType(rule.MatchType),
+		Pattern:    rule.Pattern,
+		MinAmount:  toInt32Ptr(rule.MinAmount),
+		MaxAmount:  toInt32Ptr(rule.MaxAmount),
+		Priority:   int32(rule.Priority),
+		CreatedAt:  rule.CreatedAt,
+		UpdatedAt:  rule.UpdatedAt,
+	}
+}
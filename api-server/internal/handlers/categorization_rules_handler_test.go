@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	api "apps/apis"
+	"apps/internal/repositories"
+	"apps/internal/services"
+)
+
+// testCategorizationRuleID はテスト用のユーザーの自動分類ルール
+const testCategorizationRuleID int32 = 30
+
+func newTestCategorizationRulesHandler(t *testing.T) (CategorizationRulesHandler, func(string) int) {
+	db, fake := newTestDB(t)
+	fake.Returns("FROM `categorization_rules`",
+		[]string{"id", "user_id", "category_id", "match_type", "pattern", "priority"},
+		[]driver.Value{int64(testCategorizationRuleID), int64(testUserID), int64(ownCategoryID), "contains", "コンビニ", int64(0)},
+	)
+	service := services.NewCategorizationRuleService(repositories.NewCategorizationRuleRepository(db), repositories.NewTransactionRepository(db))
+	return NewCategorizationRulesHandler(service), func(table string) int {
+		return len(fake.Statements("(INSERT INTO|UPDATE) `" + table + "`"))
+	}
+}
+
+func TestCategorizationRulesForeignCategory(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryID int32
+	}{
+		{"他のユーザーのカテゴリ", foreignCategoryID},
+		{"存在しないカテゴリ", missingCategoryID},
+	}
+
+	for _, tt := range tests {
+		categoryID := tt.categoryID
+		t.Run(tt.name+"で登録", func(t *testing.T) {
+			handler, writes := newTestCategorizationRulesHandler(t)
+			res, err := handler.PostCategorizationRules(userContext(), api.PostCategorizationRulesRequestObject{Body: &api.CreateCategorizationRuleInput{
+				CategoryId: categoryID,
+				MatchType:  api.Contains,
+				Pattern:    "コンビニ",
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PostCategorizationRules400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PostCategorizationRules400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.CATEGORYNOTFOUND {
+				t.Errorf("reason = %s, want %s", reason, api.CATEGORYNOTFOUND)
+			}
+			if n := writes("categorization_rules"); n > 0 {
+				t.Errorf("%d categorization rules written", n)
+			}
+		})
+
+		t.Run(tt.name+"に更新", func(t *testing.T) {
+			handler, writes := newTestCategorizationRulesHandler(t)
+			res, err := handler.PatchCategorizationRulesId(userContext(), api.PatchCategorizationRulesIdRequestObject{Id: testCategorizationRuleID, Body: &api.UpdateCategorizationRuleInput{
+				CategoryId: &categoryID,
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PatchCategorizationRulesId400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PatchCategorizationRulesId400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.CATEGORYNOTFOUND {
+				t.Errorf("reason = %s, want %s", reason, api.CATEGORYNOTFOUND)
+			}
+			if n := writes("categorization_rules"); n > 0 {
+				t.Errorf("%d categorization rules written", n)
+			}
+		})
+	}
+}
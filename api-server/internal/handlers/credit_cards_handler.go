@@ -0,0 +1,457 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type CreditCardsHandler interface {
+	// Get credit cards
+	// (GET /credit-cards)
+	GetCreditCards(ctx context.Context, request api.GetCreditCardsRequestObject) (api.GetCreditCardsResponseObject, error)
+	// Create credit card
+	// (POST /credit-cards)
+	PostCreditCards(ctx context.Context, request api.PostCreditCardsRequestObject) (api.PostCreditCardsResponseObject, error)
+	// Get credit card withdrawals
+	// (GET /credit-cards/withdrawals)
+	GetCreditCardsWithdrawals(ctx context.Context, request api.GetCreditCardsWithdrawalsRequestObject) (api.GetCreditCardsWithdrawalsResponseObject, error)
+	// Get credit card by ID
+	// (GET /credit-cards/{id})
+	GetCreditCardsId(ctx context.Context, request api.GetCreditCardsIdRequestObject) (api.GetCreditCardsIdResponseObject, error)
+	// Update credit card
+	// (PATCH /credit-cards/{id})
+	PatchCreditCardsId(ctx context.Context, request api.PatchCreditCardsIdRequestObject) (api.PatchCreditCardsIdResponseObject, error)
+	// Delete credit card
+	// (DELETE /credit-cards/{id})
+	DeleteCreditCardsId(ctx context.Context, request api.DeleteCreditCardsIdRequestObject) (api.DeleteCreditCardsIdResponseObject, error)
+	// Get credit card statements
+	// (GET /credit-cards/{id}/statements)
+	GetCreditCardsIdStatements(ctx context.Context, request api.GetCreditCardsIdStatementsRequestObject) (api.GetCreditCardsIdStatementsResponseObject, error)
+}
+
+type creditCardsHandler struct {
+	service services.CreditCardService
+}
+
+func NewCreditCardsHandler(service services.CreditCardService) CreditCardsHandler {
+	return &creditCardsHandler{service: service}
+}
+
+// GetCreditCards implements api.StrictServerInterface
+func (h *creditCardsHandler) GetCreditCards(ctx context.Context, request api.GetCreditCardsRequestObject) (api.GetCreditCardsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	cards, err := h.service.FetchCreditCards(userID)
+	if err != nil {
+		return api.GetCreditCards500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiCards := make([]api.CreditCard, len(cards))
+	for i := range cards {
+		apiCards[i] = toAPICreditCard(&cards[i])
+	}
+
+	return api.GetCreditCards200JSONResponse{
+		CreditCards: apiCards,
+	}, nil
+}
+
+// PostCreditCards implements api.StrictServerInterface
+func (h *creditCardsHandler) PostCreditCards(ctx context.Context, request api.PostCreditCardsRequestObject) (api.PostCreditCardsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	card, err := h.service.CreateCreditCard(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostCreditCards400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostCreditCards500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostCreditCards201JSONResponse{
+		CreditCard: toAPICreditCard(card),
+	}, nil
+}
+
+// GetCreditCardsWithdrawals implements api.StrictServerInterface
+func (h *creditCardsHandler) GetCreditCardsWithdrawals(ctx context.Context, request api.GetCreditCardsWithdrawalsRequestObject) (api.GetCreditCardsWithdrawalsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	withdrawals, err := h.service.FetchWithdrawals(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetCreditCardsWithdrawals400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetCreditCardsWithdrawals500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiWithdrawals := make([]api.CreditCardWithdrawal, len(withdrawals))
+	for i, withdrawal := range withdrawals {
+		items := make([]api.CreditCardWithdrawalItem, len(withdrawal.Items))
+		for j := range withdrawal.Items {
+			items[j] = api.CreditCardWithdrawalItem{
+				CreditCard: toAPICreditCard(&withdrawal.Items[j].CreditCard),
+				Statement:  toAPICreditCardStatement(&withdrawal.Items[j].Statement),
+			}
+		}
+		apiWithdrawals[i] = api.CreditCardWithdrawal{
+			Date:  types.Date{Time: withdrawal.Date},
+			Total: int32(withdrawal.Total),
+			Items: items,
+		}
+	}
+
+	return api.GetCreditCardsWithdrawals200JSONResponse{
+		Withdrawals: apiWithdrawals,
+	}, nil
+}
+
+// GetCreditCardsId implements api.StrictServerInterface
+func (h *creditCardsHandler) GetCreditCardsId(ctx context.Context, request api.GetCreditCardsIdRequestObject) (api.GetCreditCardsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	card, err := h.service.FetchCreditCardByID(uint(request.Id), userID)
+	if err != nil {
+		// クレジットカードが見つからない場合
+		if errors.Is(err, services.ErrCreditCardNotFound) {
+			return api.GetCreditCardsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "クレジットカードが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CREDITCARDNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetCreditCardsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetCreditCardsId200JSONResponse{
+		CreditCard: toAPICreditCard(card),
+	}, nil
+}
+
+// PatchCreditCardsId implements api.StrictServerInterface
+func (h *creditCardsHandler) PatchCreditCardsId(ctx context.Context, request api.PatchCreditCardsIdRequestObject) (api.PatchCreditCardsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	card, err := h.service.UpdateCreditCard(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PatchCreditCardsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// クレジットカードが見つからない場合
+		if errors.Is(err, services.ErrCreditCardNotFound) {
+			return api.PatchCreditCardsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "クレジットカードが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CREDITCARDNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PatchCreditCardsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PatchCreditCardsId200JSONResponse{
+		CreditCard: toAPICreditCard(card),
+	}, nil
+}
+
+// DeleteCreditCardsId implements api.StrictServerInterface
+func (h *creditCardsHandler) DeleteCreditCardsId(ctx context.Context, request api.DeleteCreditCardsIdRequestObject) (api.DeleteCreditCardsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.DeleteCreditCard(uint(request.Id), userID); err != nil {
+		// クレジットカードが見つからない場合
+		if errors.Is(err, services.ErrCreditCardNotFound) {
+			return api.DeleteCreditCardsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "クレジットカードが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CREDITCARDNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// クレジットカードが使用中の場合
+		if errors.Is(err, services.ErrCreditCardInUse) {
+			return api.DeleteCreditCardsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "このクレジットカードは取引に使用されているため削除できません",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CREDITCARDINUSE,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteCreditCardsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteCreditCardsId204Response{}, nil
+}
+
+// GetCreditCardsIdStatements implements api.StrictServerInterface
+func (h *creditCardsHandler) GetCreditCardsIdStatements(ctx context.Context, request api.GetCreditCardsIdStatementsRequestObject) (api.GetCreditCardsIdStatementsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	statements, err := h.service.FetchStatements(uint(request.Id), userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetCreditCardsIdStatements400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// クレジットカードが見つからない場合
+		if errors.Is(err, services.ErrCreditCardNotFound) {
+			return api.GetCreditCardsIdStatements404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "クレジットカードが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CREDITCARDNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetCreditCardsIdStatements500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiStatements := make([]api.CreditCardStatement, len(statements))
+	for i := range statements {
+		apiStatements[i] = toAPICreditCardStatement(&statements[i])
+	}
+
+	return api.GetCreditCardsIdStatements200JSONResponse{
+		Statements: apiStatements,
+	}, nil
+}
+
+func toAPICreditCard(c *models.CreditCard) api.CreditCard {
+	return api.CreditCard{
+		Id:                 int32(c.ID),
+		UserId:             int32(c.UserID),
+		Name:               c.Name,
+		ClosingDay:         int32(c.ClosingDay),
+		PaymentDay:         int32(c.PaymentDay),
+		PaymentMonthOffset: int32(c.PaymentMonthOffset),
+		CreatedAt:          c.CreatedAt,
+		UpdatedAt:          c.UpdatedAt,
+	}
+}
+
+func toAPICreditCardStatement(s *services.CreditCardStatement) api.CreditCardStatement {
+	return api.CreditCardStatement{
+		ClosingMonth: s.ClosingMonth,
+		PeriodStart:  types.Date{Time: s.PeriodStart},
+		PeriodEnd:    types.Date{Time: s.PeriodEnd},
+		DueDate:      types.Date{Time: s.DueDate},
+		Total:        int32(s.Total),
+		Count:        int32(s.Count),
+	}
+}
@@ -0,0 +1,404 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+	"apps/internal/validators"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type ExchangeRatesHandler interface {
+	// Get exchange rates
+	// (GET /exchange-rates)
+	GetExchangeRates(ctx context.Context, request api.GetExchangeRatesRequestObject) (api.GetExchangeRatesResponseObject, error)
+	// Create exchange rate
+	// (POST /exchange-rates)
+	PostExchangeRates(ctx context.Context, request api.PostExchangeRatesRequestObject) (api.PostExchangeRatesResponseObject, error)
+	// Import exchange rates
+	// (POST /exchange-rates/import)
+	PostExchangeRatesImport(ctx context.Context, request api.PostExchangeRatesImportRequestObject) (api.PostExchangeRatesImportResponseObject, error)
+	// Update exchange rate
+	// (PATCH /exchange-rates/{id})
+	PatchExchangeRatesId(ctx context.Context, request api.PatchExchangeRatesIdRequestObject) (api.PatchExchangeRatesIdResponseObject, error)
+	// Delete exchange rate
+	// (DELETE /exchange-rates/{id})
+	DeleteExchangeRatesId(ctx context.Context, request api.DeleteExchangeRatesIdRequestObject) (api.DeleteExchangeRatesIdResponseObject, error)
+}
+
+type exchangeRatesHandler struct {
+	service services.ExchangeRateService
+}
+
+func NewExchangeRatesHandler(service services.ExchangeRateService) ExchangeRatesHandler {
+	return &exchangeRatesHandler{service: service}
+}
+
+// GetExchangeRates implements api.StrictServerInterface
+func (h *exchangeRatesHandler) GetExchangeRates(ctx context.Context, request api.GetExchangeRatesRequestObject) (api.GetExchangeRatesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	rates, err := h.service.FetchExchangeRates(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetExchangeRates400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetExchangeRates500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiRates := make([]api.ExchangeRate, len(rates))
+	for i := range rates {
+		apiRates[i] = toAPIExchangeRate(&rates[i])
+	}
+
+	return api.GetExchangeRates200JSONResponse{
+		ExchangeRates: apiRates,
+	}, nil
+}
+
+// PostExchangeRates implements api.StrictServerInterface
+func (h *exchangeRatesHandler) PostExchangeRates(ctx context.Context, request api.PostExchangeRatesRequestObject) (api.PostExchangeRatesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	rate, err := h.service.CreateExchangeRate(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostExchangeRates400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 同じ通貨・日付の為替レートが既に存在する場合
+		if errors.Is(err, services.ErrExchangeRateAlreadyExists) {
+			return api.PostExchangeRates409JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    409,
+					Message: "同じ通貨・日付の為替レートは既に存在します",
+					Status:  api.ALREADYEXISTS,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.EXCHANGERATEALREADYEXISTS,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostExchangeRates500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostExchangeRates201JSONResponse{
+		ExchangeRate: toAPIExchangeRate(rate),
+	}, nil
+}
+
+// PostExchangeRatesImport implements api.StrictServerInterface
+func (h *exchangeRatesHandler) PostExchangeRatesImport(ctx context.Context, request api.PostExchangeRatesImportRequestObject) (api.PostExchangeRatesImportResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	input, err := readImportExchangeRatesInput(request.Body)
+	var imported int
+	if err == nil {
+		imported, err = h.service.ImportExchangeRates(userID, input)
+	}
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostExchangeRatesImport400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// CSVを読み込めない場合
+		if errors.Is(err, services.ErrInvalidCSV) {
+			return api.PostExchangeRatesImport400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "CSVファイルを読み込めないか、行数が上限を超えています",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.INVALIDCSV,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostExchangeRatesImport500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostExchangeRatesImport200JSONResponse{
+		ImportedCount: int32(imported),
+	}, nil
+}
+
+// PatchExchangeRatesId implements api.StrictServerInterface
+func (h *exchangeRatesHandler) PatchExchangeRatesId(ctx context.Context, request api.PatchExchangeRatesIdRequestObject) (api.PatchExchangeRatesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	rate, err := h.service.UpdateExchangeRate(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PatchExchangeRatesId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 為替レートが見つからない場合
+		if errors.Is(err, services.ErrExchangeRateNotFound) {
+			return api.PatchExchangeRatesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "為替レートが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.EXCHANGERATENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 同じ通貨・日付の為替レートが既に存在する場合
+		if errors.Is(err, services.ErrExchangeRateAlreadyExists) {
+			return api.PatchExchangeRatesId409JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    409,
+					Message: "同じ通貨・日付の為替レートは既に存在します",
+					Status:  api.ALREADYEXISTS,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.EXCHANGERATEALREADYEXISTS,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PatchExchangeRatesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PatchExchangeRatesId200JSONResponse{
+		ExchangeRate: toAPIExchangeRate(rate),
+	}, nil
+}
+
+// DeleteExchangeRatesId implements api.StrictServerInterface
+func (h *exchangeRatesHandler) DeleteExchangeRatesId(ctx context.Context, request api.DeleteExchangeRatesIdRequestObject) (api.DeleteExchangeRatesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.DeleteExchangeRate(uint(request.Id), userID); err != nil {
+		// 為替レートが見つからない場合
+		if errors.Is(err, services.ErrExchangeRateNotFound) {
+			return api.DeleteExchangeRatesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "為替レートが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.EXCHANGERATENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteExchangeRatesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteExchangeRatesId204Response{}, nil
+}
+
+// readImportExchangeRatesInput はmultipart/form-dataのリクエストボディを読み込む
+// ファイルは上限サイズを1バイト超えた時点で読み込みを打ち切り、サイズの検証はバリデータで行う
+func readImportExchangeRatesInput(reader *multipart.Reader) (*api.ImportExchangeRatesInput, error) {
+	input := &api.ImportExchangeRatesInput{}
+	if reader == nil {
+		return input, nil
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, validation.Errors{"file": validation.NewError("invalid_body", "リクエストの形式が正しくありません")}
+		}
+
+		data, err := io.ReadAll(io.LimitReader(part, validators.MaxImportFileSize+1))
+		part.Close()
+		if err != nil {
+			return nil, validation.Errors{"file": validation.NewError("invalid_body", "リクエストの形式が正しくありません")}
+		}
+
+		if part.FormName() == "file" {
+			input.File.InitFromBytes(data, part.FileName())
+		}
+	}
+
+	return input, nil
+}
+
+// toAPIExchangeRate converts models.ExchangeRate to api.ExchangeRate
+func toAPIExchangeRate(r *models.ExchangeRate) api.ExchangeRate {
+	return api.ExchangeRate{
+		Id:           int32(r.ID),
+		UserId:       int32(r.UserID),
+		BaseCurrency: r.BaseCurrency,
+		Currency:     r.Currency,
+		Date:         types.Date{Time: r.Date},
+		Rate:         helpers.FormatRate(r.Rate),
+		CreatedAt:    r.CreatedAt,
+		UpdatedAt:    r.UpdatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package handlers
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+// テスト用のユーザーとカテゴリ
+// testUserID のユーザーは ownCategoryID・ownSplitCategoryID のカテゴリを、otherUserID のユーザーは foreignCategoryID のカテゴリを持つ
+const (
+	testUserID  uint = 1
+	otherUserID uint = 2
+
+	ownCategoryID      int32 = 1
+	ownSplitCategoryID int32 = 2
+	foreignCategoryID  int32 = 3
+	missingCategoryID  int32 = 99
+)
+
+var categoryOwners = map[int64]int64{
+	int64(ownCategoryID):      int64(testUserID),
+	int64(ownSplitCategoryID): int64(testUserID),
+	int64(foreignCategoryID):  int64(otherUserID),
+}
+
+// newTestDB は testUserID のユーザーと categoryOwners のカテゴリがあるテスト用のDB接続を作成する
+func newTestDB(t *testing.T) (*gorm.DB, *testdb.DB) {
+	t.Helper()
+	db, fake := testdb.New(t)
+
+	fake.Returns("FROM `users`",
+		[]string{"id", "name", "base_currency", "time_zone", "month_start_day", "month_start_adjustment"},
+		[]driver.Value{int64(testUserID), "test", "JPY", "Asia/Tokyo", int64(1), "none"},
+	)
+
+	// カテゴリの存在確認（SELECT count(*) FROM categories WHERE id IN (...) [AND user_id = ?]）
+	fake.On("SELECT count\\(\\*\\) FROM `categories`", func(query string, args []driver.Value) *testdb.Rows {
+		return &testdb.Rows{Columns: []string{"count"}, Values: [][]driver.Value{{countCategories(query, args)}}}
+	})
+	return db, fake
+}
+
+// countCategories はカテゴリの件数を数えるクエリの引数から、条件に一致するカテゴリの件数を返す
+// user_id の条件がある場合は最後の引数をユーザーIDとして扱う
+func countCategories(query string, args []driver.Value) int64 {
+	ids := args
+	var userID int64 = -1
+	if strings.Contains(query, "user_id = ?") {
+		ids, userID = args[:len(args)-1], args[len(args)-1].(int64)
+	}
+
+	var count int64
+	for _, id := range ids {
+		owner, ok := categoryOwners[id.(int64)]
+		if ok && (userID == -1 || owner == userID) {
+			count++
+		}
+	}
+	return count
+}
+
+// userContext はテスト用のユーザーでログインしたリクエストのコンテキストを返す
+func userContext() context.Context {
+	return helpers.NewWithUserIDContext(context.Background(), testUserID)
+}
+
+// errorReason はエラーレスポンスの理由を返す
+func errorReason(t *testing.T, body api.ErrorBody) api.ErrorReason {
+	t.Helper()
+	if body.Error.Details == nil || len(*body.Error.Details) == 0 {
+		t.Fatalf("error response has no details: %+v", body.Error)
+	}
+	return (*body.Error.Details)[0].Reason
+}
@@ -0,0 +1,522 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime/multipart"
+	"strconv"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+	"apps/internal/validators"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type ImportHandler interface {
+	// Get import profiles
+	// (GET /import-profiles)
+	GetImportProfiles(ctx context.Context, request api.GetImportProfilesRequestObject) (api.GetImportProfilesResponseObject, error)
+	// Create import profile
+	// (POST /import-profiles)
+	PostImportProfiles(ctx context.Context, request api.PostImportProfilesRequestObject) (api.PostImportProfilesResponseObject, error)
+	// Get import profile by ID
+	// (GET /import-profiles/{id})
+	GetImportProfilesId(ctx context.Context, request api.GetImportProfilesIdRequestObject) (api.GetImportProfilesIdResponseObject, error)
+	// Update import profile
+	// (PATCH /import-profiles/{id})
+	PatchImportProfilesId(ctx context.Context, request api.PatchImportProfilesIdRequestObject) (api.PatchImportProfilesIdResponseObject, error)
+	// Delete import profile
+	// (DELETE /import-profiles/{id})
+	DeleteImportProfilesId(ctx context.Context, request api.DeleteImportProfilesIdRequestObject) (api.DeleteImportProfilesIdResponseObject, error)
+	// Import transactions from CSV
+	// (POST /transactions/import)
+	PostTransactionsImport(ctx context.Context, request api.PostTransactionsImportRequestObject) (api.PostTransactionsImportResponseObject, error)
+}
+
+type importHandler struct {
+	service services.ImportService
+}
+
+func NewImportHandler(service services.ImportService) ImportHandler {
+	return &importHandler{service: service}
+}
+
+// GetImportProfiles implements api.StrictServerInterface
+func (h *importHandler) GetImportProfiles(ctx context.Context, request api.GetImportProfilesRequestObject) (api.GetImportProfilesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	profiles, err := h.service.FetchImportProfiles(userID)
+	if err != nil {
+		return api.GetImportProfiles500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiProfiles := make([]api.ImportProfile, len(profiles))
+	for i, profile := range profiles {
+		apiProfiles[i] = toAPIImportProfile(&profile)
+	}
+
+	return api.GetImportProfiles200JSONResponse{
+		ImportProfiles: apiProfiles,
+	}, nil
+}
+
+// PostImportProfiles implements api.StrictServerInterface
+func (h *importHandler) PostImportProfiles(ctx context.Context, request api.PostImportProfilesRequestObject) (api.PostImportProfilesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	profile, err := h.service.CreateImportProfile(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostImportProfiles400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PostImportProfiles400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostImportProfiles500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostImportProfiles201JSONResponse{
+		ImportProfile: toAPIImportProfile(profile),
+	}, nil
+}
+
+// GetImportProfilesId implements api.StrictServerInterface
+func (h *importHandler) GetImportProfilesId(ctx context.Context, request api.GetImportProfilesIdRequestObject) (api.GetImportProfilesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	profile, err := h.service.FetchImportProfileByID(uint(request.Id), userID)
+	if err != nil {
+		// 取込設定が見つからない場合
+		if errors.Is(err, services.ErrImportProfileNotFound) {
+			return api.GetImportProfilesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取込設定が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.IMPORTPROFILENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetImportProfilesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetImportProfilesId200JSONResponse{
+		ImportProfile: toAPIImportProfile(profile),
+	}, nil
+}
+
+// PatchImportProfilesId implements api.StrictServerInterface
+func (h *importHandler) PatchImportProfilesId(ctx context.Context, request api.PatchImportProfilesIdRequestObject) (api.PatchImportProfilesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	profile, err := h.service.UpdateImportProfile(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PatchImportProfilesId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 取込設定が見つからない場合
+		if errors.Is(err, services.ErrImportProfileNotFound) {
+			return api.PatchImportProfilesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取込設定が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.IMPORTPROFILENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PatchImportProfilesId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PatchImportProfilesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PatchImportProfilesId200JSONResponse{
+		ImportProfile: toAPIImportProfile(profile),
+	}, nil
+}
+
+// DeleteImportProfilesId implements api.StrictServerInterface
+func (h *importHandler) DeleteImportProfilesId(ctx context.Context, request api.DeleteImportProfilesIdRequestObject) (api.DeleteImportProfilesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.DeleteImportProfile(uint(request.Id), userID); err != nil {
+		// 取込設定が見つからない場合
+		if errors.Is(err, services.ErrImportProfileNotFound) {
+			return api.DeleteImportProfilesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取込設定が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.IMPORTPROFILENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteImportProfilesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteImportProfilesId204Response{}, nil
+}
+
+// PostTransactionsImport implements api.StrictServerInterface
+func (h *importHandler) PostTransactionsImport(ctx context.Context, request api.PostTransactionsImportRequestObject) (api.PostTransactionsImportResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	input, err := readImportTransactionsInput(request.Body)
+	var result *services.ImportResult
+	if err == nil {
+		result, err = h.service.ImportTransactions(userID, input)
+	}
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostTransactionsImport400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 取込設定が見つからない場合
+		if errors.Is(err, services.ErrImportProfileNotFound) {
+			return api.PostTransactionsImport400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定された取込設定が見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.IMPORTPROFILENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// CSVを読み込めない場合
+		if errors.Is(err, services.ErrInvalidCSV) {
+			return api.PostTransactionsImport400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "CSVファイルを読み込めないか、行数が上限を超えています",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.INVALIDCSV,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PostTransactionsImport400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTransactionsImport500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	rows := make([]api.ImportTransactionRow, len(result.Rows))
+	for i, row := range result.Rows {
+		rows[i] = toAPIImportTransactionRow(&row)
+	}
+
+	return api.PostTransactionsImport200JSONResponse{
+		DryRun:        result.DryRun,
+		AcceptedCount: int32(result.AcceptedCount),
+		RejectedCount: int32(result.RejectedCount),
+		ImportedCount: int32(result.ImportedCount),
+		Rows:          rows,
+	}, nil
+}
+
+// readImportTransactionsInput はmultipart/form-dataのリクエストボディを読み込む
+// ファイルは上限サイズを1バイト超えた時点で読み込みを打ち切り、サイズの検証はバリデータで行う
+func readImportTransactionsInput(reader *multipart.Reader) (*api.ImportTransactionsInput, error) {
+	input := &api.ImportTransactionsInput{}
+	if reader == nil {
+		return input, nil
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, validation.Errors{"file": validation.NewError("invalid_body", "リクエストの形式が正しくありません")}
+		}
+
+		data, err := io.ReadAll(io.LimitReader(part, validators.MaxImportFileSize+1))
+		part.Close()
+		if err != nil {
+			return nil, validation.Errors{"file": validation.NewError("invalid_body", "リクエストの形式が正しくありません")}
+		}
+
+		switch part.FormName() {
+		case "file":
+			input.File.InitFromBytes(data, part.FileName())
+		case "profile_id":
+			// NOTE: 数値に変換できない場合は0のままとし、バリデータでエラーにする
+			if profileID, err := strconv.ParseInt(string(data), 10, 32); err == nil {
+				input.ProfileId = int32(profileID)
+			}
+		case "dry_run":
+			dryRun, err := strconv.ParseBool(string(data))
+			if err != nil {
+				return nil, validation.Errors{"dry_run": validation.NewError("invalid_dry_run", "dry_runはtrueまたはfalseを指定してください")}
+			}
+			input.DryRun = &dryRun
+		}
+	}
+
+	return input, nil
+}
+
+// toAPIImportProfile converts models.ImportProfile to api.ImportProfile
+func toAPIImportProfile(profile *models.ImportProfile) api.ImportProfile {
+	result := api.ImportProfile{
+		Id:                int32(profile.ID),
+		UserId:            int32(profile.UserID),
+		Name:              profile.Name,
+		Encoding:          api.ImportEncoding(profile.Encoding),
+		HasHeader:         profile.HasHeader,
+		SkipRows:          int32(profile.SkipRows),
+		DateColumn:        int32(profile.DateColumn),
+		DateFormat:        profile.DateFormat,
+		AmountColumn:      int32(profile.AmountColumn),
+		AmountSign:        api.AmountSign(profile.AmountSign),
+		DescriptionColumn: toInt32Ptr(profile.DescriptionColumn),
+		DefaultCategoryId: int32(profile.DefaultCategoryID),
+		CreatedAt:         profile.CreatedAt,
+		UpdatedAt:         profile.UpdatedAt,
+	}
+	if profile.IncomeCategoryID != nil {
+		incomeCategoryID := int32(*profile.IncomeCategoryID)
+		result.IncomeCategoryId = &incomeCategoryID
+	}
+	return result
+}
+
+// toAPIImportTransactionRow converts services.ImportRow to api.ImportTransactionRow
+func toAPIImportTransactionRow(row *services.ImportRow) api.ImportTransactionRow {
+	result := api.ImportTransactionRow{
+		RowNumber:   int32(row.RowNumber),
+		Amount:      toInt32Ptr(row.Amount),
+		Description: row.Description,
+	}
+	if row.CategoryID != nil {
+		categoryID := int32(*row.CategoryID)
+		result.CategoryId = &categoryID
+	}
+	if row.Date != nil {
+		result.Date = &types.Date{Time: *row.Date}
+	}
+	if row.Errors != nil {
+		errs := helpers.ValidationErrorToMetadata(row.Errors)
+		result.Errors = &errs
+	}
+	return result
+}
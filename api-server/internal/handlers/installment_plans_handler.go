@@ -0,0 +1,333 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type InstallmentPlansHandler interface {
+	// Create installment plan
+	// (POST /transactions/{id}/installments)
+	PostTransactionsIdInstallments(ctx context.Context, request api.PostTransactionsIdInstallmentsRequestObject) (api.PostTransactionsIdInstallmentsResponseObject, error)
+	// Get installment plans
+	// (GET /installment-plans)
+	GetInstallmentPlans(ctx context.Context, request api.GetInstallmentPlansRequestObject) (api.GetInstallmentPlansResponseObject, error)
+	// Get installment plan by ID
+	// (GET /installment-plans/{id})
+	GetInstallmentPlansId(ctx context.Context, request api.GetInstallmentPlansIdRequestObject) (api.GetInstallmentPlansIdResponseObject, error)
+	// Delete installment plan
+	// (DELETE /installment-plans/{id})
+	DeleteInstallmentPlansId(ctx context.Context, request api.DeleteInstallmentPlansIdRequestObject) (api.DeleteInstallmentPlansIdResponseObject, error)
+}
+
+type installmentPlansHandler struct {
+	service services.InstallmentPlanService
+}
+
+func NewInstallmentPlansHandler(service services.InstallmentPlanService) InstallmentPlansHandler {
+	return &installmentPlansHandler{service: service}
+}
+
+// PostTransactionsIdInstallments implements api.StrictServerInterface
+func (h *installmentPlansHandler) PostTransactionsIdInstallments(ctx context.Context, request api.PostTransactionsIdInstallmentsRequestObject) (api.PostTransactionsIdInstallmentsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	plan, err := h.service.CreateInstallmentPlan(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostTransactionsIdInstallments400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 取引が見つからない場合
+		if errors.Is(err, services.ErrTransactionNotFound) {
+			return api.PostTransactionsIdInstallments404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 分割払いにできない取引の場合
+		if errors.Is(err, services.ErrInstallmentNotAllowed) {
+			return api.PostTransactionsIdInstallments400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "分割明細のある取引・定期取引の取引・分割払いの取引は分割払いにできません",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.INSTALLMENTNOTALLOWED,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PostTransactionsIdInstallments400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 対象の取引が照合済みの場合
+		if errors.Is(err, services.ErrTransactionLocked) {
+			return api.PostTransactionsIdInstallments400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "照合済みの取引は変更できません。ロックを解除してから操作してください",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONLOCKED,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTransactionsIdInstallments500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTransactionsIdInstallments201JSONResponse{
+		InstallmentPlan: toAPIInstallmentPlan(plan),
+	}, nil
+}
+
+// GetInstallmentPlans implements api.StrictServerInterface
+func (h *installmentPlansHandler) GetInstallmentPlans(ctx context.Context, request api.GetInstallmentPlansRequestObject) (api.GetInstallmentPlansResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	plans, err := h.service.FetchInstallmentPlans(userID)
+	if err != nil {
+		return api.GetInstallmentPlans500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiPlans := make([]api.InstallmentPlan, len(plans))
+	for i, plan := range plans {
+		apiPlans[i] = toAPIInstallmentPlan(&plan)
+	}
+
+	return api.GetInstallmentPlans200JSONResponse{
+		InstallmentPlans: apiPlans,
+	}, nil
+}
+
+// GetInstallmentPlansId implements api.StrictServerInterface
+func (h *installmentPlansHandler) GetInstallmentPlansId(ctx context.Context, request api.GetInstallmentPlansIdRequestObject) (api.GetInstallmentPlansIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	plan, err := h.service.FetchInstallmentPlanByID(uint(request.Id), userID)
+	if err != nil {
+		// 分割払いが見つからない場合
+		if errors.Is(err, services.ErrInstallmentPlanNotFound) {
+			return api.GetInstallmentPlansId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "分割払いが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.INSTALLMENTPLANNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetInstallmentPlansId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetInstallmentPlansId200JSONResponse{
+		InstallmentPlan: toAPIInstallmentPlan(plan),
+	}, nil
+}
+
+// DeleteInstallmentPlansId implements api.StrictServerInterface
+func (h *installmentPlansHandler) DeleteInstallmentPlansId(ctx context.Context, request api.DeleteInstallmentPlansIdRequestObject) (api.DeleteInstallmentPlansIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	err := h.service.DeleteInstallmentPlan(uint(request.Id), userID)
+	if err != nil {
+		// 分割払いが見つからない場合
+		if errors.Is(err, services.ErrInstallmentPlanNotFound) {
+			return api.DeleteInstallmentPlansId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "分割払いが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.INSTALLMENTPLANNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 支払いの取引が照合済みの場合
+		if errors.Is(err, services.ErrTransactionLocked) {
+			return api.DeleteInstallmentPlansId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "照合済みの取引は変更できません。ロックを解除してから操作してください",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONLOCKED,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteInstallmentPlansId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteInstallmentPlansId204Response{}, nil
+}
+
+// toAPIInstallmentPlan converts models.InstallmentPlan to api.InstallmentPlan
+func toAPIInstallmentPlan(plan *models.InstallmentPlan) api.InstallmentPlan {
+	var annualInterestRate *string
+	if plan.AnnualInterestRate != nil {
+		rate := helpers.FormatRate(*plan.AnnualInterestRate)
+		annualInterestRate = &rate
+	}
+
+	var purchaseExchangeRate *string
+	if plan.PurchaseExchangeRate != nil {
+		rate := helpers.FormatRate(*plan.PurchaseExchangeRate)
+		purchaseExchangeRate = &rate
+	}
+
+	transactions := make([]api.Transaction, len(plan.Transactions))
+	for i := range plan.Transactions {
+		transactions[i] = toAPITransaction(&plan.Transactions[i])
+	}
+
+	return api.InstallmentPlan{
+		Id:                     int32(plan.ID),
+		UserId:                 int32(plan.UserID),
+		PurchaseDate:           types.Date{Time: plan.PurchaseDate},
+		Principal:              int32(plan.Principal),
+		PurchaseCurrency:       plan.PurchaseCurrency,
+		PurchaseOriginalAmount: int32(plan.PurchaseOriginalAmount),
+		PurchaseExchangeRate:   purchaseExchangeRate,
+		Payments:               int32(plan.Payments),
+		FirstBillingMonth:      plan.FirstBillingMonth,
+		AnnualInterestRate:     annualInterestRate,
+		TotalInterest:          int32(plan.TotalInterest),
+		Transactions:           transactions,
+		CreatedAt:              plan.CreatedAt,
+		UpdatedAt:              plan.UpdatedAt,
+	}
+}
@@ -11,15 +11,43 @@ type MainHandler struct {
 	CategoriesHandler
 	TransactionsHandler
 	BudgetsHandler
+	CalendarHandler
+	RecurringTransactionsHandler
+	ImportHandler
+	AccountsHandler
+	TransfersHandler
+	TagsHandler
+	AttachmentsHandler
+	ExchangeRatesHandler
+	TrashHandler
+	ReportsHandler
+	CategorizationRulesHandler
+	InstallmentPlansHandler
+	CreditCardsHandler
+	BudgetTemplatesHandler
 }
 
-func NewMainHandler(csrfHandler CsrfHandler, usersHandler UsersHandler, categoriesHandler CategoriesHandler, transactionsHandler TransactionsHandler, budgetsHandler BudgetsHandler) *MainHandler {
+func NewMainHandler(csrfHandler CsrfHandler, usersHandler UsersHandler, categoriesHandler CategoriesHandler, transactionsHandler TransactionsHandler, budgetsHandler BudgetsHandler, calendarHandler CalendarHandler, recurringTransactionsHandler RecurringTransactionsHandler, importHandler ImportHandler, accountsHandler AccountsHandler, transfersHandler TransfersHandler, tagsHandler TagsHandler, attachmentsHandler AttachmentsHandler, exchangeRatesHandler ExchangeRatesHandler, trashHandler TrashHandler, reportsHandler ReportsHandler, categorizationRulesHandler CategorizationRulesHandler, installmentPlansHandler InstallmentPlansHandler, creditCardsHandler CreditCardsHandler, budgetTemplatesHandler BudgetTemplatesHandler) *MainHandler {
 	return &MainHandler{
-		CsrfHandler:         csrfHandler,
-		UsersHandler:        usersHandler,
-		CategoriesHandler:   categoriesHandler,
-		TransactionsHandler: transactionsHandler,
-		BudgetsHandler:      budgetsHandler,
+		CsrfHandler:                  csrfHandler,
+		UsersHandler:                 usersHandler,
+		CategoriesHandler:            categoriesHandler,
+		TransactionsHandler:          transactionsHandler,
+		BudgetsHandler:               budgetsHandler,
+		CalendarHandler:              calendarHandler,
+		RecurringTransactionsHandler: recurringTransactionsHandler,
+		ImportHandler:                importHandler,
+		AccountsHandler:              accountsHandler,
+		TransfersHandler:             transfersHandler,
+		TagsHandler:                  tagsHandler,
+		AttachmentsHandler:           attachmentsHandler,
+		ExchangeRatesHandler:         exchangeRatesHandler,
+		TrashHandler:                 trashHandler,
+		ReportsHandler:               reportsHandler,
+		CategorizationRulesHandler:   categorizationRulesHandler,
+		InstallmentPlansHandler:      installmentPlansHandler,
+		CreditCardsHandler:           creditCardsHandler,
+		BudgetTemplatesHandler:       budgetTemplatesHandler,
 	}
 }
 
@@ -40,6 +68,14 @@ func (h *MainHandler) PostUsersSignIn(ctx context.Context, request api.PostUsers
 	return h.UsersHandler.PostUsersSignIn(ctx, request)
 }
 
+func (h *MainHandler) GetUsersMe(ctx context.Context, request api.GetUsersMeRequestObject) (api.GetUsersMeResponseObject, error) {
+	return h.UsersHandler.GetUsersMe(ctx, request)
+}
+
+func (h *MainHandler) PatchUsersMe(ctx context.Context, request api.PatchUsersMeRequestObject) (api.PatchUsersMeResponseObject, error) {
+	return h.UsersHandler.PatchUsersMe(ctx, request)
+}
+
 func (h *MainHandler) GetUsersCheckSignedIn(ctx context.Context, request api.GetUsersCheckSignedInRequestObject) (api.GetUsersCheckSignedInResponseObject, error) {
 	return h.UsersHandler.GetUsersCheckSignedIn(ctx, request)
 }
@@ -86,6 +122,34 @@ func (h *MainHandler) DeleteTransactionsId(ctx context.Context, request api.Dele
 	return h.TransactionsHandler.DeleteTransactionsId(ctx, request)
 }
 
+func (h *MainHandler) GetTransactionsExport(ctx context.Context, request api.GetTransactionsExportRequestObject) (api.GetTransactionsExportResponseObject, error) {
+	return h.TransactionsHandler.GetTransactionsExport(ctx, request)
+}
+
+func (h *MainHandler) PostTransactionsBatch(ctx context.Context, request api.PostTransactionsBatchRequestObject) (api.PostTransactionsBatchResponseObject, error) {
+	return h.TransactionsHandler.PostTransactionsBatch(ctx, request)
+}
+
+func (h *MainHandler) PostTransactionsRecategorize(ctx context.Context, request api.PostTransactionsRecategorizeRequestObject) (api.PostTransactionsRecategorizeResponseObject, error) {
+	return h.TransactionsHandler.PostTransactionsRecategorize(ctx, request)
+}
+
+func (h *MainHandler) GetTransactionsIdHistory(ctx context.Context, request api.GetTransactionsIdHistoryRequestObject) (api.GetTransactionsIdHistoryResponseObject, error) {
+	return h.TransactionsHandler.GetTransactionsIdHistory(ctx, request)
+}
+
+func (h *MainHandler) GetTransactionsDuplicates(ctx context.Context, request api.GetTransactionsDuplicatesRequestObject) (api.GetTransactionsDuplicatesResponseObject, error) {
+	return h.TransactionsHandler.GetTransactionsDuplicates(ctx, request)
+}
+
+func (h *MainHandler) PostTransactionsDuplicatesMerge(ctx context.Context, request api.PostTransactionsDuplicatesMergeRequestObject) (api.PostTransactionsDuplicatesMergeResponseObject, error) {
+	return h.TransactionsHandler.PostTransactionsDuplicatesMerge(ctx, request)
+}
+
+func (h *MainHandler) PostTransactionsIdUnlock(ctx context.Context, request api.PostTransactionsIdUnlockRequestObject) (api.PostTransactionsIdUnlockResponseObject, error) {
+	return h.TransactionsHandler.PostTransactionsIdUnlock(ctx, request)
+}
+
 // Budgets
 func (h *MainHandler) GetBudgets(ctx context.Context, request api.GetBudgetsRequestObject) (api.GetBudgetsResponseObject, error) {
 	return h.BudgetsHandler.GetBudgets(ctx, request)
@@ -95,6 +159,18 @@ func (h *MainHandler) PostBudgets(ctx context.Context, request api.PostBudgetsRe
 	return h.BudgetsHandler.PostBudgets(ctx, request)
 }
 
+func (h *MainHandler) GetBudgetsSummary(ctx context.Context, request api.GetBudgetsSummaryRequestObject) (api.GetBudgetsSummaryResponseObject, error) {
+	return h.BudgetsHandler.GetBudgetsSummary(ctx, request)
+}
+
+func (h *MainHandler) GetBudgetsAvailable(ctx context.Context, request api.GetBudgetsAvailableRequestObject) (api.GetBudgetsAvailableResponseObject, error) {
+	return h.BudgetsHandler.GetBudgetsAvailable(ctx, request)
+}
+
+func (h *MainHandler) PostBudgetsCopy(ctx context.Context, request api.PostBudgetsCopyRequestObject) (api.PostBudgetsCopyResponseObject, error) {
+	return h.BudgetsHandler.PostBudgetsCopy(ctx, request)
+}
+
 func (h *MainHandler) GetBudgetsId(ctx context.Context, request api.GetBudgetsIdRequestObject) (api.GetBudgetsIdResponseObject, error) {
 	return h.BudgetsHandler.GetBudgetsId(ctx, request)
 }
@@ -106,3 +182,301 @@ func (h *MainHandler) PatchBudgetsId(ctx context.Context, request api.PatchBudge
 func (h *MainHandler) DeleteBudgetsId(ctx context.Context, request api.DeleteBudgetsIdRequestObject) (api.DeleteBudgetsIdResponseObject, error) {
 	return h.BudgetsHandler.DeleteBudgetsId(ctx, request)
 }
+
+// Calendar
+func (h *MainHandler) GetCalendar(ctx context.Context, request api.GetCalendarRequestObject) (api.GetCalendarResponseObject, error) {
+	return h.CalendarHandler.GetCalendar(ctx, request)
+}
+
+// RecurringTransactions
+func (h *MainHandler) GetRecurringTransactions(ctx context.Context, request api.GetRecurringTransactionsRequestObject) (api.GetRecurringTransactionsResponseObject, error) {
+	return h.RecurringTransactionsHandler.GetRecurringTransactions(ctx, request)
+}
+
+func (h *MainHandler) PostRecurringTransactions(ctx context.Context, request api.PostRecurringTransactionsRequestObject) (api.PostRecurringTransactionsResponseObject, error) {
+	return h.RecurringTransactionsHandler.PostRecurringTransactions(ctx, request)
+}
+
+func (h *MainHandler) PostRecurringTransactionsGenerate(ctx context.Context, request api.PostRecurringTransactionsGenerateRequestObject) (api.PostRecurringTransactionsGenerateResponseObject, error) {
+	return h.RecurringTransactionsHandler.PostRecurringTransactionsGenerate(ctx, request)
+}
+
+func (h *MainHandler) GetRecurringTransactionsId(ctx context.Context, request api.GetRecurringTransactionsIdRequestObject) (api.GetRecurringTransactionsIdResponseObject, error) {
+	return h.RecurringTransactionsHandler.GetRecurringTransactionsId(ctx, request)
+}
+
+func (h *MainHandler) PatchRecurringTransactionsId(ctx context.Context, request api.PatchRecurringTransactionsIdRequestObject) (api.PatchRecurringTransactionsIdResponseObject, error) {
+	return h.RecurringTransactionsHandler.PatchRecurringTransactionsId(ctx, request)
+}
+
+func (h *MainHandler) DeleteRecurringTransactionsId(ctx context.Context, request api.DeleteRecurringTransactionsIdRequestObject) (api.DeleteRecurringTransactionsIdResponseObject, error) {
+	return h.RecurringTransactionsHandler.DeleteRecurringTransactionsId(ctx, request)
+}
+
+// Import
+func (h *MainHandler) GetImportProfiles(ctx context.Context, request api.GetImportProfilesRequestObject) (api.GetImportProfilesResponseObject, error) {
+	return h.ImportHandler.GetImportProfiles(ctx, request)
+}
+
+func (h *MainHandler) PostImportProfiles(ctx context.Context, request api.PostImportProfilesRequestObject) (api.PostImportProfilesResponseObject, error) {
+	return h.ImportHandler.PostImportProfiles(ctx, request)
+}
+
+func (h *MainHandler) GetImportProfilesId(ctx context.Context, request api.GetImportProfilesIdRequestObject) (api.GetImportProfilesIdResponseObject, error) {
+	return h.ImportHandler.GetImportProfilesId(ctx, request)
+}
+
+func (h *MainHandler) PatchImportProfilesId(ctx context.Context, request api.PatchImportProfilesIdRequestObject) (api.PatchImportProfilesIdResponseObject, error) {
+	return h.ImportHandler.PatchImportProfilesId(ctx, request)
+}
+
+func (h *MainHandler) DeleteImportProfilesId(ctx context.Context, request api.DeleteImportProfilesIdRequestObject) (api.DeleteImportProfilesIdResponseObject, error) {
+	return h.ImportHandler.DeleteImportProfilesId(ctx, request)
+}
+
+func (h *MainHandler) PostTransactionsImport(ctx context.Context, request api.PostTransactionsImportRequestObject) (api.PostTransactionsImportResponseObject, error) {
+	return h.ImportHandler.PostTransactionsImport(ctx, request)
+}
+
+// Accounts
+func (h *MainHandler) GetAccounts(ctx context.Context, request api.GetAccountsRequestObject) (api.GetAccountsResponseObject, error) {
+	return h.AccountsHandler.GetAccounts(ctx, request)
+}
+
+func (h *MainHandler) PostAccounts(ctx context.Context, request api.PostAccountsRequestObject) (api.PostAccountsResponseObject, error) {
+	return h.AccountsHandler.PostAccounts(ctx, request)
+}
+
+func (h *MainHandler) GetAccountsBalances(ctx context.Context, request api.GetAccountsBalancesRequestObject) (api.GetAccountsBalancesResponseObject, error) {
+	return h.AccountsHandler.GetAccountsBalances(ctx, request)
+}
+
+func (h *MainHandler) GetAccountsId(ctx context.Context, request api.GetAccountsIdRequestObject) (api.GetAccountsIdResponseObject, error) {
+	return h.AccountsHandler.GetAccountsId(ctx, request)
+}
+
+func (h *MainHandler) PatchAccountsId(ctx context.Context, request api.PatchAccountsIdRequestObject) (api.PatchAccountsIdResponseObject, error) {
+	return h.AccountsHandler.PatchAccountsId(ctx, request)
+}
+
+func (h *MainHandler) DeleteAccountsId(ctx context.Context, request api.DeleteAccountsIdRequestObject) (api.DeleteAccountsIdResponseObject, error) {
+	return h.AccountsHandler.DeleteAccountsId(ctx, request)
+}
+
+func (h *MainHandler) GetAccountsIdEntries(ctx context.Context, request api.GetAccountsIdEntriesRequestObject) (api.GetAccountsIdEntriesResponseObject, error) {
+	return h.AccountsHandler.GetAccountsIdEntries(ctx, request)
+}
+
+func (h *MainHandler) PostAccountsIdReconcile(ctx context.Context, request api.PostAccountsIdReconcileRequestObject) (api.PostAccountsIdReconcileResponseObject, error) {
+	return h.AccountsHandler.PostAccountsIdReconcile(ctx, request)
+}
+
+func (h *MainHandler) GetAccountsIdReconciliations(ctx context.Context, request api.GetAccountsIdReconciliationsRequestObject) (api.GetAccountsIdReconciliationsResponseObject, error) {
+	return h.AccountsHandler.GetAccountsIdReconciliations(ctx, request)
+}
+
+// Transfers
+func (h *MainHandler) GetTransfers(ctx context.Context, request api.GetTransfersRequestObject) (api.GetTransfersResponseObject, error) {
+	return h.TransfersHandler.GetTransfers(ctx, request)
+}
+
+func (h *MainHandler) PostTransfers(ctx context.Context, request api.PostTransfersRequestObject) (api.PostTransfersResponseObject, error) {
+	return h.TransfersHandler.PostTransfers(ctx, request)
+}
+
+func (h *MainHandler) GetTransfersId(ctx context.Context, request api.GetTransfersIdRequestObject) (api.GetTransfersIdResponseObject, error) {
+	return h.TransfersHandler.GetTransfersId(ctx, request)
+}
+
+func (h *MainHandler) DeleteTransfersId(ctx context.Context, request api.DeleteTransfersIdRequestObject) (api.DeleteTransfersIdResponseObject, error) {
+	return h.TransfersHandler.DeleteTransfersId(ctx, request)
+}
+
+// Tags
+func (h *MainHandler) GetTags(ctx context.Context, request api.GetTagsRequestObject) (api.GetTagsResponseObject, error) {
+	return h.TagsHandler.GetTags(ctx, request)
+}
+
+func (h *MainHandler) PostTags(ctx context.Context, request api.PostTagsRequestObject) (api.PostTagsResponseObject, error) {
+	return h.TagsHandler.PostTags(ctx, request)
+}
+
+func (h *MainHandler) GetTagsSummary(ctx context.Context, request api.GetTagsSummaryRequestObject) (api.GetTagsSummaryResponseObject, error) {
+	return h.TagsHandler.GetTagsSummary(ctx, request)
+}
+
+func (h *MainHandler) GetTagsId(ctx context.Context, request api.GetTagsIdRequestObject) (api.GetTagsIdResponseObject, error) {
+	return h.TagsHandler.GetTagsId(ctx, request)
+}
+
+func (h *MainHandler) PatchTagsId(ctx context.Context, request api.PatchTagsIdRequestObject) (api.PatchTagsIdResponseObject, error) {
+	return h.TagsHandler.PatchTagsId(ctx, request)
+}
+
+func (h *MainHandler) DeleteTagsId(ctx context.Context, request api.DeleteTagsIdRequestObject) (api.DeleteTagsIdResponseObject, error) {
+	return h.TagsHandler.DeleteTagsId(ctx, request)
+}
+
+// Attachments
+func (h *MainHandler) GetTransactionsIdAttachments(ctx context.Context, request api.GetTransactionsIdAttachmentsRequestObject) (api.GetTransactionsIdAttachmentsResponseObject, error) {
+	return h.AttachmentsHandler.GetTransactionsIdAttachments(ctx, request)
+}
+
+func (h *MainHandler) PostTransactionsIdAttachments(ctx context.Context, request api.PostTransactionsIdAttachmentsRequestObject) (api.PostTransactionsIdAttachmentsResponseObject, error) {
+	return h.AttachmentsHandler.PostTransactionsIdAttachments(ctx, request)
+}
+
+func (h *MainHandler) GetTransactionsIdAttachmentsAttachmentId(ctx context.Context, request api.GetTransactionsIdAttachmentsAttachmentIdRequestObject) (api.GetTransactionsIdAttachmentsAttachmentIdResponseObject, error) {
+	return h.AttachmentsHandler.GetTransactionsIdAttachmentsAttachmentId(ctx, request)
+}
+
+func (h *MainHandler) DeleteTransactionsIdAttachmentsAttachmentId(ctx context.Context, request api.DeleteTransactionsIdAttachmentsAttachmentIdRequestObject) (api.DeleteTransactionsIdAttachmentsAttachmentIdResponseObject, error) {
+	return h.AttachmentsHandler.DeleteTransactionsIdAttachmentsAttachmentId(ctx, request)
+}
+
+// ExchangeRates
+func (h *MainHandler) GetExchangeRates(ctx context.Context, request api.GetExchangeRatesRequestObject) (api.GetExchangeRatesResponseObject, error) {
+	return h.ExchangeRatesHandler.GetExchangeRates(ctx, request)
+}
+
+func (h *MainHandler) PostExchangeRates(ctx context.Context, request api.PostExchangeRatesRequestObject) (api.PostExchangeRatesResponseObject, error) {
+	return h.ExchangeRatesHandler.PostExchangeRates(ctx, request)
+}
+
+func (h *MainHandler) PostExchangeRatesImport(ctx context.Context, request api.PostExchangeRatesImportRequestObject) (api.PostExchangeRatesImportResponseObject, error) {
+	return h.ExchangeRatesHandler.PostExchangeRatesImport(ctx, request)
+}
+
+func (h *MainHandler) PatchExchangeRatesId(ctx context.Context, request api.PatchExchangeRatesIdRequestObject) (api.PatchExchangeRatesIdResponseObject, error) {
+	return h.ExchangeRatesHandler.PatchExchangeRatesId(ctx, request)
+}
+
+func (h *MainHandler) DeleteExchangeRatesId(ctx context.Context, request api.DeleteExchangeRatesIdRequestObject) (api.DeleteExchangeRatesIdResponseObject, error) {
+	return h.ExchangeRatesHandler.DeleteExchangeRatesId(ctx, request)
+}
+
+// Trash
+func (h *MainHandler) GetTrash(ctx context.Context, request api.GetTrashRequestObject) (api.GetTrashResponseObject, error) {
+	return h.TrashHandler.GetTrash(ctx, request)
+}
+
+func (h *MainHandler) DeleteTrashBudgetsId(ctx context.Context, request api.DeleteTrashBudgetsIdRequestObject) (api.DeleteTrashBudgetsIdResponseObject, error) {
+	return h.TrashHandler.DeleteTrashBudgetsId(ctx, request)
+}
+
+func (h *MainHandler) PostTrashBudgetsIdRestore(ctx context.Context, request api.PostTrashBudgetsIdRestoreRequestObject) (api.PostTrashBudgetsIdRestoreResponseObject, error) {
+	return h.TrashHandler.PostTrashBudgetsIdRestore(ctx, request)
+}
+
+func (h *MainHandler) DeleteTrashCategoriesId(ctx context.Context, request api.DeleteTrashCategoriesIdRequestObject) (api.DeleteTrashCategoriesIdResponseObject, error) {
+	return h.TrashHandler.DeleteTrashCategoriesId(ctx, request)
+}
+
+func (h *MainHandler) PostTrashCategoriesIdRestore(ctx context.Context, request api.PostTrashCategoriesIdRestoreRequestObject) (api.PostTrashCategoriesIdRestoreResponseObject, error) {
+	return h.TrashHandler.PostTrashCategoriesIdRestore(ctx, request)
+}
+
+func (h *MainHandler) DeleteTrashTransactionsId(ctx context.Context, request api.DeleteTrashTransactionsIdRequestObject) (api.DeleteTrashTransactionsIdResponseObject, error) {
+	return h.TrashHandler.DeleteTrashTransactionsId(ctx, request)
+}
+
+func (h *MainHandler) PostTrashTransactionsIdRestore(ctx context.Context, request api.PostTrashTransactionsIdRestoreRequestObject) (api.PostTrashTransactionsIdRestoreResponseObject, error) {
+	return h.TrashHandler.PostTrashTransactionsIdRestore(ctx, request)
+}
+
+// Reports
+func (h *MainHandler) GetReportsTrends(ctx context.Context, request api.GetReportsTrendsRequestObject) (api.GetReportsTrendsResponseObject, error) {
+	return h.ReportsHandler.GetReportsTrends(ctx, request)
+}
+
+// CategorizationRules
+func (h *MainHandler) GetCategorizationRules(ctx context.Context, request api.GetCategorizationRulesRequestObject) (api.GetCategorizationRulesResponseObject, error) {
+	return h.CategorizationRulesHandler.GetCategorizationRules(ctx, request)
+}
+
+func (h *MainHandler) PostCategorizationRules(ctx context.Context, request api.PostCategorizationRulesRequestObject) (api.PostCategorizationRulesResponseObject, error) {
+	return h.CategorizationRulesHandler.PostCategorizationRules(ctx, request)
+}
+
+func (h *MainHandler) GetCategorizationRulesId(ctx context.Context, request api.GetCategorizationRulesIdRequestObject) (api.GetCategorizationRulesIdResponseObject, error) {
+	return h.CategorizationRulesHandler.GetCategorizationRulesId(ctx, request)
+}
+
+func (h *MainHandler) PatchCategorizationRulesId(ctx context.Context, request api.PatchCategorizationRulesIdRequestObject) (api.PatchCategorizationRulesIdResponseObject, error) {
+	return h.CategorizationRulesHandler.PatchCategorizationRulesId(ctx, request)
+}
+
+func (h *MainHandler) DeleteCategorizationRulesId(ctx context.Context, request api.DeleteCategorizationRulesIdRequestObject) (api.DeleteCategorizationRulesIdResponseObject, error) {
+	return h.CategorizationRulesHandler.DeleteCategorizationRulesId(ctx, request)
+}
+
+func (h *MainHandler) PostCategorizationRulesIdApply(ctx context.Context, request api.PostCategorizationRulesIdApplyRequestObject) (api.PostCategorizationRulesIdApplyResponseObject, error) {
+	return h.CategorizationRulesHandler.PostCategorizationRulesIdApply(ctx, request)
+}
+
+// InstallmentPlans
+func (h *MainHandler) PostTransactionsIdInstallments(ctx context.Context, request api.PostTransactionsIdInstallmentsRequestObject) (api.PostTransactionsIdInstallmentsResponseObject, error) {
+	return h.InstallmentPlansHandler.PostTransactionsIdInstallments(ctx, request)
+}
+
+func (h *MainHandler) GetInstallmentPlans(ctx context.Context, request api.GetInstallmentPlansRequestObject) (api.GetInstallmentPlansResponseObject, error) {
+	return h.InstallmentPlansHandler.GetInstallmentPlans(ctx, request)
+}
+
+func (h *MainHandler) GetInstallmentPlansId(ctx context.Context, request api.GetInstallmentPlansIdRequestObject) (api.GetInstallmentPlansIdResponseObject, error) {
+	return h.InstallmentPlansHandler.GetInstallmentPlansId(ctx, request)
+}
+
+func (h *MainHandler) DeleteInstallmentPlansId(ctx context.Context, request api.DeleteInstallmentPlansIdRequestObject) (api.DeleteInstallmentPlansIdResponseObject, error) {
+	return h.InstallmentPlansHandler.DeleteInstallmentPlansId(ctx, request)
+}
+
+// CreditCardsHandler
+func (h *MainHandler) GetCreditCards(ctx context.Context, request api.GetCreditCardsRequestObject) (api.GetCreditCardsResponseObject, error) {
+	return h.CreditCardsHandler.GetCreditCards(ctx, request)
+}
+
+func (h *MainHandler) PostCreditCards(ctx context.Context, request api.PostCreditCardsRequestObject) (api.PostCreditCardsResponseObject, error) {
+	return h.CreditCardsHandler.PostCreditCards(ctx, request)
+}
+
+func (h *MainHandler) GetCreditCardsWithdrawals(ctx context.Context, request api.GetCreditCardsWithdrawalsRequestObject) (api.GetCreditCardsWithdrawalsResponseObject, error) {
+	return h.CreditCardsHandler.GetCreditCardsWithdrawals(ctx, request)
+}
+
+func (h *MainHandler) GetCreditCardsId(ctx context.Context, request api.GetCreditCardsIdRequestObject) (api.GetCreditCardsIdResponseObject, error) {
+	return h.CreditCardsHandler.GetCreditCardsId(ctx, request)
+}
+
+func (h *MainHandler) PatchCreditCardsId(ctx context.Context, request api.PatchCreditCardsIdRequestObject) (api.PatchCreditCardsIdResponseObject, error) {
+	return h.CreditCardsHandler.PatchCreditCardsId(ctx, request)
+}
+
+func (h *MainHandler) DeleteCreditCardsId(ctx context.Context, request api.DeleteCreditCardsIdRequestObject) (api.DeleteCreditCardsIdResponseObject, error) {
+	return h.CreditCardsHandler.DeleteCreditCardsId(ctx, request)
+}
+
+func (h *MainHandler) GetCreditCardsIdStatements(ctx context.Context, request api.GetCreditCardsIdStatementsRequestObject) (api.GetCreditCardsIdStatementsResponseObject, error) {
+	return h.CreditCardsHandler.GetCreditCardsIdStatements(ctx, request)
+}
+
+// BudgetTemplates
+func (h *MainHandler) GetBudgetTemplates(ctx context.Context, request api.GetBudgetTemplatesRequestObject) (api.GetBudgetTemplatesResponseObject, error) {
+	return h.BudgetTemplatesHandler.GetBudgetTemplates(ctx, request)
+}
+
+func (h *MainHandler) PostBudgetTemplates(ctx context.Context, request api.PostBudgetTemplatesRequestObject) (api.PostBudgetTemplatesResponseObject, error) {
+	return h.BudgetTemplatesHandler.PostBudgetTemplates(ctx, request)
+}
+
+func (h *MainHandler) GetBudgetTemplatesId(ctx context.Context, request api.GetBudgetTemplatesIdRequestObject) (api.GetBudgetTemplatesIdResponseObject, error) {
+	return h.BudgetTemplatesHandler.GetBudgetTemplatesId(ctx, request)
+}
+
+func (h *MainHandler) PatchBudgetTemplatesId(ctx context.Context, request api.PatchBudgetTemplatesIdRequestObject) (api.PatchBudgetTemplatesIdResponseObject, error) {
+	return h.BudgetTemplatesHandler.PatchBudgetTemplatesId(ctx, request)
+}
+
+func (h *MainHandler) DeleteBudgetTemplatesId(ctx context.Context, request api.DeleteBudgetTemplatesIdRequestObject) (api.DeleteBudgetTemplatesIdResponseObject, error) {
+	return h.BudgetTemplatesHandler.DeleteBudgetTemplatesId(ctx, request)
+}
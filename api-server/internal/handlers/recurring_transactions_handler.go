@@ -0,0 +1,372 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type RecurringTransactionsHandler interface {
+	// Get recurring transactions
+	// (GET /recurring-transactions)
+	GetRecurringTransactions(ctx context.Context, request api.GetRecurringTransactionsRequestObject) (api.GetRecurringTransactionsResponseObject, error)
+	// Create recurring transaction
+	// (POST /recurring-transactions)
+	PostRecurringTransactions(ctx context.Context, request api.PostRecurringTransactionsRequestObject) (api.PostRecurringTransactionsResponseObject, error)
+	// Generate transactions from recurring transactions
+	// (POST /recurring-transactions/generate)
+	PostRecurringTransactionsGenerate(ctx context.Context, request api.PostRecurringTransactionsGenerateRequestObject) (api.PostRecurringTransactionsGenerateResponseObject, error)
+	// Get recurring transaction by ID
+	// (GET /recurring-transactions/{id})
+	GetRecurringTransactionsId(ctx context.Context, request api.GetRecurringTransactionsIdRequestObject) (api.GetRecurringTransactionsIdResponseObject, error)
+	// Update recurring transaction
+	// (PATCH /recurring-transactions/{id})
+	PatchRecurringTransactionsId(ctx context.Context, request api.PatchRecurringTransactionsIdRequestObject) (api.PatchRecurringTransactionsIdResponseObject, error)
+	// Delete recurring transaction
+	// (DELETE /recurring-transactions/{id})
+	DeleteRecurringTransactionsId(ctx context.Context, request api.DeleteRecurringTransactionsIdRequestObject) (api.DeleteRecurringTransactionsIdResponseObject, error)
+}
+
+type recurringTransactionsHandler struct {
+	service services.RecurringTransactionService
+}
+
+func NewRecurringTransactionsHandler(service services.RecurringTransactionService) RecurringTransactionsHandler {
+	return &recurringTransactionsHandler{service: service}
+}
+
+// GetRecurringTransactions implements api.StrictServerInterface
+func (h *recurringTransactionsHandler) GetRecurringTransactions(ctx context.Context, request api.GetRecurringTransactionsRequestObject) (api.GetRecurringTransactionsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	recurringTransactions, err := h.service.FetchRecurringTransactions(userID)
+	if err != nil {
+		return api.GetRecurringTransactions500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiRecurringTransactions := make([]api.RecurringTransaction, len(recurringTransactions))
+	for i, rt := range recurringTransactions {
+		apiRecurringTransactions[i] = toAPIRecurringTransaction(&rt)
+	}
+
+	return api.GetRecurringTransactions200JSONResponse{
+		RecurringTransactions: apiRecurringTransactions,
+	}, nil
+}
+
+// PostRecurringTransactions implements api.StrictServerInterface
+func (h *recurringTransactionsHandler) PostRecurringTransactions(ctx context.Context, request api.PostRecurringTransactionsRequestObject) (api.PostRecurringTransactionsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	recurringTransaction, err := h.service.CreateRecurringTransaction(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostRecurringTransactions400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PostRecurringTransactions400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		return api.PostRecurringTransactions500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostRecurringTransactions201JSONResponse{
+		RecurringTransaction: toAPIRecurringTransaction(recurringTransaction),
+	}, nil
+}
+
+// PostRecurringTransactionsGenerate implements api.StrictServerInterface
+func (h *recurringTransactionsHandler) PostRecurringTransactionsGenerate(ctx context.Context, request api.PostRecurringTransactionsGenerateRequestObject) (api.PostRecurringTransactionsGenerateResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	generated, err := h.service.GenerateTransactions(userID, request.Body)
+	if err != nil {
+		return api.PostRecurringTransactionsGenerate500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostRecurringTransactionsGenerate200JSONResponse{
+		GeneratedCount: int32(generated),
+	}, nil
+}
+
+// GetRecurringTransactionsId implements api.StrictServerInterface
+func (h *recurringTransactionsHandler) GetRecurringTransactionsId(ctx context.Context, request api.GetRecurringTransactionsIdRequestObject) (api.GetRecurringTransactionsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	recurringTransaction, err := h.service.FetchRecurringTransactionByID(uint(request.Id), userID)
+	if err != nil {
+		if errors.Is(err, services.ErrRecurringTransactionNotFound) {
+			return api.GetRecurringTransactionsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "定期取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.RECURRINGTRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		return api.GetRecurringTransactionsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetRecurringTransactionsId200JSONResponse{
+		RecurringTransaction: toAPIRecurringTransaction(recurringTransaction),
+	}, nil
+}
+
+// PatchRecurringTransactionsId implements api.StrictServerInterface
+func (h *recurringTransactionsHandler) PatchRecurringTransactionsId(ctx context.Context, request api.PatchRecurringTransactionsIdRequestObject) (api.PatchRecurringTransactionsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	recurringTransaction, err := h.service.UpdateRecurringTransaction(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PatchRecurringTransactionsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 定期取引が見つからない場合
+		if errors.Is(err, services.ErrRecurringTransactionNotFound) {
+			return api.PatchRecurringTransactionsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "定期取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.RECURRINGTRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PatchRecurringTransactionsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PatchRecurringTransactionsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PatchRecurringTransactionsId200JSONResponse{
+		RecurringTransaction: toAPIRecurringTransaction(recurringTransaction),
+	}, nil
+}
+
+// DeleteRecurringTransactionsId implements api.StrictServerInterface
+func (h *recurringTransactionsHandler) DeleteRecurringTransactionsId(ctx context.Context, request api.DeleteRecurringTransactionsIdRequestObject) (api.DeleteRecurringTransactionsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.DeleteRecurringTransaction(uint(request.Id), userID); err != nil {
+		// 定期取引が見つからない場合
+		if errors.Is(err, services.ErrRecurringTransactionNotFound) {
+			return api.DeleteRecurringTransactionsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "定期取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.RECURRINGTRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteRecurringTransactionsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteRecurringTransactionsId204Response{}, nil
+}
+
+// toAPIRecurringTransaction converts models.RecurringTransaction to api.RecurringTransaction
+func toAPIRecurringTransaction(rt *models.RecurringTransaction) api.RecurringTransaction {
+	result := api.RecurringTransaction{
+		Id:          int32(rt.ID),
+		UserId:      int32(rt.UserID),
+		CategoryId:  int32(rt.CategoryID),
+		Category:    toAPICategory(&rt.Category),
+		Amount:      int32(rt.Amount),
+		Description: rt.Description,
+		Frequency:   api.RecurrenceFrequency(rt.Frequency),
+		Interval:    int32(rt.Interval),
+		DayOfMonth:  toInt32Ptr(rt.DayOfMonth),
+		DayOfWeek:   toInt32Ptr(rt.DayOfWeek),
+		MonthOfYear: toInt32Ptr(rt.MonthOfYear),
+		StartDate:   types.Date{Time: rt.StartDate},
+		CreatedAt:   rt.CreatedAt,
+		UpdatedAt:   rt.UpdatedAt,
+	}
+	if rt.EndDate != nil {
+		result.EndDate = &types.Date{Time: *rt.EndDate}
+	}
+	if rt.GeneratedUntil != nil {
+		result.GeneratedUntil = &types.Date{Time: *rt.GeneratedUntil}
+	}
+	return result
+}
+
+func toInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/repositories"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// testRecurringTransactionID はテスト用のユーザーの定期取引
+const testRecurringTransactionID int32 = 20
+
+func newTestRecurringTransactionsHandler(t *testing.T) (RecurringTransactionsHandler, func(string) int) {
+	db, fake := newTestDB(t)
+	fake.Returns("FROM `recurring_transactions`",
+		[]string{"id", "user_id", "category_id", "amount", "frequency", "interval", "day_of_month", "start_date"},
+		[]driver.Value{int64(testRecurringTransactionID), int64(testUserID), int64(ownCategoryID), int64(80000), "monthly", int64(1), int64(25), time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC)},
+	)
+	service := services.NewRecurringTransactionService(repositories.NewRecurringTransactionRepository(db), repositories.NewUserRepository(db))
+	return NewRecurringTransactionsHandler(service), func(table string) int {
+		return len(fake.Statements("(INSERT INTO|UPDATE) `" + table + "`"))
+	}
+}
+
+func TestRecurringTransactionsForeignCategory(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryID int32
+	}{
+		{"他のユーザーのカテゴリ", foreignCategoryID},
+		{"存在しないカテゴリ", missingCategoryID},
+	}
+
+	dayOfMonth := int32(25)
+	for _, tt := range tests {
+		categoryID := tt.categoryID
+		t.Run(tt.name+"で登録", func(t *testing.T) {
+			handler, writes := newTestRecurringTransactionsHandler(t)
+			res, err := handler.PostRecurringTransactions(userContext(), api.PostRecurringTransactionsRequestObject{Body: &api.CreateRecurringTransactionInput{
+				Amount:     80000,
+				CategoryId: categoryID,
+				Frequency:  api.Monthly,
+				DayOfMonth: &dayOfMonth,
+				StartDate:  types.Date{Time: time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC)},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PostRecurringTransactions400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PostRecurringTransactions400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.CATEGORYNOTFOUND {
+				t.Errorf("reason = %s, want %s", reason, api.CATEGORYNOTFOUND)
+			}
+			if n := writes("recurring_transactions"); n > 0 {
+				t.Errorf("%d recurring transactions written", n)
+			}
+		})
+
+		t.Run(tt.name+"に更新", func(t *testing.T) {
+			handler, writes := newTestRecurringTransactionsHandler(t)
+			res, err := handler.PatchRecurringTransactionsId(userContext(), api.PatchRecurringTransactionsIdRequestObject{Id: testRecurringTransactionID, Body: &api.UpdateRecurringTransactionInput{
+				CategoryId: &categoryID,
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PatchRecurringTransactionsId400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PatchRecurringTransactionsId400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.CATEGORYNOTFOUND {
+				t.Errorf("reason = %s, want %s", reason, api.CATEGORYNOTFOUND)
+			}
+			if n := writes("recurring_transactions"); n > 0 {
+				t.Errorf("%d recurring transactions written", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type ReportsHandler interface {
+	// Get trend report
+	// (GET /reports/trends)
+	GetReportsTrends(ctx context.Context, request api.GetReportsTrendsRequestObject) (api.GetReportsTrendsResponseObject, error)
+}
+
+type reportsHandler struct {
+	service services.ReportService
+}
+
+func NewReportsHandler(service services.ReportService) ReportsHandler {
+	return &reportsHandler{service: service}
+}
+
+// GetReportsTrends implements api.StrictServerInterface
+func (h *reportsHandler) GetReportsTrends(ctx context.Context, request api.GetReportsTrendsRequestObject) (api.GetReportsTrendsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	report, err := h.service.FetchTrendReport(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetReportsTrends400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetReportsTrends500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	categories := make([]api.Category, len(report.Categories))
+	for i := range report.Categories {
+		categories[i] = toAPICategory(&report.Categories[i])
+	}
+
+	buckets := make([]api.TrendBucket, len(report.Buckets))
+	for i := range report.Buckets {
+		bucket := &report.Buckets[i]
+		items := make([]api.TrendCategoryItem, len(bucket.Categories))
+		for j := range bucket.Categories {
+			item := &bucket.Categories[j]
+			items[j] = api.TrendCategoryItem{
+				CategoryId: int32(item.CategoryID),
+				Income:     int32(item.Income),
+				Expense:    int32(item.Expense),
+				Net:        int32(item.Net),
+				Comparison: toAPITrendComparison(item.Comparison),
+			}
+		}
+		buckets[i] = api.TrendBucket{
+			StartDate:  types.Date{Time: bucket.StartDate},
+			EndDate:    types.Date{Time: bucket.EndDate},
+			Income:     int32(bucket.Income),
+			Expense:    int32(bucket.Expense),
+			Net:        int32(bucket.Net),
+			Categories: items,
+			Comparison: toAPITrendComparison(bucket.Comparison),
+		}
+	}
+
+	return api.GetReportsTrends200JSONResponse{
+		StartDate:  types.Date{Time: report.StartDate},
+		EndDate:    types.Date{Time: report.EndDate},
+		Period:     report.Period,
+		Compare:    report.Compare,
+		Categories: categories,
+		Buckets:    buckets,
+	}, nil
+}
+
+// toAPITrendComparison converts services.TrendComparison to api.TrendComparison
+func toAPITrendComparison(c *services.TrendComparison) *api.TrendComparison {
+	if c == nil {
+		return nil
+	}
+	return &api.TrendComparison{
+		StartDate:         types.Date{Time: c.StartDate},
+		EndDate:           types.Date{Time: c.EndDate},
+		Income:            int32(c.Income),
+		Expense:           int32(c.Expense),
+		Net:               int32(c.Net),
+		IncomeDelta:       int32(c.IncomeDelta),
+		ExpenseDelta:      int32(c.ExpenseDelta),
+		NetDelta:          int32(c.NetDelta),
+		IncomeChangeRate:  c.IncomeChangeRate,
+		ExpenseChangeRate: c.ExpenseChangeRate,
+	}
+}
@@ -0,0 +1,389 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type TagsHandler interface {
+	// Get tags
+	// (GET /tags)
+	GetTags(ctx context.Context, request api.GetTagsRequestObject) (api.GetTagsResponseObject, error)
+	// Create tag
+	// (POST /tags)
+	PostTags(ctx context.Context, request api.PostTagsRequestObject) (api.PostTagsResponseObject, error)
+	// Get tag summary
+	// (GET /tags/summary)
+	GetTagsSummary(ctx context.Context, request api.GetTagsSummaryRequestObject) (api.GetTagsSummaryResponseObject, error)
+	// Get tag by ID
+	// (GET /tags/{id})
+	GetTagsId(ctx context.Context, request api.GetTagsIdRequestObject) (api.GetTagsIdResponseObject, error)
+	// Update tag
+	// (PATCH /tags/{id})
+	PatchTagsId(ctx context.Context, request api.PatchTagsIdRequestObject) (api.PatchTagsIdResponseObject, error)
+	// Delete tag
+	// (DELETE /tags/{id})
+	DeleteTagsId(ctx context.Context, request api.DeleteTagsIdRequestObject) (api.DeleteTagsIdResponseObject, error)
+}
+
+type tagsHandler struct {
+	service services.TagService
+}
+
+func NewTagsHandler(service services.TagService) TagsHandler {
+	return &tagsHandler{service: service}
+}
+
+// GetTags implements api.StrictServerInterface
+func (h *tagsHandler) GetTags(ctx context.Context, request api.GetTagsRequestObject) (api.GetTagsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	tags, err := h.service.FetchTags(userID)
+	if err != nil {
+		return api.GetTags500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetTags200JSONResponse{
+		Tags: toAPITags(tags),
+	}, nil
+}
+
+// PostTags implements api.StrictServerInterface
+func (h *tagsHandler) PostTags(ctx context.Context, request api.PostTagsRequestObject) (api.PostTagsResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	tag, err := h.service.CreateTag(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostTags400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 同じ名前のタグが既に存在する場合
+		if errors.Is(err, services.ErrTagAlreadyExists) {
+			return api.PostTags409JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    409,
+					Message: "同じ名前のタグは既に存在します",
+					Status:  api.ALREADYEXISTS,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TAGALREADYEXISTS,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTags500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTags201JSONResponse{
+		Tag: toAPITag(tag),
+	}, nil
+}
+
+// GetTagsSummary implements api.StrictServerInterface
+func (h *tagsHandler) GetTagsSummary(ctx context.Context, request api.GetTagsSummaryRequestObject) (api.GetTagsSummaryResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	summary, err := h.service.FetchTagSummary(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetTagsSummary400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetTagsSummary500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	items := make([]api.TagSummaryItem, len(summary.Items))
+	for i, item := range summary.Items {
+		items[i] = api.TagSummaryItem{
+			TagId:            int32(item.Tag.ID),
+			Tag:              toAPITag(&summary.Items[i].Tag),
+			Income:           int32(item.Income),
+			Expense:          int32(item.Expense),
+			TransactionCount: int32(item.TransactionCount),
+		}
+	}
+
+	return api.GetTagsSummary200JSONResponse{
+		StartDate: types.Date{Time: summary.StartDate},
+		EndDate:   types.Date{Time: summary.EndDate},
+		Items:     items,
+	}, nil
+}
+
+// GetTagsId implements api.StrictServerInterface
+func (h *tagsHandler) GetTagsId(ctx context.Context, request api.GetTagsIdRequestObject) (api.GetTagsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	tag, err := h.service.FetchTagByID(uint(request.Id), userID)
+	if err != nil {
+		// タグが見つからない場合
+		if errors.Is(err, services.ErrTagNotFound) {
+			return api.GetTagsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "タグが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TAGNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetTagsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetTagsId200JSONResponse{
+		Tag: toAPITag(tag),
+	}, nil
+}
+
+// PatchTagsId implements api.StrictServerInterface
+func (h *tagsHandler) PatchTagsId(ctx context.Context, request api.PatchTagsIdRequestObject) (api.PatchTagsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	tag, err := h.service.UpdateTag(uint(request.Id), userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PatchTagsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// タグが見つからない場合
+		if errors.Is(err, services.ErrTagNotFound) {
+			return api.PatchTagsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "タグが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TAGNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 同じ名前のタグが既に存在する場合
+		if errors.Is(err, services.ErrTagAlreadyExists) {
+			return api.PatchTagsId409JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    409,
+					Message: "同じ名前のタグは既に存在します",
+					Status:  api.ALREADYEXISTS,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TAGALREADYEXISTS,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PatchTagsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PatchTagsId200JSONResponse{
+		Tag: toAPITag(tag),
+	}, nil
+}
+
+// DeleteTagsId implements api.StrictServerInterface
+func (h *tagsHandler) DeleteTagsId(ctx context.Context, request api.DeleteTagsIdRequestObject) (api.DeleteTagsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.DeleteTag(uint(request.Id), userID); err != nil {
+		// タグが見つからない場合
+		if errors.Is(err, services.ErrTagNotFound) {
+			return api.DeleteTagsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "タグが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TAGNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteTagsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteTagsId204Response{}, nil
+}
+
+// toAPITag converts models.Tag to api.Tag
+func toAPITag(t *models.Tag) api.Tag {
+	return api.Tag{
+		Id:        int32(t.ID),
+		UserId:    int32(t.UserID),
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// toAPITags converts []models.Tag to []api.Tag
+func toAPITags(tags []models.Tag) []api.Tag {
+	apiTags := make([]api.Tag, len(tags))
+	for i := range tags {
+		apiTags[i] = toAPITag(&tags[i])
+	}
+	return apiTags
+}
@@ -2,7 +2,10 @@ package handlers
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
 
 	api "apps/apis"
 	"apps/internal/helpers"
@@ -28,6 +31,27 @@ type TransactionsHandler interface {
 	// Delete transaction
 	// (DELETE /transactions/{id})
 	DeleteTransactionsId(ctx context.Context, request api.DeleteTransactionsIdRequestObject) (api.DeleteTransactionsIdResponseObject, error)
+	// Export transactions
+	// (GET /transactions/export)
+	GetTransactionsExport(ctx context.Context, request api.GetTransactionsExportRequestObject) (api.GetTransactionsExportResponseObject, error)
+	// Batch transactions
+	// (POST /transactions/batch)
+	PostTransactionsBatch(ctx context.Context, request api.PostTransactionsBatchRequestObject) (api.PostTransactionsBatchResponseObject, error)
+	// Recategorize transactions
+	// (POST /transactions/recategorize)
+	PostTransactionsRecategorize(ctx context.Context, request api.PostTransactionsRecategorizeRequestObject) (api.PostTransactionsRecategorizeResponseObject, error)
+	// Get transaction history
+	// (GET /transactions/{id}/history)
+	GetTransactionsIdHistory(ctx context.Context, request api.GetTransactionsIdHistoryRequestObject) (api.GetTransactionsIdHistoryResponseObject, error)
+	// Get duplicate transactions
+	// (GET /transactions/duplicates)
+	GetTransactionsDuplicates(ctx context.Context, request api.GetTransactionsDuplicatesRequestObject) (api.GetTransactionsDuplicatesResponseObject, error)
+	// Merge duplicate transactions
+	// (POST /transactions/duplicates/merge)
+	PostTransactionsDuplicatesMerge(ctx context.Context, request api.PostTransactionsDuplicatesMergeRequestObject) (api.PostTransactionsDuplicatesMergeResponseObject, error)
+	// Unlock reconciled transaction
+	// (POST /transactions/{id}/unlock)
+	PostTransactionsIdUnlock(ctx context.Context, request api.PostTransactionsIdUnlockRequestObject) (api.PostTransactionsIdUnlockResponseObject, error)
 }
 
 type transactionsHandler struct {
@@ -42,8 +66,47 @@ func NewTransactionsHandler(service services.TransactionService) TransactionsHan
 func (h *transactionsHandler) GetTransactions(ctx context.Context, request api.GetTransactionsRequestObject) (api.GetTransactionsResponseObject, error) {
 	userID, _ := helpers.ExtractUserID(ctx)
 
-	transactions, err := h.service.FetchTransactions(userID, &request.Params)
+	transactions, nextCursor, err := h.service.FetchTransactions(userID, &request.Params)
 	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetTransactions400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カーソルが不正な場合
+		if errors.Is(err, services.ErrInvalidCursor) {
+			return api.GetTransactions400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "ページの指定が正しくありません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.INVALIDCURSOR,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
 		return api.GetTransactions500JSONResponse{
 			Error: api.ErrorResponse{
 				Code:    500,
@@ -67,6 +130,7 @@ func (h *transactionsHandler) GetTransactions(ctx context.Context, request api.G
 
 	return api.GetTransactions200JSONResponse{
 		Transactions: apiTransactions,
+		NextCursor:   nextCursor,
 	}, nil
 }
 
@@ -96,6 +160,78 @@ func (h *transactionsHandler) PostTransactions(ctx context.Context, request api.
 			}, nil
 		}
 
+		// 口座が見つからない場合
+		if errors.Is(err, services.ErrAccountNotFound) {
+			return api.PostTransactions400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定された口座が見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// クレジットカードが見つからない場合
+		if errors.Is(err, services.ErrCreditCardNotFound) {
+			return api.PostTransactions400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたクレジットカードが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CREDITCARDNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// タグが見つからない場合
+		if errors.Is(err, services.ErrTagNotFound) {
+			return api.PostTransactions400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたタグが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TAGNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 為替レートが見つからない場合
+		if errors.Is(err, services.ErrExchangeRateNotFound) {
+			return api.PostTransactions400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "取引日以前の為替レートが登録されていません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.EXCHANGERATENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
 		// カテゴリが見つからない場合
 		if errors.Is(err, services.ErrCategoryNotFound) {
 			return api.PostTransactions400JSONResponse{
@@ -114,6 +250,27 @@ func (h *transactionsHandler) PostTransactions(ctx context.Context, request api.
 			}, nil
 		}
 
+		// 重複の疑いがある取引がある場合
+		var duplicateErr *services.DuplicateTransactionError
+		if errors.As(err, &duplicateErr) {
+			metadata := map[string]string{"candidate_ids": joinIDs(duplicateErr.CandidateIDs)}
+			return api.PostTransactions409JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    409,
+					Message: "同じ内容の取引が既に登録されています。登録する場合はもう一度送信してください",
+					Status:  api.ALREADYEXISTS,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.POSSIBLEDUPLICATETRANSACTION,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
 		return api.PostTransactions500JSONResponse{
 			Error: api.ErrorResponse{
 				Code:    500,
@@ -223,6 +380,96 @@ func (h *transactionsHandler) PatchTransactionsId(ctx context.Context, request a
 			}, nil
 		}
 
+		// 取引が照合済みの場合
+		if errors.Is(err, services.ErrTransactionLocked) {
+			return api.PatchTransactionsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "照合済みの取引は変更できません。ロックを解除してから操作してください",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONLOCKED,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 口座が見つからない場合
+		if errors.Is(err, services.ErrAccountNotFound) {
+			return api.PatchTransactionsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定された口座が見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// クレジットカードが見つからない場合
+		if errors.Is(err, services.ErrCreditCardNotFound) {
+			return api.PatchTransactionsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたクレジットカードが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CREDITCARDNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// タグが見つからない場合
+		if errors.Is(err, services.ErrTagNotFound) {
+			return api.PatchTransactionsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたタグが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TAGNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 為替レートが見つからない場合
+		if errors.Is(err, services.ErrExchangeRateNotFound) {
+			return api.PatchTransactionsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "取引日以前の為替レートが登録されていません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.EXCHANGERATENOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
 		// カテゴリが見つからない場合
 		if errors.Is(err, services.ErrCategoryNotFound) {
 			return api.PatchTransactionsId400JSONResponse{
@@ -286,6 +533,24 @@ func (h *transactionsHandler) DeleteTransactionsId(ctx context.Context, request
 			}, nil
 		}
 
+		// 取引が照合済みの場合
+		if errors.Is(err, services.ErrTransactionLocked) {
+			return api.DeleteTransactionsId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "照合済みの取引は変更できません。ロックを解除してから操作してください",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONLOCKED,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
 		// その他のエラー（データベースエラーなど）
 		return api.DeleteTransactionsId500JSONResponse{
 			Error: api.ErrorResponse{
@@ -306,25 +571,559 @@ func (h *transactionsHandler) DeleteTransactionsId(ctx context.Context, request
 	return api.DeleteTransactionsId204Response{}, nil
 }
 
-// toAPITransaction converts models.Transaction to api.Transaction
-func toAPITransaction(t *models.Transaction) api.Transaction {
-	return api.Transaction{
-		Id:         int32(t.ID),
-		UserId:     int32(t.UserID),
-		CategoryId: int32(t.CategoryID),
-		Category: api.Category{
-			Id:        int32(t.Category.ID),
-			UserId:    int32(t.Category.UserID),
-			Name:      t.Category.Name,
-			Type:      api.CategoryType(t.Category.Type),
-			Color:     t.Category.Color,
-			CreatedAt: t.Category.CreatedAt,
-			UpdatedAt: t.Category.UpdatedAt,
+// GetTransactionsExport implements api.StrictServerInterface
+func (h *transactionsHandler) GetTransactionsExport(ctx context.Context, request api.GetTransactionsExportRequestObject) (api.GetTransactionsExportResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	body, err := h.service.ExportTransactions(ctx, userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetTransactionsExport400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		return api.GetTransactionsExport500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	if request.Params.Format == api.Ndjson {
+		return api.GetTransactionsExport200ApplicationxNdjsonResponse{
+			Body: body,
+			Headers: api.GetTransactionsExport200ResponseHeaders{
+				ContentDisposition: `attachment; filename="transactions.ndjson"`,
+			},
+		}, nil
+	}
+
+	return api.GetTransactionsExport200TextcsvResponse{
+		Body: body,
+		Headers: api.GetTransactionsExport200ResponseHeaders{
+			ContentDisposition: `attachment; filename="transactions.csv"`,
 		},
-		Amount:      int32(t.Amount),
-		Date:        types.Date{Time: t.Date},
-		Description: t.Description,
-		CreatedAt:   t.CreatedAt,
-		UpdatedAt:   t.UpdatedAt,
+	}, nil
+}
+
+// PostTransactionsBatch implements api.StrictServerInterface
+func (h *transactionsHandler) PostTransactionsBatch(ctx context.Context, request api.PostTransactionsBatchRequestObject) (api.PostTransactionsBatchResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	result, err := h.service.BatchTransactions(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostTransactionsBatch400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTransactionsBatch500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	results := make([]api.BatchTransactionResult, len(result.Items))
+	for i, item := range result.Items {
+		results[i] = api.BatchTransactionResult{
+			Index:  int32(i),
+			Action: item.Action,
+			Status: api.Skipped,
+		}
+		switch {
+		case item.Errors != nil:
+			errs := helpers.ValidationErrorToMetadata(item.Errors)
+			results[i].Status = api.Failed
+			results[i].Errors = &errs
+		case result.Applied:
+			results[i].Status = api.Succeeded
+			if item.Transaction != nil {
+				transaction := toAPITransaction(item.Transaction)
+				results[i].Transaction = &transaction
+			}
+		}
+	}
+
+	return api.PostTransactionsBatch200JSONResponse{
+		Applied: result.Applied,
+		Results: results,
+	}, nil
+}
+
+// PostTransactionsRecategorize implements api.StrictServerInterface
+func (h *transactionsHandler) PostTransactionsRecategorize(ctx context.Context, request api.PostTransactionsRecategorizeRequestObject) (api.PostTransactionsRecategorizeResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	updated, err := h.service.RecategorizeTransactions(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostTransactionsRecategorize400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PostTransactionsRecategorize400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定されたカテゴリが見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTransactionsRecategorize500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTransactionsRecategorize200JSONResponse{
+		UpdatedCount: int32(updated),
+	}, nil
+}
+
+// GetTransactionsIdHistory implements api.StrictServerInterface
+func (h *transactionsHandler) GetTransactionsIdHistory(ctx context.Context, request api.GetTransactionsIdHistoryRequestObject) (api.GetTransactionsIdHistoryResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	histories, err := h.service.FetchTransactionHistory(uint(request.Id), userID)
+	if err != nil {
+		// 取引が見つからない場合
+		if errors.Is(err, services.ErrTransactionNotFound) {
+			return api.GetTransactionsIdHistory404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetTransactionsIdHistory500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiHistories := make([]api.ChangeHistory, len(histories))
+	for i := range histories {
+		apiHistories[i] = toAPIChangeHistory(&histories[i])
+	}
+
+	return api.GetTransactionsIdHistory200JSONResponse{
+		Histories: apiHistories,
+	}, nil
+}
+
+// GetTransactionsDuplicates implements api.StrictServerInterface
+func (h *transactionsHandler) GetTransactionsDuplicates(ctx context.Context, request api.GetTransactionsDuplicatesRequestObject) (api.GetTransactionsDuplicatesResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	groups, err := h.service.FetchDuplicateTransactions(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetTransactionsDuplicates400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetTransactionsDuplicates500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiGroups := make([]api.DuplicateTransactionGroup, len(groups))
+	for i, group := range groups {
+		transactions := make([]api.Transaction, len(group))
+		for j := range group {
+			transactions[j] = toAPITransaction(&group[j])
+		}
+		apiGroups[i] = api.DuplicateTransactionGroup{Transactions: transactions}
+	}
+
+	return api.GetTransactionsDuplicates200JSONResponse{
+		Groups: apiGroups,
+	}, nil
+}
+
+// PostTransactionsDuplicatesMerge implements api.StrictServerInterface
+func (h *transactionsHandler) PostTransactionsDuplicatesMerge(ctx context.Context, request api.PostTransactionsDuplicatesMergeRequestObject) (api.PostTransactionsDuplicatesMergeResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	transaction, err := h.service.MergeDuplicateTransactions(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostTransactionsDuplicatesMerge400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// いずれかの取引が見つからない場合
+		if errors.Is(err, services.ErrTransactionNotFound) {
+			return api.PostTransactionsDuplicatesMerge404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// いずれかの取引が照合済みの場合
+		if errors.Is(err, services.ErrTransactionLocked) {
+			return api.PostTransactionsDuplicatesMerge400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "照合済みの取引は変更できません。ロックを解除してから操作してください",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONLOCKED,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTransactionsDuplicatesMerge500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTransactionsDuplicatesMerge200JSONResponse{
+		Transaction: toAPITransaction(transaction),
+	}, nil
+}
+
+// PostTransactionsIdUnlock implements api.StrictServerInterface
+func (h *transactionsHandler) PostTransactionsIdUnlock(ctx context.Context, request api.PostTransactionsIdUnlockRequestObject) (api.PostTransactionsIdUnlockResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	transaction, err := h.service.UnlockTransaction(uint(request.Id), userID)
+	if err != nil {
+		// 取引が見つからない場合
+		if errors.Is(err, services.ErrTransactionNotFound) {
+			return api.PostTransactionsIdUnlock404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTransactionsIdUnlock500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTransactionsIdUnlock200JSONResponse{
+		Transaction: toAPITransaction(transaction),
+	}, nil
+}
+
+// toAPITransaction converts models.Transaction to api.Transaction
+func toAPITransaction(t *models.Transaction) api.Transaction {
+	var recurringTransactionID *int32
+	if t.RecurringTransactionID != nil {
+		id := int32(*t.RecurringTransactionID)
+		recurringTransactionID = &id
+	}
+
+	var accountID *int32
+	if t.AccountID != nil {
+		id := int32(*t.AccountID)
+		accountID = &id
+	}
+
+	var creditCardID *int32
+	if t.CreditCardID != nil {
+		id := int32(*t.CreditCardID)
+		creditCardID = &id
+	}
+
+	var installmentPlanID, installmentNumber *int32
+	if t.InstallmentPlanID != nil {
+		id := int32(*t.InstallmentPlanID)
+		installmentPlanID = &id
+	}
+	if t.InstallmentNumber != nil {
+		number := int32(*t.InstallmentNumber)
+		installmentNumber = &number
+	}
+
+	var exchangeRate *string
+	if t.ExchangeRate != nil {
+		rate := helpers.FormatRate(*t.ExchangeRate)
+		exchangeRate = &rate
+	}
+
+	splits := make([]api.TransactionSplit, len(t.Splits))
+	for i := range t.Splits {
+		split := &t.Splits[i]
+		splits[i] = api.TransactionSplit{
+			Id:             int32(split.ID),
+			CategoryId:     int32(split.CategoryID),
+			Category:       toAPICategory(&split.Category),
+			Amount:         int32(split.Amount),
+			OriginalAmount: int32(split.OriginalAmount),
+			Memo:           split.Memo,
+		}
+	}
+
+	var reconciliationID *int32
+	if t.ReconciliationID != nil {
+		id := int32(*t.ReconciliationID)
+		reconciliationID = &id
+	}
+
+	return api.Transaction{
+		Id:         int32(t.ID),
+		UserId:     int32(t.UserID),
+		CategoryId: int32(t.CategoryID),
+		Category: api.Category{
+			Id:             int32(t.Category.ID),
+			UserId:         int32(t.Category.UserID),
+			Name:           t.Category.Name,
+			Type:           api.CategoryType(t.Category.Type),
+			Color:          t.Category.Color,
+			BudgetRollover: api.BudgetRollover(t.Category.BudgetRollover),
+			CreatedAt:      t.Category.CreatedAt,
+			UpdatedAt:      t.Category.UpdatedAt,
+		},
+		RecurringTransactionId: recurringTransactionID,
+		InstallmentPlanId:      installmentPlanID,
+		InstallmentNumber:      installmentNumber,
+		AccountId:              accountID,
+		CreditCardId:           creditCardID,
+		Cleared:                t.Cleared,
+		ReconciliationId:       reconciliationID,
+		Amount:                 int32(t.Amount),
+		Currency:               t.Currency,
+		OriginalAmount:         int32(t.OriginalAmount),
+		ExchangeRate:           exchangeRate,
+		Date:                   types.Date{Time: t.Date},
+		Description:            t.Description,
+		Splits:                 splits,
+		Tags:                   toAPITags(t.Tags),
+		CreatedAt:              t.CreatedAt,
+		UpdatedAt:              t.UpdatedAt,
+	}
+}
+
+// toAPIChangeHistory converts models.ChangeHistory to api.ChangeHistory
+func toAPIChangeHistory(history *models.ChangeHistory) api.ChangeHistory {
+	return api.ChangeHistory{
+		Id:         int32(history.ID),
+		RecordType: api.ChangeRecordType(history.RecordType),
+		RecordId:   int32(history.RecordID),
+		Action:     api.ChangeAction(history.Action),
+		Before:     toAPIChangeValues(history.Before),
+		After:      toAPIChangeValues(history.After),
+		CreatedAt:  history.CreatedAt,
+	}
+}
+
+// toAPIChangeValues は変更履歴に記録したJSONを項目名と値のマップに変換する。記録がない場合は nil を返す
+// NOTE: 変更履歴のJSONはRepository層で作成したオブジェクトのため、変換に失敗した場合も nil とする
+func toAPIChangeValues(raw json.RawMessage) *map[string]interface{} {
+	if len(raw) == 0 {
+		return nil
+	}
+	var values map[string]interface{}
+	if err := json.Unmarshal(raw, &values); err != nil || values == nil {
+		return nil
+	}
+	return &values
+}
+
+// joinIDs はIDをカンマ区切りの文字列に変換する
+func joinIDs(ids []uint) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatUint(uint64(id), 10)
 	}
+	return strings.Join(s, ",")
 }
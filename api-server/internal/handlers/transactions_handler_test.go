@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/repositories"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// testTransactionID はテスト用のユーザーの取引（ownCategoryID のカテゴリ、3000円）
+const testTransactionID int32 = 10
+
+func newTestTransactionsHandler(t *testing.T) (TransactionsHandler, func(string) int) {
+	db, fake := newTestDB(t)
+	fake.Returns("FROM `transactions`",
+		[]string{"id", "user_id", "category_id", "amount", "currency", "original_amount", "date"},
+		[]driver.Value{int64(testTransactionID), int64(testUserID), int64(ownCategoryID), int64(3000), "JPY", int64(3000), time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)},
+	)
+	service := services.NewTransactionService(
+		repositories.NewTransactionRepository(db),
+		repositories.NewAccountRepository(db),
+		repositories.NewCreditCardRepository(db),
+		repositories.NewTagRepository(db),
+		repositories.NewUserRepository(db),
+		repositories.NewExchangeRateRepository(db),
+		repositories.NewChangeHistoryRepository(db),
+		repositories.NewCategorizationRuleRepository(db),
+	)
+	return NewTransactionsHandler(service), func(table string) int {
+		return len(fake.Statements("(INSERT INTO|UPDATE) `" + table + "`"))
+	}
+}
+
+func TestPostTransactionsForeignSplitCategory(t *testing.T) {
+	tests := []struct {
+		name            string
+		splitCategoryID int32
+	}{
+		{"他のユーザーのカテゴリ", foreignCategoryID},
+		{"存在しないカテゴリ", missingCategoryID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, writes := newTestTransactionsHandler(t)
+			force := true
+			res, err := handler.PostTransactions(userContext(), api.PostTransactionsRequestObject{Body: &api.CreateTransactionInput{
+				Amount:     3000,
+				CategoryId: ownCategoryID,
+				Date:       types.Date{Time: time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)},
+				Force:      &force,
+				Splits: &[]api.TransactionSplitInput{
+					{Amount: 2000, CategoryId: ownSplitCategoryID},
+					{Amount: 1000, CategoryId: tt.splitCategoryID},
+				},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PostTransactions400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PostTransactions400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.CATEGORYNOTFOUND {
+				t.Errorf("reason = %s, want %s", reason, api.CATEGORYNOTFOUND)
+			}
+			if n := writes("transactions"); n > 0 {
+				t.Errorf("%d transactions written", n)
+			}
+		})
+	}
+}
+
+func TestPatchTransactionsIdForeignSplitCategory(t *testing.T) {
+	tests := []struct {
+		name            string
+		splitCategoryID int32
+	}{
+		{"他のユーザーのカテゴリ", foreignCategoryID},
+		{"存在しないカテゴリ", missingCategoryID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, writes := newTestTransactionsHandler(t)
+			res, err := handler.PatchTransactionsId(userContext(), api.PatchTransactionsIdRequestObject{Id: testTransactionID, Body: &api.UpdateTransactionInput{
+				Splits: &[]api.TransactionSplitInput{
+					{Amount: 2000, CategoryId: ownSplitCategoryID},
+					{Amount: 1000, CategoryId: tt.splitCategoryID},
+				},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PatchTransactionsId400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PatchTransactionsId400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.CATEGORYNOTFOUND {
+				t.Errorf("reason = %s, want %s", reason, api.CATEGORYNOTFOUND)
+			}
+			if n := writes("transaction_splits"); n > 0 {
+				t.Errorf("%d splits written", n)
+			}
+		})
+	}
+}
+
+func TestPostTransactionsRecategorizeForeignCategory(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryID int32
+	}{
+		{"他のユーザーのカテゴリ", foreignCategoryID},
+		{"存在しないカテゴリ", missingCategoryID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, writes := newTestTransactionsHandler(t)
+			from := ownCategoryID
+			res, err := handler.PostTransactionsRecategorize(userContext(), api.PostTransactionsRecategorizeRequestObject{Body: &api.RecategorizeTransactionsInput{
+				CategoryId: tt.categoryID,
+				Filter:     api.RecategorizeTransactionsFilter{CategoryId: &from},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PostTransactionsRecategorize400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PostTransactionsRecategorize400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.CATEGORYNOTFOUND {
+				t.Errorf("reason = %s, want %s", reason, api.CATEGORYNOTFOUND)
+			}
+			if n := writes("transactions") + writes("transaction_splits"); n > 0 {
+				t.Errorf("%d transaction rows written", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/services"
+
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type TransfersHandler interface {
+	// Get transfers
+	// (GET /transfers)
+	GetTransfers(ctx context.Context, request api.GetTransfersRequestObject) (api.GetTransfersResponseObject, error)
+	// Create transfer
+	// (POST /transfers)
+	PostTransfers(ctx context.Context, request api.PostTransfersRequestObject) (api.PostTransfersResponseObject, error)
+	// Get transfer by ID
+	// (GET /transfers/{id})
+	GetTransfersId(ctx context.Context, request api.GetTransfersIdRequestObject) (api.GetTransfersIdResponseObject, error)
+	// Delete transfer
+	// (DELETE /transfers/{id})
+	DeleteTransfersId(ctx context.Context, request api.DeleteTransfersIdRequestObject) (api.DeleteTransfersIdResponseObject, error)
+}
+
+type transfersHandler struct {
+	service services.TransferService
+}
+
+func NewTransfersHandler(service services.TransferService) TransfersHandler {
+	return &transfersHandler{service: service}
+}
+
+// GetTransfers implements api.StrictServerInterface
+func (h *transfersHandler) GetTransfers(ctx context.Context, request api.GetTransfersRequestObject) (api.GetTransfersResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	transfers, err := h.service.FetchTransfers(userID, &request.Params)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.GetTransfers400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetTransfers500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	apiTransfers := make([]api.Transfer, len(transfers))
+	for i := range transfers {
+		apiTransfers[i] = toAPITransfer(&transfers[i])
+	}
+
+	return api.GetTransfers200JSONResponse{
+		Transfers: apiTransfers,
+	}, nil
+}
+
+// PostTransfers implements api.StrictServerInterface
+func (h *transfersHandler) PostTransfers(ctx context.Context, request api.PostTransfersRequestObject) (api.PostTransfersResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	transfer, err := h.service.CreateTransfer(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PostTransfers400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 口座が見つからない場合
+		if errors.Is(err, services.ErrAccountNotFound) {
+			return api.PostTransfers400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "指定された口座が見つかりません",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.ACCOUNTNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTransfers500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTransfers201JSONResponse{
+		Transfer: toAPITransfer(transfer),
+	}, nil
+}
+
+// GetTransfersId implements api.StrictServerInterface
+func (h *transfersHandler) GetTransfersId(ctx context.Context, request api.GetTransfersIdRequestObject) (api.GetTransfersIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	transfer, err := h.service.FetchTransferByID(uint(request.Id), userID)
+	if err != nil {
+		// 振替が見つからない場合
+		if errors.Is(err, services.ErrTransferNotFound) {
+			return api.GetTransfersId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "振替が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSFERNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetTransfersId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetTransfersId200JSONResponse{
+		Transfer: toAPITransfer(transfer),
+	}, nil
+}
+
+// DeleteTransfersId implements api.StrictServerInterface
+func (h *transfersHandler) DeleteTransfersId(ctx context.Context, request api.DeleteTransfersIdRequestObject) (api.DeleteTransfersIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.DeleteTransfer(uint(request.Id), userID); err != nil {
+		// 振替が見つからない場合
+		if errors.Is(err, services.ErrTransferNotFound) {
+			return api.DeleteTransfersId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "振替が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSFERNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteTransfersId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteTransfersId204Response{}, nil
+}
+
+// toAPITransfer converts models.Transfer to api.Transfer
+func toAPITransfer(t *models.Transfer) api.Transfer {
+	return api.Transfer{
+		Id:            int32(t.ID),
+		UserId:        int32(t.UserID),
+		FromAccountId: int32(t.FromAccountID),
+		FromAccount:   toAPIAccount(&t.FromAccount),
+		ToAccountId:   int32(t.ToAccountID),
+		ToAccount:     toAPIAccount(&t.ToAccount),
+		Amount:        int32(t.Amount),
+		Date:          types.Date{Time: t.Date},
+		Description:   t.Description,
+		CreatedAt:     t.CreatedAt,
+		UpdatedAt:     t.UpdatedAt,
+	}
+}
@@ -0,0 +1,442 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/services"
+)
+
+type TrashHandler interface {
+	// Get trash
+	// (GET /trash)
+	GetTrash(ctx context.Context, request api.GetTrashRequestObject) (api.GetTrashResponseObject, error)
+	// Purge budget
+	// (DELETE /trash/budgets/{id})
+	DeleteTrashBudgetsId(ctx context.Context, request api.DeleteTrashBudgetsIdRequestObject) (api.DeleteTrashBudgetsIdResponseObject, error)
+	// Restore budget
+	// (POST /trash/budgets/{id}/restore)
+	PostTrashBudgetsIdRestore(ctx context.Context, request api.PostTrashBudgetsIdRestoreRequestObject) (api.PostTrashBudgetsIdRestoreResponseObject, error)
+	// Purge category
+	// (DELETE /trash/categories/{id})
+	DeleteTrashCategoriesId(ctx context.Context, request api.DeleteTrashCategoriesIdRequestObject) (api.DeleteTrashCategoriesIdResponseObject, error)
+	// Restore category
+	// (POST /trash/categories/{id}/restore)
+	PostTrashCategoriesIdRestore(ctx context.Context, request api.PostTrashCategoriesIdRestoreRequestObject) (api.PostTrashCategoriesIdRestoreResponseObject, error)
+	// Purge transaction
+	// (DELETE /trash/transactions/{id})
+	DeleteTrashTransactionsId(ctx context.Context, request api.DeleteTrashTransactionsIdRequestObject) (api.DeleteTrashTransactionsIdResponseObject, error)
+	// Restore transaction
+	// (POST /trash/transactions/{id}/restore)
+	PostTrashTransactionsIdRestore(ctx context.Context, request api.PostTrashTransactionsIdRestoreRequestObject) (api.PostTrashTransactionsIdRestoreResponseObject, error)
+}
+
+type trashHandler struct {
+	service services.TrashService
+}
+
+func NewTrashHandler(service services.TrashService) TrashHandler {
+	return &trashHandler{service: service}
+}
+
+// GetTrash implements api.StrictServerInterface
+func (h *trashHandler) GetTrash(ctx context.Context, request api.GetTrashRequestObject) (api.GetTrashResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	trash, err := h.service.FetchTrash(userID)
+	if err != nil {
+		// その他のエラー（データベースエラーなど）
+		return api.GetTrash500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	transactions := make([]api.TrashedTransaction, len(trash.Transactions))
+	for i := range trash.Transactions {
+		t := &trash.Transactions[i]
+		transactions[i] = api.TrashedTransaction{
+			Transaction: toAPITransaction(t),
+			DeletedAt:   t.DeletedAt.Time,
+			PurgeAt:     trash.PurgeAt(t.DeletedAt.Time),
+		}
+	}
+
+	budgets := make([]api.TrashedBudget, len(trash.Budgets))
+	for i := range trash.Budgets {
+		b := &trash.Budgets[i]
+		budgets[i] = api.TrashedBudget{
+			Budget:    toAPIBudget(b),
+			DeletedAt: b.DeletedAt.Time,
+			PurgeAt:   trash.PurgeAt(b.DeletedAt.Time),
+		}
+	}
+
+	categories := make([]api.TrashedCategory, len(trash.Categories))
+	for i := range trash.Categories {
+		c := &trash.Categories[i]
+		categories[i] = api.TrashedCategory{
+			Category:  toAPICategory(c),
+			DeletedAt: c.DeletedAt.Time,
+			PurgeAt:   trash.PurgeAt(c.DeletedAt.Time),
+		}
+	}
+
+	return api.GetTrash200JSONResponse{
+		Transactions:  transactions,
+		Budgets:       budgets,
+		Categories:    categories,
+		RetentionDays: int32(trash.Retention.Hours() / 24),
+	}, nil
+}
+
+// DeleteTrashBudgetsId implements api.StrictServerInterface
+func (h *trashHandler) DeleteTrashBudgetsId(ctx context.Context, request api.DeleteTrashBudgetsIdRequestObject) (api.DeleteTrashBudgetsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.PurgeBudget(uint(request.Id), userID); err != nil {
+		// ゴミ箱に予算が見つからない場合
+		if errors.Is(err, services.ErrBudgetNotFound) {
+			return api.DeleteTrashBudgetsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "予算が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteTrashBudgetsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteTrashBudgetsId204Response{}, nil
+}
+
+// PostTrashBudgetsIdRestore implements api.StrictServerInterface
+func (h *trashHandler) PostTrashBudgetsIdRestore(ctx context.Context, request api.PostTrashBudgetsIdRestoreRequestObject) (api.PostTrashBudgetsIdRestoreResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	budget, err := h.service.RestoreBudget(uint(request.Id), userID)
+	if err != nil {
+		// ゴミ箱に予算が見つからない場合
+		if errors.Is(err, services.ErrBudgetNotFound) {
+			return api.PostTrashBudgetsIdRestore404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "予算が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリがゴミ箱にある場合
+		if errors.Is(err, services.ErrCategoryInTrash) {
+			return api.PostTrashBudgetsIdRestore400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "カテゴリがゴミ箱にあるため元に戻せません。先にカテゴリを元に戻してください",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYINTRASH,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 同じカテゴリ・月の予算が既に存在する場合
+		if errors.Is(err, services.ErrBudgetAlreadyExists) {
+			return api.PostTrashBudgetsIdRestore409JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    409,
+					Message: "この月のこのカテゴリの予算は既に存在します",
+					Status:  api.ALREADYEXISTS,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BUDGETALREADYEXISTS,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTrashBudgetsIdRestore500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTrashBudgetsIdRestore200JSONResponse{
+		Budget: toAPIBudget(budget),
+	}, nil
+}
+
+// DeleteTrashCategoriesId implements api.StrictServerInterface
+func (h *trashHandler) DeleteTrashCategoriesId(ctx context.Context, request api.DeleteTrashCategoriesIdRequestObject) (api.DeleteTrashCategoriesIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.PurgeCategory(uint(request.Id), userID); err != nil {
+		// ゴミ箱にカテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.DeleteTrashCategoriesId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "カテゴリが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// ゴミ箱にある取引・予算から参照されている場合
+		if errors.Is(err, services.ErrCategoryInUse) {
+			return api.DeleteTrashCategoriesId400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "このカテゴリはゴミ箱にある取引・予算で使用中のため削除できません",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYINUSE,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteTrashCategoriesId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteTrashCategoriesId204Response{}, nil
+}
+
+// PostTrashCategoriesIdRestore implements api.StrictServerInterface
+func (h *trashHandler) PostTrashCategoriesIdRestore(ctx context.Context, request api.PostTrashCategoriesIdRestoreRequestObject) (api.PostTrashCategoriesIdRestoreResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	category, err := h.service.RestoreCategory(uint(request.Id), userID)
+	if err != nil {
+		// ゴミ箱にカテゴリが見つからない場合
+		if errors.Is(err, services.ErrCategoryNotFound) {
+			return api.PostTrashCategoriesIdRestore404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "カテゴリが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTrashCategoriesIdRestore500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTrashCategoriesIdRestore200JSONResponse{
+		Category: toAPICategory(category),
+	}, nil
+}
+
+// DeleteTrashTransactionsId implements api.StrictServerInterface
+func (h *trashHandler) DeleteTrashTransactionsId(ctx context.Context, request api.DeleteTrashTransactionsIdRequestObject) (api.DeleteTrashTransactionsIdResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	if err := h.service.PurgeTransaction(uint(request.Id), userID); err != nil {
+		// ゴミ箱に取引が見つからない場合
+		if errors.Is(err, services.ErrTransactionNotFound) {
+			return api.DeleteTrashTransactionsId404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.DeleteTrashTransactionsId500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.DeleteTrashTransactionsId204Response{}, nil
+}
+
+// PostTrashTransactionsIdRestore implements api.StrictServerInterface
+func (h *trashHandler) PostTrashTransactionsIdRestore(ctx context.Context, request api.PostTrashTransactionsIdRestoreRequestObject) (api.PostTrashTransactionsIdRestoreResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	transaction, err := h.service.RestoreTransaction(uint(request.Id), userID)
+	if err != nil {
+		// ゴミ箱に取引が見つからない場合
+		if errors.Is(err, services.ErrTransactionNotFound) {
+			return api.PostTrashTransactionsIdRestore404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "取引が見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.TRANSACTIONNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// カテゴリがゴミ箱にある場合
+		if errors.Is(err, services.ErrCategoryInTrash) {
+			return api.PostTrashTransactionsIdRestore400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "カテゴリがゴミ箱にあるため元に戻せません。先にカテゴリを元に戻してください",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.CATEGORYINTRASH,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PostTrashTransactionsIdRestore500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PostTrashTransactionsIdRestore200JSONResponse{
+		Transaction: toAPITransaction(transaction),
+	}, nil
+}
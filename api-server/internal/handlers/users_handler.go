@@ -3,6 +3,7 @@ package handlers
 import (
 	api "apps/apis"
 	"apps/internal/helpers"
+	"apps/internal/models"
 	"apps/internal/services"
 	"context"
 	"errors"
@@ -20,6 +21,12 @@ type UsersHandler interface {
 	// User CheckSignedIn
 	// (GET /users/checkSignedIn)
 	GetUsersCheckSignedIn(ctx context.Context, request api.GetUsersCheckSignedInRequestObject) (api.GetUsersCheckSignedInResponseObject, error)
+	// Get Current User
+	// (GET /users/me)
+	GetUsersMe(ctx context.Context, request api.GetUsersMeRequestObject) (api.GetUsersMeResponseObject, error)
+	// Update Current User
+	// (PATCH /users/me)
+	PatchUsersMe(ctx context.Context, request api.PatchUsersMeRequestObject) (api.PatchUsersMeResponseObject, error)
 	// User SignOut
 	// (POST /users/signOut)
 	PostUsersSignOut(ctx context.Context, request api.PostUsersSignOutRequestObject) (api.PostUsersSignOutResponseObject, error)
@@ -214,6 +221,134 @@ func (uh *usersHandler) GetUsersCheckSignedIn(ctx context.Context, request api.G
 	}, nil
 }
 
+func (uh *usersHandler) GetUsersMe(ctx context.Context, request api.GetUsersMeRequestObject) (api.GetUsersMeResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	user, err := uh.userService.FetchCurrentUser(userID)
+	if err != nil {
+		// ユーザーが見つからない場合
+		if errors.Is(err, services.ErrUserNotFound) {
+			return api.GetUsersMe404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "ユーザーが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.USERNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.GetUsersMe500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.GetUsersMe200JSONResponse{
+		User: toAPIUser(user),
+	}, nil
+}
+
+func (uh *usersHandler) PatchUsersMe(ctx context.Context, request api.PatchUsersMeRequestObject) (api.PatchUsersMeResponseObject, error) {
+	userID, _ := helpers.ExtractUserID(ctx)
+
+	user, err := uh.userService.UpdateCurrentUser(userID, request.Body)
+	if err != nil {
+		// バリデーションエラーの場合
+		metadata := helpers.ValidationErrorToMetadata(err)
+		if len(metadata) > 0 {
+			return api.PatchUsersMe400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "入力内容に誤りがあります",
+					Status:  api.INVALIDARGUMENT,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:     api.ErrorInfoTypeErrorInfo,
+							Reason:   api.VALIDATIONERROR,
+							Domain:   "budget-calendar.example.com",
+							Metadata: &metadata,
+						},
+					},
+				},
+			}, nil
+		}
+
+		// ユーザーが見つからない場合
+		if errors.Is(err, services.ErrUserNotFound) {
+			return api.PatchUsersMe404JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    404,
+					Message: "ユーザーが見つかりません",
+					Status:  api.NOTFOUND,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.USERNOTFOUND,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// 基準通貨の金額を保持しているデータがあり基準通貨を変更できない場合
+		if errors.Is(err, services.ErrBaseCurrencyInUse) {
+			return api.PatchUsersMe400JSONResponse{
+				Error: api.ErrorResponse{
+					Code:    400,
+					Message: "取引や予算などが登録されているため基準通貨を変更できません",
+					Status:  api.FAILEDPRECONDITION,
+					Details: &[]api.ErrorInfo{
+						{
+							Type:   api.ErrorInfoTypeErrorInfo,
+							Reason: api.BASECURRENCYINUSE,
+							Domain: "budget-calendar.example.com",
+						},
+					},
+				},
+			}, nil
+		}
+
+		// その他のエラー（データベースエラーなど）
+		return api.PatchUsersMe500JSONResponse{
+			Error: api.ErrorResponse{
+				Code:    500,
+				Message: "エラーが発生しました",
+				Status:  api.INTERNAL,
+				Details: &[]api.ErrorInfo{
+					{
+						Type:   api.ErrorInfoTypeErrorInfo,
+						Reason: api.DATABASEERROR,
+						Domain: "budget-calendar.example.com",
+					},
+				},
+			},
+		}, nil
+	}
+
+	return api.PatchUsersMe200JSONResponse{
+		User: toAPIUser(user),
+	}, nil
+}
+
 func (uh *usersHandler) PostUsersSignOut(ctx context.Context, request api.PostUsersSignOutRequestObject) (api.PostUsersSignOutResponseObject, error) {
 	var sameSite http.SameSite
 	if os.Getenv("APP_ENV") == "production" {
@@ -243,3 +378,18 @@ func (uh *usersHandler) PostUsersSignOut(ctx context.Context, request api.PostUs
 		},
 	}, nil
 }
+
+// toAPIUser converts models.User to api.ModelsUser
+func toAPIUser(u *models.User) api.ModelsUser {
+	return api.ModelsUser{
+		Id:                   int32(u.ID),
+		Name:                 u.Name,
+		Email:                u.Email,
+		BaseCurrency:         u.BaseCurrency,
+		TimeZone:             u.TimeZone,
+		MonthStartDay:        int32(u.MonthStartDay),
+		MonthStartAdjustment: api.ModelsMonthStartAdjustment(u.MonthStartAdjustment),
+		CreatedAt:            u.CreatedAt,
+		UpdatedAt:            u.UpdatedAt,
+	}
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"database/sql/driver"
+	"testing"
+
+	api "apps/apis"
+	"apps/internal/repositories"
+	"apps/internal/services"
+	"apps/storage"
+)
+
+func TestPatchUsersMeBaseCurrencyInUse(t *testing.T) {
+	// 基準通貨の補助単位で金額を保持しているデータ（テーブルと件数を数えるクエリの条件）
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"取引", "FROM `transactions`"},
+		{"分割払い", "FROM `installment_plans`"},
+		{"定期取引", "FROM `recurring_transactions`"},
+		{"照合", "FROM `reconciliations`"},
+		{"振替", "FROM `transfers`"},
+		{"予算", "FROM `budgets`"},
+		{"予算テンプレート", "FROM `budget_templates`"},
+		{"金額条件のある自動分類ルール", "FROM `categorization_rules` WHERE .*min_amount IS NOT NULL OR max_amount IS NOT NULL"},
+		{"初期残高のある口座", "FROM `accounts` WHERE .*opening_balance <> 0"},
+	}
+
+	usd := "USD"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, fake := newTestDB(t)
+			fake.Returns("SELECT count\\(\\*\\) "+tt.query, []string{"count"}, []driver.Value{int64(1)})
+			handler := NewUsersHandler(services.NewUserService(repositories.NewUserRepository(db), storage.NewLocalStorage(t.TempDir())))
+
+			res, err := handler.PatchUsersMe(userContext(), api.PatchUsersMeRequestObject{Body: &api.UserUpdateCurrentUserInput{BaseCurrency: &usd}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, ok := res.(api.PatchUsersMe400JSONResponse)
+			if !ok {
+				t.Fatalf("response = %T, want PatchUsersMe400JSONResponse", res)
+			}
+			if reason := errorReason(t, api.ErrorBody(got)); reason != api.BASECURRENCYINUSE {
+				t.Errorf("reason = %s, want %s", reason, api.BASECURRENCYINUSE)
+			}
+			if n := len(fake.Statements("^UPDATE `users`")); n != 0 {
+				t.Errorf("users updated %d times, want 0", n)
+			}
+		})
+	}
+
+	t.Run("金額を保持しているデータがない", func(t *testing.T) {
+		db, fake := newTestDB(t)
+		handler := NewUsersHandler(services.NewUserService(repositories.NewUserRepository(db), storage.NewLocalStorage(t.TempDir())))
+
+		res, err := handler.PatchUsersMe(userContext(), api.PatchUsersMeRequestObject{Body: &api.UserUpdateCurrentUserInput{BaseCurrency: &usd}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := res.(api.PatchUsersMe200JSONResponse); !ok {
+			t.Fatalf("response = %T, want PatchUsersMe200JSONResponse", res)
+		}
+		updates := fake.Statements("^UPDATE `users` SET `base_currency`=\\?")
+		if len(updates) != 1 || updates[0].Args[0] != usd {
+			t.Errorf("users updates = %v, want base_currency = %s", updates, usd)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"math/big"
+	"strings"
+)
+
+// currencyMinorUnits は ISO 4217 の通貨コードごとの補助単位の桁数（例: JPY は0桁、USD は2桁、KWD は3桁）
+// 金額はすべて補助単位の整数（USD であればセント）で扱う
+var currencyMinorUnits = map[string]int{}
+
+func init() {
+	for _, code := range strings.Fields(`BIF CLP DJF GNF ISK JPY KMF KRW PYG RWF UGX VND VUV XAF XOF XPF`) {
+		currencyMinorUnits[code] = 0
+	}
+	for _, code := range strings.Fields(`
+		AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BMD BND BOB BRL BSD BTN BWP BYN BZD
+		CAD CDF CHF CNY COP CRC CUP CVE CZK DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD
+		GTQ GYD HKD HNL HTG HUF IDR ILS INR IRR JMD KES KGS KHR KPW KYD KZT LAK LBP LKR LRD LSL MAD
+		MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MYR MZN NAD NGN NIO NOK NPR NZD PAB PEN PGK PHP
+		PKR PLN QAR RON RSD RUB SAR SBD SCR SDG SEK SGD SHP SLE SOS SRD SSP STN SVC SYP SZL THB TJS
+		TMT TOP TRY TTD TWD TZS UAH USD UYU UZS VES WST XCD YER ZAR ZMW ZWG`) {
+		currencyMinorUnits[code] = 2
+	}
+	for _, code := range strings.Fields(`BHD IQD JOD KWD LYD OMR TND`) {
+		currencyMinorUnits[code] = 3
+	}
+}
+
+// IsCurrency は ISO 4217 の通貨コードか判定する
+func IsCurrency(code string) bool {
+	_, ok := currencyMinorUnits[code]
+	return ok
+}
+
+// ParseRate は "151.25" 形式の為替レートを変換する。正の数でない場合は false を返す
+func ParseRate(rate string) (*big.Rat, bool) {
+	r, ok := new(big.Rat).SetString(rate)
+	if !ok || r.Sign() <= 0 {
+		return nil, false
+	}
+	return r, true
+}
+
+// FormatRate はDBの DECIMAL 型の為替レートから末尾の0を取り除く（例: "151.2500000000" → "151.25"）
+func FormatRate(rate string) string {
+	if !strings.Contains(rate, ".") {
+		return rate
+	}
+	return strings.TrimSuffix(strings.TrimRight(rate, "0"), ".")
+}
+
+// ConvertCurrency は from の補助単位の金額を、1 from = rate to のレートで to の補助単位の金額に換算する
+// 端数は四捨五入する（例: 1234 USD セント、レート 150.5 → 1857 JPY）
+func ConvertCurrency(amount int, from, to string, rate *big.Rat) int {
+	value := new(big.Rat).Mul(big.NewRat(int64(amount), 1), rate)
+	value.Mul(value, new(big.Rat).SetFrac(pow10(currencyMinorUnits[to]), pow10(currencyMinorUnits[from])))
+	return RoundRat(value)
+}
+
+// RoundRat は value を整数に四捨五入する（0から遠い方へ丸める）
+func RoundRat(value *big.Rat) int {
+	quo, rem := new(big.Int).QuoRem(value.Num(), value.Denom(), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(value.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(int64(value.Num().Sign())))
+	}
+	return int(quo.Int64())
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
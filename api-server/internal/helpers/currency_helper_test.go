@@ -0,0 +1,77 @@
+package helpers
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestConvertCurrency(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int
+		from   string
+		to     string
+		rate   string
+		want   int
+	}{
+		// USD（2桁）→ JPY（0桁）
+		{"USD → JPY", 1234, "USD", "JPY", "150.5", 1857},
+		{"USD → JPY 0.5円は切り上げ", 100, "USD", "JPY", "150.5", 151},
+		{"USD → JPY 負の0.5円は0から遠い方へ", -100, "USD", "JPY", "150.5", -151},
+		{"USD → JPY 少額", 5, "USD", "JPY", "150", 8},
+		{"USD → JPY 負の少額", -5, "USD", "JPY", "150", -8},
+		// JPY（0桁）→ USD（2桁）
+		{"JPY → USD", 1000, "JPY", "USD", "0.0066", 660},
+		{"JPY → USD 0.665セント", 1, "JPY", "USD", "0.00665", 1},
+		// 3桁の通貨
+		{"KWD → JPY", 1000, "KWD", "JPY", "490.125", 490},
+		{"USD → KWD", 12345, "USD", "KWD", "0.3075", 37961},
+		{"JPY → KWD 4.5フィルス", 2, "JPY", "KWD", "0.00225", 5},
+		{"BHD → USD", 1500, "BHD", "USD", "2.659", 399},
+		{"同じ通貨", 500, "JPY", "JPY", "1", 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, ok := ParseRate(tt.rate)
+			if !ok {
+				t.Fatalf("ParseRate(%q) failed", tt.rate)
+			}
+			if got := ConvertCurrency(tt.amount, tt.from, tt.to, rate); got != tt.want {
+				t.Errorf("ConvertCurrency(%d, %s, %s, %s) = %d, want %d", tt.amount, tt.from, tt.to, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundRat(t *testing.T) {
+	tests := []struct {
+		num, denom int64
+		want       int
+	}{
+		{0, 1, 0},
+		{4, 1, 4},
+		{-4, 1, -4},
+		{1, 3, 0},
+		{2, 3, 1},
+		{-1, 3, 0},
+		{-2, 3, -1},
+		{1, 2, 1},
+		{-1, 2, -1},
+		{3, 2, 2},
+		{5, 2, 3},
+		{-5, 2, -3},
+		{7, 3, 2},
+		{-7, 3, -2},
+		{2499, 1000, 2},
+		{2500, 1000, 3},
+		{-2500, 1000, -3},
+	}
+
+	for _, tt := range tests {
+		value := big.NewRat(tt.num, tt.denom)
+		if got := RoundRat(value); got != tt.want {
+			t.Errorf("RoundRat(%s) = %d, want %d", value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type AccountType string
+
+const (
+	AccountTypeCash       AccountType = "cash"
+	AccountTypeBank       AccountType = "bank"
+	AccountTypeCreditCard AccountType = "credit_card"
+	AccountTypeEMoney     AccountType = "e_money"
+)
+
+// Account はお金の置き場所（現金・銀行口座・クレジットカード・電子マネー）
+// 残高は OpeningBalance に、紐づく取引の収入・支出と振替の入出金を反映して求める
+type Account struct {
+	ID             uint        `gorm:"primaryKey" json:"id"`
+	UserID         uint        `gorm:"not null;index" json:"user_id"`
+	User           User        `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Name           string      `gorm:"size:100;not null" json:"name"`
+	Type           AccountType `gorm:"size:20;not null" json:"type"`
+	OpeningBalance int         `gorm:"not null;default:0" json:"opening_balance"`
+	CreatedAt      time.Time   `json:"created_at"`
+	UpdatedAt      time.Time   `json:"updated_at"`
+}
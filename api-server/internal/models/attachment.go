@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Attachment は取引に添付したレシートなどのファイル
+// ファイルの内容はストレージに StorageKey で保存し、DBにはメタデータのみを保存する
+type Attachment struct {
+	ID            uint        `gorm:"primaryKey" json:"id"`
+	UserID        uint        `gorm:"not null" json:"user_id"`
+	User          User        `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	TransactionID uint        `gorm:"not null;index" json:"transaction_id"`
+	Transaction   Transaction `gorm:"foreignKey:TransactionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	FileName      string      `gorm:"size:255;not null" json:"file_name"`
+	ContentType   string      `gorm:"size:100;not null" json:"content_type"`
+	Size          int         `gorm:"not null" json:"size"`
+	StorageKey    string      `gorm:"size:255;not null;uniqueIndex" json:"-"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
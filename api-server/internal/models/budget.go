@@ -1,15 +1,20 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Budget struct {
-	ID         uint      `gorm:"primaryKey" json:"id"`
-	UserID     uint      `gorm:"not null;index" json:"user_id"`
-	User       User      `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
-	CategoryID uint      `gorm:"not null;index" json:"category_id"`
-	Category   Category  `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"category"`
-	Amount     int       `gorm:"not null" json:"amount"`
-	Month      string    `gorm:"size:7;not null;index" json:"month"` // YYYY-MM形式
-	CreatedAt  time.Time `json:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at"`
+	ID         uint           `gorm:"primaryKey" json:"id"`
+	UserID     uint           `gorm:"not null;index" json:"user_id"`
+	User       User           `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	CategoryID uint           `gorm:"not null;index" json:"category_id"`
+	Category   Category       `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"category"`
+	Amount     int            `gorm:"not null" json:"amount"`
+	Month      string         `gorm:"size:7;not null;index" json:"month"` // YYYY-MM形式
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+	DeletedAt  gorm.DeletedAt `gorm:"index" json:"-"` // ゴミ箱に移動した日時。保持期間を過ぎると完全に削除される
 }
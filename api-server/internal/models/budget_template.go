@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// BudgetTemplate は月の予算をまとめて登録するための、カテゴリごとの予算額のひな形
+type BudgetTemplate struct {
+	ID        uint                 `gorm:"primaryKey" json:"id"`
+	UserID    uint                 `gorm:"not null;uniqueIndex:uk_user_name" json:"user_id"`
+	User      User                 `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Name      string               `gorm:"size:100;not null;uniqueIndex:uk_user_name" json:"name"`
+	Items     []BudgetTemplateItem `gorm:"foreignKey:BudgetTemplateID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"items"`
+	CreatedAt time.Time            `json:"created_at"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// BudgetTemplateItem は予算テンプレートのカテゴリごとの予算額。1つのテンプレートにつき1カテゴリ1件
+type BudgetTemplateItem struct {
+	ID               uint      `gorm:"primaryKey" json:"id"`
+	BudgetTemplateID uint      `gorm:"not null;uniqueIndex:uk_budget_template_category" json:"budget_template_id"`
+	CategoryID       uint      `gorm:"not null;uniqueIndex:uk_budget_template_category" json:"category_id"`
+	Category         Category  `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"category"`
+	Amount           int       `gorm:"not null" json:"amount"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+type CategorizationMatchType string
+
+const (
+	CategorizationMatchTypeContains CategorizationMatchType = "contains"
+	CategorizationMatchTypePrefix   CategorizationMatchType = "prefix"
+	CategorizationMatchTypeRegex    CategorizationMatchType = "regex"
+)
+
+// CategorizationRule は取引の説明からカテゴリを自動で決めるためのルール
+// Priority の小さい順（同じ場合はIDの小さい順）に判定し、最初に一致したルールの CategoryID を使う
+type CategorizationRule struct {
+	ID         uint                    `gorm:"primaryKey" json:"id"`
+	UserID     uint                    `gorm:"not null;index:idx_user_priority" json:"user_id"`
+	User       User                    `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	CategoryID uint                    `gorm:"not null;index" json:"category_id"`
+	Category   Category                `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"category"`
+	MatchType  CategorizationMatchType `gorm:"size:10;not null" json:"match_type"`
+	Pattern    string                  `gorm:"size:255;not null" json:"pattern"`
+	MinAmount  *int                    `json:"min_amount"` // 金額の下限（以上）
+	MaxAmount  *int                    `json:"max_amount"` // 金額の上限（以下）
+	Priority   int                     `gorm:"not null;default:0;index:idx_user_priority" json:"priority"`
+	CreatedAt  time.Time               `json:"created_at"`
+	UpdatedAt  time.Time               `json:"updated_at"`
+}
@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type CategoryType string
 
@@ -9,13 +13,24 @@ const (
 	CategoryTypeExpense CategoryType = "expense"
 )
 
+// BudgetRollover は予算の余りや超過を翌月に繰り越す方法
+type BudgetRollover string
+
+const (
+	BudgetRolloverNone              BudgetRollover = "none"
+	BudgetRolloverSurplus           BudgetRollover = "surplus"             // 余った予算のみ翌月に繰り越す
+	BudgetRolloverSurplusAndDeficit BudgetRollover = "surplus_and_deficit" // 超過した支出も翌月の予算から差し引く
+)
+
 type Category struct {
-	ID        uint         `gorm:"primaryKey" json:"id"`
-	UserID    uint         `gorm:"not null;index" json:"user_id"`
-	User      User         `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
-	Name      string       `gorm:"size:100;not null" json:"name"`
-	Type      CategoryType `gorm:"size:10;not null" json:"type"`
-	Color     string       `gorm:"size:20" json:"color"`
-	CreatedAt time.Time    `json:"created_at"`
-	UpdatedAt time.Time    `json:"updated_at"`
+	ID             uint           `gorm:"primaryKey" json:"id"`
+	UserID         uint           `gorm:"not null;index" json:"user_id"`
+	User           User           `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Name           string         `gorm:"size:100;not null" json:"name"`
+	Type           CategoryType   `gorm:"size:10;not null" json:"type"`
+	Color          string         `gorm:"size:20" json:"color"`
+	BudgetRollover BudgetRollover `gorm:"size:20;not null;default:none" json:"budget_rollover"`
+	CreatedAt      time.Time      `json:"created_at"`
+	UpdatedAt      time.Time      `json:"updated_at"`
+	DeletedAt      gorm.DeletedAt `gorm:"index" json:"-"` // ゴミ箱に移動した日時。保持期間を過ぎると完全に削除される
 }
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// ChangeRecordType は変更履歴の対象の種類
+type ChangeRecordType string
+
+const (
+	ChangeRecordTypeTransaction ChangeRecordType = "transaction"
+	ChangeRecordTypeBudget      ChangeRecordType = "budget"
+)
+
+// ChangeAction は変更履歴の操作の種類
+type ChangeAction string
+
+const (
+	ChangeActionCreate  ChangeAction = "create"
+	ChangeActionUpdate  ChangeAction = "update"
+	ChangeActionDelete  ChangeAction = "delete"
+	ChangeActionRestore ChangeAction = "restore"
+)
+
+// ChangeHistory は取引・予算の登録・更新・削除の履歴
+// Before・After は変更された項目の変更前・変更後の値（登録の場合は Before、削除の場合は After が nil）
+type ChangeHistory struct {
+	ID         uint             `gorm:"primaryKey" json:"id"`
+	UserID     uint             `gorm:"not null" json:"user_id"`
+	User       User             `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	RecordType ChangeRecordType `gorm:"size:20;not null;index:idx_record" json:"record_type"`
+	RecordID   uint             `gorm:"not null;index:idx_record" json:"record_id"`
+	Action     ChangeAction     `gorm:"size:10;not null" json:"action"`
+	Before     json.RawMessage  `gorm:"type:json" json:"before"`
+	After      json.RawMessage  `gorm:"type:json" json:"after"`
+	CreatedAt  time.Time        `json:"created_at"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// CreditCard はクレジットカードの締め日・支払日の設定
+// 締め日の月ごとに、前回の締め日の翌日から締め日までに CreditCardID を指定した取引を請求としてまとめ、
+// PaymentMonthOffset か月後の支払日に引き落とされるものとして扱う
+type CreditCard struct {
+	ID                 uint      `gorm:"primaryKey" json:"id"`
+	UserID             uint      `gorm:"not null;index" json:"user_id"`
+	User               User      `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Name               string    `gorm:"size:100;not null" json:"name"`
+	ClosingDay         int       `gorm:"not null" json:"closing_day"`                    // 締め日（1〜31）。月の日数を超える場合は月末日
+	PaymentDay         int       `gorm:"not null" json:"payment_day"`                    // 支払日（1〜31）。月の日数を超える場合は月末日
+	PaymentMonthOffset int       `gorm:"not null;default:1" json:"payment_month_offset"` // 締め日から支払日までの月数
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// ExchangeRate はユーザーが登録した為替レート
+// 1 Currency = Rate BaseCurrency を表し、Date 以降で次のレートが登録されるまでの取引の換算に使う
+type ExchangeRate struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	UserID       uint      `gorm:"not null;uniqueIndex:uk_user_currency_date" json:"user_id"`
+	User         User      `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	BaseCurrency string    `gorm:"type:char(3);not null;uniqueIndex:uk_user_currency_date" json:"base_currency"`
+	Currency     string    `gorm:"type:char(3);not null;uniqueIndex:uk_user_currency_date" json:"currency"`
+	Date         time.Time `gorm:"type:date;not null;uniqueIndex:uk_user_currency_date" json:"date"`
+	Rate         string    `gorm:"type:decimal(20,10);not null" json:"rate"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
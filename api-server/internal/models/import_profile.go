@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+type ImportEncoding string
+
+const (
+	ImportEncodingUTF8     ImportEncoding = "utf-8"
+	ImportEncodingShiftJIS ImportEncoding = "shift_jis"
+)
+
+type AmountSign string
+
+const (
+	AmountSignNegativeIsExpense AmountSign = "negative_is_expense"
+	AmountSignPositiveIsExpense AmountSign = "positive_is_expense"
+)
+
+// ImportProfile はCSV取込時の列の対応付け設定
+// 列番号はすべて1始まり
+type ImportProfile struct {
+	ID                uint           `gorm:"primaryKey" json:"id"`
+	UserID            uint           `gorm:"not null;index" json:"user_id"`
+	User              User           `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Name              string         `gorm:"size:100;not null" json:"name"`
+	Encoding          ImportEncoding `gorm:"size:20;not null" json:"encoding"`
+	HasHeader         bool           `gorm:"not null;default:false" json:"has_header"`
+	SkipRows          int            `gorm:"not null;default:0" json:"skip_rows"`
+	DateColumn        int            `gorm:"not null" json:"date_column"`
+	DateFormat        string         `gorm:"size:20;not null" json:"date_format"` // 例: YYYY/MM/DD
+	AmountColumn      int            `gorm:"not null" json:"amount_column"`
+	AmountSign        AmountSign     `gorm:"size:20;not null" json:"amount_sign"`
+	DescriptionColumn *int           `json:"description_column"`
+	DefaultCategoryID uint           `gorm:"not null" json:"default_category_id"`
+	IncomeCategoryID  *uint          `json:"income_category_id"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// InstallmentPlan は分割払いの支払い計画
+// 購入した取引を1回目の支払いとし、2回目以降の支払いを取引として登録する
+// 各回の支払いの取引は InstallmentPlanID と InstallmentNumber で計画に紐づく
+type InstallmentPlan struct {
+	ID                     uint          `gorm:"primaryKey" json:"id"`
+	UserID                 uint          `gorm:"not null;index" json:"user_id"`
+	User                   User          `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	PurchaseDate           time.Time     `gorm:"type:date;not null" json:"purchase_date"`
+	Principal              int           `gorm:"not null" json:"principal"`                         // 購入金額（基準通貨）
+	PurchaseCurrency       string        `gorm:"type:char(3);not null" json:"purchase_currency"`    // 購入した取引の通貨。取り消し時に取引を戻すのに使う
+	PurchaseOriginalAmount int           `gorm:"not null" json:"purchase_original_amount"`          // 購入した取引の通貨での元の金額
+	PurchaseExchangeRate   *string       `gorm:"type:decimal(20,10)" json:"purchase_exchange_rate"` // 購入した取引の換算レート。基準通貨の取引の場合は nil
+	Payments               int           `gorm:"not null" json:"payments"`                          // 支払回数
+	FirstBillingMonth      string        `gorm:"type:char(7);not null" json:"first_billing_month"`  // 初回の支払月（YYYY-MM）
+	AnnualInterestRate     *string       `gorm:"type:decimal(5,2)" json:"annual_interest_rate"`     // 手数料の実質年率（%）。手数料がない場合は nil
+	TotalInterest          int           `gorm:"not null;default:0" json:"total_interest"`          // 手数料の合計
+	Transactions           []Transaction `gorm:"foreignKey:InstallmentPlanID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL" json:"transactions"`
+	CreatedAt              time.Time     `json:"created_at"`
+	UpdatedAt              time.Time     `json:"updated_at"`
+}
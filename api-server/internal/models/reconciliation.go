@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// Reconciliation は口座を銀行の明細と照合した記録
+// 明細の日付までの消込済みの残高が明細の残高と一致した時点で作成し、その時点で未照合だった消込済みの取引を
+// ReconciliationID で紐づけてロックする
+type Reconciliation struct {
+	ID               uint      `gorm:"primaryKey" json:"id"`
+	UserID           uint      `gorm:"not null;index" json:"user_id"`
+	User             User      `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	AccountID        uint      `gorm:"not null;index" json:"account_id"`
+	Account          Account   `gorm:"foreignKey:AccountID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	StatementDate    time.Time `gorm:"type:date;not null" json:"statement_date"`
+	Balance          int       `gorm:"not null" json:"balance"`           // 明細の日付時点の残高
+	TransactionCount int       `gorm:"not null" json:"transaction_count"` // 照合済みにした取引の件数
+	CreatedAt        time.Time `json:"created_at"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "weekly"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "monthly"
+	RecurrenceFrequencyYearly  RecurrenceFrequency = "yearly"
+)
+
+type RecurringTransaction struct {
+	ID             uint                `gorm:"primaryKey" json:"id"`
+	UserID         uint                `gorm:"not null;index" json:"user_id"`
+	User           User                `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	CategoryID     uint                `gorm:"not null;index" json:"category_id"`
+	Category       Category            `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"category"`
+	Amount         int                 `gorm:"not null" json:"amount"`
+	Description    string              `gorm:"size:255" json:"description"`
+	Frequency      RecurrenceFrequency `gorm:"size:10;not null" json:"frequency"`
+	Interval       int                 `gorm:"not null;default:1" json:"interval"`
+	DayOfMonth     *int                `json:"day_of_month"`  // monthly, yearly
+	DayOfWeek      *int                `json:"day_of_week"`   // weekly（0=日曜）
+	MonthOfYear    *int                `json:"month_of_year"` // yearly
+	StartDate      time.Time           `gorm:"type:date;not null" json:"start_date"`
+	EndDate        *time.Time          `gorm:"type:date" json:"end_date"`
+	GeneratedUntil *time.Time          `gorm:"type:date" json:"generated_until"` // 取引を生成済みの最終日
+	CreatedAt      time.Time           `json:"created_at"`
+	UpdatedAt      time.Time           `json:"updated_at"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// Tag はカテゴリとは別の軸（旅行・プロジェクト・人など）で取引を分類するためのラベル
+// 1つの取引に複数のタグを付けられる（transaction_tags で多対多）
+type Tag struct {
+	ID        uint      `gorm:"primaryKey" json:"id"`
+	UserID    uint      `gorm:"not null;uniqueIndex:uk_user_name" json:"user_id"`
+	User      User      `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	Name      string    `gorm:"size:50;not null;uniqueIndex:uk_user_name" json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -1,16 +1,33 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 type Transaction struct {
-	ID          uint      `gorm:"primaryKey" json:"id"`
-	UserID      uint      `gorm:"not null;index" json:"user_id"`
-	User        User      `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
-	CategoryID  uint      `gorm:"not null;index" json:"category_id"`
-	Category    Category  `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"category"`
-	Amount      int       `gorm:"not null" json:"amount"`
-	Date        time.Time `gorm:"not null" json:"date"`
-	Description string    `gorm:"size:255" json:"description"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID                     uint               `gorm:"primaryKey" json:"id"`
+	UserID                 uint               `gorm:"not null;index" json:"user_id"`
+	User                   User               `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	CategoryID             uint               `gorm:"not null;index" json:"category_id"`
+	Category               Category           `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"category"`
+	AccountID              *uint              `gorm:"index" json:"account_id"`
+	CreditCardID           *uint              `gorm:"index" json:"credit_card_id"`
+	Cleared                bool               `gorm:"not null;default:false" json:"cleared"` // 銀行の明細で確認済みかどうか
+	ReconciliationID       *uint              `gorm:"index" json:"reconciliation_id"`        // 照合済みの場合の照合ID。照合済みの取引は更新・削除できない
+	RecurringTransactionID *uint              `gorm:"uniqueIndex:uk_recurring_transaction_date" json:"recurring_transaction_id"`
+	InstallmentPlanID      *uint              `gorm:"index" json:"installment_plan_id"`
+	InstallmentNumber      *int               `json:"installment_number"`                       // 分割払いの支払回（1から始まる）
+	Amount                 int                `gorm:"not null" json:"amount"`                   // 基準通貨に換算した金額
+	Currency               string             `gorm:"type:char(3);not null" json:"currency"`    // 取引の通貨（ISO 4217）
+	OriginalAmount         int                `gorm:"not null" json:"original_amount"`          // Currency の補助単位での元の金額
+	ExchangeRate           *string            `gorm:"type:decimal(20,10)" json:"exchange_rate"` // 換算に使ったレート。基準通貨の取引の場合は nil
+	Date                   time.Time          `gorm:"not null;uniqueIndex:uk_recurring_transaction_date" json:"date"`
+	Description            string             `gorm:"size:255" json:"description"`
+	Splits                 []TransactionSplit `gorm:"foreignKey:TransactionID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"splits"`
+	Tags                   []Tag              `gorm:"many2many:transaction_tags;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"tags"`
+	CreatedAt              time.Time          `json:"created_at"`
+	UpdatedAt              time.Time          `json:"updated_at"`
+	DeletedAt              gorm.DeletedAt     `gorm:"index" json:"-"` // ゴミ箱に移動した日時。保持期間を過ぎると完全に削除される
 }
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// TransactionSplit は1つの取引を複数のカテゴリに分けた明細
+// 明細の金額の合計は親の取引の金額（元の金額の合計は親の元の金額）と一致する
+type TransactionSplit struct {
+	ID             uint      `gorm:"primaryKey" json:"id"`
+	TransactionID  uint      `gorm:"not null;index" json:"transaction_id"`
+	CategoryID     uint      `gorm:"not null;index" json:"category_id"`
+	Category       Category  `gorm:"foreignKey:CategoryID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"category"`
+	Amount         int       `gorm:"not null" json:"amount"`          // 基準通貨に換算した金額
+	OriginalAmount int       `gorm:"not null" json:"original_amount"` // 取引の通貨での元の金額
+	Memo           string    `gorm:"size:255" json:"memo"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Transfer は口座間の振替
+// 振替元の出金と振替先の入金の組を1行で記録し、収入・支出には含めない
+// NOTE: 取引（Transaction）とは別に記録するため、取引一覧・カレンダーには含まれない。口座の残高と入出金一覧（accountEntries）で取引と合わせて扱う
+type Transfer struct {
+	ID            uint      `gorm:"primaryKey" json:"id"`
+	UserID        uint      `gorm:"not null;index" json:"user_id"`
+	User          User      `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE" json:"-"`
+	FromAccountID uint      `gorm:"not null;index" json:"from_account_id"`
+	FromAccount   Account   `gorm:"foreignKey:FromAccountID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"from_account"`
+	ToAccountID   uint      `gorm:"not null;index" json:"to_account_id"`
+	ToAccount     Account   `gorm:"foreignKey:ToAccountID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT" json:"to_account"`
+	Amount        int       `gorm:"not null" json:"amount"`
+	Date          time.Time `gorm:"not null;index" json:"date"`
+	Description   string    `gorm:"size:255" json:"description"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
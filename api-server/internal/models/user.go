@@ -2,14 +2,27 @@ package models
 
 import "time"
 
+// MonthStartAdjustment は月の開始日が土日に当たる場合の調整方法
+type MonthStartAdjustment string
+
+const (
+	MonthStartAdjustmentNone            MonthStartAdjustment = "none"
+	MonthStartAdjustmentPreviousWeekday MonthStartAdjustment = "previous_weekday" // 直前の金曜日にする
+	MonthStartAdjustmentNextWeekday     MonthStartAdjustment = "next_weekday"     // 直後の月曜日にする
+)
+
 type User struct {
-	ID           uint          `gorm:"primaryKey" json:"id"`
-	Email        string        `gorm:"size:255;uniqueIndex;not null" json:"email"`
-	Name         string        `gorm:"size:100;not null" json:"name"`
-	Password     string        `gorm:"size:255;not null" json:"-"`
-	Categories   []Category    `gorm:"foreignKey:UserID" json:"-"`
-	Transactions []Transaction `gorm:"foreignKey:UserID" json:"-"`
-	Budgets      []Budget      `gorm:"foreignKey:UserID" json:"-"`
-	CreatedAt    time.Time     `json:"created_at"`
-	UpdatedAt    time.Time     `json:"updated_at"`
+	ID                   uint                 `gorm:"primaryKey" json:"id"`
+	Email                string               `gorm:"size:255;uniqueIndex;not null" json:"email"`
+	Name                 string               `gorm:"size:100;not null" json:"name"`
+	BaseCurrency         string               `gorm:"type:char(3);not null;default:JPY" json:"base_currency"` // 金額の集計・換算に使う通貨（ISO 4217）
+	TimeZone             string               `gorm:"size:64;not null;default:Asia/Tokyo" json:"time_zone"`   // 日付の境界の判定に使うタイムゾーン（IANAタイムゾーン名）
+	MonthStartDay        int                  `gorm:"not null;default:1" json:"month_start_day"`              // 予算の対象月の開始日（1〜28）
+	MonthStartAdjustment MonthStartAdjustment `gorm:"size:20;not null;default:none" json:"month_start_adjustment"`
+	Password             string               `gorm:"size:255;not null" json:"-"`
+	Categories           []Category           `gorm:"foreignKey:UserID" json:"-"`
+	Transactions         []Transaction        `gorm:"foreignKey:UserID" json:"-"`
+	Budgets              []Budget             `gorm:"foreignKey:UserID" json:"-"`
+	CreatedAt            time.Time            `json:"created_at"`
+	UpdatedAt            time.Time            `json:"updated_at"`
 }
@@ -0,0 +1,176 @@
+package repositories
+
+import (
+	"time"
+
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// AccountTotal は口座ごとの入出金の合計
+type AccountTotal struct {
+	AccountID uint
+	Amount    int
+}
+
+// AccountDailyTotal は口座ごとの日別の入出金の合計
+type AccountDailyTotal struct {
+	AccountID uint
+	Date      time.Time
+	Amount    int
+}
+
+// AccountEntry は口座の1件の入出金。Kind は "transaction"・"transfer_in"・"transfer_out" のいずれかで、
+// RecordID は Kind に応じて取引IDまたは振替IDとなる
+type AccountEntry struct {
+	Kind        string
+	RecordID    uint
+	Date        time.Time
+	Amount      int
+	Description string
+	Cleared     bool
+}
+
+type AccountRepository interface {
+	FindAll(userID uint) ([]models.Account, error)
+	FindByID(id, userID uint) (*models.Account, error)
+	Create(account *models.Account) error
+	Update(id, userID uint, updates map[string]interface{}) (*models.Account, error)
+	Delete(id, userID uint) error
+	SumBefore(userID uint, date string) ([]AccountTotal, error)
+	SumDaily(userID uint, startDate, endDate string) ([]AccountDailyTotal, error)
+	FindEntries(id, userID uint, startDate, endDate string) ([]AccountEntry, error)
+}
+
+type accountRepository struct {
+	db *gorm.DB
+}
+
+func NewAccountRepository(db *gorm.DB) AccountRepository {
+	return &accountRepository{db}
+}
+
+func (r *accountRepository) FindAll(userID uint) ([]models.Account, error) {
+	var accounts []models.Account
+	err := r.db.Where("user_id = ?", userID).Order("id ASC").Find(&accounts).Error
+	return accounts, err
+}
+
+func (r *accountRepository) FindByID(id, userID uint) (*models.Account, error) {
+	var account models.Account
+	err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&account).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &account, nil
+}
+
+func (r *accountRepository) Create(account *models.Account) error {
+	return r.db.Create(account).Error
+}
+
+func (r *accountRepository) Update(id, userID uint, updates map[string]interface{}) (*models.Account, error) {
+	// 存在確認
+	var existing models.Account
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	// 更新
+	if err := r.db.Model(&models.Account{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	// 更新後のデータを取得
+	var account models.Account
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&account).Error; err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+func (r *accountRepository) Delete(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.Account{})
+	if result.Error != nil {
+		if helpers.IsForeignKeyViolation(result.Error) {
+			return ErrForeignKeyViolation
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SumBefore は date より前の入出金を口座ごとに合計する（開始残高は含まない）
+func (r *accountRepository) SumBefore(userID uint, date string) ([]AccountTotal, error) {
+	var totals []AccountTotal
+
+	err := r.db.Table("(?) AS account_entries", accountEntries(r.db, userID)).
+		Select("account_id, SUM(amount) AS amount").
+		Where("date < ?", date).
+		Group("account_id").
+		Scan(&totals).Error
+	return totals, err
+}
+
+// SumDaily は startDate 以上 endDate 未満の入出金を口座ごと・日別に合計する
+func (r *accountRepository) SumDaily(userID uint, startDate, endDate string) ([]AccountDailyTotal, error) {
+	var totals []AccountDailyTotal
+
+	err := r.db.Table("(?) AS account_entries", accountEntries(r.db, userID)).
+		Select("account_id, DATE(date) AS date, SUM(amount) AS amount").
+		Where("date >= ? AND date < ?", startDate, endDate).
+		Group("account_id, DATE(date)").
+		Order("date ASC").
+		Scan(&totals).Error
+	return totals, err
+}
+
+// FindEntries は startDate 以上 endDate 未満の口座の入出金を日付の昇順に取得する
+// 分割された取引は分割明細ごとの入出金を合計して1件とする
+func (r *accountRepository) FindEntries(id, userID uint, startDate, endDate string) ([]AccountEntry, error) {
+	var entries []AccountEntry
+
+	err := r.db.Table("(?) AS account_entries", accountEntries(r.db, userID)).
+		Select("kind, record_id, date, SUM(amount) AS amount, description, cleared").
+		Where("account_id = ? AND date >= ? AND date < ?", id, startDate, endDate).
+		Group("kind, record_id, date, description, cleared").
+		Order("date ASC, kind ASC, record_id ASC").
+		Scan(&entries).Error
+	return entries, err
+}
+
+// accountEntries は口座の入出金を1行ずつ並べるサブクエリを返す
+// 取引は収入を正、支出を負の金額とし（分割された取引は分割明細ごとのカテゴリで判定）、
+// 振替は振替先の入金（正）と振替元の出金（負）の2行とする
+// cleared は取引の消込済みの状態で、振替は常に消込済み（TRUE）とする
+// kind・record_id は入出金の種類と、取引IDまたは振替ID
+func accountEntries(db *gorm.DB, userID uint) *gorm.DB {
+	transactions := db.Table("(?) AS transaction_lines", transactionLines(db)).
+		Select(`transaction_lines.account_id, transaction_lines.date,
+			CASE WHEN categories.type = ? THEN transaction_lines.amount ELSE -transaction_lines.amount END AS amount,
+			transaction_lines.cleared, 'transaction' AS kind, transaction_lines.id AS record_id, transaction_lines.description`, models.CategoryTypeIncome).
+		Joins("JOIN categories ON categories.id = transaction_lines.category_id").
+		Where("transaction_lines.user_id = ? AND transaction_lines.account_id IS NOT NULL", userID)
+
+	transfersIn := db.Model(&models.Transfer{}).
+		Select("to_account_id AS account_id, date, amount, TRUE AS cleared, 'transfer_in' AS kind, id AS record_id, description").
+		Where("user_id = ?", userID)
+
+	transfersOut := db.Model(&models.Transfer{}).
+		Select("from_account_id AS account_id, date, -amount AS amount, TRUE AS cleared, 'transfer_out' AS kind, id AS record_id, description").
+		Where("user_id = ?", userID)
+
+	return db.Raw("(?) UNION ALL (?) UNION ALL (?)", transactions, transfersIn, transfersOut)
+}
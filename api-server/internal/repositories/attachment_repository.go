@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type AttachmentRepository interface {
+	FindAll(transactionID, userID uint) ([]models.Attachment, error)
+	FindByID(id, transactionID, userID uint) (*models.Attachment, error)
+	Create(attachment *models.Attachment) error
+	Delete(id, transactionID, userID uint) error
+}
+
+type attachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) AttachmentRepository {
+	return &attachmentRepository{db}
+}
+
+func (r *attachmentRepository) FindAll(transactionID, userID uint) ([]models.Attachment, error) {
+	var attachments []models.Attachment
+	err := r.db.Where("transaction_id = ? AND user_id = ?", transactionID, userID).Order("id ASC").Find(&attachments).Error
+	return attachments, err
+}
+
+func (r *attachmentRepository) FindByID(id, transactionID, userID uint) (*models.Attachment, error) {
+	var attachment models.Attachment
+	err := r.db.Where("id = ? AND transaction_id = ? AND user_id = ?", id, transactionID, userID).First(&attachment).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+func (r *attachmentRepository) Create(attachment *models.Attachment) error {
+	if err := r.db.Create(attachment).Error; err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return ErrForeignKeyViolation
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *attachmentRepository) Delete(id, transactionID, userID uint) error {
+	result := r.db.Where("id = ? AND transaction_id = ? AND user_id = ?", id, transactionID, userID).Delete(&models.Attachment{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -1,18 +1,58 @@
 package repositories
 
 import (
+	"time"
+
 	"apps/internal/helpers"
 	"apps/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// BudgetSummaryRow はカテゴリごとの予算額と実績額の集計結果
+type BudgetSummaryRow struct {
+	CategoryID uint
+	Category   models.Category `gorm:"-"`
+	BudgetID   *uint
+	Planned    int
+	Spent      int
+}
+
+// BudgetAmount はコピーするカテゴリごとの予算額
+type BudgetAmount struct {
+	CategoryID uint
+	Amount     int
+}
+
+// BudgetCopyResult は予算のコピーで作成・上書き・変更しなかった予算の件数
+type BudgetCopyResult struct {
+	Created int
+	Updated int
+	Skipped int
+}
+
+// CategoryDailySpent はカテゴリごとの日別の実績額の合計
+type CategoryDailySpent struct {
+	CategoryID uint
+	Date       time.Time
+	Spent      int
+}
+
 type BudgetRepository interface {
-	FindAll(userID uint, month *string, categoryID *int32) ([]models.Budget, error)
+	FindAll(userID uint, month *string, categoryID *int32, page *PageParams) ([]models.Budget, bool, error)
+	Summarize(userID uint, month, startDate, endDate string) ([]BudgetSummaryRow, error)
+	FindUntil(userID uint, month string) ([]models.Budget, error)
+	SumSpentDaily(userID uint, categoryIDs []uint, startDate, endDate string) ([]CategoryDailySpent, error)
+	Copy(userID uint, month string, amounts []BudgetAmount, overwrite bool) (*BudgetCopyResult, error)
 	FindByID(id, userID uint) (*models.Budget, error)
 	Create(budget *models.Budget) error
 	Update(id, userID uint, updates map[string]interface{}) (*models.Budget, error)
 	Delete(id, userID uint) error
+	FindDeleted(userID uint) ([]models.Budget, error)
+	Restore(id, userID uint) (*models.Budget, error)
+	Purge(id, userID uint) error
+	PurgeDeletedBefore(before time.Time) (int64, error)
 }
 
 type budgetRepository struct {
@@ -23,7 +63,8 @@ func NewBudgetRepository(db *gorm.DB) BudgetRepository {
 	return &budgetRepository{db}
 }
 
-func (r *budgetRepository) FindAll(userID uint, month *string, categoryID *int32) ([]models.Budget, error) {
+// FindAll は条件に一致する予算を取得する。page を指定した場合は1ページ分と次のページの有無を返す
+func (r *budgetRepository) FindAll(userID uint, month *string, categoryID *int32, page *PageParams) ([]models.Budget, bool, error) {
 	var budgets []models.Budget
 
 	query := r.db.Preload("Category").Where("user_id = ?", userID)
@@ -35,10 +76,90 @@ func (r *budgetRepository) FindAll(userID uint, month *string, categoryID *int32
 		query = query.Where("category_id = ?", *categoryID)
 	}
 
-	err := query.Order("month DESC, category_id ASC").Find(&budgets).Error
+	if page == nil {
+		err := query.Order("month DESC, category_id ASC").Find(&budgets).Error
+		return budgets, false, err
+	}
+
+	if err := paginate(query, "budgets", page).Find(&budgets).Error; err != nil {
+		return nil, false, err
+	}
+	budgets, hasNext := trimPage(budgets, page)
+	return budgets, hasNext, nil
+}
+
+// Summarize は対象月の予算と、startDate 以上 endDate 未満の取引合計をカテゴリごとに結合する
+// 予算が設定されたカテゴリに加え、予算未設定でも支出のあるカテゴリを含む
+// 分割された取引は分割明細ごとにそのカテゴリの実績に含める
+func (r *budgetRepository) Summarize(userID uint, month, startDate, endDate string) ([]BudgetSummaryRow, error) {
+	var rows []BudgetSummaryRow
+
+	spent := r.db.Table("(?) AS transaction_lines", transactionLines(r.db)).
+		Select("category_id, SUM(amount) AS spent").
+		Where("user_id = ? AND date >= ? AND date < ?", userID, startDate, endDate).
+		Group("category_id")
+
+	err := r.db.Table("categories").
+		Select("categories.id AS category_id, budgets.id AS budget_id, COALESCE(budgets.amount, 0) AS planned, COALESCE(t.spent, 0) AS spent").
+		Joins("LEFT JOIN budgets ON budgets.category_id = categories.id AND budgets.user_id = ? AND budgets.month = ? AND budgets.deleted_at IS NULL", userID, month).
+		Joins("LEFT JOIN (?) AS t ON t.category_id = categories.id", spent).
+		Where("categories.user_id = ? AND categories.deleted_at IS NULL", userID).
+		Where("budgets.id IS NOT NULL OR (t.spent IS NOT NULL AND categories.type = ?)", models.CategoryTypeExpense).
+		Order("categories.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return rows, nil
+	}
+
+	categoryIDs := make([]uint, len(rows))
+	for i, row := range rows {
+		categoryIDs[i] = row.CategoryID
+	}
+
+	var categories []models.Category
+	if err := r.db.Where("id IN ?", categoryIDs).Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	categoriesByID := make(map[uint]models.Category, len(categories))
+	for _, c := range categories {
+		categoriesByID[c.ID] = c
+	}
+	for i := range rows {
+		rows[i].Category = categoriesByID[rows[i].CategoryID]
+	}
+
+	return rows, nil
+}
+
+// FindUntil は month 以前の予算をカテゴリ・月の順に取得する。ゴミ箱にあるカテゴリの予算は含まない
+func (r *budgetRepository) FindUntil(userID uint, month string) ([]models.Budget, error) {
+	var budgets []models.Budget
+	err := r.db.Preload("Category").
+		Joins("JOIN categories ON categories.id = budgets.category_id AND categories.deleted_at IS NULL").
+		Where("budgets.user_id = ? AND budgets.month <= ?", userID, month).
+		Order("budgets.category_id ASC, budgets.month ASC").
+		Find(&budgets).Error
 	return budgets, err
 }
 
+// SumSpentDaily は startDate 以上 endDate 未満の categoryIDs のカテゴリの取引合計を、カテゴリごと・日別に日付の順で返す
+// Summarize と同様に、分割された取引は分割明細ごとにそのカテゴリの実績に含める
+func (r *budgetRepository) SumSpentDaily(userID uint, categoryIDs []uint, startDate, endDate string) ([]CategoryDailySpent, error) {
+	var totals []CategoryDailySpent
+
+	err := r.db.Table("(?) AS transaction_lines", transactionLines(r.db)).
+		Select("category_id, DATE(date) AS date, SUM(amount) AS spent").
+		Where("user_id = ? AND category_id IN ? AND date >= ? AND date < ?", userID, categoryIDs, startDate, endDate).
+		Group("category_id, DATE(date)").
+		Order("date ASC").
+		Scan(&totals).Error
+	return totals, err
+}
+
 func (r *budgetRepository) FindByID(id, userID uint) (*models.Budget, error) {
 	var budget models.Budget
 	err := r.db.Preload("Category").Where("id = ? AND user_id = ?", id, userID).First(&budget).Error
@@ -51,8 +172,18 @@ func (r *budgetRepository) FindByID(id, userID uint) (*models.Budget, error) {
 	return &budget, nil
 }
 
+// Create は予算を登録し、変更履歴を記録する
 func (r *budgetRepository) Create(budget *models.Budget) error {
-	if err := r.db.Create(budget).Error; err != nil {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := ensureCategoriesExist(tx, budget.UserID, budget.CategoryID); err != nil {
+			return err
+		}
+		if err := tx.Create(budget).Error; err != nil {
+			return err
+		}
+		return recordChange(tx, budget.UserID, models.ChangeRecordTypeBudget, budget.ID, models.ChangeActionCreate, nil, budgetSnapshot(budget))
+	})
+	if err != nil {
 		if helpers.IsDuplicateEntry(err) {
 			return ErrDuplicateEntry
 		}
@@ -66,18 +197,36 @@ func (r *budgetRepository) Create(budget *models.Budget) error {
 	return r.db.Preload("Category").First(budget, budget.ID).Error
 }
 
+// Update は予算を更新し、更新した項目の変更前・変更後の値を変更履歴に記録する
 func (r *budgetRepository) Update(id, userID uint, updates map[string]interface{}) (*models.Budget, error) {
-	// 存在確認
-	var existing models.Budget
-	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, ErrNotFound
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 存在確認
+		var existing models.Budget
+		if err := tx.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+		if err := ensureCategoriesExist(tx, userID, updatedCategoryIDs(updates, "category_id")...); err != nil {
+			return err
+		}
+
+		// 更新
+		if err := tx.Model(&models.Budget{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+			return err
 		}
-		return nil, err
-	}
 
-	// 更新
-	if err := r.db.Model(&models.Budget{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+		// 変更履歴を記録
+		var updated models.Budget
+		if err := tx.First(&updated, id).Error; err != nil {
+			return err
+		}
+		keys := updatedKeys(updates)
+		return recordChange(tx, userID, models.ChangeRecordTypeBudget, id, models.ChangeActionUpdate,
+			budgetSnapshot(&existing).pick(keys), budgetSnapshot(&updated).pick(keys))
+	})
+	if err != nil {
 		if helpers.IsDuplicateEntry(err) {
 			return nil, ErrDuplicateEntry
 		}
@@ -96,13 +245,161 @@ func (r *budgetRepository) Update(id, userID uint, updates map[string]interface{
 	return &budget, nil
 }
 
-func (r *budgetRepository) Delete(id, userID uint) error {
-	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.Budget{})
-	if result.Error != nil {
-		return result.Error
+// Copy は amounts を month の予算として1つのトランザクションで登録し、それぞれ変更履歴を記録する
+// month に同じカテゴリの予算が既にある場合は、overwrite が true なら予算額を上書きし、false なら変更しない
+// ゴミ箱にあるカテゴリの予算額は登録せず、件数にも含めない
+func (r *budgetRepository) Copy(userID uint, month string, amounts []BudgetAmount, overwrite bool) (*BudgetCopyResult, error) {
+	result := &BudgetCopyResult{}
+	if len(amounts) == 0 {
+		return result, nil
 	}
-	if result.RowsAffected == 0 {
-		return ErrNotFound
+
+	categoryIDs := make([]uint, len(amounts))
+	for i, amount := range amounts {
+		categoryIDs[i] = amount.CategoryID
 	}
-	return nil
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var aliveIDs []uint
+		if err := tx.Model(&models.Category{}).Where("id IN ? AND user_id = ?", categoryIDs, userID).Pluck("id", &aliveIDs).Error; err != nil {
+			return err
+		}
+		alive := make(map[uint]bool, len(aliveIDs))
+		for _, id := range aliveIDs {
+			alive[id] = true
+		}
+
+		var existing []models.Budget
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND month = ? AND category_id IN ?", userID, month, categoryIDs).
+			Find(&existing).Error; err != nil {
+			return err
+		}
+		existingByCategory := make(map[uint]*models.Budget, len(existing))
+		for i := range existing {
+			existingByCategory[existing[i].CategoryID] = &existing[i]
+		}
+
+		for _, amount := range amounts {
+			if !alive[amount.CategoryID] {
+				continue
+			}
+
+			if budget, ok := existingByCategory[amount.CategoryID]; ok {
+				if !overwrite || budget.Amount == amount.Amount {
+					result.Skipped++
+					continue
+				}
+				keys := []string{"amount"}
+				before := budgetSnapshot(budget).pick(keys)
+				if err := tx.Model(&models.Budget{}).Where("id = ?", budget.ID).Update("amount", amount.Amount).Error; err != nil {
+					return err
+				}
+				budget.Amount = amount.Amount
+				if err := recordChange(tx, userID, models.ChangeRecordTypeBudget, budget.ID, models.ChangeActionUpdate,
+					before, budgetSnapshot(budget).pick(keys)); err != nil {
+					return err
+				}
+				result.Updated++
+				continue
+			}
+
+			budget := models.Budget{
+				UserID:     userID,
+				CategoryID: amount.CategoryID,
+				Amount:     amount.Amount,
+				Month:      month,
+			}
+			if err := tx.Create(&budget).Error; err != nil {
+				return err
+			}
+			if err := recordChange(tx, userID, models.ChangeRecordTypeBudget, budget.ID, models.ChangeActionCreate, nil, budgetSnapshot(&budget)); err != nil {
+				return err
+			}
+			result.Created++
+		}
+		return nil
+	})
+	if err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return nil, ErrDuplicateEntry
+		}
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// Delete は予算をゴミ箱に移動し、変更履歴を記録する
+func (r *budgetRepository) Delete(id, userID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var existing models.Budget
+		if err := tx.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		if err := tx.Delete(&existing).Error; err != nil {
+			return err
+		}
+		return recordChange(tx, userID, models.ChangeRecordTypeBudget, id, models.ChangeActionDelete, budgetSnapshot(&existing), nil)
+	})
+}
+
+// FindDeleted はゴミ箱にある予算を取得する
+func (r *budgetRepository) FindDeleted(userID uint) ([]models.Budget, error) {
+	return findDeleted[models.Budget](r.db.Preload("Category", func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	}), userID)
+}
+
+// Restore はゴミ箱にある予算を元に戻す
+// カテゴリがゴミ箱にある場合は ErrForeignKeyViolation、同じカテゴリ・月の予算が既にある場合は ErrDuplicateEntry を返す
+func (r *budgetRepository) Restore(id, userID uint) (*models.Budget, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		budget, err := findDeletedByID[models.Budget](tx, id, userID)
+		if err != nil {
+			return err
+		}
+		if err := ensureCategoriesExist(tx, budget.UserID, budget.CategoryID); err != nil {
+			return err
+		}
+		if err := restore[models.Budget](tx, id, userID); err != nil {
+			return err
+		}
+		return recordChange(tx, userID, models.ChangeRecordTypeBudget, id, models.ChangeActionRestore, nil, budgetSnapshot(budget))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.FindByID(id, userID)
+}
+
+// Purge はゴミ箱にある予算を変更履歴とともに完全に削除する
+func (r *budgetRepository) Purge(id, userID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := purge[models.Budget](tx, id, userID); err != nil {
+			return err
+		}
+		return deleteChangeHistories(tx, models.ChangeRecordTypeBudget, id)
+	})
+}
+
+// PurgeDeletedBefore は before より前にゴミ箱に移動した予算を変更履歴とともに完全に削除し、削除した件数を返す
+func (r *budgetRepository) PurgeDeletedBefore(before time.Time) (int64, error) {
+	var purged int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("record_type = ? AND record_id IN (?)", models.ChangeRecordTypeBudget,
+			tx.Unscoped().Model(&models.Budget{}).Select("id").Where("deleted_at < ?", before)).
+			Delete(&models.ChangeHistory{}).Error; err != nil {
+			return err
+		}
+
+		var err error
+		purged, err = purgeDeletedBefore[models.Budget](tx, before)
+		return err
+	})
+	return purged, err
 }
@@ -0,0 +1,141 @@
+package repositories
+
+import (
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type BudgetTemplateRepository interface {
+	FindAll(userID uint) ([]models.BudgetTemplate, error)
+	FindByID(id, userID uint) (*models.BudgetTemplate, error)
+	Create(template *models.BudgetTemplate) error
+	Update(id, userID uint, updates map[string]interface{}, items []models.BudgetTemplateItem) (*models.BudgetTemplate, error)
+	Delete(id, userID uint) error
+}
+
+type budgetTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewBudgetTemplateRepository(db *gorm.DB) BudgetTemplateRepository {
+	return &budgetTemplateRepository{db}
+}
+
+// preloadBudgetTemplate は予算テンプレートのレスポンスに必要なカテゴリごとの予算額をカテゴリIDの順にプリロードする
+// NOTE: テンプレートはゴミ箱にあるカテゴリの予算額も保持するため、カテゴリはゴミ箱にあるものも含める
+func preloadBudgetTemplate(db *gorm.DB) *gorm.DB {
+	return db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("budget_template_items.category_id ASC")
+	}).
+		Preload("Items.Category", func(db *gorm.DB) *gorm.DB {
+			return db.Unscoped()
+		})
+}
+
+func (r *budgetTemplateRepository) FindAll(userID uint) ([]models.BudgetTemplate, error) {
+	var templates []models.BudgetTemplate
+	err := preloadBudgetTemplate(r.db).Where("user_id = ?", userID).Order("name ASC").Find(&templates).Error
+	return templates, err
+}
+
+func (r *budgetTemplateRepository) FindByID(id, userID uint) (*models.BudgetTemplate, error) {
+	var template models.BudgetTemplate
+	err := preloadBudgetTemplate(r.db).Where("id = ? AND user_id = ?", id, userID).First(&template).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &template, nil
+}
+
+// Create は予算テンプレートをカテゴリごとの予算額とともに登録する
+func (r *budgetTemplateRepository) Create(template *models.BudgetTemplate) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := ensureCategoriesExist(tx, template.UserID, budgetTemplateCategoryIDs(template.Items)...); err != nil {
+			return err
+		}
+		return tx.Create(template).Error
+	})
+	if err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return ErrDuplicateEntry
+		}
+		if helpers.IsForeignKeyViolation(err) {
+			return ErrForeignKeyViolation
+		}
+		return err
+	}
+
+	return preloadBudgetTemplate(r.db).First(template, template.ID).Error
+}
+
+// Update は予算テンプレートを更新する。items が nil の場合はカテゴリごとの予算額を変更せず、指定した場合はすべて置き換える
+func (r *budgetTemplateRepository) Update(id, userID uint, updates map[string]interface{}, items []models.BudgetTemplateItem) (*models.BudgetTemplate, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// 存在確認
+		var existing models.BudgetTemplate
+		if err := tx.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+		if err := ensureCategoriesExist(tx, userID, budgetTemplateCategoryIDs(items)...); err != nil {
+			return err
+		}
+
+		if len(updates) > 0 {
+			if err := tx.Model(&models.BudgetTemplate{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+				return err
+			}
+		}
+
+		if items != nil {
+			if err := tx.Where("budget_template_id = ?", id).Delete(&models.BudgetTemplateItem{}).Error; err != nil {
+				return err
+			}
+			for i := range items {
+				items[i].BudgetTemplateID = id
+			}
+			if err := tx.Create(&items).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return nil, ErrDuplicateEntry
+		}
+		if helpers.IsForeignKeyViolation(err) {
+			return nil, ErrForeignKeyViolation
+		}
+		return nil, err
+	}
+
+	return r.FindByID(id, userID)
+}
+
+// Delete は予算テンプレートを削除する。カテゴリごとの予算額は外部キーの ON DELETE CASCADE で削除される
+func (r *budgetTemplateRepository) Delete(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.BudgetTemplate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func budgetTemplateCategoryIDs(items []models.BudgetTemplateItem) []uint {
+	ids := make([]uint, len(items))
+	for i, item := range items {
+		ids[i] = item.CategoryID
+	}
+	return ids
+}
@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type CategorizationRuleRepository interface {
+	FindAll(userID uint) ([]models.CategorizationRule, error)
+	FindByID(id, userID uint) (*models.CategorizationRule, error)
+	Create(rule *models.CategorizationRule) error
+	Update(id, userID uint, updates map[string]interface{}) (*models.CategorizationRule, error)
+	Delete(id, userID uint) error
+}
+
+type categorizationRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewCategorizationRuleRepository(db *gorm.DB) CategorizationRuleRepository {
+	return &categorizationRuleRepository{db}
+}
+
+// FindAll は自動分類ルールを判定する順（優先度の小さい順、同じ場合はIDの小さい順）に取得する
+func (r *categorizationRuleRepository) FindAll(userID uint) ([]models.CategorizationRule, error) {
+	var rules []models.CategorizationRule
+	err := r.db.Preload("Category").Where("user_id = ?", userID).Order("priority ASC, id ASC").Find(&rules).Error
+	return rules, err
+}
+
+func (r *categorizationRuleRepository) FindByID(id, userID uint) (*models.CategorizationRule, error) {
+	var rule models.CategorizationRule
+	err := r.db.Preload("Category").Where("id = ? AND user_id = ?", id, userID).First(&rule).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *categorizationRuleRepository) Create(rule *models.CategorizationRule) error {
+	if err := ensureCategoriesExist(r.db, rule.UserID, rule.CategoryID); err != nil {
+		return err
+	}
+	if err := r.db.Create(rule).Error; err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return ErrForeignKeyViolation
+		}
+		return err
+	}
+
+	// Categoryをプリロードして返す
+	return r.db.Preload("Category").First(rule, rule.ID).Error
+}
+
+func (r *categorizationRuleRepository) Update(id, userID uint, updates map[string]interface{}) (*models.CategorizationRule, error) {
+	// 存在確認
+	var existing models.CategorizationRule
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if err := ensureCategoriesExist(r.db, userID, updatedCategoryIDs(updates, "category_id")...); err != nil {
+		return nil, err
+	}
+
+	// 更新
+	if err := r.db.Model(&models.CategorizationRule{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return nil, ErrForeignKeyViolation
+		}
+		return nil, err
+	}
+
+	// 更新後のデータを取得
+	var rule models.CategorizationRule
+	if err := r.db.Preload("Category").Where("id = ? AND user_id = ?", id, userID).First(&rule).Error; err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (r *categorizationRuleRepository) Delete(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.CategorizationRule{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
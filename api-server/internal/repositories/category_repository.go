@@ -1,10 +1,12 @@
 package repositories
 
 import (
-	"apps/internal/helpers"
+	"time"
+
 	"apps/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CategoryRepository interface {
@@ -13,6 +15,10 @@ type CategoryRepository interface {
 	Create(category *models.Category) error
 	Update(id, userID uint, updates map[string]interface{}) (*models.Category, error)
 	Delete(id, userID uint) error
+	FindDeleted(userID uint) ([]models.Category, error)
+	Restore(id, userID uint) (*models.Category, error)
+	Purge(id, userID uint) error
+	PurgeDeletedBefore(before time.Time) (int64, error)
 }
 
 type categoryRepository struct {
@@ -69,16 +75,113 @@ func (r *categoryRepository) Update(id, userID uint, updates map[string]interfac
 	return &category, nil
 }
 
+// Delete はカテゴリをゴミ箱に移動する
+// 削除されていない取引・分割明細・予算や、定期取引・取込設定・自動分類ルールから参照されている場合は ErrForeignKeyViolation を返す
+// NOTE: 論理削除では外部キー制約が働かないため、参照の有無をDBトランザクション内で確認する
 func (r *categoryRepository) Delete(id, userID uint) error {
-	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.Category{})
-	if result.Error != nil {
-		if helpers.IsForeignKeyViolation(result.Error) {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var category models.Category
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND user_id = ?", id, userID).First(&category).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		var inUse bool
+		err := tx.Raw(`SELECT EXISTS (?) OR EXISTS (?) OR EXISTS (?) OR EXISTS (?) OR EXISTS (?) OR EXISTS (?)`,
+			tx.Model(&models.Transaction{}).Select("1").Where("category_id = ?", id),
+			tx.Model(&models.TransactionSplit{}).Select("1").
+				Joins("JOIN transactions ON transactions.id = transaction_splits.transaction_id AND transactions.deleted_at IS NULL").
+				Where("transaction_splits.category_id = ?", id),
+			tx.Model(&models.Budget{}).Select("1").Where("category_id = ?", id),
+			tx.Model(&models.RecurringTransaction{}).Select("1").Where("category_id = ?", id),
+			tx.Model(&models.ImportProfile{}).Select("1").Where("default_category_id = ? OR income_category_id = ?", id, id),
+			tx.Model(&models.CategorizationRule{}).Select("1").Where("category_id = ?", id),
+		).Scan(&inUse).Error
+		if err != nil {
+			return err
+		}
+		if inUse {
 			return ErrForeignKeyViolation
 		}
-		return result.Error
+
+		return tx.Delete(&category).Error
+	})
+}
+
+// FindDeleted はゴミ箱にあるカテゴリを取得する
+func (r *categoryRepository) FindDeleted(userID uint) ([]models.Category, error) {
+	return findDeleted[models.Category](r.db, userID)
+}
+
+// Restore はゴミ箱にあるカテゴリを元に戻す
+func (r *categoryRepository) Restore(id, userID uint) (*models.Category, error) {
+	if err := restore[models.Category](r.db, id, userID); err != nil {
+		return nil, err
+	}
+	return r.FindByID(id, userID)
+}
+
+// Purge はゴミ箱にあるカテゴリを完全に削除する
+// ゴミ箱にある取引・予算から参照されている場合は ErrForeignKeyViolation を返す
+func (r *categoryRepository) Purge(id, userID uint) error {
+	return purge[models.Category](r.db, id, userID)
+}
+
+// PurgeDeletedBefore は before より前にゴミ箱に移動したカテゴリを完全に削除し、削除した件数を返す
+// ゴミ箱にある取引・予算からまだ参照されているカテゴリは残し、それらが完全に削除された後に削除する
+func (r *categoryRepository) PurgeDeletedBefore(before time.Time) (int64, error) {
+	result := r.db.Unscoped().
+		Where("deleted_at < ?", before).
+		Where("NOT EXISTS (?)", r.db.Unscoped().Model(&models.Transaction{}).Select("1").Where("transactions.category_id = categories.id")).
+		Where("NOT EXISTS (?)", r.db.Model(&models.TransactionSplit{}).Select("1").Where("transaction_splits.category_id = categories.id")).
+		Where("NOT EXISTS (?)", r.db.Unscoped().Model(&models.Budget{}).Select("1").Where("budgets.category_id = categories.id")).
+		Delete(&models.Category{})
+	return result.RowsAffected, result.Error
+}
+
+// ensureCategoriesExist は ids のカテゴリがすべて userID のユーザーのもので、ゴミ箱にないことを確認する
+// 存在しない・他のユーザーの・ゴミ箱にあるカテゴリが含まれる場合は ErrForeignKeyViolation を返す
+// NOTE: 他のユーザーのカテゴリとゴミ箱にあるカテゴリは外部キー制約では検出できないため、参照する行の登録・更新前に確認する
+func ensureCategoriesExist(db *gorm.DB, userID uint, ids ...uint) error {
+	unique := make(map[uint]struct{}, len(ids))
+	for _, id := range ids {
+		unique[id] = struct{}{}
 	}
-	if result.RowsAffected == 0 {
-		return ErrNotFound
+	if len(unique) == 0 {
+		return nil
+	}
+
+	keys := make([]uint, 0, len(unique))
+	for id := range unique {
+		keys = append(keys, id)
+	}
+
+	var count int64
+	if err := db.Model(&models.Category{}).Where("id IN ? AND user_id = ?", keys, userID).Count(&count).Error; err != nil {
+		return err
+	}
+	if int(count) != len(keys) {
+		return ErrForeignKeyViolation
 	}
 	return nil
 }
+
+// updatedCategoryIDs は updates のうち keys に指定したカテゴリIDの値を取り出す
+func updatedCategoryIDs(updates map[string]interface{}, keys ...string) []uint {
+	var ids []uint
+	for _, key := range keys {
+		switch v := updates[key].(type) {
+		case int32:
+			ids = append(ids, uint(v))
+		case uint:
+			ids = append(ids, v)
+		case *int32:
+			if v != nil {
+				ids = append(ids, uint(*v))
+			}
+		}
+	}
+	return ids
+}
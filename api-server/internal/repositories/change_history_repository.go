@@ -0,0 +1,148 @@
+package repositories
+
+import (
+	"encoding/json"
+	"sort"
+
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ChangeHistoryRepository interface {
+	FindByRecord(recordType models.ChangeRecordType, recordID, userID uint) ([]models.ChangeHistory, error)
+}
+
+type changeHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewChangeHistoryRepository(db *gorm.DB) ChangeHistoryRepository {
+	return &changeHistoryRepository{db}
+}
+
+// FindByRecord は取引・予算の変更履歴を古い順に取得する
+func (r *changeHistoryRepository) FindByRecord(recordType models.ChangeRecordType, recordID, userID uint) ([]models.ChangeHistory, error) {
+	var histories []models.ChangeHistory
+	err := r.db.Where("record_type = ? AND record_id = ? AND user_id = ?", recordType, recordID, userID).
+		Order("id ASC").
+		Find(&histories).Error
+	return histories, err
+}
+
+// snapshot は変更履歴に記録する項目名と値
+type snapshot map[string]interface{}
+
+// transactionSnapshot は変更履歴に記録する取引の項目を返す
+// Splits・Tags はプリロード（または登録時に指定）されている想定
+func transactionSnapshot(transaction *models.Transaction) snapshot {
+	splits := make([]snapshot, len(transaction.Splits))
+	for i, split := range transaction.Splits {
+		splits[i] = snapshot{
+			"category_id":     split.CategoryID,
+			"amount":          split.Amount,
+			"original_amount": split.OriginalAmount,
+			"memo":            split.Memo,
+		}
+	}
+
+	tagIDs := make([]uint, len(transaction.Tags))
+	for i, tag := range transaction.Tags {
+		tagIDs[i] = tag.ID
+	}
+	sort.Slice(tagIDs, func(i, j int) bool { return tagIDs[i] < tagIDs[j] })
+
+	var exchangeRate *string
+	if transaction.ExchangeRate != nil {
+		rate := helpers.FormatRate(*transaction.ExchangeRate)
+		exchangeRate = &rate
+	}
+
+	return snapshot{
+		"date":                transaction.Date.Format("2006-01-02"),
+		"amount":              transaction.Amount,
+		"currency":            transaction.Currency,
+		"original_amount":     transaction.OriginalAmount,
+		"exchange_rate":       exchangeRate,
+		"description":         transaction.Description,
+		"category_id":         transaction.CategoryID,
+		"account_id":          transaction.AccountID,
+		"credit_card_id":      transaction.CreditCardID,
+		"cleared":             transaction.Cleared,
+		"reconciliation_id":   transaction.ReconciliationID,
+		"installment_plan_id": transaction.InstallmentPlanID,
+		"installment_number":  transaction.InstallmentNumber,
+		"splits":              splits,
+		"tag_ids":             tagIDs,
+	}
+}
+
+// budgetSnapshot は変更履歴に記録する予算の項目を返す
+func budgetSnapshot(budget *models.Budget) snapshot {
+	return snapshot{
+		"category_id": budget.CategoryID,
+		"amount":      budget.Amount,
+		"month":       budget.Month,
+	}
+}
+
+// pick は keys に指定した項目のみを返す。記録対象でない項目は含まない
+func (s snapshot) pick(keys []string) snapshot {
+	picked := make(snapshot, len(keys))
+	for _, key := range keys {
+		if v, ok := s[key]; ok {
+			picked[key] = v
+		}
+	}
+	return picked
+}
+
+// updatedKeys は updates で更新した項目名を返す
+func updatedKeys(updates map[string]interface{}) []string {
+	keys := make([]string, 0, len(updates))
+	for key := range updates {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// newChangeHistory は変更前・変更後の値から変更履歴を作成する。nil の値は NULL として記録する
+func newChangeHistory(userID uint, recordType models.ChangeRecordType, recordID uint, action models.ChangeAction, before, after snapshot) (*models.ChangeHistory, error) {
+	history := &models.ChangeHistory{
+		UserID:     userID,
+		RecordType: recordType,
+		RecordID:   recordID,
+		Action:     action,
+	}
+
+	var err error
+	if before != nil {
+		if history.Before, err = json.Marshal(before); err != nil {
+			return nil, err
+		}
+	}
+	if after != nil {
+		if history.After, err = json.Marshal(after); err != nil {
+			return nil, err
+		}
+	}
+	return history, nil
+}
+
+// recordChange は変更履歴を1件登録する。記録対象の行の変更と同じDBトランザクションで呼び出す
+func recordChange(tx *gorm.DB, userID uint, recordType models.ChangeRecordType, recordID uint, action models.ChangeAction, before, after snapshot) error {
+	history, err := newChangeHistory(userID, recordType, recordID, action, before, after)
+	if err != nil {
+		return err
+	}
+	return tx.Create(history).Error
+}
+
+// deleteChangeHistories は完全に削除した取引・予算の変更履歴を削除する
+func deleteChangeHistories(tx *gorm.DB, recordType models.ChangeRecordType, recordIDs ...uint) error {
+	if len(recordIDs) == 0 {
+		return nil
+	}
+	return tx.Where("record_type = ? AND record_id IN ?", recordType, recordIDs).Delete(&models.ChangeHistory{}).Error
+}
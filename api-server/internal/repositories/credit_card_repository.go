@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"time"
+
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// CreditCardDailyTotal はクレジットカードごとの日別の利用額の合計
+type CreditCardDailyTotal struct {
+	CreditCardID uint
+	Date         time.Time
+	Amount       int // 支出を正、収入（返金など）を負とした合計
+	Count        int // 取引件数
+}
+
+type CreditCardRepository interface {
+	FindAll(userID uint) ([]models.CreditCard, error)
+	FindByID(id, userID uint) (*models.CreditCard, error)
+	Create(card *models.CreditCard) error
+	Update(id, userID uint, updates map[string]interface{}) (*models.CreditCard, error)
+	Delete(id, userID uint) error
+	SumDaily(userID uint, startDate, endDate string) ([]CreditCardDailyTotal, error)
+}
+
+type creditCardRepository struct {
+	db *gorm.DB
+}
+
+func NewCreditCardRepository(db *gorm.DB) CreditCardRepository {
+	return &creditCardRepository{db}
+}
+
+func (r *creditCardRepository) FindAll(userID uint) ([]models.CreditCard, error) {
+	var cards []models.CreditCard
+	err := r.db.Where("user_id = ?", userID).Order("id ASC").Find(&cards).Error
+	return cards, err
+}
+
+func (r *creditCardRepository) FindByID(id, userID uint) (*models.CreditCard, error) {
+	var card models.CreditCard
+	err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&card).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &card, nil
+}
+
+func (r *creditCardRepository) Create(card *models.CreditCard) error {
+	return r.db.Create(card).Error
+}
+
+func (r *creditCardRepository) Update(id, userID uint, updates map[string]interface{}) (*models.CreditCard, error) {
+	// 存在確認
+	var existing models.CreditCard
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	// 更新
+	if err := r.db.Model(&models.CreditCard{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	// 更新後のデータを取得
+	var card models.CreditCard
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&card).Error; err != nil {
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+// Delete はクレジットカードを削除する。取引（ゴミ箱にあるものを含む）に使用されている場合は ErrForeignKeyViolation を返す
+func (r *creditCardRepository) Delete(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.CreditCard{})
+	if result.Error != nil {
+		if helpers.IsForeignKeyViolation(result.Error) {
+			return ErrForeignKeyViolation
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SumDaily は startDate 以上 endDate 未満にクレジットカードで支払った取引を、カードごと・日別に合計する
+// 分割された取引は分割明細ごとのカテゴリで支出・収入を判定する
+func (r *creditCardRepository) SumDaily(userID uint, startDate, endDate string) ([]CreditCardDailyTotal, error) {
+	var totals []CreditCardDailyTotal
+
+	err := r.db.Table("(?) AS transaction_lines", transactionLines(r.db)).
+		Select(`transaction_lines.credit_card_id, DATE(transaction_lines.date) AS date,
+			SUM(CASE WHEN categories.type = ? THEN transaction_lines.amount ELSE -transaction_lines.amount END) AS amount,
+			COUNT(DISTINCT transaction_lines.id) AS count`, models.CategoryTypeExpense).
+		Joins("JOIN categories ON categories.id = transaction_lines.category_id").
+		Where("transaction_lines.user_id = ? AND transaction_lines.credit_card_id IS NOT NULL", userID).
+		Where("transaction_lines.date >= ? AND transaction_lines.date < ?", startDate, endDate).
+		Group("transaction_lines.credit_card_id, DATE(transaction_lines.date)").
+		Order("date ASC").
+		Scan(&totals).Error
+	return totals, err
+}
@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"time"
+
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ExchangeRateFindParams struct {
+	BaseCurrency string
+	Currency     *string
+	StartDate    *string
+	EndDate      *string
+}
+
+type ExchangeRateRepository interface {
+	FindAll(userID uint, params *ExchangeRateFindParams) ([]models.ExchangeRate, error)
+	FindByID(id, userID uint) (*models.ExchangeRate, error)
+	FindLatest(userID uint, baseCurrency, currency string, date time.Time) (*models.ExchangeRate, error)
+	Create(rate *models.ExchangeRate) error
+	Update(id, userID uint, updates map[string]interface{}) (*models.ExchangeRate, error)
+	Delete(id, userID uint) error
+	Upsert(rates []models.ExchangeRate) error
+}
+
+type exchangeRateRepository struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) ExchangeRateRepository {
+	return &exchangeRateRepository{db}
+}
+
+func (r *exchangeRateRepository) FindAll(userID uint, params *ExchangeRateFindParams) ([]models.ExchangeRate, error) {
+	var rates []models.ExchangeRate
+
+	query := r.db.Where("user_id = ? AND base_currency = ?", userID, params.BaseCurrency)
+	if params.Currency != nil {
+		query = query.Where("currency = ?", *params.Currency)
+	}
+	if params.StartDate != nil {
+		query = query.Where("date >= ?", *params.StartDate)
+	}
+	if params.EndDate != nil {
+		query = query.Where("date <= ?", *params.EndDate)
+	}
+
+	err := query.Order("date DESC, currency ASC").Find(&rates).Error
+	return rates, err
+}
+
+func (r *exchangeRateRepository) FindByID(id, userID uint) (*models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+	err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&rate).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// FindLatest は date 以前で最も新しい currency の為替レートを取得する
+func (r *exchangeRateRepository) FindLatest(userID uint, baseCurrency, currency string, date time.Time) (*models.ExchangeRate, error) {
+	var rate models.ExchangeRate
+	err := r.db.Where("user_id = ? AND base_currency = ? AND currency = ? AND date <= ?", userID, baseCurrency, currency, date.Format("2006-01-02")).
+		Order("date DESC").
+		First(&rate).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &rate, nil
+}
+
+func (r *exchangeRateRepository) Create(rate *models.ExchangeRate) error {
+	if err := r.db.Create(rate).Error; err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return ErrDuplicateEntry
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *exchangeRateRepository) Update(id, userID uint, updates map[string]interface{}) (*models.ExchangeRate, error) {
+	// 存在確認
+	var existing models.ExchangeRate
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	// 更新
+	if err := r.db.Model(&models.ExchangeRate{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return nil, ErrDuplicateEntry
+		}
+		return nil, err
+	}
+
+	// 更新後のデータを取得
+	var rate models.ExchangeRate
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&rate).Error; err != nil {
+		return nil, err
+	}
+
+	return &rate, nil
+}
+
+func (r *exchangeRateRepository) Delete(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.ExchangeRate{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Upsert は為替レートを1つのDBトランザクションで登録する。同じ通貨・日付のレートが既にある場合は上書きする
+func (r *exchangeRateRepository) Upsert(rates []models.ExchangeRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Clauses(clause.OnConflict{
+			DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+		}).CreateInBatches(&rates, 500).Error
+	})
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type ImportProfileRepository interface {
+	FindAll(userID uint) ([]models.ImportProfile, error)
+	FindByID(id, userID uint) (*models.ImportProfile, error)
+	Create(profile *models.ImportProfile) error
+	Update(id, userID uint, updates map[string]interface{}) (*models.ImportProfile, error)
+	Delete(id, userID uint) error
+}
+
+type importProfileRepository struct {
+	db *gorm.DB
+}
+
+func NewImportProfileRepository(db *gorm.DB) ImportProfileRepository {
+	return &importProfileRepository{db}
+}
+
+func (r *importProfileRepository) FindAll(userID uint) ([]models.ImportProfile, error) {
+	var profiles []models.ImportProfile
+	err := r.db.Where("user_id = ?", userID).Order("id ASC").Find(&profiles).Error
+	return profiles, err
+}
+
+func (r *importProfileRepository) FindByID(id, userID uint) (*models.ImportProfile, error) {
+	var profile models.ImportProfile
+	err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&profile).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &profile, nil
+}
+
+func (r *importProfileRepository) Create(profile *models.ImportProfile) error {
+	categoryIDs := []uint{profile.DefaultCategoryID}
+	if profile.IncomeCategoryID != nil {
+		categoryIDs = append(categoryIDs, *profile.IncomeCategoryID)
+	}
+	if err := ensureCategoriesExist(r.db, profile.UserID, categoryIDs...); err != nil {
+		return err
+	}
+	if err := r.db.Create(profile).Error; err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return ErrForeignKeyViolation
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *importProfileRepository) Update(id, userID uint, updates map[string]interface{}) (*models.ImportProfile, error) {
+	// 存在確認
+	var existing models.ImportProfile
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if err := ensureCategoriesExist(r.db, userID, updatedCategoryIDs(updates, "default_category_id", "income_category_id")...); err != nil {
+		return nil, err
+	}
+
+	// 更新
+	if err := r.db.Model(&models.ImportProfile{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return nil, ErrForeignKeyViolation
+		}
+		return nil, err
+	}
+
+	// 更新後のデータを取得
+	var profile models.ImportProfile
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&profile).Error; err != nil {
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+func (r *importProfileRepository) Delete(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.ImportProfile{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package repositories
+
+import (
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type InstallmentPlanRepository interface {
+	FindAll(userID uint) ([]models.InstallmentPlan, error)
+	FindByID(id, userID uint) (*models.InstallmentPlan, error)
+	Create(plan *models.InstallmentPlan, transactionID uint, payments []models.Transaction) error
+	Delete(id, userID uint) error
+}
+
+type installmentPlanRepository struct {
+	db *gorm.DB
+}
+
+func NewInstallmentPlanRepository(db *gorm.DB) InstallmentPlanRepository {
+	return &installmentPlanRepository{db}
+}
+
+// preloadInstallmentPlan は分割払いのレスポンスに必要な各回の支払いの取引を支払回の順にプリロードする
+// NOTE: ゴミ箱に移動した支払いの取引は含めない
+func preloadInstallmentPlan(db *gorm.DB) *gorm.DB {
+	return db.Preload("Transactions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("transactions.installment_number ASC")
+	}).
+		Preload("Transactions.Category").
+		Preload("Transactions.Splits.Category").
+		Preload("Transactions.Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("tags.name ASC")
+		})
+}
+
+// FindAll は分割払いを購入日の新しい順に取得する
+func (r *installmentPlanRepository) FindAll(userID uint) ([]models.InstallmentPlan, error) {
+	var plans []models.InstallmentPlan
+	err := preloadInstallmentPlan(r.db).Where("user_id = ?", userID).Order("purchase_date DESC, id DESC").Find(&plans).Error
+	return plans, err
+}
+
+func (r *installmentPlanRepository) FindByID(id, userID uint) (*models.InstallmentPlan, error) {
+	var plan models.InstallmentPlan
+	err := preloadInstallmentPlan(r.db).Where("id = ? AND user_id = ?", id, userID).First(&plan).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// Create は分割払いを登録し、transactionID の取引を1回目の支払い（payments[0]）の金額・日付に変更して、
+// 2回目以降の支払い（payments[1:]）を取引として登録する。それぞれ変更履歴を記録する
+// 取引が見つからない場合は ErrNotFound を返し、何も登録しない
+func (r *installmentPlanRepository) Create(plan *models.InstallmentPlan, transactionID uint, payments []models.Transaction) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(plan).Error; err != nil {
+			return err
+		}
+
+		first := payments[0]
+		updates := map[string]interface{}{
+			"amount":              first.Amount,
+			"currency":            first.Currency,
+			"original_amount":     first.OriginalAmount,
+			"exchange_rate":       nil,
+			"date":                first.Date,
+			"installment_plan_id": plan.ID,
+			"installment_number":  1,
+		}
+		if err := updateTransaction(tx, transactionID, plan.UserID, updates, nil, nil); err != nil {
+			return err
+		}
+
+		for i := range payments[1:] {
+			payment := &payments[i+1]
+			payment.InstallmentPlanID = &plan.ID
+			if err := createTransaction(tx, payment); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return ErrForeignKeyViolation
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete は分割払いを取り消す。1回目の支払いの取引を購入時の金額・通貨・換算レート・日付に戻し、2回目以降の支払いの取引をゴミ箱に移動する
+// ゴミ箱にある支払いの取引は、外部キーの ON DELETE SET NULL で分割払いとの紐づけのみ解除する
+func (r *installmentPlanRepository) Delete(id, userID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var plan models.InstallmentPlan
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND user_id = ?", id, userID).First(&plan).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		var payments []models.Transaction
+		if err := tx.Where("installment_plan_id = ? AND user_id = ?", id, userID).Find(&payments).Error; err != nil {
+			return err
+		}
+		for _, payment := range payments {
+			if payment.InstallmentNumber != nil && *payment.InstallmentNumber == 1 {
+				updates := map[string]interface{}{
+					"amount":              plan.Principal,
+					"currency":            plan.PurchaseCurrency,
+					"original_amount":     plan.PurchaseOriginalAmount,
+					"exchange_rate":       plan.PurchaseExchangeRate,
+					"date":                plan.PurchaseDate,
+					"installment_plan_id": nil,
+					"installment_number":  nil,
+				}
+				if err := updateTransaction(tx, payment.ID, userID, updates, nil, nil); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := deleteTransaction(tx, payment.ID, userID); err != nil {
+				return err
+			}
+		}
+
+		return tx.Delete(&plan).Error
+	})
+}
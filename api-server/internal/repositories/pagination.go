@@ -0,0 +1,51 @@
+package repositories
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// PageParams はキーセットページネーションの条件
+// 並び替えキーが同じ値の行は id で順序を決める
+type PageParams struct {
+	SortColumn string // 並び替えに使用するカラム（例: transactions.date）
+	Desc       bool
+	Limit      int
+	After      *PageCursor
+}
+
+// PageCursor は前のページの最後の行の位置
+type PageCursor struct {
+	Value interface{} // 最後の行の並び替えキーの値
+	ID    uint
+}
+
+// paginate はキーセットページネーションの条件と並び順をクエリに追加する
+// 次のページの有無を判定できるよう、Limit より1件多く取得する
+func paginate(query *gorm.DB, table string, page *PageParams) *gorm.DB {
+	idColumn := table + ".id"
+	direction, operator := "ASC", ">"
+	if page.Desc {
+		direction, operator = "DESC", "<"
+	}
+
+	if page.After != nil {
+		query = query.Where(
+			fmt.Sprintf("(%s %s ?) OR (%s = ? AND %s %s ?)", page.SortColumn, operator, page.SortColumn, idColumn, operator),
+			page.After.Value, page.After.Value, page.After.ID,
+		)
+	}
+
+	return query.
+		Order(fmt.Sprintf("%s %s, %s %s", page.SortColumn, direction, idColumn, direction)).
+		Limit(page.Limit + 1)
+}
+
+// trimPage は Limit より1件多く取得した結果を Limit 件に切り詰め、次のページがあるかを返す
+func trimPage[T any](items []T, page *PageParams) ([]T, bool) {
+	if len(items) > page.Limit {
+		return items[:page.Limit], true
+	}
+	return items, false
+}
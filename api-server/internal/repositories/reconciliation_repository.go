@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReconciliationSummary は口座の照合に使う、ある日付までの消込の状況
+type ReconciliationSummary struct {
+	ClearedTotal   int // 消込済みの入出金の合計（開始残高は含まない）
+	ClearedCount   int // 消込済みで未照合の取引の件数
+	UnclearedCount int // 未消込の取引の件数
+}
+
+type ReconciliationRepository interface {
+	FindAll(accountID, userID uint) ([]models.Reconciliation, error)
+	Summarize(accountID, userID uint, endDate string) (*ReconciliationSummary, error)
+	Create(reconciliation *models.Reconciliation) error
+}
+
+type reconciliationRepository struct {
+	db *gorm.DB
+}
+
+func NewReconciliationRepository(db *gorm.DB) ReconciliationRepository {
+	return &reconciliationRepository{db}
+}
+
+// FindAll は口座の照合を明細の日付の新しい順に取得する
+func (r *reconciliationRepository) FindAll(accountID, userID uint) ([]models.Reconciliation, error) {
+	var reconciliations []models.Reconciliation
+	err := r.db.Where("account_id = ? AND user_id = ?", accountID, userID).Order("statement_date DESC, id DESC").Find(&reconciliations).Error
+	return reconciliations, err
+}
+
+// Summarize は endDate 未満の口座の入出金のうち消込済みのものを合計し、取引の消込の件数を数える
+func (r *reconciliationRepository) Summarize(accountID, userID uint, endDate string) (*ReconciliationSummary, error) {
+	var summary ReconciliationSummary
+
+	err := r.db.Table("(?) AS account_entries", accountEntries(r.db, userID)).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("account_id = ? AND cleared AND date < ?", accountID, endDate).
+		Scan(&summary.ClearedTotal).Error
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.db.Model(&models.Transaction{}).
+		Select(`COALESCE(SUM(CASE WHEN cleared AND reconciliation_id IS NULL THEN 1 ELSE 0 END), 0) AS cleared_count,
+			COALESCE(SUM(CASE WHEN NOT cleared THEN 1 ELSE 0 END), 0) AS uncleared_count`).
+		Where("user_id = ? AND account_id = ? AND date < ?", userID, accountID, endDate).
+		Scan(&summary).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// Create は照合を登録し、明細の日付以前の口座の消込済みで未照合の取引を照合済みにしてロックする
+// 照合済みにした取引の件数を reconciliation.TransactionCount に設定し、取引ごとに変更履歴を記録する
+func (r *reconciliationRepository) Create(reconciliation *models.Reconciliation) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var targets []models.Transaction
+		if err := tx.Select("id").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ? AND account_id = ? AND cleared AND reconciliation_id IS NULL AND date < ?",
+				reconciliation.UserID, reconciliation.AccountID, reconciliation.StatementDate.AddDate(0, 0, 1)).
+			Find(&targets).Error; err != nil {
+			return err
+		}
+
+		reconciliation.TransactionCount = len(targets)
+		if err := tx.Create(reconciliation).Error; err != nil {
+			return err
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(targets))
+		histories := make([]*models.ChangeHistory, len(targets))
+		for i, target := range targets {
+			ids[i] = target.ID
+			history, err := newChangeHistory(reconciliation.UserID, models.ChangeRecordTypeTransaction, target.ID, models.ChangeActionUpdate,
+				snapshot{"reconciliation_id": nil}, snapshot{"reconciliation_id": reconciliation.ID})
+			if err != nil {
+				return err
+			}
+			histories[i] = history
+		}
+
+		if err := tx.Model(&models.Transaction{}).Where("id IN ?", ids).Update("reconciliation_id", reconciliation.ID).Error; err != nil {
+			return err
+		}
+		return tx.Create(&histories).Error
+	})
+}
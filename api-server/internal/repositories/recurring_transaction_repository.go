@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"time"
+
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RecurringTransactionRepository interface {
+	FindAll(userID uint) ([]models.RecurringTransaction, error)
+	FindByID(id, userID uint) (*models.RecurringTransaction, error)
+	Create(recurringTransaction *models.RecurringTransaction) error
+	Update(id, userID uint, updates map[string]interface{}) (*models.RecurringTransaction, error)
+	Delete(id, userID uint) error
+	SaveOccurrences(recurringTransaction *models.RecurringTransaction, transactions []models.Transaction, generatedUntil time.Time) (int64, error)
+}
+
+type recurringTransactionRepository struct {
+	db *gorm.DB
+}
+
+func NewRecurringTransactionRepository(db *gorm.DB) RecurringTransactionRepository {
+	return &recurringTransactionRepository{db}
+}
+
+func (r *recurringTransactionRepository) FindAll(userID uint) ([]models.RecurringTransaction, error) {
+	var recurringTransactions []models.RecurringTransaction
+	err := r.db.Preload("Category").Where("user_id = ?", userID).Order("id ASC").Find(&recurringTransactions).Error
+	return recurringTransactions, err
+}
+
+func (r *recurringTransactionRepository) FindByID(id, userID uint) (*models.RecurringTransaction, error) {
+	var recurringTransaction models.RecurringTransaction
+	err := r.db.Preload("Category").Where("id = ? AND user_id = ?", id, userID).First(&recurringTransaction).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &recurringTransaction, nil
+}
+
+func (r *recurringTransactionRepository) Create(recurringTransaction *models.RecurringTransaction) error {
+	if err := ensureCategoriesExist(r.db, recurringTransaction.UserID, recurringTransaction.CategoryID); err != nil {
+		return err
+	}
+	if err := r.db.Create(recurringTransaction).Error; err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return ErrForeignKeyViolation
+		}
+		return err
+	}
+
+	// Categoryをプリロードして返す
+	return r.db.Preload("Category").First(recurringTransaction, recurringTransaction.ID).Error
+}
+
+func (r *recurringTransactionRepository) Update(id, userID uint, updates map[string]interface{}) (*models.RecurringTransaction, error) {
+	// 存在確認
+	var existing models.RecurringTransaction
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if err := ensureCategoriesExist(r.db, userID, updatedCategoryIDs(updates, "category_id")...); err != nil {
+		return nil, err
+	}
+
+	// 更新
+	if err := r.db.Model(&models.RecurringTransaction{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return nil, ErrForeignKeyViolation
+		}
+		return nil, err
+	}
+
+	// 更新後のデータを取得
+	var recurringTransaction models.RecurringTransaction
+	if err := r.db.Preload("Category").Where("id = ? AND user_id = ?", id, userID).First(&recurringTransaction).Error; err != nil {
+		return nil, err
+	}
+
+	return &recurringTransaction, nil
+}
+
+func (r *recurringTransactionRepository) Delete(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.RecurringTransaction{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SaveOccurrences は定期取引から生成した取引を登録し、生成済みの最終日を更新する
+// 同じ定期取引・同じ日付の取引が既に存在する場合は登録しないため、何度実行しても重複しない
+// 新たに登録した取引ごとに変更履歴を記録する。戻り値は新たに登録された件数
+func (r *recurringTransactionRepository) SaveOccurrences(recurringTransaction *models.RecurringTransaction, transactions []models.Transaction, generatedUntil time.Time) (int64, error) {
+	var created int64
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for i := range transactions {
+			transaction := &transactions[i]
+			result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(transaction)
+			if result.Error != nil {
+				if helpers.IsForeignKeyViolation(result.Error) {
+					return ErrForeignKeyViolation
+				}
+				return result.Error
+			}
+			// NOTE: 生成済みの日付の取引は登録されない（RowsAffected が0）ため、変更履歴も記録しない
+			if result.RowsAffected == 0 {
+				continue
+			}
+			created++
+			if err := recordChange(tx, transaction.UserID, models.ChangeRecordTypeTransaction, transaction.ID, models.ChangeActionCreate, nil, transactionSnapshot(transaction)); err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&models.RecurringTransaction{}).
+			Where("id = ? AND user_id = ?", recurringTransaction.ID, recurringTransaction.UserID).
+			Update("generated_until", generatedUntil).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return created, nil
+}
@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"apps/internal/models"
+	"apps/internal/testdb"
+)
+
+func TestSaveOccurrencesRecordsHistoryForCreatedTransactions(t *testing.T) {
+	db, fake := testdb.New(t)
+
+	// 2026-02-25 の取引は生成済みとして、ON DUPLICATE KEY で登録されないようにする
+	generated := time.Date(2026, 2, 25, 0, 0, 0, 0, time.UTC)
+	fake.Affects("^INSERT INTO `transactions`", func(query string, args []driver.Value) int64 {
+		for _, arg := range args {
+			if date, ok := arg.(time.Time); ok && date.Equal(generated) {
+				return 0
+			}
+		}
+		return 1
+	})
+
+	recurringTransaction := &models.RecurringTransaction{ID: 5, UserID: 9, CategoryID: 2, Amount: 80000}
+	var transactions []models.Transaction
+	for _, month := range []time.Month{time.January, time.February, time.March} {
+		transactions = append(transactions, models.Transaction{
+			UserID:                 9,
+			CategoryID:             2,
+			RecurringTransactionID: &recurringTransaction.ID,
+			Amount:                 80000,
+			Currency:               "JPY",
+			OriginalAmount:         80000,
+			Date:                   time.Date(2026, month, 25, 0, 0, 0, 0, time.UTC),
+		})
+	}
+
+	created, err := NewRecurringTransactionRepository(db).SaveOccurrences(recurringTransaction, transactions, time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created != 2 {
+		t.Errorf("created = %d, want 2", created)
+	}
+
+	histories := fake.Statements("^INSERT INTO `change_histories`")
+	if len(histories) != 2 {
+		t.Fatalf("change histories = %d, want 2", len(histories))
+	}
+	for i, want := range []*models.Transaction{&transactions[0], &transactions[2]} {
+		history := histories[i].Inserted()
+		if want.ID == 0 || history["record_id"] != int64(want.ID) || history["record_type"] != string(models.ChangeRecordTypeTransaction) {
+			t.Errorf("history %d = %v, want transaction %d", i, history, want.ID)
+		}
+		if history["action"] != string(models.ChangeActionCreate) || history["user_id"] != int64(9) {
+			t.Errorf("history %d = %v, want create by user 9", i, history)
+		}
+		after, _ := history["after"].([]byte)
+		var snapshot map[string]interface{}
+		if err := json.Unmarshal(after, &snapshot); err != nil {
+			t.Fatalf("history %d: after = %s: %v", i, after, err)
+		}
+		if snapshot["date"] != want.Date.Format("2006-01-02") {
+			t.Errorf("history %d: after = %s", i, after)
+		}
+	}
+}
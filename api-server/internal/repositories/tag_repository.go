@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// TagTotal はタグごとの収入・支出合計の集計結果
+type TagTotal struct {
+	TagID   uint
+	Income  int
+	Expense int
+	Count   int
+}
+
+type TagRepository interface {
+	FindAll(userID uint) ([]models.Tag, error)
+	FindByID(id, userID uint) (*models.Tag, error)
+	FindByIDs(ids []uint, userID uint) ([]models.Tag, error)
+	Create(tag *models.Tag) error
+	Update(id, userID uint, updates map[string]interface{}) (*models.Tag, error)
+	Delete(id, userID uint) error
+	SumByTag(userID uint, startDate, endDate string) ([]TagTotal, error)
+}
+
+type tagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return &tagRepository{db}
+}
+
+func (r *tagRepository) FindAll(userID uint) ([]models.Tag, error) {
+	var tags []models.Tag
+	err := r.db.Where("user_id = ?", userID).Order("name ASC").Find(&tags).Error
+	return tags, err
+}
+
+func (r *tagRepository) FindByID(id, userID uint) (*models.Tag, error) {
+	var tag models.Tag
+	err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&tag).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &tag, nil
+}
+
+// FindByIDs は ids のうちユーザーのタグを取得する。存在しないIDや他のユーザーのタグは含まれない
+func (r *tagRepository) FindByIDs(ids []uint, userID uint) ([]models.Tag, error) {
+	var tags []models.Tag
+	if len(ids) == 0 {
+		return tags, nil
+	}
+	err := r.db.Where("id IN ? AND user_id = ?", ids, userID).Find(&tags).Error
+	return tags, err
+}
+
+func (r *tagRepository) Create(tag *models.Tag) error {
+	if err := r.db.Create(tag).Error; err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return ErrDuplicateEntry
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *tagRepository) Update(id, userID uint, updates map[string]interface{}) (*models.Tag, error) {
+	// 存在確認
+	var existing models.Tag
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	// 更新
+	if err := r.db.Model(&models.Tag{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+		if helpers.IsDuplicateEntry(err) {
+			return nil, ErrDuplicateEntry
+		}
+		return nil, err
+	}
+
+	// 更新後のデータを取得
+	var tag models.Tag
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&tag).Error; err != nil {
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+// Delete はタグを削除する。取引との紐づけは外部キーの ON DELETE CASCADE で削除される
+func (r *tagRepository) Delete(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.Tag{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// SumByTag は startDate 以上 endDate 以下の取引をタグごとに集計する。取引のないタグは含まれない
+// 分割された取引は分割明細ごとにそのカテゴリのタイプで集計する
+func (r *tagRepository) SumByTag(userID uint, startDate, endDate string) ([]TagTotal, error) {
+	var totals []TagTotal
+
+	lines := transactionLines(r.db).
+		Where("transactions.user_id = ? AND transactions.date >= ? AND transactions.date <= ?", userID, startDate, endDate)
+
+	err := r.db.Table("transaction_tags").
+		Select(`transaction_tags.tag_id,
+			COALESCE(SUM(CASE WHEN categories.type = ? THEN transaction_lines.amount ELSE 0 END), 0) AS income,
+			COALESCE(SUM(CASE WHEN categories.type = ? THEN transaction_lines.amount ELSE 0 END), 0) AS expense,
+			COUNT(DISTINCT transaction_lines.id) AS count`, models.CategoryTypeIncome, models.CategoryTypeExpense).
+		Joins("JOIN (?) AS transaction_lines ON transaction_lines.id = transaction_tags.transaction_id", lines).
+		Joins("JOIN categories ON categories.id = transaction_lines.category_id").
+		Group("transaction_tags.tag_id").
+		Scan(&totals).Error
+	return totals, err
+}
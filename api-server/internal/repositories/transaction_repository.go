@@ -1,25 +1,140 @@
 package repositories
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	"apps/internal/helpers"
 	"apps/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// ErrSplitAmountMismatch は分割明細の金額の合計が取引の金額と一致しない場合のエラー
+var ErrSplitAmountMismatch = errors.New("split amount mismatch")
+
+// ErrTransactionLocked は照合済みでロックされた取引を変更しようとした場合のエラー
+var ErrTransactionLocked = errors.New("transaction locked")
+
 type TransactionFindParams struct {
-	StartDate  *string
-	EndDate    *string
-	Type       *string
-	CategoryID *int32
+	IDs                []uint // いずれかに一致（空のスライスの場合はどの取引にも一致しない）
+	StartDate          *string
+	EndDate            *string
+	Type               *string
+	CategoryID         *int32
+	AccountID          *int32
+	CreditCardID       *int32
+	Cleared            *bool
+	Query              *string // 説明のキーワード（空白区切りですべてを含む）
+	MinAmount          *int32
+	MaxAmount          *int32
+	CategoryIDs        []int32 // いずれかに一致
+	ExcludeCategoryIDs []int32
+	TagID              *int32
+	AnyTagIDs          []int32 // いずれかのタグが付いている
+	AllTagIDs          []int32 // すべてのタグが付いている
+}
+
+// TransactionDailyTotal は日別の収入・支出合計の集計結果
+type TransactionDailyTotal struct {
+	Date    time.Time
+	Income  int
+	Expense int
+	Count   int
+}
+
+// TransactionTotal は収入・支出合計の集計結果
+type TransactionTotal struct {
+	Income  int
+	Expense int
+}
+
+// TransactionCategoryDailyTotal はカテゴリごと・日別の収入・支出合計の集計結果
+type TransactionCategoryDailyTotal struct {
+	Date       time.Time
+	CategoryID uint
+	Income     int
+	Expense    int
+}
+
+// TransactionExportRow はエクスポート用にカテゴリ名・カテゴリタイプを含めた取引
+type TransactionExportRow struct {
+	ID             uint
+	Date           time.Time
+	Amount         int
+	Currency       string
+	OriginalAmount int
+	Description    string
+	CategoryID     uint
+	CategoryName   string
+	CategoryType   models.CategoryType
+}
+
+// TransactionBatchAction は一括操作の種類
+type TransactionBatchAction string
+
+const (
+	TransactionBatchCreate TransactionBatchAction = "create"
+	TransactionBatchUpdate TransactionBatchAction = "update"
+	TransactionBatchDelete TransactionBatchAction = "delete"
+)
+
+// TransactionBatchOperation は一括操作の1件分
+// create の場合は Transaction、update の場合は ID と Updates・Splits・Tags、delete の場合は ID を使用する
+type TransactionBatchOperation struct {
+	Action      TransactionBatchAction
+	ID          uint
+	Transaction *models.Transaction
+	Updates     map[string]interface{}
+	Splits      []models.TransactionSplit
+	Tags        []models.Tag
+}
+
+// BatchError は一括操作のうち失敗した操作の位置と原因
+type BatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("operation %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchError) Unwrap() error {
+	return e.Err
 }
 
 type TransactionRepository interface {
-	FindAll(userID uint, params *TransactionFindParams) ([]models.Transaction, error)
+	FindAll(userID uint, params *TransactionFindParams, page *PageParams) ([]models.Transaction, bool, error)
+	Each(ctx context.Context, userID uint, params *TransactionFindParams, fn func(row *TransactionExportRow) error) error
+	SumBefore(userID uint, date string) (*TransactionTotal, error)
+	SumDaily(userID uint, startDate, endDate string) ([]TransactionDailyTotal, error)
+	SumDailyByCategory(userID uint, startDate, endDate string) ([]TransactionCategoryDailyTotal, error)
 	FindByID(id, userID uint) (*models.Transaction, error)
+	FindByAmountAndDate(userID uint, amount int, startDate, endDate string) ([]models.Transaction, error)
+	FindPossibleDuplicates(userID uint, startDate, endDate string, windowDays int) ([]models.Transaction, error)
 	Create(transaction *models.Transaction) error
-	Update(id, userID uint, updates map[string]interface{}) (*models.Transaction, error)
+	CreateBatch(transactions []models.Transaction) error
+	Update(id, userID uint, updates map[string]interface{}, splits []models.TransactionSplit, tags []models.Tag) (*models.Transaction, error)
 	Delete(id, userID uint) error
+	ApplyBatch(userID uint, operations []TransactionBatchOperation) ([]*models.Transaction, error)
+	UpdateCategoryByFilter(userID uint, params *TransactionFindParams, categoryID uint) (int64, error)
+	MergeDuplicates(keepID uint, duplicateIDs []uint, userID uint) (*models.Transaction, error)
+	Unlock(id, userID uint) (*models.Transaction, error)
+	FindDeleted(userID uint) ([]models.Transaction, error)
+	Restore(id, userID uint) (*models.Transaction, error)
+	Purge(id, userID uint) error
+	PurgeDeletedBefore(before time.Time) ([]PurgedTransaction, error)
+}
+
+// PurgedTransaction は完全に削除した取引のIDと所有者（添付ファイルの削除に使用する）
+type PurgedTransaction struct {
+	ID     uint
+	UserID uint
 }
 
 type transactionRepository struct {
@@ -30,34 +145,239 @@ func NewTransactionRepository(db *gorm.DB) TransactionRepository {
 	return &transactionRepository{db}
 }
 
-func (r *transactionRepository) FindAll(userID uint, params *TransactionFindParams) ([]models.Transaction, error) {
+// FindAll は条件に一致する取引を取得する。page を指定した場合は1ページ分と次のページの有無を返す
+func (r *transactionRepository) FindAll(userID uint, params *TransactionFindParams, page *PageParams) ([]models.Transaction, bool, error) {
 	var transactions []models.Transaction
 
-	query := r.db.Preload("Category").Where("user_id = ?", userID)
+	query := filterTransactions(preloadTransaction(r.db), userID, params)
+
+	if page == nil {
+		err := query.Order("transactions.date DESC").Find(&transactions).Error
+		return transactions, false, err
+	}
+
+	if err := paginate(query, "transactions", page).Find(&transactions).Error; err != nil {
+		return nil, false, err
+	}
+	transactions, hasNext := trimPage(transactions, page)
+	return transactions, hasNext, nil
+}
+
+// Each は条件に一致する取引をカテゴリ名・カテゴリタイプ付きで日付の昇順に1件ずつ fn に渡す
+// DBカーソルで1行ずつ読み込むため、件数が多くてもすべてをメモリに載せない
+// fn がエラーを返した場合や ctx がキャンセルされた場合は読み込みを中断し、そのエラーを返す
+func (r *transactionRepository) Each(ctx context.Context, userID uint, params *TransactionFindParams, fn func(row *TransactionExportRow) error) error {
+	query := filterTransactions(r.db.WithContext(ctx).Model(&models.Transaction{}), userID, params).
+		Select(`transactions.id, transactions.date, transactions.amount, transactions.currency, transactions.original_amount, transactions.description,
+			transactions.category_id, categories.name AS category_name, categories.type AS category_type`).
+		Joins("JOIN categories ON categories.id = transactions.category_id")
+
+	rows, err := query.Order("transactions.date ASC, transactions.id ASC").Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		// 呼び出し元がキャンセルされた場合（クライアントの切断など）は読み込みを打ち切る
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var row TransactionExportRow
+		if err := r.db.ScanRows(rows, &row); err != nil {
+			return err
+		}
+		if err := fn(&row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// filterTransactions は TransactionFindParams の条件をクエリに追加する
+// カテゴリ・カテゴリタイプの条件は、分割された取引では分割明細のカテゴリで判定する
+// NOTE: 呼び出し側で categories を JOIN する場合があるため、カテゴリタイプはサブクエリで絞り込む
+func filterTransactions(query *gorm.DB, userID uint, params *TransactionFindParams) *gorm.DB {
+	query = query.Where("transactions.user_id = ?", userID)
 
 	if params != nil {
+		if params.IDs != nil {
+			query = query.Where("transactions.id IN ?", params.IDs)
+		}
 		if params.StartDate != nil {
-			query = query.Where("date >= ?", *params.StartDate)
+			query = query.Where("transactions.date >= ?", *params.StartDate)
 		}
 		if params.EndDate != nil {
-			query = query.Where("date <= ?", *params.EndDate)
+			query = query.Where("transactions.date <= ?", *params.EndDate)
 		}
 		if params.Type != nil {
-			query = query.Joins("JOIN categories ON categories.id = transactions.category_id").
-				Where("categories.type = ?", *params.Type)
+			query = query.Where(categoryCondition("%s IN (SELECT id FROM categories WHERE type = ?)"), *params.Type, *params.Type)
 		}
 		if params.CategoryID != nil {
-			query = query.Where("category_id = ?", *params.CategoryID)
+			query = query.Where(categoryCondition("%s = ?"), *params.CategoryID, *params.CategoryID)
+		}
+		if params.AccountID != nil {
+			query = query.Where("transactions.account_id = ?", *params.AccountID)
+		}
+		if params.CreditCardID != nil {
+			query = query.Where("transactions.credit_card_id = ?", *params.CreditCardID)
+		}
+		if params.Cleared != nil {
+			query = query.Where("transactions.cleared = ?", *params.Cleared)
+		}
+		if params.TagID != nil {
+			query = query.Where("transactions.id IN (SELECT transaction_id FROM transaction_tags WHERE tag_id = ?)", *params.TagID)
+		}
+		if len(params.AnyTagIDs) > 0 {
+			query = query.Where("transactions.id IN (SELECT transaction_id FROM transaction_tags WHERE tag_id IN ?)", params.AnyTagIDs)
+		}
+		if len(params.AllTagIDs) > 0 {
+			query = query.Where(`transactions.id IN (SELECT transaction_id FROM transaction_tags WHERE tag_id IN ?
+				GROUP BY transaction_id HAVING COUNT(*) = ?)`, params.AllTagIDs, len(uniqueIDs(params.AllTagIDs)))
+		}
+		if params.Query != nil {
+			if against := fulltextQuery(*params.Query); against != "" {
+				query = query.Where("MATCH(transactions.description) AGAINST (? IN BOOLEAN MODE)", against)
+			}
+		}
+		if params.MinAmount != nil {
+			query = query.Where("transactions.amount >= ?", *params.MinAmount)
+		}
+		if params.MaxAmount != nil {
+			query = query.Where("transactions.amount <= ?", *params.MaxAmount)
+		}
+		if len(params.CategoryIDs) > 0 {
+			query = query.Where(categoryCondition("%s IN ?"), params.CategoryIDs, params.CategoryIDs)
+		}
+		if len(params.ExcludeCategoryIDs) > 0 {
+			query = query.Not(categoryCondition("%s IN ?"), params.ExcludeCategoryIDs, params.ExcludeCategoryIDs)
 		}
 	}
 
-	err := query.Order("date DESC").Find(&transactions).Error
-	return transactions, err
+	return query
+}
+
+// uniqueIDs は重複を除いたIDを返す
+func uniqueIDs(ids []int32) []int32 {
+	seen := make(map[int32]bool, len(ids))
+	var unique []int32
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	return unique
+}
+
+// categoryCondition はカテゴリIDの条件 cond（%s にカラムが入る）を、分割された取引では分割明細のいずれかが、
+// 分割されていない取引では取引自体が満たすかを判定する条件に変換する。プレースホルダの値は2回ずつ渡す
+// NOTE: UPDATE の対象の transactions をサブクエリで参照できないため、サブクエリは transaction_splits のみを使う
+func categoryCondition(cond string) string {
+	return fmt.Sprintf(`(transactions.id IN (SELECT transaction_id FROM transaction_splits WHERE %s)
+		OR (NOT EXISTS (SELECT 1 FROM transaction_splits WHERE transaction_splits.transaction_id = transactions.id) AND %s))`,
+		fmt.Sprintf(cond, "transaction_splits.category_id"), fmt.Sprintf(cond, "transactions.category_id"))
+}
+
+// transactionLines は取引をカテゴリごとの明細に展開するサブクエリを返す
+// 分割された取引は分割明細ごとの行、分割されていない取引は取引自体の1行となり、カテゴリ別の集計に使用する
+// ゴミ箱にある取引は含まない
+func transactionLines(db *gorm.DB) *gorm.DB {
+	return db.Table("transactions").
+		Select(`transactions.id, transactions.user_id, transactions.account_id, transactions.credit_card_id, transactions.cleared, transactions.date, transactions.description,
+			COALESCE(transaction_splits.category_id, transactions.category_id) AS category_id,
+			COALESCE(transaction_splits.amount, transactions.amount) AS amount`).
+		Joins("LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id").
+		Where("transactions.deleted_at IS NULL")
+}
+
+// transactionCategoryIDs は取引と分割明細が参照するカテゴリIDを返す
+func transactionCategoryIDs(transaction *models.Transaction) []uint {
+	ids := []uint{transaction.CategoryID}
+	for _, split := range transaction.Splits {
+		ids = append(ids, split.CategoryID)
+	}
+	return ids
+}
+
+// fulltextQuery はキーワードを BOOLEAN MODE の検索式に変換する
+// 空白で区切られた語をそれぞれフレーズとして扱い、すべてを含む行に一致させる（例: 昼 ご飯 → +"昼" +"ご飯"）
+// NOTE: 語をダブルクォートで囲むことで、ユーザーの入力した + - * などが演算子として解釈されないようにする
+func fulltextQuery(keywords string) string {
+	var terms []string
+	for _, word := range strings.Fields(keywords) {
+		word = strings.ReplaceAll(word, `"`, "")
+		if word != "" {
+			terms = append(terms, `+"`+word+`"`)
+		}
+	}
+	return strings.Join(terms, " ")
+}
+
+// preloadTransaction は取引のレスポンスに必要な関連（カテゴリ・分割明細・タグ）をプリロードする
+func preloadTransaction(db *gorm.DB) *gorm.DB {
+	return db.Preload("Category").Preload("Splits.Category").Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Order("tags.name ASC")
+	})
+}
+
+// SumBefore は date より前の取引の収入・支出を合計する
+// 分割された取引は分割明細ごとにそのカテゴリのタイプで集計する
+func (r *transactionRepository) SumBefore(userID uint, date string) (*TransactionTotal, error) {
+	var total TransactionTotal
+
+	err := r.db.Table("(?) AS transactions", transactionLines(r.db)).
+		Select(`COALESCE(SUM(CASE WHEN categories.type = ? THEN transactions.amount ELSE 0 END), 0) AS income,
+			COALESCE(SUM(CASE WHEN categories.type = ? THEN transactions.amount ELSE 0 END), 0) AS expense`, models.CategoryTypeIncome, models.CategoryTypeExpense).
+		Joins("JOIN categories ON categories.id = transactions.category_id").
+		Where("transactions.user_id = ? AND transactions.date < ?", userID, date).
+		Scan(&total).Error
+	if err != nil {
+		return nil, err
+	}
+	return &total, nil
+}
+
+// SumDaily は startDate 以上 endDate 未満の取引を日別に集計する
+// NOTE: 取引日はその日のUTCの0時で保存しているため、DATE() でそのまま取引日ごとに集計できる（接続は loc=UTC）
+// 分割された取引は分割明細ごとにそのカテゴリのタイプで集計する
+func (r *transactionRepository) SumDaily(userID uint, startDate, endDate string) ([]TransactionDailyTotal, error) {
+	var totals []TransactionDailyTotal
+
+	err := r.db.Table("(?) AS transactions", transactionLines(r.db)).
+		Select(`DATE(transactions.date) AS date,
+			COALESCE(SUM(CASE WHEN categories.type = ? THEN transactions.amount ELSE 0 END), 0) AS income,
+			COALESCE(SUM(CASE WHEN categories.type = ? THEN transactions.amount ELSE 0 END), 0) AS expense,
+			COUNT(DISTINCT transactions.id) AS count`, models.CategoryTypeIncome, models.CategoryTypeExpense).
+		Joins("JOIN categories ON categories.id = transactions.category_id").
+		Where("transactions.user_id = ? AND transactions.date >= ? AND transactions.date < ?", userID, startDate, endDate).
+		Group("DATE(transactions.date)").
+		Order("date ASC").
+		Scan(&totals).Error
+	return totals, err
+}
+
+// SumDailyByCategory は startDate 以上 endDate 未満の取引をカテゴリごと・日別に集計する
+// 分割された取引は分割明細ごとにそのカテゴリで集計する
+func (r *transactionRepository) SumDailyByCategory(userID uint, startDate, endDate string) ([]TransactionCategoryDailyTotal, error) {
+	var totals []TransactionCategoryDailyTotal
+
+	err := r.db.Table("(?) AS transactions", transactionLines(r.db)).
+		Select(`DATE(transactions.date) AS date, transactions.category_id,
+			COALESCE(SUM(CASE WHEN categories.type = ? THEN transactions.amount ELSE 0 END), 0) AS income,
+			COALESCE(SUM(CASE WHEN categories.type = ? THEN transactions.amount ELSE 0 END), 0) AS expense`, models.CategoryTypeIncome, models.CategoryTypeExpense).
+		Joins("JOIN categories ON categories.id = transactions.category_id").
+		Where("transactions.user_id = ? AND transactions.date >= ? AND transactions.date < ?", userID, startDate, endDate).
+		Group("DATE(transactions.date), transactions.category_id").
+		Order("date ASC, transactions.category_id ASC").
+		Scan(&totals).Error
+	return totals, err
 }
 
 func (r *transactionRepository) FindByID(id, userID uint) (*models.Transaction, error) {
 	var transaction models.Transaction
-	err := r.db.Preload("Category").Where("id = ? AND user_id = ?", id, userID).First(&transaction).Error
+	err := preloadTransaction(r.db).Where("id = ? AND user_id = ?", id, userID).First(&transaction).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, ErrNotFound
@@ -67,30 +387,106 @@ func (r *transactionRepository) FindByID(id, userID uint) (*models.Transaction,
 	return &transaction, nil
 }
 
+// FindByAmountAndDate は金額（基準通貨）が amount で、日付が startDate 以上 endDate 以下の取引をIDの昇順に取得する
+func (r *transactionRepository) FindByAmountAndDate(userID uint, amount int, startDate, endDate string) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+	err := r.db.Where("user_id = ? AND amount = ? AND date >= ? AND date <= ?", userID, amount, startDate, endDate).
+		Order("id ASC").
+		Find(&transactions).Error
+	return transactions, err
+}
+
+// FindPossibleDuplicates は startDate 以上 endDate 以下の取引のうち、金額（基準通貨）が同じで
+// 日付の差が windowDays 日以内の他の取引がある取引を、日付・IDの昇順に取得する
+// NOTE: 説明が似ているかは呼び出し側で判定する
+func (r *transactionRepository) FindPossibleDuplicates(userID uint, startDate, endDate string, windowDays int) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	others := r.db.Table("transactions AS others").Select("1").
+		Where("others.user_id = transactions.user_id AND others.id <> transactions.id AND others.amount = transactions.amount AND others.deleted_at IS NULL").
+		Where("others.date BETWEEN DATE_SUB(transactions.date, INTERVAL ? DAY) AND DATE_ADD(transactions.date, INTERVAL ? DAY)", windowDays, windowDays).
+		Where("others.date >= ? AND others.date <= ?", startDate, endDate)
+
+	err := preloadTransaction(r.db).
+		Where("transactions.user_id = ? AND transactions.date >= ? AND transactions.date <= ?", userID, startDate, endDate).
+		Where("EXISTS (?)", others).
+		Order("transactions.date ASC, transactions.id ASC").
+		Find(&transactions).Error
+	return transactions, err
+}
+
+// Create は取引を登録する。Splits・Tags を持つ場合は分割明細・タグとの紐づけも同じDBトランザクションで登録する
+// NOTE: タグ自体は登録・更新しない（Tags は ID のみ指定されている想定）
 func (r *transactionRepository) Create(transaction *models.Transaction) error {
-	if err := r.db.Create(transaction).Error; err != nil {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return createTransaction(tx, transaction)
+	})
+	if err != nil {
 		if helpers.IsForeignKeyViolation(err) {
 			return ErrForeignKeyViolation
 		}
 		return err
 	}
 
-	// Categoryをプリロードして返す
-	return r.db.Preload("Category").First(transaction, transaction.ID).Error
+	// Category・分割明細・タグをプリロードして返す
+	return preloadTransaction(r.db).First(transaction, transaction.ID).Error
 }
 
-func (r *transactionRepository) Update(id, userID uint, updates map[string]interface{}) (*models.Transaction, error) {
-	// 存在確認
-	var existing models.Transaction
-	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, ErrNotFound
+// CreateBatch は複数の取引を1つのDBトランザクションで登録する。1件でも失敗した場合はすべて取り消す
+func (r *transactionRepository) CreateBatch(transactions []models.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	categoryIDs := make(map[uint][]uint)
+	for i := range transactions {
+		categoryIDs[transactions[i].UserID] = append(categoryIDs[transactions[i].UserID], transactionCategoryIDs(&transactions[i])...)
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for userID, ids := range categoryIDs {
+			if err := ensureCategoriesExist(tx, userID, ids...); err != nil {
+				return err
+			}
 		}
-		return nil, err
+		if err := tx.Create(&transactions).Error; err != nil {
+			if helpers.IsForeignKeyViolation(err) {
+				return ErrForeignKeyViolation
+			}
+			return err
+		}
+
+		histories := make([]*models.ChangeHistory, len(transactions))
+		for i := range transactions {
+			history, err := newChangeHistory(transactions[i].UserID, models.ChangeRecordTypeTransaction, transactions[i].ID, models.ChangeActionCreate, nil, transactionSnapshot(&transactions[i]))
+			if err != nil {
+				return err
+			}
+			histories[i] = history
+		}
+		return tx.Create(&histories).Error
+	})
+}
+
+// createTransaction は tx 内で取引と分割明細・タグとの紐づけを登録し、変更履歴を記録する
+func createTransaction(tx *gorm.DB, transaction *models.Transaction) error {
+	if err := ensureCategoriesExist(tx, transaction.UserID, transactionCategoryIDs(transaction)...); err != nil {
+		return err
+	}
+	if err := tx.Omit("Tags.*").Create(transaction).Error; err != nil {
+		return err
 	}
+	return recordChange(tx, transaction.UserID, models.ChangeRecordTypeTransaction, transaction.ID, models.ChangeActionCreate, nil, transactionSnapshot(transaction))
+}
 
-	// 更新
-	if err := r.db.Model(&models.Transaction{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+// Update は取引を更新する。splits が nil の場合は分割明細を変更せず、空の場合は分割を解除する
+// tags が nil の場合はタグを変更せず、それ以外の場合は指定したタグに置き換える
+// 更新後の分割明細の金額の合計が取引の金額と一致しない場合は ErrSplitAmountMismatch を返し、何も更新しない
+func (r *transactionRepository) Update(id, userID uint, updates map[string]interface{}, splits []models.TransactionSplit, tags []models.Tag) (*models.Transaction, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return updateTransaction(tx, id, userID, updates, splits, tags)
+	})
+	if err != nil {
 		if helpers.IsForeignKeyViolation(err) {
 			return nil, ErrForeignKeyViolation
 		}
@@ -99,20 +495,465 @@ func (r *transactionRepository) Update(id, userID uint, updates map[string]inter
 
 	// 更新後のデータを取得
 	var transaction models.Transaction
-	if err := r.db.Preload("Category").Where("id = ? AND user_id = ?", id, userID).First(&transaction).Error; err != nil {
+	if err := preloadTransaction(r.db).Where("id = ? AND user_id = ?", id, userID).First(&transaction).Error; err != nil {
 		return nil, err
 	}
 
 	return &transaction, nil
 }
 
+// updateTransaction は tx 内で取引と分割明細・タグを更新し、分割明細の金額の合計を確認して変更履歴を記録する
+// 照合済みの取引の場合は ErrTransactionLocked を返す
+func updateTransaction(tx *gorm.DB, id, userID uint, updates map[string]interface{}, splits []models.TransactionSplit, tags []models.Tag) error {
+	// 存在確認
+	// NOTE: 更新内容が既存の値と同じ場合も RowsAffected が0になるため、存在確認は別に行う
+	var existing models.Transaction
+	if err := tx.Preload("Splits").Preload("Tags").Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return ErrNotFound
+		}
+		return err
+	}
+	if existing.ReconciliationID != nil {
+		return ErrTransactionLocked
+	}
+
+	categoryIDs := updatedCategoryIDs(updates, "category_id")
+	for _, split := range splits {
+		categoryIDs = append(categoryIDs, split.CategoryID)
+	}
+	if err := ensureCategoriesExist(tx, userID, categoryIDs...); err != nil {
+		return err
+	}
+
+	if len(updates) > 0 {
+		if err := tx.Model(&models.Transaction{}).Where("id = ? AND user_id = ?", id, userID).Updates(updates).Error; err != nil {
+			return err
+		}
+	}
+
+	if splits != nil {
+		if err := tx.Where("transaction_id = ?", id).Delete(&models.TransactionSplit{}).Error; err != nil {
+			return err
+		}
+		for i := range splits {
+			splits[i].TransactionID = id
+		}
+		if len(splits) > 0 {
+			if err := tx.Create(&splits).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	if tags != nil {
+		if err := tx.Model(&existing).Omit("Tags.*").Association("Tags").Replace(tags); err != nil {
+			return err
+		}
+	}
+
+	// 分割明細の金額の合計を確認
+	var total struct {
+		Amount     int
+		SplitCount int
+		SplitTotal int
+	}
+	err := tx.Model(&models.Transaction{}).
+		Select("transactions.amount, COUNT(transaction_splits.id) AS split_count, COALESCE(SUM(transaction_splits.amount), 0) AS split_total").
+		Joins("LEFT JOIN transaction_splits ON transaction_splits.transaction_id = transactions.id").
+		Where("transactions.id = ?", id).
+		Group("transactions.id, transactions.amount").
+		Scan(&total).Error
+	if err != nil {
+		return err
+	}
+	if total.SplitCount > 0 && total.SplitTotal != total.Amount {
+		return ErrSplitAmountMismatch
+	}
+
+	// 変更履歴を記録
+	var updated models.Transaction
+	if err := tx.Preload("Splits").Preload("Tags").First(&updated, id).Error; err != nil {
+		return err
+	}
+	keys := updatedKeys(updates)
+	if splits != nil {
+		keys = append(keys, "splits")
+	}
+	if tags != nil {
+		keys = append(keys, "tag_ids")
+	}
+	return recordChange(tx, userID, models.ChangeRecordTypeTransaction, id, models.ChangeActionUpdate,
+		transactionSnapshot(&existing).pick(keys), transactionSnapshot(&updated).pick(keys))
+}
+
+// Delete は取引をゴミ箱に移動する。分割明細・タグ・添付ファイルは完全に削除されるまで残す
 func (r *transactionRepository) Delete(id, userID uint) error {
-	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.Transaction{})
-	if result.Error != nil {
-		return result.Error
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return deleteTransaction(tx, id, userID)
+	})
+}
+
+// deleteTransaction は tx 内で取引をゴミ箱に移動し、変更履歴を記録する
+// 照合済みの取引の場合は ErrTransactionLocked を返す
+func deleteTransaction(tx *gorm.DB, id, userID uint) error {
+	var existing models.Transaction
+	if err := tx.Preload("Splits").Preload("Tags").Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return ErrNotFound
+		}
+		return err
+	}
+	if existing.ReconciliationID != nil {
+		return ErrTransactionLocked
+	}
+
+	if err := tx.Delete(&existing).Error; err != nil {
+		return err
+	}
+	return recordChange(tx, userID, models.ChangeRecordTypeTransaction, id, models.ChangeActionDelete, transactionSnapshot(&existing), nil)
+}
+
+// ApplyBatch は一括操作を指定された順に1つのDBトランザクションで実行し、操作ごとの作成・更新後の取引を返す（delete の場合は nil）
+// 1件でも失敗した場合はすべて取り消し、失敗した操作の位置を *BatchError で返す
+func (r *transactionRepository) ApplyBatch(userID uint, operations []TransactionBatchOperation) ([]*models.Transaction, error) {
+	results := make([]*models.Transaction, len(operations))
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for i, operation := range operations {
+			id, err := applyBatchOperation(tx, userID, operation)
+			if err != nil {
+				if helpers.IsForeignKeyViolation(err) {
+					err = ErrForeignKeyViolation
+				}
+				return &BatchError{Index: i, Err: err}
+			}
+			if operation.Action == TransactionBatchDelete {
+				continue
+			}
+
+			var transaction models.Transaction
+			if err := preloadTransaction(tx).First(&transaction, id).Error; err != nil {
+				return &BatchError{Index: i, Err: err}
+			}
+			results[i] = &transaction
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// applyBatchOperation は一括操作の1件を実行し、対象の取引IDを返す
+func applyBatchOperation(tx *gorm.DB, userID uint, operation TransactionBatchOperation) (uint, error) {
+	switch operation.Action {
+	case TransactionBatchCreate:
+		if err := createTransaction(tx, operation.Transaction); err != nil {
+			return 0, err
+		}
+		return operation.Transaction.ID, nil
+
+	case TransactionBatchUpdate:
+		if err := updateTransaction(tx, operation.ID, userID, operation.Updates, operation.Splits, operation.Tags); err != nil {
+			return 0, err
+		}
+		return operation.ID, nil
+
+	case TransactionBatchDelete:
+		if err := deleteTransaction(tx, operation.ID, userID); err != nil {
+			return 0, err
+		}
+		return operation.ID, nil
+	}
+
+	return 0, fmt.Errorf("unknown batch action: %s", operation.Action)
+}
+
+// UpdateCategoryByFilter は条件に一致するすべての取引のカテゴリを変更し、変更した件数を返す
+// 分割された取引は、カテゴリ・カテゴリタイプの条件に一致する分割明細のカテゴリを変更する（条件がない場合はすべての分割明細）
+// 変更前のカテゴリと異なる取引ごとに変更履歴を記録する。照合済みの取引は変更しない
+func (r *transactionRepository) UpdateCategoryByFilter(userID uint, params *TransactionFindParams, categoryID uint) (int64, error) {
+	var updated int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := ensureCategoriesExist(tx, userID, categoryID); err != nil {
+			return err
+		}
+
+		var targets []models.Transaction
+		if err := filterTransactions(tx.Model(&models.Transaction{}), userID, params).
+			Preload("Splits").
+			Select("transactions.id, transactions.category_id").
+			Where("transactions.reconciliation_id IS NULL").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Find(&targets).Error; err != nil {
+			return err
+		}
+
+		var splitTransactionIDs []uint
+		for _, target := range targets {
+			if len(target.Splits) > 0 {
+				splitTransactionIDs = append(splitTransactionIDs, target.ID)
+			}
+		}
+		matchedSplits := make(map[uint]bool)
+		if len(splitTransactionIDs) > 0 {
+			var splitIDs []uint
+			if err := filterSplitCategories(tx.Model(&models.TransactionSplit{}), params).
+				Where("transaction_splits.transaction_id IN ?", splitTransactionIDs).
+				Pluck("transaction_splits.id", &splitIDs).Error; err != nil {
+				return err
+			}
+			for _, id := range splitIDs {
+				matchedSplits[id] = true
+			}
+		}
+
+		var ids, splitIDs []uint
+		var histories []*models.ChangeHistory
+		for i := range targets {
+			target := &targets[i]
+			key := "category_id"
+			if len(target.Splits) == 0 {
+				if target.CategoryID == categoryID {
+					continue
+				}
+				ids = append(ids, target.ID)
+			} else {
+				key = "splits"
+				var changed []uint
+				for _, split := range target.Splits {
+					if matchedSplits[split.ID] && split.CategoryID != categoryID {
+						changed = append(changed, split.ID)
+					}
+				}
+				if len(changed) == 0 {
+					continue
+				}
+				splitIDs = append(splitIDs, changed...)
+			}
+
+			before := transactionSnapshot(target).pick([]string{key})
+			for j := range target.Splits {
+				if matchedSplits[target.Splits[j].ID] {
+					target.Splits[j].CategoryID = categoryID
+				}
+			}
+			target.CategoryID = categoryID
+			after := transactionSnapshot(target).pick([]string{key})
+
+			history, err := newChangeHistory(userID, models.ChangeRecordTypeTransaction, target.ID, models.ChangeActionUpdate, before, after)
+			if err != nil {
+				return err
+			}
+			histories = append(histories, history)
+		}
+		if len(histories) == 0 {
+			return nil
+		}
+
+		if len(ids) > 0 {
+			if err := tx.Model(&models.Transaction{}).Where("id IN ?", ids).Update("category_id", categoryID).Error; err != nil {
+				return err
+			}
+		}
+		if len(splitIDs) > 0 {
+			if err := tx.Model(&models.TransactionSplit{}).Where("id IN ?", splitIDs).Update("category_id", categoryID).Error; err != nil {
+				return err
+			}
+		}
+		updated = int64(len(histories))
+		return tx.Create(&histories).Error
+	})
+	if err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return 0, ErrForeignKeyViolation
+		}
+		return 0, err
+	}
+	return updated, nil
+}
+
+// filterSplitCategories は TransactionFindParams のカテゴリ・カテゴリタイプの条件を分割明細のクエリに追加する
+// filterTransactions で分割された取引を絞り込んだ条件のうち、分割明細ごとに判定できるものだけを適用する
+func filterSplitCategories(query *gorm.DB, params *TransactionFindParams) *gorm.DB {
+	if params == nil {
+		return query
+	}
+	if params.Type != nil {
+		query = query.Where("transaction_splits.category_id IN (SELECT id FROM categories WHERE type = ?)", *params.Type)
+	}
+	if params.CategoryID != nil {
+		query = query.Where("transaction_splits.category_id = ?", *params.CategoryID)
+	}
+	if len(params.CategoryIDs) > 0 {
+		query = query.Where("transaction_splits.category_id IN ?", params.CategoryIDs)
+	}
+	if len(params.ExcludeCategoryIDs) > 0 {
+		query = query.Where("transaction_splits.category_id NOT IN ?", params.ExcludeCategoryIDs)
+	}
+	return query
+}
+
+// MergeDuplicates は重複した取引を keepID の取引にまとめる
+// duplicateIDs の取引のタグを keepID の取引に追加し、duplicateIDs の取引をゴミ箱に移動する。それぞれ変更履歴を記録する
+// いずれかの取引が見つからない場合は ErrNotFound を返し、何も変更しない
+func (r *transactionRepository) MergeDuplicates(keepID uint, duplicateIDs []uint, userID uint) (*models.Transaction, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var targets []models.Transaction
+		if err := tx.Preload("Tags").
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND user_id = ?", append([]uint{keepID}, duplicateIDs...), userID).
+			Find(&targets).Error; err != nil {
+			return err
+		}
+		if len(targets) != len(duplicateIDs)+1 {
+			return ErrNotFound
+		}
+
+		// 重複した取引のタグのうち、残す取引に付いていないものを追加
+		var tags []models.Tag
+		seen := make(map[uint]bool)
+		for _, target := range targets {
+			if target.ID != keepID {
+				continue
+			}
+			for _, tag := range target.Tags {
+				seen[tag.ID] = true
+				tags = append(tags, models.Tag{ID: tag.ID})
+			}
+		}
+		kept := len(tags)
+		for _, target := range targets {
+			if target.ID == keepID {
+				continue
+			}
+			for _, tag := range target.Tags {
+				if !seen[tag.ID] {
+					seen[tag.ID] = true
+					tags = append(tags, models.Tag{ID: tag.ID})
+				}
+			}
+		}
+		if len(tags) > kept {
+			if err := updateTransaction(tx, keepID, userID, map[string]interface{}{}, nil, tags); err != nil {
+				return err
+			}
+		}
+
+		for _, id := range duplicateIDs {
+			if err := deleteTransaction(tx, id, userID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	if result.RowsAffected == 0 {
-		return ErrNotFound
+	return r.FindByID(keepID, userID)
+}
+
+// Unlock は照合済みの取引のロックを解除し、変更履歴を記録する。照合済みでない場合は何もしない
+func (r *transactionRepository) Unlock(id, userID uint) (*models.Transaction, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var existing models.Transaction
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ? AND user_id = ?", id, userID).First(&existing).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+		if existing.ReconciliationID == nil {
+			return nil
+		}
+
+		if err := tx.Model(&existing).Update("reconciliation_id", nil).Error; err != nil {
+			return err
+		}
+		return recordChange(tx, userID, models.ChangeRecordTypeTransaction, id, models.ChangeActionUpdate,
+			snapshot{"reconciliation_id": *existing.ReconciliationID}, snapshot{"reconciliation_id": nil})
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return r.FindByID(id, userID)
+}
+
+// preloadDeletedTransaction はゴミ箱にある取引のレスポンスに必要な関連をプリロードする
+// 取引と同様にゴミ箱にあるカテゴリも含める
+func preloadDeletedTransaction(db *gorm.DB) *gorm.DB {
+	unscoped := func(db *gorm.DB) *gorm.DB {
+		return db.Unscoped()
+	}
+	return db.Preload("Category", unscoped).Preload("Splits.Category", unscoped).Preload("Tags", func(db *gorm.DB) *gorm.DB {
+		return db.Order("tags.name ASC")
+	})
+}
+
+// FindDeleted はゴミ箱にある取引を取得する
+func (r *transactionRepository) FindDeleted(userID uint) ([]models.Transaction, error) {
+	return findDeleted[models.Transaction](preloadDeletedTransaction(r.db), userID)
+}
+
+// Restore はゴミ箱にある取引を元に戻す
+// 取引・分割明細のカテゴリがゴミ箱にある場合は ErrForeignKeyViolation を返し、何も変更しない
+func (r *transactionRepository) Restore(id, userID uint) (*models.Transaction, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		transaction, err := findDeletedByID[models.Transaction](tx.Preload("Splits").Preload("Tags"), id, userID)
+		if err != nil {
+			return err
+		}
+		if err := ensureCategoriesExist(tx, userID, transactionCategoryIDs(transaction)...); err != nil {
+			return err
+		}
+		if err := restore[models.Transaction](tx, id, userID); err != nil {
+			return err
+		}
+		return recordChange(tx, userID, models.ChangeRecordTypeTransaction, id, models.ChangeActionRestore, nil, transactionSnapshot(transaction))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.FindByID(id, userID)
+}
+
+// Purge はゴミ箱にある取引を完全に削除する。分割明細・タグとの紐づけ・添付ファイルの行は外部キーの ON DELETE CASCADE で削除され、変更履歴も削除する
+// NOTE: 添付ファイルの実体は呼び出し側でストレージから削除する
+func (r *transactionRepository) Purge(id, userID uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := purge[models.Transaction](tx, id, userID); err != nil {
+			return err
+		}
+		return deleteChangeHistories(tx, models.ChangeRecordTypeTransaction, id)
+	})
+}
+
+// PurgeDeletedBefore は before より前にゴミ箱に移動した取引を変更履歴とともに完全に削除し、削除した取引を返す
+func (r *transactionRepository) PurgeDeletedBefore(before time.Time) ([]PurgedTransaction, error) {
+	var purged []PurgedTransaction
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&models.Transaction{}).
+			Select("id, user_id").
+			Where("deleted_at < ?", before).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Scan(&purged).Error; err != nil {
+			return err
+		}
+		if len(purged) == 0 {
+			return nil
+		}
+
+		ids := make([]uint, len(purged))
+		for i, transaction := range purged {
+			ids[i] = transaction.ID
+		}
+		if err := tx.Unscoped().Where("id IN ?", ids).Delete(&models.Transaction{}).Error; err != nil {
+			return err
+		}
+		return deleteChangeHistories(tx, models.ChangeRecordTypeTransaction, ids...)
+	})
+	return purged, err
 }
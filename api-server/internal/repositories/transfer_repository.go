@@ -0,0 +1,85 @@
+package repositories
+
+import (
+	"apps/internal/helpers"
+	"apps/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type TransferFindParams struct {
+	StartDate *string
+	EndDate   *string
+	AccountID *int32 // 振替元または振替先
+}
+
+type TransferRepository interface {
+	FindAll(userID uint, params *TransferFindParams) ([]models.Transfer, error)
+	FindByID(id, userID uint) (*models.Transfer, error)
+	Create(transfer *models.Transfer) error
+	Delete(id, userID uint) error
+}
+
+type transferRepository struct {
+	db *gorm.DB
+}
+
+func NewTransferRepository(db *gorm.DB) TransferRepository {
+	return &transferRepository{db}
+}
+
+func (r *transferRepository) FindAll(userID uint, params *TransferFindParams) ([]models.Transfer, error) {
+	var transfers []models.Transfer
+
+	query := r.db.Preload("FromAccount").Preload("ToAccount").Where("user_id = ?", userID)
+
+	if params != nil {
+		if params.StartDate != nil {
+			query = query.Where("date >= ?", *params.StartDate)
+		}
+		if params.EndDate != nil {
+			query = query.Where("date <= ?", *params.EndDate)
+		}
+		if params.AccountID != nil {
+			query = query.Where("from_account_id = ? OR to_account_id = ?", *params.AccountID, *params.AccountID)
+		}
+	}
+
+	err := query.Order("date DESC, id DESC").Find(&transfers).Error
+	return transfers, err
+}
+
+func (r *transferRepository) FindByID(id, userID uint) (*models.Transfer, error) {
+	var transfer models.Transfer
+	err := r.db.Preload("FromAccount").Preload("ToAccount").Where("id = ? AND user_id = ?", id, userID).First(&transfer).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+func (r *transferRepository) Create(transfer *models.Transfer) error {
+	if err := r.db.Create(transfer).Error; err != nil {
+		if helpers.IsForeignKeyViolation(err) {
+			return ErrForeignKeyViolation
+		}
+		return err
+	}
+
+	// 口座をプリロードして返す
+	return r.db.Preload("FromAccount").Preload("ToAccount").First(transfer, transfer.ID).Error
+}
+
+func (r *transferRepository) Delete(id, userID uint) error {
+	result := r.db.Where("id = ? AND user_id = ?", id, userID).Delete(&models.Transfer{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package repositories
+
+import (
+	"time"
+
+	"apps/internal/helpers"
+
+	"gorm.io/gorm"
+)
+
+// 論理削除（ゴミ箱）の共通処理
+// 取引・予算・カテゴリの Delete は行を論理削除してゴミ箱に移動し、通常の検索からは除外される
+
+// findDeleted は userID のゴミ箱にある行を、ゴミ箱に移動した日時の新しい順に取得する
+func findDeleted[T any](db *gorm.DB, userID uint) ([]T, error) {
+	var rows []T
+	err := db.Unscoped().
+		Where("user_id = ? AND deleted_at IS NOT NULL", userID).
+		Order("deleted_at DESC, id DESC").
+		Find(&rows).Error
+	return rows, err
+}
+
+// findDeletedByID はゴミ箱にある1行を取得する。ゴミ箱にない場合は ErrNotFound を返す
+func findDeletedByID[T any](db *gorm.DB, id, userID uint) (*T, error) {
+	var row T
+	err := db.Unscoped().Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, userID).First(&row).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &row, nil
+}
+
+// restore はゴミ箱にある行を元に戻す。ゴミ箱にない場合は ErrNotFound を返す
+func restore[T any](db *gorm.DB, id, userID uint) error {
+	result := db.Unscoped().Model(new(T)).
+		Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, userID).
+		Update("deleted_at", nil)
+	if result.Error != nil {
+		if helpers.IsDuplicateEntry(result.Error) {
+			return ErrDuplicateEntry
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// purge はゴミ箱にある行を完全に削除する。ゴミ箱にない場合は ErrNotFound を返す
+func purge[T any](db *gorm.DB, id, userID uint) error {
+	result := db.Unscoped().
+		Where("id = ? AND user_id = ? AND deleted_at IS NOT NULL", id, userID).
+		Delete(new(T))
+	if result.Error != nil {
+		if helpers.IsForeignKeyViolation(result.Error) {
+			return ErrForeignKeyViolation
+		}
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// purgeDeletedBefore は before より前にゴミ箱に移動した行を完全に削除し、削除した件数を返す
+func purgeDeletedBefore[T any](db *gorm.DB, before time.Time) (int64, error) {
+	result := db.Unscoped().Where("deleted_at < ?", before).Delete(new(T))
+	return result.RowsAffected, result.Error
+}
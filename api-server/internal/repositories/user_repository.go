@@ -10,7 +10,11 @@ type UserRepository interface {
 	FindByEmail(email string) (*models.User, error)
 	ExistsByEmail(email string) (bool, error)
 	ExistsByID(id uint) (bool, error)
+	FindByID(id uint) (*models.User, error)
 	Create(user *models.User) error
+	Update(id uint, updates map[string]interface{}) (*models.User, error)
+	HasBaseCurrencyAmounts(id uint) (bool, error)
+	Delete(id uint) error
 }
 
 type userRepository struct {
@@ -52,3 +56,117 @@ func (r *userRepository) ExistsByID(id uint) (bool, error) {
 func (r *userRepository) Create(user *models.User) error {
 	return r.db.Create(user).Error
 }
+
+func (r *userRepository) FindByID(id uint) (*models.User, error) {
+	var user models.User
+	err := r.db.Where("id = ?", id).First(&user).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) Update(id uint, updates map[string]interface{}) (*models.User, error) {
+	// 存在確認
+	var existing models.User
+	if err := r.db.Where("id = ?", id).First(&existing).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	// 更新
+	if err := r.db.Model(&models.User{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		return nil, err
+	}
+
+	// 更新後のデータを取得
+	var user models.User
+	if err := r.db.Where("id = ?", id).First(&user).Error; err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// baseCurrencyAmounts は基準通貨の補助単位で金額を保持しているデータのモデルと、金額を保持している行の条件
+var baseCurrencyAmounts = []struct {
+	model     interface{}
+	condition string
+}{
+	{&models.Transaction{}, ""},
+	{&models.InstallmentPlan{}, ""},
+	{&models.RecurringTransaction{}, ""},
+	{&models.Reconciliation{}, ""},
+	{&models.Transfer{}, ""},
+	{&models.Budget{}, ""},
+	{&models.BudgetTemplate{}, ""},
+	{&models.CategorizationRule{}, "min_amount IS NOT NULL OR max_amount IS NOT NULL"},
+	{&models.Account{}, "opening_balance <> 0"},
+}
+
+// HasBaseCurrencyAmounts はユーザーが基準通貨の金額を保持しているデータを1件以上登録しているか判定する（ゴミ箱にあるデータを含む）
+// 取引・予算・予算テンプレート・定期取引・振替・口座の初期残高などが対象
+func (r *userRepository) HasBaseCurrencyAmounts(id uint) (bool, error) {
+	for _, target := range baseCurrencyAmounts {
+		query := r.db.Unscoped().Model(target.model).Where("user_id = ?", id)
+		if target.condition != "" {
+			query = query.Where(target.condition)
+		}
+		var count int64
+		if err := query.Limit(1).Count(&count).Error; err != nil {
+			return false, err
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// userDependents はユーザーに紐づくデータのモデルを、参照している側が先になる順に並べたもの
+// NOTE: 分割明細・取引とタグの紐づけ・予算テンプレートの項目は user_id を持たないため、取引・予算テンプレートの削除による ON DELETE CASCADE で削除される
+var userDependents = []interface{}{
+	&models.Attachment{},
+	&models.Transaction{},
+	&models.InstallmentPlan{},
+	&models.RecurringTransaction{},
+	&models.Reconciliation{},
+	&models.Transfer{},
+	&models.CreditCard{},
+	&models.Budget{},
+	&models.BudgetTemplate{},
+	&models.CategorizationRule{},
+	&models.ImportProfile{},
+	&models.ExchangeRate{},
+	&models.ChangeHistory{},
+	&models.Tag{},
+	&models.Account{},
+	&models.Category{},
+}
+
+// Delete はユーザーとユーザーのすべてのデータを削除する（ゴミ箱にあるデータを含む）
+// NOTE: カテゴリ・口座などは他のデータから ON DELETE RESTRICT で参照されているため、ユーザーの削除による CASCADE の順序に依存しないよう、
+// ユーザーに紐づくデータを参照している側から順にすべて削除してからユーザーを削除する
+func (r *userRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, model := range userDependents {
+			if err := tx.Unscoped().Where("user_id = ?", id).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		result := tx.Where("id = ?", id).Delete(&models.User{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrNotFound
+		}
+		return nil
+	})
+}
@@ -0,0 +1,106 @@
+package repositories
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"regexp"
+	"testing"
+
+	"apps/internal/testdb"
+
+	"gorm.io/gorm/schema"
+)
+
+// userOwnedTables は models パッケージのうち UserID を持つモデルのテーブル名を返す
+func userOwnedTables(t *testing.T) []string {
+	t.Helper()
+	pkgs, err := parser.ParseDir(token.NewFileSet(), "../models", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var naming schema.NamingStrategy
+	var tables []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				spec, ok := n.(*ast.TypeSpec)
+				if !ok {
+					return true
+				}
+				st, ok := spec.Type.(*ast.StructType)
+				if !ok {
+					return false
+				}
+				for _, field := range st.Fields.List {
+					for _, name := range field.Names {
+						if name.Name == "UserID" {
+							tables = append(tables, naming.TableName(spec.Name.Name))
+						}
+					}
+				}
+				return false
+			})
+		}
+	}
+	return tables
+}
+
+func TestUserRepositoryDeleteRemovesAllUserData(t *testing.T) {
+	db, fake := testdb.New(t)
+	const userID = 7
+
+	if err := NewUserRepository(db).Delete(userID); err != nil {
+		t.Fatal(err)
+	}
+
+	deleteRegex := regexp.MustCompile("^DELETE FROM `(\\w+)` WHERE (user_id|id) = \\?")
+	order := make(map[string]int)
+	for i, s := range fake.Statements("^DELETE FROM") {
+		m := deleteRegex.FindStringSubmatch(s.Query)
+		if m == nil {
+			t.Fatalf("unexpected delete: %s", s.Query)
+		}
+		if len(s.Args) != 1 || s.Args[0] != int64(userID) {
+			t.Errorf("%s: args = %v, want [%d]", s.Query, s.Args, userID)
+		}
+		order[m[1]] = i
+	}
+
+	tables := userOwnedTables(t)
+	if len(tables) == 0 {
+		t.Fatal("no user-owned tables found in models")
+	}
+	for _, table := range tables {
+		if _, ok := order[table]; !ok {
+			t.Errorf("%s is not deleted", table)
+		}
+	}
+
+	// NOTE: ON DELETE RESTRICT で参照している側を先に削除する
+	before := [][2]string{
+		{"attachments", "transactions"},
+		{"transactions", "categories"},
+		{"transactions", "accounts"},
+		{"transactions", "credit_cards"},
+		{"transfers", "accounts"},
+		{"budgets", "categories"},
+		{"recurring_transactions", "categories"},
+		{"categorization_rules", "categories"},
+		{"import_profiles", "categories"},
+	}
+	for _, pair := range before {
+		if order[pair[0]] > order[pair[1]] {
+			t.Errorf("%s is deleted after %s", pair[0], pair[1])
+		}
+	}
+	for _, table := range tables {
+		if order[table] > order["users"] {
+			t.Errorf("%s is deleted after users", table)
+		}
+	}
+	if commits := fake.Statements("^COMMIT$"); len(commits) != 1 {
+		t.Errorf("commits = %d, want 1", len(commits))
+	}
+}
@@ -0,0 +1,338 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+// AccountBalancePoint は口座のある日の終わりの残高
+type AccountBalancePoint struct {
+	Date    time.Time
+	Balance int
+}
+
+// AccountBalance は口座の現在の残高と、指定期間の日別の残高推移
+type AccountBalance struct {
+	Account models.Account
+	Balance int
+	History []AccountBalancePoint
+}
+
+// AccountEntry は口座の1件の入出金と、それを反映した後の残高
+type AccountEntry struct {
+	repositories.AccountEntry
+	Balance int
+}
+
+// AccountEntryList は口座の指定期間の入出金
+type AccountEntryList struct {
+	OpeningBalance int // 期間の開始日より前の残高（開始残高を含む）
+	Entries        []AccountEntry
+}
+
+// ReconciliationResult は口座の照合の結果。差額がなく照合した場合のみ Reconciliation を設定する
+type ReconciliationResult struct {
+	StatementDate   time.Time
+	ExpectedBalance int
+	ClearedBalance  int
+	ClearedCount    int
+	UnclearedCount  int
+	Reconciliation  *models.Reconciliation
+}
+
+// Difference は明細の残高と消込済みの残高の差額
+func (r *ReconciliationResult) Difference() int {
+	return r.ExpectedBalance - r.ClearedBalance
+}
+
+type AccountService interface {
+	FetchAccounts(userID uint) ([]models.Account, error)
+	FetchAccountByID(id uint, userID uint) (*models.Account, error)
+	CreateAccount(userID uint, input *api.CreateAccountInput) (*models.Account, error)
+	UpdateAccount(id uint, userID uint, input *api.UpdateAccountInput) (*models.Account, error)
+	DeleteAccount(id uint, userID uint) error
+	FetchAccountBalances(userID uint, params *api.GetAccountsBalancesParams) ([]AccountBalance, error)
+	FetchAccountEntries(id uint, userID uint, params *api.GetAccountsIdEntriesParams) (*AccountEntryList, error)
+	ReconcileAccount(id uint, userID uint, input *api.ReconcileAccountInput) (*ReconciliationResult, error)
+	FetchReconciliations(id uint, userID uint) ([]models.Reconciliation, error)
+}
+
+type accountService struct {
+	repo               repositories.AccountRepository
+	userRepo           repositories.UserRepository
+	reconciliationRepo repositories.ReconciliationRepository
+}
+
+func NewAccountService(repo repositories.AccountRepository, userRepo repositories.UserRepository, reconciliationRepo repositories.ReconciliationRepository) AccountService {
+	return &accountService{repo: repo, userRepo: userRepo, reconciliationRepo: reconciliationRepo}
+}
+
+func (s *accountService) FetchAccounts(userID uint) ([]models.Account, error) {
+	return s.repo.FindAll(userID)
+}
+
+func (s *accountService) FetchAccountByID(id uint, userID uint) (*models.Account, error) {
+	account, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, err
+	}
+	return account, nil
+}
+
+func (s *accountService) CreateAccount(userID uint, input *api.CreateAccountInput) (*models.Account, error) {
+	if err := validators.ValidateCreateAccount(input); err != nil {
+		return nil, err
+	}
+
+	account := models.Account{
+		UserID: userID,
+		Name:   input.Name,
+		Type:   models.AccountType(input.Type),
+	}
+	if input.OpeningBalance != nil {
+		account.OpeningBalance = int(*input.OpeningBalance)
+	}
+
+	if err := s.repo.Create(&account); err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
+
+func (s *accountService) UpdateAccount(id uint, userID uint, input *api.UpdateAccountInput) (*models.Account, error) {
+	if err := validators.ValidateUpdateAccount(input); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if input.Name != nil {
+		updates["name"] = *input.Name
+	}
+	if input.Type != nil {
+		updates["type"] = string(*input.Type)
+	}
+	if input.OpeningBalance != nil {
+		updates["opening_balance"] = *input.OpeningBalance
+	}
+
+	account, err := s.repo.Update(id, userID, updates)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (s *accountService) DeleteAccount(id uint, userID uint) error {
+	err := s.repo.Delete(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrAccountNotFound
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return ErrAccountInUse
+		}
+		return err
+	}
+	return nil
+}
+
+// FetchAccountBalances は口座ごとの現在（ユーザーのタイムゾーンでの本日まで）の残高と、開始日〜終了日の日別の残高推移を返す
+// NOTE: 入出金のない日も含めて期間の全日分の残高を返す
+func (s *accountService) FetchAccountBalances(userID uint, params *api.GetAccountsBalancesParams) ([]AccountBalance, error) {
+	if err := validators.ValidateGetAccountsBalances(params); err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse(dateLayout, params.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(dateLayout, params.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	end = end.AddDate(0, 0, 1)
+
+	accounts, err := s.repo.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	today, err := userToday(s.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.repo.SumBefore(userID, today.AddDate(0, 0, 1).Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+	beforeStart, err := s.repo.SumBefore(userID, start.Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+	daily, err := s.repo.SumDaily(userID, start.Format(dateLayout), end.Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	currentByAccount := make(map[uint]int, len(current))
+	for _, t := range current {
+		currentByAccount[t.AccountID] = t.Amount
+	}
+	beforeStartByAccount := make(map[uint]int, len(beforeStart))
+	for _, t := range beforeStart {
+		beforeStartByAccount[t.AccountID] = t.Amount
+	}
+	dailyByAccount := make(map[uint]map[string]int, len(accounts))
+	for _, t := range daily {
+		if dailyByAccount[t.AccountID] == nil {
+			dailyByAccount[t.AccountID] = make(map[string]int)
+		}
+		dailyByAccount[t.AccountID][t.Date.Format(dateLayout)] = t.Amount
+	}
+
+	balances := make([]AccountBalance, len(accounts))
+	for i, account := range accounts {
+		balance := account.OpeningBalance + beforeStartByAccount[account.ID]
+		var history []AccountBalancePoint
+		for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+			balance += dailyByAccount[account.ID][d.Format(dateLayout)]
+			history = append(history, AccountBalancePoint{Date: d, Balance: balance})
+		}
+
+		balances[i] = AccountBalance{
+			Account: account,
+			Balance: account.OpeningBalance + currentByAccount[account.ID],
+			History: history,
+		}
+	}
+
+	return balances, nil
+}
+
+// FetchAccountEntries は口座の開始日〜終了日の入出金を、取引と振替をまとめて日付の昇順に返す
+// 振替は取引とは別に記録しているため、口座ごとの入出金の一覧はここで取引と振替を合わせて作る
+func (s *accountService) FetchAccountEntries(id uint, userID uint, params *api.GetAccountsIdEntriesParams) (*AccountEntryList, error) {
+	if err := validators.ValidateGetAccountEntries(params); err != nil {
+		return nil, err
+	}
+
+	account, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, err
+	}
+
+	end, err := time.Parse(dateLayout, params.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	beforeStart, err := s.repo.SumBefore(userID, params.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.repo.FindEntries(id, userID, params.StartDate, end.AddDate(0, 0, 1).Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	list := &AccountEntryList{
+		OpeningBalance: account.OpeningBalance,
+		Entries:        make([]AccountEntry, len(entries)),
+	}
+	for _, t := range beforeStart {
+		if t.AccountID == id {
+			list.OpeningBalance += t.Amount
+		}
+	}
+
+	balance := list.OpeningBalance
+	for i, entry := range entries {
+		balance += entry.Amount
+		list.Entries[i] = AccountEntry{AccountEntry: entry, Balance: balance}
+	}
+
+	return list, nil
+}
+
+// ReconcileAccount は明細の日付までの消込済みの残高を明細の残高と比較する
+// 差額がなく dry_run でない場合は照合を登録し、明細の日付以前の消込済みの取引を照合済みにしてロックする
+// NOTE: 差額がある場合もエラーにはせず、差額を含む結果を返す
+func (s *accountService) ReconcileAccount(id uint, userID uint, input *api.ReconcileAccountInput) (*ReconciliationResult, error) {
+	if err := validators.ValidateReconcileAccount(input); err != nil {
+		return nil, err
+	}
+
+	account, err := s.FetchAccountByID(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	statementDate := input.StatementDate.Time
+	summary, err := s.reconciliationRepo.Summarize(account.ID, userID, statementDate.AddDate(0, 0, 1).Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ReconciliationResult{
+		StatementDate:   statementDate,
+		ExpectedBalance: int(input.ExpectedBalance),
+		ClearedBalance:  account.OpeningBalance + summary.ClearedTotal,
+		ClearedCount:    summary.ClearedCount,
+		UnclearedCount:  summary.UnclearedCount,
+	}
+	if result.Difference() != 0 || (input.DryRun != nil && *input.DryRun) {
+		return result, nil
+	}
+
+	reconciliation := models.Reconciliation{
+		UserID:        userID,
+		AccountID:     account.ID,
+		StatementDate: statementDate,
+		Balance:       result.ExpectedBalance,
+	}
+	if err := s.reconciliationRepo.Create(&reconciliation); err != nil {
+		return nil, err
+	}
+	result.Reconciliation = &reconciliation
+
+	return result, nil
+}
+
+// FetchReconciliations は口座の照合の履歴を明細の日付の新しい順に返す
+func (s *accountService) FetchReconciliations(id uint, userID uint) ([]models.Reconciliation, error) {
+	if _, err := s.FetchAccountByID(id, userID); err != nil {
+		return nil, err
+	}
+	return s.reconciliationRepo.FindAll(id, userID)
+}
+
+// checkAccount は口座がユーザーのものか確認する。accountID が nil の場合は何もしない
+func checkAccount(repo repositories.AccountRepository, userID uint, accountID *int32) error {
+	if accountID == nil {
+		return nil
+	}
+	if _, err := repo.FindByID(uint(*accountID), userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrAccountNotFound
+		}
+		return err
+	}
+	return nil
+}
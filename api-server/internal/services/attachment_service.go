@@ -0,0 +1,195 @@
+package services
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+	"apps/storage"
+)
+
+type AttachmentService interface {
+	FetchAttachments(transactionID uint, userID uint) ([]models.Attachment, error)
+	UploadAttachment(transactionID uint, userID uint, input *api.UploadAttachmentInput) (*models.Attachment, error)
+	DownloadAttachment(id uint, transactionID uint, userID uint) (*models.Attachment, io.ReadCloser, error)
+	DeleteAttachment(id uint, transactionID uint, userID uint) error
+}
+
+type attachmentService struct {
+	repo            repositories.AttachmentRepository
+	transactionRepo repositories.TransactionRepository
+	storage         storage.Storage
+}
+
+func NewAttachmentService(repo repositories.AttachmentRepository, transactionRepo repositories.TransactionRepository, storage storage.Storage) AttachmentService {
+	return &attachmentService{repo: repo, transactionRepo: transactionRepo, storage: storage}
+}
+
+func (s *attachmentService) FetchAttachments(transactionID uint, userID uint) ([]models.Attachment, error) {
+	if err := s.checkTransaction(transactionID, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAll(transactionID, userID)
+}
+
+// UploadAttachment はファイルをストレージに保存してから添付ファイルを登録する
+// 登録に失敗した場合は保存したファイルを削除する
+func (s *attachmentService) UploadAttachment(transactionID uint, userID uint, input *api.UploadAttachmentInput) (*models.Attachment, error) {
+	if err := validators.ValidateUploadAttachment(input); err != nil {
+		return nil, err
+	}
+	if err := s.checkTransaction(transactionID, userID); err != nil {
+		return nil, err
+	}
+
+	data, err := input.File.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	key, err := newAttachmentKey(userID, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := models.Attachment{
+		UserID:        userID,
+		TransactionID: transactionID,
+		FileName:      attachmentFileName(input.File.Filename()),
+		ContentType:   http.DetectContentType(data),
+		Size:          len(data),
+		StorageKey:    key,
+	}
+
+	if err := s.storage.Put(key, bytes.NewReader(data), int64(len(data)), attachment.ContentType); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(&attachment); err != nil {
+		deleteAttachmentFile(s.storage, key)
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+// DownloadAttachment は添付ファイルとその内容を読み込む Reader を返す。Reader は呼び出し側で閉じる
+func (s *attachmentService) DownloadAttachment(id uint, transactionID uint, userID uint) (*models.Attachment, io.ReadCloser, error) {
+	attachment, err := s.repo.FindByID(id, transactionID, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, nil, ErrAttachmentNotFound
+		}
+		return nil, nil, err
+	}
+
+	body, err := s.storage.Get(attachment.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, ErrAttachmentNotFound
+		}
+		return nil, nil, err
+	}
+
+	return attachment, body, nil
+}
+
+// DeleteAttachment は添付ファイルを削除してから、ストレージのファイルを削除する
+func (s *attachmentService) DeleteAttachment(id uint, transactionID uint, userID uint) error {
+	attachment, err := s.repo.FindByID(id, transactionID, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrAttachmentNotFound
+		}
+		return err
+	}
+
+	if err := s.repo.Delete(id, transactionID, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrAttachmentNotFound
+		}
+		return err
+	}
+
+	deleteAttachmentFile(s.storage, attachment.StorageKey)
+	return nil
+}
+
+// checkTransaction は取引がユーザーのものか確認する
+func (s *attachmentService) checkTransaction(transactionID uint, userID uint) error {
+	if _, err := s.transactionRepo.FindByID(transactionID, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrTransactionNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// userAttachmentPrefix はユーザーの添付ファイルを保存するキーのプレフィックスを返す
+func userAttachmentPrefix(userID uint) string {
+	return fmt.Sprintf("users/%d/", userID)
+}
+
+// transactionAttachmentPrefix は取引の添付ファイルを保存するキーのプレフィックスを返す
+// NOTE: キーはユーザー・取引ごとのプレフィックスを持つため、取引の削除時はプレフィックスでまとめて削除できる
+func transactionAttachmentPrefix(userID uint, transactionID uint) string {
+	return fmt.Sprintf("%stransactions/%d/", userAttachmentPrefix(userID), transactionID)
+}
+
+// newAttachmentKey は添付ファイルを保存する推測できないキーを生成する
+func newAttachmentKey(userID uint, transactionID uint) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return transactionAttachmentPrefix(userID, transactionID) + hex.EncodeToString(random), nil
+}
+
+// attachmentFileName はアップロードされたファイル名からディレクトリ部分（/ または \ 区切り）を取り除く
+func attachmentFileName(name string) string {
+	name = name[strings.LastIndexAny(name, `/\`)+1:]
+	if name == "" {
+		return "attachment"
+	}
+	return name
+}
+
+// deleteAttachmentFile はストレージのファイルを削除する
+// NOTE: DBからは削除済みのため、ストレージの削除に失敗してもエラーにはせずログに残す
+func deleteAttachmentFile(s storage.Storage, key string) {
+	if err := s.Delete(key); err != nil {
+		log.Printf("failed to delete attachment file %q: %v", key, err)
+	}
+}
+
+// deleteTransactionAttachmentFiles は完全に削除した取引の添付ファイルをストレージからまとめて削除する
+// NOTE: 添付ファイルのレコードは外部キーの ON DELETE CASCADE で削除済みのため、失敗してもエラーにはせずログに残す
+func deleteTransactionAttachmentFiles(s storage.Storage, userID uint, transactionIDs ...uint) {
+	for _, transactionID := range transactionIDs {
+		prefix := transactionAttachmentPrefix(userID, transactionID)
+		if err := s.DeletePrefix(prefix); err != nil {
+			log.Printf("failed to delete attachment files %q: %v", prefix, err)
+		}
+	}
+}
+
+// deleteUserAttachmentFiles は削除したユーザーの添付ファイルをストレージからまとめて削除する
+// NOTE: 添付ファイルのレコードはユーザーの削除で削除済みのため、失敗してもエラーにはせずログに残す
+func deleteUserAttachmentFiles(s storage.Storage, userID uint) {
+	prefix := userAttachmentPrefix(userID)
+	if err := s.DeletePrefix(prefix); err != nil {
+		log.Printf("failed to delete attachment files %q: %v", prefix, err)
+	}
+}
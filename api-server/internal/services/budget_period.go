@@ -0,0 +1,51 @@
+package services
+
+import (
+	"time"
+
+	"apps/internal/models"
+	"apps/internal/repositories"
+)
+
+// budgetMonthRange は予算の対象月（YYYY-MM形式）の期間を、開始日と翌月の開始日（期間の終了日の翌日）で返す
+// 対象月の期間はその月の startDay 日から翌月の startDay 日の前日までとし、
+// 開始日が土日に当たる場合は adjustment に従って前後の平日にずらす
+func budgetMonthRange(month string, startDay int, adjustment models.MonthStartAdjustment) (time.Time, time.Time, error) {
+	first, err := time.Parse("2006-01", month)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return monthStartDate(first, startDay, adjustment), monthStartDate(first.AddDate(0, 1, 0), startDay, adjustment), nil
+}
+
+// monthStartDate は month の月の開始日を返す
+func monthStartDate(month time.Time, startDay int, adjustment models.MonthStartAdjustment) time.Time {
+	d := time.Date(month.Year(), month.Month(), startDay, 0, 0, 0, 0, time.UTC)
+
+	switch adjustment {
+	case models.MonthStartAdjustmentPreviousWeekday:
+		switch d.Weekday() {
+		case time.Saturday:
+			d = d.AddDate(0, 0, -1)
+		case time.Sunday:
+			d = d.AddDate(0, 0, -2)
+		}
+	case models.MonthStartAdjustmentNextWeekday:
+		switch d.Weekday() {
+		case time.Saturday:
+			d = d.AddDate(0, 0, 2)
+		case time.Sunday:
+			d = d.AddDate(0, 0, 1)
+		}
+	}
+	return d
+}
+
+// userBudgetMonthRange はユーザーの月の開始日の設定に基づいて、予算の対象月の開始日と翌月の開始日を返す
+func userBudgetMonthRange(repo repositories.UserRepository, userID uint, month string) (time.Time, time.Time, error) {
+	user, err := repo.FindByID(userID)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return budgetMonthRange(month, user.MonthStartDay, user.MonthStartAdjustment)
+}
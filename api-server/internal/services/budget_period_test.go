@@ -0,0 +1,59 @@
+package services
+
+import (
+	"testing"
+
+	"apps/internal/models"
+)
+
+func TestBudgetMonthRange(t *testing.T) {
+	tests := []struct {
+		name       string
+		month      string
+		startDay   int
+		adjustment models.MonthStartAdjustment
+		wantStart  string
+		wantEnd    string
+	}{
+		{"1日始まり", "2026-01", 1, models.MonthStartAdjustmentNone, "2026-01-01", "2026-02-01"},
+		{"25日始まり 年をまたぐ", "2026-12", 25, models.MonthStartAdjustmentNone, "2026-12-25", "2027-01-25"},
+		{"28日始まり 平年の2月", "2026-02", 28, models.MonthStartAdjustmentNone, "2026-02-28", "2026-03-28"},
+		{"28日始まり うるう年の2月", "2028-02", 28, models.MonthStartAdjustmentNone, "2028-02-28", "2028-03-28"},
+		{"土曜日でも調整しない", "2026-08", 1, models.MonthStartAdjustmentNone, "2026-08-01", "2026-09-01"},
+		// 2026-08-01 は土曜日
+		{"前の平日 土曜日は前月の金曜日", "2026-08", 1, models.MonthStartAdjustmentPreviousWeekday, "2026-07-31", "2026-09-01"},
+		// 2026-02-01, 2026-03-01 は日曜日
+		{"前の平日 開始日と終了日の両方が日曜日", "2026-02", 1, models.MonthStartAdjustmentPreviousWeekday, "2026-01-30", "2026-02-27"},
+		{"次の平日 開始日と終了日の両方が日曜日", "2026-02", 1, models.MonthStartAdjustmentNextWeekday, "2026-02-02", "2026-03-02"},
+		// 2026-02-28, 2026-03-28 は土曜日
+		{"前の平日 2月末日の土曜日", "2026-02", 28, models.MonthStartAdjustmentPreviousWeekday, "2026-02-27", "2026-03-27"},
+		{"次の平日 2月末日の土曜日は翌月にずれる", "2026-02", 28, models.MonthStartAdjustmentNextWeekday, "2026-03-02", "2026-03-30"},
+		// 2027-12-25, 2028-01-01 は土曜日
+		{"前の平日 年末の土曜日", "2027-12", 25, models.MonthStartAdjustmentPreviousWeekday, "2027-12-24", "2028-01-25"},
+		{"前の平日 元日の土曜日は前年の大晦日", "2027-12", 1, models.MonthStartAdjustmentPreviousWeekday, "2027-12-01", "2027-12-31"},
+		{"次の平日 元日の土曜日", "2027-12", 1, models.MonthStartAdjustmentNextWeekday, "2027-12-01", "2028-01-03"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := budgetMonthRange(tt.month, tt.startDay, tt.adjustment)
+			if err != nil {
+				t.Fatalf("budgetMonthRange(%q, %d, %q) returned error: %v", tt.month, tt.startDay, tt.adjustment, err)
+			}
+			if got := start.Format(dateLayout); got != tt.wantStart {
+				t.Errorf("start = %s, want %s", got, tt.wantStart)
+			}
+			if got := end.Format(dateLayout); got != tt.wantEnd {
+				t.Errorf("end = %s, want %s", got, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestBudgetMonthRangeInvalidMonth(t *testing.T) {
+	for _, month := range []string{"2026-13", "2026/01", ""} {
+		if _, _, err := budgetMonthRange(month, 1, models.MonthStartAdjustmentNone); err == nil {
+			t.Errorf("budgetMonthRange(%q) returned no error", month)
+		}
+	}
+}
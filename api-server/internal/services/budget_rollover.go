@@ -0,0 +1,189 @@
+package services
+
+import (
+	"time"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/validators"
+)
+
+// BudgetAvailableItem はカテゴリごとの繰越を含めた予算の利用可能額と実績
+type BudgetAvailableItem struct {
+	Category    models.Category
+	BudgetID    *uint // 対象月の予算が未設定の場合はnil
+	Planned     int
+	CarriedOver int // 前月までの繰越額。超過分を繰り越した場合は負の値
+	Available   int // 予算額 + 繰越額
+	Spent       int
+	Remaining   int
+}
+
+// BudgetAvailable は1ヶ月分の繰越を含めた予算の利用可能額と実績
+type BudgetAvailable struct {
+	Month            string
+	StartDate        time.Time // 集計期間の開始日
+	EndDate          time.Time // 集計期間の終了日
+	Items            []BudgetAvailableItem
+	TotalPlanned     int
+	TotalCarriedOver int
+	TotalAvailable   int
+	TotalSpent       int
+	TotalRemaining   int
+}
+
+// budgetChainMonth は繰越の計算に使う1ヶ月分の期間
+type budgetChainMonth struct {
+	month string
+	start time.Time
+	end   time.Time // 翌月の開始日
+}
+
+// FetchBudgetAvailable は対象月の予算の利用可能額をカテゴリごとに計算する
+// カテゴリの最初の予算の月から前月までの予算額と実績額の差を、カテゴリの繰越方法に従って月ごとに繰り越す
+// 予算が未設定の月も予算額0として実績額を差し引く
+// NOTE: 繰越方法は過去の月にも現在のカテゴリの設定を適用する
+func (s *budgetService) FetchBudgetAvailable(userID uint, params *api.GetBudgetsAvailableParams) (*BudgetAvailable, error) {
+	if err := validators.ValidateGetBudgetsAvailable(params); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := budgetMonthRange(params.Month, user.MonthStartDay, user.MonthStartAdjustment)
+	if err != nil {
+		return nil, err
+	}
+
+	available := &BudgetAvailable{
+		Month:     params.Month,
+		StartDate: start,
+		EndDate:   end.AddDate(0, 0, -1),
+		Items:     []BudgetAvailableItem{},
+	}
+
+	budgets, err := s.repo.FindUntil(userID, params.Month)
+	if err != nil {
+		return nil, err
+	}
+	if len(budgets) == 0 {
+		return available, nil
+	}
+
+	// 予算はカテゴリ・月の順に並んでいるため、カテゴリごとの最初の予算が最も古い月になる
+	var categoryIDs []uint
+	categories := make(map[uint]models.Category)
+	firstMonths := make(map[uint]string)
+	budgetsByMonth := make(map[uint]map[string]*models.Budget)
+	firstMonth := params.Month
+	for i := range budgets {
+		b := &budgets[i]
+		if _, ok := categories[b.CategoryID]; !ok {
+			categoryIDs = append(categoryIDs, b.CategoryID)
+			categories[b.CategoryID] = b.Category
+			firstMonths[b.CategoryID] = b.Month
+			budgetsByMonth[b.CategoryID] = make(map[string]*models.Budget)
+		}
+		budgetsByMonth[b.CategoryID][b.Month] = b
+		if b.Month < firstMonth {
+			firstMonth = b.Month
+		}
+	}
+
+	chain, err := budgetChain(firstMonth, params.Month, user.MonthStartDay, user.MonthStartAdjustment)
+	if err != nil {
+		return nil, err
+	}
+
+	daily, err := s.repo.SumSpentDaily(userID, categoryIDs, chain[0].start.Format(dateLayout), end.Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+	spent := make(map[uint]map[string]int, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		spent[categoryID] = make(map[string]int)
+	}
+	// 日別の実績は日付の順に並んでいるため、期間を順にたどって月ごとに合計する
+	i := 0
+	for _, t := range daily {
+		for i < len(chain)-1 && !t.Date.Before(chain[i].end) {
+			i++
+		}
+		spent[t.CategoryID][chain[i].month] += t.Spent
+	}
+
+	for _, categoryID := range categoryIDs {
+		category := categories[categoryID]
+		item := BudgetAvailableItem{Category: category}
+
+		for _, m := range chain {
+			if m.month < firstMonths[categoryID] {
+				continue
+			}
+			planned := 0
+			b, ok := budgetsByMonth[categoryID][m.month]
+			if ok {
+				planned = b.Amount
+			}
+			if m.month == params.Month {
+				if ok {
+					item.BudgetID = &b.ID
+				}
+				item.Planned = planned
+				item.Spent = spent[categoryID][m.month]
+				break
+			}
+			item.CarriedOver = rollover(category.BudgetRollover, item.CarriedOver+planned-spent[categoryID][m.month])
+		}
+		item.Available = item.Planned + item.CarriedOver
+		item.Remaining = item.Available - item.Spent
+		available.Items = append(available.Items, item)
+
+		available.TotalPlanned += item.Planned
+		available.TotalCarriedOver += item.CarriedOver
+		available.TotalSpent += item.Spent
+	}
+	available.TotalAvailable = available.TotalPlanned + available.TotalCarriedOver
+	available.TotalRemaining = available.TotalAvailable - available.TotalSpent
+
+	return available, nil
+}
+
+// budgetChain は firstMonth から lastMonth までの各月の期間を月の順に返す
+func budgetChain(firstMonth, lastMonth string, startDay int, adjustment models.MonthStartAdjustment) ([]budgetChainMonth, error) {
+	first, err := time.Parse("2006-01", firstMonth)
+	if err != nil {
+		return nil, err
+	}
+	last, err := time.Parse("2006-01", lastMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	var chain []budgetChainMonth
+	for m := first; !m.After(last); m = m.AddDate(0, 1, 0) {
+		chain = append(chain, budgetChainMonth{
+			month: m.Format("2006-01"),
+			start: monthStartDate(m, startDay, adjustment),
+			end:   monthStartDate(m.AddDate(0, 1, 0), startDay, adjustment),
+		})
+	}
+	return chain, nil
+}
+
+// rollover は月末時点の残額（繰越額 + 予算額 - 実績額）のうち、繰越方法に従って翌月に繰り越す額を返す
+func rollover(mode models.BudgetRollover, balance int) int {
+	switch mode {
+	case models.BudgetRolloverSurplus:
+		if balance < 0 {
+			return 0
+		}
+		return balance
+	case models.BudgetRolloverSurplusAndDeficit:
+		return balance
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/testdb"
+)
+
+func TestRollover(t *testing.T) {
+	tests := []struct {
+		mode    models.BudgetRollover
+		balance int
+		want    int
+	}{
+		{models.BudgetRolloverNone, 3000, 0},
+		{models.BudgetRolloverNone, -3000, 0},
+		{models.BudgetRolloverSurplus, 3000, 3000},
+		{models.BudgetRolloverSurplus, 0, 0},
+		{models.BudgetRolloverSurplus, -3000, 0},
+		{models.BudgetRolloverSurplusAndDeficit, 3000, 3000},
+		{models.BudgetRolloverSurplusAndDeficit, -3000, -3000},
+	}
+
+	for _, tt := range tests {
+		if got := rollover(tt.mode, tt.balance); got != tt.want {
+			t.Errorf("rollover(%s, %d) = %d, want %d", tt.mode, tt.balance, got, tt.want)
+		}
+	}
+}
+
+func TestFetchBudgetAvailableCarriesOverDeficit(t *testing.T) {
+	const (
+		deficitCategoryID   = 1 // 超過も繰り越す
+		surplusCategoryID   = 2 // 余りのみ繰り越す
+		noneCategoryID      = 3 // 繰り越さない
+		overspentCategoryID = 4 // 超過も繰り越す。予算のない月を挟み、繰越額が予算額を上回る
+	)
+
+	db, fake := testdb.New(t)
+	fake.Returns("FROM `users`",
+		[]string{"id", "base_currency", "time_zone", "month_start_day", "month_start_adjustment"},
+		[]driver.Value{int64(1), "JPY", "Asia/Tokyo", int64(1), "none"},
+	)
+	fake.Returns("FROM `categories`",
+		[]string{"id", "user_id", "name", "type", "budget_rollover"},
+		[]driver.Value{int64(deficitCategoryID), int64(1), "食費", "expense", "surplus_and_deficit"},
+		[]driver.Value{int64(surplusCategoryID), int64(1), "日用品", "expense", "surplus"},
+		[]driver.Value{int64(noneCategoryID), int64(1), "交際費", "expense", "none"},
+		[]driver.Value{int64(overspentCategoryID), int64(1), "旅行", "expense", "surplus_and_deficit"},
+	)
+	budget := func(id, categoryID int64, month string, amount int64) []driver.Value {
+		return []driver.Value{id, int64(1), categoryID, month, amount}
+	}
+	fake.Returns("FROM `budgets`",
+		[]string{"id", "user_id", "category_id", "month", "amount"},
+		budget(1, deficitCategoryID, "2026-01", 10000),
+		budget(2, deficitCategoryID, "2026-02", 10000),
+		budget(3, deficitCategoryID, "2026-03", 10000),
+		budget(4, surplusCategoryID, "2026-01", 10000),
+		budget(5, surplusCategoryID, "2026-02", 10000),
+		budget(6, surplusCategoryID, "2026-03", 10000),
+		budget(7, noneCategoryID, "2026-01", 10000),
+		budget(8, noneCategoryID, "2026-02", 10000),
+		budget(9, noneCategoryID, "2026-03", 10000),
+		budget(10, overspentCategoryID, "2026-01", 10000),
+		budget(11, overspentCategoryID, "2026-03", 5000),
+	)
+	spent := func(categoryID int64, date string, amount int64) []driver.Value {
+		d, _ := time.Parse(dateLayout, date)
+		return []driver.Value{categoryID, d, amount}
+	}
+	fake.Returns("AS transaction_lines",
+		[]string{"category_id", "date", "spent"},
+		spent(deficitCategoryID, "2026-01-10", 15000),
+		spent(surplusCategoryID, "2026-01-10", 15000),
+		spent(noneCategoryID, "2026-01-10", 15000),
+		spent(overspentCategoryID, "2026-01-20", 25000),
+		spent(deficitCategoryID, "2026-02-10", 8000),
+		spent(surplusCategoryID, "2026-02-10", 8000),
+		spent(noneCategoryID, "2026-02-10", 8000),
+		spent(deficitCategoryID, "2026-03-10", 3000),
+		spent(surplusCategoryID, "2026-03-10", 3000),
+		spent(noneCategoryID, "2026-03-10", 3000),
+	)
+	service := NewBudgetService(repositories.NewBudgetRepository(db), repositories.NewUserRepository(db), repositories.NewBudgetTemplateRepository(db))
+
+	available, err := service.FetchBudgetAvailable(1, &api.GetBudgetsAvailableParams{Month: "2026-03"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		categoryID  uint
+		carriedOver int
+		available   int
+		remaining   int
+	}{
+		// 1月 -5000 → 2月 -5000 + 10000 - 8000 = -3000
+		{deficitCategoryID, -3000, 7000, 4000},
+		// 1月の超過は繰り越さず0 → 2月 10000 - 8000 = 2000
+		{surplusCategoryID, 2000, 12000, 9000},
+		{noneCategoryID, 0, 10000, 7000},
+		// 1月 10000 - 25000 = -15000 → 予算のない2月もそのまま -15000
+		{overspentCategoryID, -15000, -10000, -10000},
+	}
+	if len(available.Items) != len(tests) {
+		t.Fatalf("items = %d, want %d", len(available.Items), len(tests))
+	}
+	for i, tt := range tests {
+		item := available.Items[i]
+		if item.Category.ID != tt.categoryID || item.CarriedOver != tt.carriedOver || item.Available != tt.available || item.Remaining != tt.remaining {
+			t.Errorf("item %d = category %d carried over %d, available %d, remaining %d; want category %d carried over %d, available %d, remaining %d",
+				i, item.Category.ID, item.CarriedOver, item.Available, item.Remaining, tt.categoryID, tt.carriedOver, tt.available, tt.remaining)
+		}
+	}
+	if available.TotalCarriedOver != -16000 || available.TotalAvailable != 19000 {
+		t.Errorf("totals = carried over %d, available %d, want -16000, 19000", available.TotalCarriedOver, available.TotalAvailable)
+	}
+}
@@ -2,6 +2,9 @@ package services
 
 import (
 	"errors"
+	"math"
+	"strconv"
+	"time"
 
 	api "apps/apis"
 	"apps/internal/models"
@@ -9,32 +12,156 @@ import (
 	"apps/internal/validators"
 )
 
+// BudgetSummaryItem はカテゴリごとの予算と実績
+type BudgetSummaryItem struct {
+	Category    models.Category
+	BudgetID    *uint
+	Planned     int
+	Spent       int
+	Remaining   int
+	PercentUsed *float64 // 予算未設定の場合はnil
+}
+
+// BudgetCopy は予算のコピーの結果
+type BudgetCopy struct {
+	Created int
+	Updated int
+	Skipped int
+	Budgets []models.Budget // コピー後のコピー先の月の予算
+}
+
+// BudgetSummary は1ヶ月分の予算と実績
+type BudgetSummary struct {
+	Month            string
+	StartDate        time.Time // 集計期間の開始日
+	EndDate          time.Time // 集計期間の終了日
+	Items            []BudgetSummaryItem
+	TotalPlanned     int
+	TotalSpent       int
+	TotalRemaining   int
+	TotalPercentUsed *float64
+}
+
 type BudgetService interface {
-	FetchBudgets(userID uint, params *api.GetBudgetsParams) ([]models.Budget, error)
+	FetchBudgets(userID uint, params *api.GetBudgetsParams) ([]models.Budget, *string, error)
+	FetchBudgetSummary(userID uint, params *api.GetBudgetsSummaryParams) (*BudgetSummary, error)
+	FetchBudgetAvailable(userID uint, params *api.GetBudgetsAvailableParams) (*BudgetAvailable, error)
 	FetchBudgetByID(id uint, userID uint) (*models.Budget, error)
 	CreateBudget(userID uint, input *api.CreateBudgetInput) (*models.Budget, error)
 	UpdateBudget(id uint, userID uint, input *api.UpdateBudgetInput) (*models.Budget, error)
 	DeleteBudget(id uint, userID uint) error
+	CopyBudgets(userID uint, input *api.CopyBudgetsInput) (*BudgetCopy, error)
 }
 
 type budgetService struct {
-	repo repositories.BudgetRepository
+	repo         repositories.BudgetRepository
+	userRepo     repositories.UserRepository
+	templateRepo repositories.BudgetTemplateRepository
+}
+
+func NewBudgetService(repo repositories.BudgetRepository, userRepo repositories.UserRepository, templateRepo repositories.BudgetTemplateRepository) BudgetService {
+	return &budgetService{repo: repo, userRepo: userRepo, templateRepo: templateRepo}
 }
 
-func NewBudgetService(repo repositories.BudgetRepository) BudgetService {
-	return &budgetService{repo}
+// budgetSortKeys は予算一覧の並び替えキーごとのカラムとカーソル値の変換方法
+var budgetSortKeys = map[api.BudgetSortKey]sortKey[models.Budget]{
+	api.BudgetSortKeyMonth: {
+		column: "budgets.month",
+		value:  func(b *models.Budget) string { return b.Month },
+		parse:  parseMonthValue,
+	},
+	api.BudgetSortKeyAmount: {
+		column: "budgets.amount",
+		value:  func(b *models.Budget) string { return strconv.Itoa(b.Amount) },
+		parse:  parseIntValue,
+	},
+	api.BudgetSortKeyCreatedAt: {
+		column: "budgets.created_at",
+		value:  func(b *models.Budget) string { return b.CreatedAt.Format(time.RFC3339Nano) },
+		parse:  parseTimeValue,
+	},
+}
+
+// FetchBudgets は条件に一致する予算を1ページ分取得し、次のページのカーソルとともに返す
+func (s *budgetService) FetchBudgets(userID uint, params *api.GetBudgetsParams) ([]models.Budget, *string, error) {
+	if err := validators.ValidateGetBudgets(params); err != nil {
+		return nil, nil, err
+	}
+
+	sort := api.BudgetSortKeyMonth
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+	key := budgetSortKeys[sort]
+
+	page, err := newPageParams(key, string(sort), params.Order, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	budgets, hasNext, err := s.repo.FindAll(userID, params.Month, params.CategoryId, page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return budgets, nextCursor(key, string(sort), page, budgets, hasNext, func(b *models.Budget) uint { return b.ID }), nil
 }
 
-func (s *budgetService) FetchBudgets(userID uint, params *api.GetBudgetsParams) ([]models.Budget, error) {
-	var month *string
-	var categoryID *int32
+func (s *budgetService) FetchBudgetSummary(userID uint, params *api.GetBudgetsSummaryParams) (*BudgetSummary, error) {
+	if err := validators.ValidateGetBudgetsSummary(params); err != nil {
+		return nil, err
+	}
+
+	// 実績はユーザーの月の開始日に基づく期間で集計する
+	start, end, err := userBudgetMonthRange(s.userRepo, userID, params.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.Summarize(userID, params.Month, start.Format(dateLayout), end.Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &BudgetSummary{
+		Month:     params.Month,
+		StartDate: start,
+		EndDate:   end.AddDate(0, 0, -1),
+		Items:     make([]BudgetSummaryItem, len(rows)),
+	}
+	hasBudget := false
+	for i, row := range rows {
+		item := BudgetSummaryItem{
+			Category:  row.Category,
+			BudgetID:  row.BudgetID,
+			Planned:   row.Planned,
+			Spent:     row.Spent,
+			Remaining: row.Planned - row.Spent,
+		}
+		if row.BudgetID != nil {
+			item.PercentUsed = percentUsed(row.Spent, row.Planned)
+			hasBudget = true
+		}
+		summary.Items[i] = item
 
-	if params != nil {
-		month = params.Month
-		categoryID = params.CategoryId
+		summary.TotalPlanned += item.Planned
+		summary.TotalSpent += item.Spent
 	}
+	summary.TotalRemaining = summary.TotalPlanned - summary.TotalSpent
+	if hasBudget {
+		summary.TotalPercentUsed = percentUsed(summary.TotalSpent, summary.TotalPlanned)
+	}
+
+	return summary, nil
+}
 
-	return s.repo.FindAll(userID, month, categoryID)
+// percentUsed は予算消化率（%）を小数第1位で丸めて返す
+func percentUsed(spent, planned int) *float64 {
+	if planned <= 0 {
+		return nil
+	}
+	p := math.Round(float64(spent)*1000/float64(planned)) / 10
+	return &p
 }
 
 func (s *budgetService) FetchBudgetByID(id uint, userID uint) (*models.Budget, error) {
@@ -117,3 +244,54 @@ func (s *budgetService) DeleteBudget(id uint, userID uint) error {
 	}
 	return nil
 }
+
+// CopyBudgets はコピー元の月または予算テンプレートのすべての予算を、コピー先の月の予算として1つのトランザクションで登録する
+// コピー先の月に同じカテゴリの予算が既にある場合は on_conflict（省略時は skip）に従う
+func (s *budgetService) CopyBudgets(userID uint, input *api.CopyBudgetsInput) (*BudgetCopy, error) {
+	if err := validators.ValidateCopyBudgets(input); err != nil {
+		return nil, err
+	}
+
+	var amounts []repositories.BudgetAmount
+	if input.TemplateId != nil {
+		template, err := s.templateRepo.FindByID(uint(*input.TemplateId), userID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrNotFound) {
+				return nil, ErrBudgetTemplateNotFound
+			}
+			return nil, err
+		}
+		for _, item := range template.Items {
+			amounts = append(amounts, repositories.BudgetAmount{CategoryID: item.CategoryID, Amount: item.Amount})
+		}
+	} else {
+		budgets, _, err := s.repo.FindAll(userID, input.SourceMonth, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, budget := range budgets {
+			amounts = append(amounts, repositories.BudgetAmount{CategoryID: budget.CategoryID, Amount: budget.Amount})
+		}
+	}
+
+	overwrite := input.OnConflict != nil && *input.OnConflict == api.Overwrite
+	result, err := s.repo.Copy(userID, input.TargetMonth, amounts, overwrite)
+	if err != nil {
+		if errors.Is(err, repositories.ErrDuplicateEntry) {
+			return nil, ErrBudgetAlreadyExists
+		}
+		return nil, err
+	}
+
+	budgets, _, err := s.repo.FindAll(userID, &input.TargetMonth, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BudgetCopy{
+		Created: result.Created,
+		Updated: result.Updated,
+		Skipped: result.Skipped,
+		Budgets: budgets,
+	}, nil
+}
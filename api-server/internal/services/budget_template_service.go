@@ -0,0 +1,120 @@
+package services
+
+import (
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+type BudgetTemplateService interface {
+	FetchBudgetTemplates(userID uint) ([]models.BudgetTemplate, error)
+	FetchBudgetTemplateByID(id uint, userID uint) (*models.BudgetTemplate, error)
+	CreateBudgetTemplate(userID uint, input *api.CreateBudgetTemplateInput) (*models.BudgetTemplate, error)
+	UpdateBudgetTemplate(id uint, userID uint, input *api.UpdateBudgetTemplateInput) (*models.BudgetTemplate, error)
+	DeleteBudgetTemplate(id uint, userID uint) error
+}
+
+type budgetTemplateService struct {
+	repo repositories.BudgetTemplateRepository
+}
+
+func NewBudgetTemplateService(repo repositories.BudgetTemplateRepository) BudgetTemplateService {
+	return &budgetTemplateService{repo: repo}
+}
+
+func (s *budgetTemplateService) FetchBudgetTemplates(userID uint) ([]models.BudgetTemplate, error) {
+	return s.repo.FindAll(userID)
+}
+
+func (s *budgetTemplateService) FetchBudgetTemplateByID(id uint, userID uint) (*models.BudgetTemplate, error) {
+	template, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrBudgetTemplateNotFound
+		}
+		return nil, err
+	}
+	return template, nil
+}
+
+func (s *budgetTemplateService) CreateBudgetTemplate(userID uint, input *api.CreateBudgetTemplateInput) (*models.BudgetTemplate, error) {
+	if err := validators.ValidateCreateBudgetTemplate(input); err != nil {
+		return nil, err
+	}
+
+	template := models.BudgetTemplate{
+		UserID: userID,
+		Name:   input.Name,
+		Items:  newBudgetTemplateItems(input.Items),
+	}
+
+	if err := s.repo.Create(&template); err != nil {
+		if errors.Is(err, repositories.ErrDuplicateEntry) {
+			return nil, ErrBudgetTemplateAlreadyExists
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return &template, nil
+}
+
+func (s *budgetTemplateService) UpdateBudgetTemplate(id uint, userID uint, input *api.UpdateBudgetTemplateInput) (*models.BudgetTemplate, error) {
+	if err := validators.ValidateUpdateBudgetTemplate(input); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if input.Name != nil {
+		updates["name"] = *input.Name
+	}
+
+	var items []models.BudgetTemplateItem
+	if input.Items != nil {
+		items = newBudgetTemplateItems(*input.Items)
+	}
+
+	template, err := s.repo.Update(id, userID, updates, items)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrBudgetTemplateNotFound
+		}
+		if errors.Is(err, repositories.ErrDuplicateEntry) {
+			return nil, ErrBudgetTemplateAlreadyExists
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// DeleteBudgetTemplate は予算テンプレートを削除する。テンプレートからコピーした予算は削除しない
+func (s *budgetTemplateService) DeleteBudgetTemplate(id uint, userID uint) error {
+	err := s.repo.Delete(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrBudgetTemplateNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func newBudgetTemplateItems(inputs []api.BudgetTemplateItemInput) []models.BudgetTemplateItem {
+	items := make([]models.BudgetTemplateItem, len(inputs))
+	for i, input := range inputs {
+		items[i] = models.BudgetTemplateItem{
+			CategoryID: uint(input.CategoryId),
+			Amount:     int(input.Amount),
+		}
+	}
+	return items
+}
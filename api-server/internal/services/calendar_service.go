@@ -0,0 +1,107 @@
+package services
+
+import (
+	"time"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+const dateLayout = "2006-01-02"
+
+// CalendarDay はカレンダーの1日分の集計
+type CalendarDay struct {
+	Date             time.Time
+	Income           int
+	Expense          int
+	TransactionCount int
+	Balance          int // その日までのすべての取引の累計（収入 - 支出）。前月以前の取引を含む
+}
+
+// Calendar は1ヶ月分のカレンダー
+type Calendar struct {
+	Month        string
+	TotalIncome  int
+	TotalExpense int
+	Days         []CalendarDay
+	Budgets      []models.Budget
+}
+
+type CalendarService interface {
+	FetchCalendar(userID uint, params *api.GetCalendarParams) (*Calendar, error)
+}
+
+type calendarService struct {
+	transactionRepo repositories.TransactionRepository
+	budgetRepo      repositories.BudgetRepository
+}
+
+func NewCalendarService(transactionRepo repositories.TransactionRepository, budgetRepo repositories.BudgetRepository) CalendarService {
+	return &calendarService{transactionRepo: transactionRepo, budgetRepo: budgetRepo}
+}
+
+func (s *calendarService) FetchCalendar(userID uint, params *api.GetCalendarParams) (*Calendar, error) {
+	if err := validators.ValidateGetCalendar(params); err != nil {
+		return nil, err
+	}
+
+	start, end, err := monthRange(params.Month)
+	if err != nil {
+		return nil, err
+	}
+
+	beforeStart, err := s.transactionRepo.SumBefore(userID, start.Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+	totals, err := s.transactionRepo.SumDaily(userID, start.Format(dateLayout), end.Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, _, err := s.budgetRepo.FindAll(userID, &params.Month, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	totalsByDate := make(map[string]repositories.TransactionDailyTotal, len(totals))
+	for _, t := range totals {
+		totalsByDate[t.Date.Format(dateLayout)] = t
+	}
+
+	calendar := &Calendar{
+		Month:   params.Month,
+		Budgets: budgets,
+	}
+
+	// NOTE: 取引のない日も含めて月の全日分を返す
+	balance := beforeStart.Income - beforeStart.Expense
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		t := totalsByDate[d.Format(dateLayout)]
+		balance += t.Income - t.Expense
+		calendar.TotalIncome += t.Income
+		calendar.TotalExpense += t.Expense
+		calendar.Days = append(calendar.Days, CalendarDay{
+			Date:             d,
+			Income:           t.Income,
+			Expense:          t.Expense,
+			TransactionCount: t.Count,
+			Balance:          balance,
+		})
+	}
+
+	return calendar, nil
+}
+
+// monthRange はYYYY-MM形式の月から、月初日と翌月初日をUTCの0時で返す
+// NOTE: 取引日・振替日はタイムゾーンを持たない日付としてその日のUTCの0時で保存しているため、月の範囲や日別の集計は
+// ユーザーのタイムゾーンに関係なくUTCの日付で求める。ユーザーのタイムゾーンは「今日」の判定（userToday）にのみ使う
+func monthRange(month string) (time.Time, time.Time, error) {
+	start, err := time.Parse("2006-01", month)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return start, start.AddDate(0, 1, 0), nil
+}
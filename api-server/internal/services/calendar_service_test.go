@@ -0,0 +1,174 @@
+package services
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/repositories"
+	"apps/internal/testdb"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func TestFetchCalendarBalanceIncludesPreviousMonths(t *testing.T) {
+	db, fake := testdb.New(t)
+	// 2026-03-01 より前の取引の合計（収入 300000 - 支出 120000）
+	fake.Returns("AS expense FROM", []string{"income", "expense"}, []driver.Value{int64(300000), int64(120000)})
+	fake.Returns("GROUP BY DATE\\(transactions.date\\)", []string{"date", "income", "expense", "count"},
+		[]driver.Value{time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), int64(0), int64(5000), int64(1)},
+		[]driver.Value{time.Date(2026, 3, 25, 0, 0, 0, 0, time.UTC), int64(250000), int64(0), int64(1)},
+	)
+	service := NewCalendarService(repositories.NewTransactionRepository(db), repositories.NewBudgetRepository(db))
+
+	calendar, err := service.FetchCalendar(1, &api.GetCalendarParams{Month: "2026-03"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	befores := fake.Statements("AS expense FROM")
+	if len(befores) != 1 || befores[0].Args[len(befores[0].Args)-1] != "2026-03-01" {
+		t.Errorf("sum before queries = %v, want date < 2026-03-01", befores)
+	}
+
+	tests := []struct {
+		date    string
+		balance int
+	}{
+		{"2026-03-01", 180000},
+		{"2026-03-02", 175000},
+		{"2026-03-24", 175000},
+		{"2026-03-25", 425000},
+		{"2026-03-31", 425000},
+	}
+	balances := make(map[string]int, len(calendar.Days))
+	for _, day := range calendar.Days {
+		balances[day.Date.Format(dateLayout)] = day.Balance
+	}
+	for _, tt := range tests {
+		if got := balances[tt.date]; got != tt.balance {
+			t.Errorf("balance on %s = %d, want %d", tt.date, got, tt.balance)
+		}
+	}
+	if calendar.TotalIncome != 250000 || calendar.TotalExpense != 5000 {
+		t.Errorf("totals = %d / %d, want 250000 / 5000", calendar.TotalIncome, calendar.TotalExpense)
+	}
+}
+
+func TestMonthRange(t *testing.T) {
+	tests := []struct {
+		month     string
+		wantStart string
+		wantEnd   string
+	}{
+		{"2026-01", "2026-01-01", "2026-02-01"},
+		{"2026-02", "2026-02-01", "2026-03-01"},
+		{"2028-02", "2028-02-01", "2028-03-01"},
+		{"2026-12", "2026-12-01", "2027-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.month, func(t *testing.T) {
+			start, end, err := monthRange(tt.month)
+			if err != nil {
+				t.Fatalf("monthRange(%q) returned error: %v", tt.month, err)
+			}
+			for _, c := range []struct {
+				got  time.Time
+				want string
+			}{{start, tt.wantStart}, {end, tt.wantEnd}} {
+				want, _ := time.Parse(dateLayout, c.want)
+				if !c.got.Equal(want) || c.got.Location() != time.UTC {
+					t.Errorf("monthRange(%q) bound = %s, want %s", tt.month, c.got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestMonthRangeContainsStoredDate は、月をまたぐ時刻でユーザーのタイムゾーンの「今日」は翌月（前月）の範囲に入る一方、
+// APIで受け取った取引日（UTCの0時で保存される）はタイムゾーンに関係なくその日付の月の範囲に入ることを確認する
+func TestMonthRangeContainsStoredDate(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeZone    string
+		now         string // 現在時刻（RFC 3339）
+		storedDate  string // APIで受け取る取引日
+		wantToday   time.Time
+		todayStart  time.Time // 今日を含む月の範囲
+		todayEnd    time.Time
+		storedStart time.Time // 取引日を含む月の範囲
+		storedEnd   time.Time
+	}{
+		{
+			name:     "東京ではUTCの3月31日15:30が4月1日",
+			timeZone: "Asia/Tokyo", now: "2026-03-31T15:30:00Z", storedDate: "2026-03-31",
+			wantToday:   time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			todayStart:  time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			todayEnd:    time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+			storedStart: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			storedEnd:   time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "ニューヨークではUTCの4月1日03:00が3月31日",
+			timeZone: "America/New_York", now: "2026-04-01T03:00:00Z", storedDate: "2026-04-01",
+			wantToday:   time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC),
+			todayStart:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			todayEnd:    time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			storedStart: time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+			storedEnd:   time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "東京ではUTCの12月31日15:00が翌年の元日",
+			timeZone: "Asia/Tokyo", now: "2026-12-31T15:00:00Z", storedDate: "2026-12-31",
+			wantToday:   time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			todayStart:  time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			todayEnd:    time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC),
+			storedStart: time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+			storedEnd:   time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now, err := time.Parse(time.RFC3339, tt.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			today, err := todayIn(tt.timeZone, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !today.Equal(tt.wantToday) {
+				t.Fatalf("todayIn(%s, %s) = %s, want %s", tt.timeZone, tt.now, today, tt.wantToday)
+			}
+			start, end, err := monthRange(today.Format("2006-01"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !start.Equal(tt.todayStart) || !end.Equal(tt.todayEnd) {
+				t.Errorf("month range of today = [%s, %s), want [%s, %s)", start, end, tt.todayStart, tt.todayEnd)
+			}
+
+			var stored openapi_types.Date
+			if err := json.Unmarshal([]byte(`"`+tt.storedDate+`"`), &stored); err != nil {
+				t.Fatal(err)
+			}
+			start, end, err = monthRange(stored.Time.Format("2006-01"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !start.Equal(tt.storedStart) || !end.Equal(tt.storedEnd) {
+				t.Errorf("month range of %s = [%s, %s), want [%s, %s)", tt.storedDate, start, end, tt.storedStart, tt.storedEnd)
+			}
+			if stored.Time.Before(tt.storedStart) || !stored.Time.Before(tt.storedEnd) {
+				t.Errorf("stored date %s is not in [%s, %s)", stored.Time, tt.storedStart, tt.storedEnd)
+			}
+			if !stored.Time.Before(tt.todayStart) && stored.Time.Before(tt.todayEnd) {
+				t.Errorf("stored date %s is in the month of today [%s, %s)", stored.Time, tt.todayStart, tt.todayEnd)
+			}
+		})
+	}
+}
@@ -0,0 +1,298 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+// 自動分類ルールの適用結果に含める取引の上限
+const categorizationResultLimit = 100
+
+// CategorizationRuleApplyResult は自動分類ルールを既存の取引に適用した結果
+type CategorizationRuleApplyResult struct {
+	DryRun       bool
+	MatchedCount int
+	UpdatedCount int
+	Transactions []models.Transaction // カテゴリが変更される（された）取引。日付の新しい順に最大 categorizationResultLimit 件
+}
+
+type CategorizationRuleService interface {
+	FetchCategorizationRules(userID uint) ([]models.CategorizationRule, error)
+	FetchCategorizationRuleByID(id uint, userID uint) (*models.CategorizationRule, error)
+	CreateCategorizationRule(userID uint, input *api.CreateCategorizationRuleInput) (*models.CategorizationRule, error)
+	UpdateCategorizationRule(id uint, userID uint, input *api.UpdateCategorizationRuleInput) (*models.CategorizationRule, error)
+	DeleteCategorizationRule(id uint, userID uint) error
+	ApplyCategorizationRule(ctx context.Context, id uint, userID uint, input *api.ApplyCategorizationRuleInput) (*CategorizationRuleApplyResult, error)
+}
+
+type categorizationRuleService struct {
+	repo            repositories.CategorizationRuleRepository
+	transactionRepo repositories.TransactionRepository
+}
+
+func NewCategorizationRuleService(repo repositories.CategorizationRuleRepository, transactionRepo repositories.TransactionRepository) CategorizationRuleService {
+	return &categorizationRuleService{repo: repo, transactionRepo: transactionRepo}
+}
+
+func (s *categorizationRuleService) FetchCategorizationRules(userID uint) ([]models.CategorizationRule, error) {
+	return s.repo.FindAll(userID)
+}
+
+func (s *categorizationRuleService) FetchCategorizationRuleByID(id uint, userID uint) (*models.CategorizationRule, error) {
+	rule, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCategorizationRuleNotFound
+		}
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (s *categorizationRuleService) CreateCategorizationRule(userID uint, input *api.CreateCategorizationRuleInput) (*models.CategorizationRule, error) {
+	if err := validators.ValidateCreateCategorizationRule(input); err != nil {
+		return nil, err
+	}
+	if err := validators.ValidateCategorizationRuleCondition(input.MatchType, input.Pattern, input.MinAmount, input.MaxAmount); err != nil {
+		return nil, err
+	}
+
+	rule := models.CategorizationRule{
+		UserID:     userID,
+		CategoryID: uint(input.CategoryId),
+		MatchType:  models.CategorizationMatchType(input.MatchType),
+		Pattern:    input.Pattern,
+		MinAmount:  toIntPtr(input.MinAmount),
+		MaxAmount:  toIntPtr(input.MaxAmount),
+	}
+	if input.Priority != nil {
+		rule.Priority = int(*input.Priority)
+	}
+
+	if err := s.repo.Create(&rule); err != nil {
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func (s *categorizationRuleService) UpdateCategorizationRule(id uint, userID uint, input *api.UpdateCategorizationRuleInput) (*models.CategorizationRule, error) {
+	if err := validators.ValidateUpdateCategorizationRule(input); err != nil {
+		return nil, err
+	}
+
+	// NOTE: 一致方法・パターン・金額の範囲は既存の値と組み合わせてチェックする
+	if input.MatchType != nil || input.Pattern != nil || input.MinAmount != nil || input.MaxAmount != nil {
+		existing, err := s.FetchCategorizationRuleByID(id, userID)
+		if err != nil {
+			return nil, err
+		}
+		matchType, pattern := api.CategorizationMatchType(existing.MatchType), existing.Pattern
+		minAmount, maxAmount := toInt32Ptr(existing.MinAmount), toInt32Ptr(existing.MaxAmount)
+		if input.MatchType != nil {
+			matchType = *input.MatchType
+		}
+		if input.Pattern != nil {
+			pattern = *input.Pattern
+		}
+		if input.MinAmount != nil {
+			minAmount = input.MinAmount
+		}
+		if input.MaxAmount != nil {
+			maxAmount = input.MaxAmount
+		}
+		if err := validators.ValidateCategorizationRuleCondition(matchType, pattern, minAmount, maxAmount); err != nil {
+			return nil, err
+		}
+	}
+
+	updates := make(map[string]interface{})
+	if input.CategoryId != nil {
+		updates["category_id"] = *input.CategoryId
+	}
+	if input.MatchType != nil {
+		updates["match_type"] = *input.MatchType
+	}
+	if input.Pattern != nil {
+		updates["pattern"] = *input.Pattern
+	}
+	if input.MinAmount != nil {
+		updates["min_amount"] = *input.MinAmount
+	}
+	if input.MaxAmount != nil {
+		updates["max_amount"] = *input.MaxAmount
+	}
+	if input.Priority != nil {
+		updates["priority"] = *input.Priority
+	}
+
+	rule, err := s.repo.Update(id, userID, updates)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCategorizationRuleNotFound
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// DeleteCategorizationRule は自動分類ルールを削除する。分類済みの取引のカテゴリは変わらない
+func (s *categorizationRuleService) DeleteCategorizationRule(id uint, userID uint) error {
+	if err := s.repo.Delete(id, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCategorizationRuleNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ApplyCategorizationRule は既存の取引のうちルールに一致し、カテゴリが異なるものをルールのカテゴリに変更する
+// 他のルールの優先度は考慮しない。dry_run の場合は変更される取引を返すのみで変更しない
+func (s *categorizationRuleService) ApplyCategorizationRule(ctx context.Context, id uint, userID uint, input *api.ApplyCategorizationRuleInput) (*CategorizationRuleApplyResult, error) {
+	if err := validators.ValidateApplyCategorizationRule(input); err != nil {
+		return nil, err
+	}
+
+	rule, err := s.FetchCategorizationRuleByID(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	matcher, err := newCategorizationMatcher(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: 日付・金額の範囲はDBで絞り込み、説明の照合はルールと同じ方法で行う
+	params := &repositories.TransactionFindParams{
+		MinAmount: toInt32Ptr(rule.MinAmount),
+		MaxAmount: toInt32Ptr(rule.MaxAmount),
+	}
+	if input.StartDate != nil {
+		startDate := input.StartDate.Format(dateLayout)
+		params.StartDate = &startDate
+	}
+	if input.EndDate != nil {
+		endDate := input.EndDate.Format(dateLayout)
+		params.EndDate = &endDate
+	}
+
+	ids := []uint{}
+	err = s.transactionRepo.Each(ctx, userID, params, func(row *repositories.TransactionExportRow) error {
+		if row.CategoryID != rule.CategoryID && matcher.matches(row.Description, row.Amount) {
+			ids = append(ids, row.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CategorizationRuleApplyResult{
+		DryRun:       input.DryRun != nil && *input.DryRun,
+		MatchedCount: len(ids),
+	}
+	if len(ids) == 0 {
+		result.Transactions = []models.Transaction{}
+		return result, nil
+	}
+
+	if !result.DryRun {
+		updated, err := s.transactionRepo.UpdateCategoryByFilter(userID, &repositories.TransactionFindParams{IDs: ids}, rule.CategoryID)
+		if err != nil {
+			if errors.Is(err, repositories.ErrForeignKeyViolation) {
+				return nil, ErrCategoryNotFound
+			}
+			return nil, err
+		}
+		result.UpdatedCount = int(updated)
+	}
+
+	// NOTE: Each は日付の昇順に渡すため、末尾が日付の新しい取引になる
+	if len(ids) > categorizationResultLimit {
+		ids = ids[len(ids)-categorizationResultLimit:]
+	}
+	transactions, _, err := s.transactionRepo.FindAll(userID, &repositories.TransactionFindParams{IDs: ids}, nil)
+	if err != nil {
+		return nil, err
+	}
+	result.Transactions = transactions
+
+	return result, nil
+}
+
+// categorize はルールを優先度の順に判定し、最初に一致したルールを返す。一致するルールがない場合は nil を返す
+func categorize(rules []models.CategorizationRule, description string, amount int) (*models.CategorizationRule, error) {
+	for i := range rules {
+		matcher, err := newCategorizationMatcher(&rules[i])
+		if err != nil {
+			return nil, err
+		}
+		if matcher.matches(description, amount) {
+			return &rules[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// categorizationMatcher は自動分類ルールの条件で取引を照合する
+type categorizationMatcher struct {
+	rule    *models.CategorizationRule
+	pattern string         // contains・prefix の場合は小文字に変換したパターン
+	regexp  *regexp.Regexp // regex の場合のみ
+}
+
+func newCategorizationMatcher(rule *models.CategorizationRule) (*categorizationMatcher, error) {
+	m := &categorizationMatcher{rule: rule, pattern: strings.ToLower(rule.Pattern)}
+	if rule.MatchType == models.CategorizationMatchTypeRegex {
+		re, err := regexp.Compile(rule.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		m.regexp = re
+	}
+	return m, nil
+}
+
+// matches は説明と金額（基準通貨）がルールの条件に一致するか判定する
+// contains・prefix は大文字・小文字を区別しない
+func (m *categorizationMatcher) matches(description string, amount int) bool {
+	if m.rule.MinAmount != nil && amount < *m.rule.MinAmount {
+		return false
+	}
+	if m.rule.MaxAmount != nil && amount > *m.rule.MaxAmount {
+		return false
+	}
+
+	switch m.rule.MatchType {
+	case models.CategorizationMatchTypeContains:
+		return strings.Contains(strings.ToLower(description), m.pattern)
+	case models.CategorizationMatchTypePrefix:
+		return strings.HasPrefix(strings.ToLower(description), m.pattern)
+	case models.CategorizationMatchTypeRegex:
+		return m.regexp.MatchString(description)
+	}
+	return false
+}
+
+func toInt32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
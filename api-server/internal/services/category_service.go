@@ -46,10 +46,14 @@ func (s *categoryService) CreateCategory(userID uint, input *api.CreateCategoryI
 	}
 
 	category := models.Category{
-		UserID: userID,
-		Name:   input.Name,
-		Type:   models.CategoryType(input.Type),
-		Color:  input.Color,
+		UserID:         userID,
+		Name:           input.Name,
+		Type:           models.CategoryType(input.Type),
+		Color:          input.Color,
+		BudgetRollover: models.BudgetRolloverNone,
+	}
+	if input.BudgetRollover != nil {
+		category.BudgetRollover = models.BudgetRollover(*input.BudgetRollover)
 	}
 
 	if err := s.repo.Create(&category); err != nil {
@@ -71,6 +75,9 @@ func (s *categoryService) UpdateCategory(id uint, userID uint, input *api.Update
 	if input.Color != nil {
 		updates["color"] = *input.Color
 	}
+	if input.BudgetRollover != nil {
+		updates["budget_rollover"] = string(*input.BudgetRollover)
+	}
 
 	category, err := s.repo.Update(id, userID, updates)
 	if err != nil {
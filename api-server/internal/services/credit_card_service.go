@@ -0,0 +1,298 @@
+package services
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+// defaultWithdrawalDays は引き落とし予定の終了日を省略した場合の期間の日数
+const defaultWithdrawalDays = 60
+
+// CreditCardStatement はクレジットカードの締め日の月ごとの請求
+type CreditCardStatement struct {
+	ClosingMonth string    // 締め日の月（YYYY-MM形式）
+	PeriodStart  time.Time // 利用期間の開始日（前回の締め日の翌日）
+	PeriodEnd    time.Time // 利用期間の終了日（締め日）
+	DueDate      time.Time // 支払日
+	Total        int       // 支出を正、収入（返金など）を負とした合計
+	Count        int
+}
+
+// CreditCardWithdrawalItem は引き落とし日に引き落とされるカードごとの請求
+type CreditCardWithdrawalItem struct {
+	CreditCard models.CreditCard
+	Statement  CreditCardStatement
+}
+
+// CreditCardWithdrawal は引き落とし日ごとのクレジットカードの請求
+type CreditCardWithdrawal struct {
+	Date  time.Time
+	Total int
+	Items []CreditCardWithdrawalItem
+}
+
+type CreditCardService interface {
+	FetchCreditCards(userID uint) ([]models.CreditCard, error)
+	FetchCreditCardByID(id uint, userID uint) (*models.CreditCard, error)
+	CreateCreditCard(userID uint, input *api.CreateCreditCardInput) (*models.CreditCard, error)
+	UpdateCreditCard(id uint, userID uint, input *api.UpdateCreditCardInput) (*models.CreditCard, error)
+	DeleteCreditCard(id uint, userID uint) error
+	FetchStatements(id uint, userID uint, params *api.GetCreditCardsIdStatementsParams) ([]CreditCardStatement, error)
+	FetchWithdrawals(userID uint, params *api.GetCreditCardsWithdrawalsParams) ([]CreditCardWithdrawal, error)
+}
+
+type creditCardService struct {
+	repo     repositories.CreditCardRepository
+	userRepo repositories.UserRepository
+}
+
+func NewCreditCardService(repo repositories.CreditCardRepository, userRepo repositories.UserRepository) CreditCardService {
+	return &creditCardService{repo: repo, userRepo: userRepo}
+}
+
+func (s *creditCardService) FetchCreditCards(userID uint) ([]models.CreditCard, error) {
+	return s.repo.FindAll(userID)
+}
+
+func (s *creditCardService) FetchCreditCardByID(id uint, userID uint) (*models.CreditCard, error) {
+	card, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCreditCardNotFound
+		}
+		return nil, err
+	}
+	return card, nil
+}
+
+func (s *creditCardService) CreateCreditCard(userID uint, input *api.CreateCreditCardInput) (*models.CreditCard, error) {
+	if err := validators.ValidateCreateCreditCard(input); err != nil {
+		return nil, err
+	}
+
+	card := models.CreditCard{
+		UserID:             userID,
+		Name:               input.Name,
+		ClosingDay:         int(input.ClosingDay),
+		PaymentDay:         int(input.PaymentDay),
+		PaymentMonthOffset: 1,
+	}
+	if input.PaymentMonthOffset != nil {
+		card.PaymentMonthOffset = int(*input.PaymentMonthOffset)
+	}
+
+	if err := s.repo.Create(&card); err != nil {
+		return nil, err
+	}
+
+	return &card, nil
+}
+
+func (s *creditCardService) UpdateCreditCard(id uint, userID uint, input *api.UpdateCreditCardInput) (*models.CreditCard, error) {
+	if err := validators.ValidateUpdateCreditCard(input); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if input.Name != nil {
+		updates["name"] = *input.Name
+	}
+	if input.ClosingDay != nil {
+		updates["closing_day"] = *input.ClosingDay
+	}
+	if input.PaymentDay != nil {
+		updates["payment_day"] = *input.PaymentDay
+	}
+	if input.PaymentMonthOffset != nil {
+		updates["payment_month_offset"] = *input.PaymentMonthOffset
+	}
+
+	card, err := s.repo.Update(id, userID, updates)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCreditCardNotFound
+		}
+		return nil, err
+	}
+
+	return card, nil
+}
+
+func (s *creditCardService) DeleteCreditCard(id uint, userID uint) error {
+	err := s.repo.Delete(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCreditCardNotFound
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return ErrCreditCardInUse
+		}
+		return err
+	}
+	return nil
+}
+
+// FetchStatements は start_month から end_month までの締め日の月ごとの請求を取得する
+func (s *creditCardService) FetchStatements(id uint, userID uint, params *api.GetCreditCardsIdStatementsParams) ([]CreditCardStatement, error) {
+	if err := validators.ValidateGetCreditCardStatements(params); err != nil {
+		return nil, err
+	}
+
+	card, err := s.FetchCreditCardByID(id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse("2006-01", params.StartMonth)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse("2006-01", params.EndMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	var statements []CreditCardStatement
+	for month := start; !month.After(end); month = month.AddDate(0, 1, 0) {
+		statements = append(statements, creditCardStatement(card, month))
+	}
+
+	totals, err := s.repo.SumDaily(userID, statements[0].PeriodStart.Format(dateLayout), statements[len(statements)-1].PeriodEnd.AddDate(0, 0, 1).Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+	applyCreditCardTotals(statements, card.ID, totals)
+	return statements, nil
+}
+
+// FetchWithdrawals は期間内に支払日を迎えるクレジットカードの請求を、引き落とし日ごとにまとめて日付の昇順で取得する
+// 取引のない請求は引き落とされないため含めない
+func (s *creditCardService) FetchWithdrawals(userID uint, params *api.GetCreditCardsWithdrawalsParams) ([]CreditCardWithdrawal, error) {
+	today, err := userToday(s.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validators.ValidateGetCreditCardWithdrawals(params, today.Format(dateLayout)); err != nil {
+		return nil, err
+	}
+
+	start := today
+	if params.StartDate != nil {
+		if start, err = time.Parse(dateLayout, *params.StartDate); err != nil {
+			return nil, err
+		}
+	}
+	end := start.AddDate(0, 0, defaultWithdrawalDays-1)
+	if params.EndDate != nil {
+		if end, err = time.Parse(dateLayout, *params.EndDate); err != nil {
+			return nil, err
+		}
+	}
+
+	cards, err := s.repo.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 支払日は締め日の月の PaymentMonthOffset か月後の月にあるため、期間内の月から締め日の月を求める
+	statementsByCard := make([][]CreditCardStatement, len(cards))
+	var periodStart, periodEnd time.Time
+	first := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := range cards {
+		for month := first; !month.After(end); month = month.AddDate(0, 1, 0) {
+			statement := creditCardStatement(&cards[i], month.AddDate(0, -cards[i].PaymentMonthOffset, 0))
+			if statement.DueDate.Before(start) || statement.DueDate.After(end) {
+				continue
+			}
+			statementsByCard[i] = append(statementsByCard[i], statement)
+			if periodStart.IsZero() || statement.PeriodStart.Before(periodStart) {
+				periodStart = statement.PeriodStart
+			}
+			if statement.PeriodEnd.After(periodEnd) {
+				periodEnd = statement.PeriodEnd
+			}
+		}
+	}
+	if periodStart.IsZero() {
+		return []CreditCardWithdrawal{}, nil
+	}
+
+	totals, err := s.repo.SumDaily(userID, periodStart.Format(dateLayout), periodEnd.AddDate(0, 0, 1).Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawals := []CreditCardWithdrawal{}
+	byDate := make(map[time.Time]int)
+	for i, statements := range statementsByCard {
+		applyCreditCardTotals(statements, cards[i].ID, totals)
+		for _, statement := range statements {
+			if statement.Count == 0 {
+				continue
+			}
+			j, ok := byDate[statement.DueDate]
+			if !ok {
+				j = len(withdrawals)
+				byDate[statement.DueDate] = j
+				withdrawals = append(withdrawals, CreditCardWithdrawal{Date: statement.DueDate})
+			}
+			withdrawals[j].Total += statement.Total
+			withdrawals[j].Items = append(withdrawals[j].Items, CreditCardWithdrawalItem{CreditCard: cards[i], Statement: statement})
+		}
+	}
+
+	sort.Slice(withdrawals, func(i, j int) bool {
+		return withdrawals[i].Date.Before(withdrawals[j].Date)
+	})
+	return withdrawals, nil
+}
+
+// applyCreditCardTotals はカードごと・日別の合計のうち cardID のものを、利用期間が日付の昇順に並んだ statements の合計と件数に加える
+func applyCreditCardTotals(statements []CreditCardStatement, cardID uint, totals []repositories.CreditCardDailyTotal) {
+	for _, t := range totals {
+		if t.CreditCardID != cardID {
+			continue
+		}
+		date := toDate(t.Date)
+		i := sort.Search(len(statements), func(i int) bool {
+			return !statements[i].PeriodEnd.Before(date)
+		})
+		if i < len(statements) && !date.Before(statements[i].PeriodStart) {
+			statements[i].Total += t.Amount
+			statements[i].Count += t.Count
+		}
+	}
+}
+
+// creditCardStatement は month の月に締める請求の利用期間と支払日を返す（合計と件数は含まない）
+// 締め日・支払日が月の日数を超える場合は月末日とする
+func creditCardStatement(card *models.CreditCard, month time.Time) CreditCardStatement {
+	prev := month.AddDate(0, -1, 0)
+	due := month.AddDate(0, card.PaymentMonthOffset, 0)
+	return CreditCardStatement{
+		ClosingMonth: month.Format("2006-01"),
+		PeriodStart:  clampedDate(prev.Year(), prev.Month(), card.ClosingDay).AddDate(0, 0, 1),
+		PeriodEnd:    clampedDate(month.Year(), month.Month(), card.ClosingDay),
+		DueDate:      clampedDate(due.Year(), due.Month(), card.PaymentDay),
+	}
+}
+
+// checkCreditCard はクレジットカードがユーザーのものか確認する。creditCardID が nil の場合は何もしない
+func checkCreditCard(repo repositories.CreditCardRepository, userID uint, creditCardID *int32) error {
+	if creditCardID == nil {
+		return nil
+	}
+	if _, err := repo.FindByID(uint(*creditCardID), userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCreditCardNotFound
+		}
+		return err
+	}
+	return nil
+}
@@ -6,17 +6,22 @@ import "errors"
 var (
 	ErrEmailAlreadyExists   = errors.New("email already exists")
 	ErrAuthenticationFailed = errors.New("authentication failed")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrBaseCurrencyInUse    = errors.New("base currency in use")
 )
 
 // Transaction関連エラー
 var (
-	ErrTransactionNotFound = errors.New("transaction not found")
+	ErrTransactionNotFound          = errors.New("transaction not found")
+	ErrPossibleDuplicateTransaction = errors.New("possible duplicate transaction")
+	ErrTransactionLocked            = errors.New("transaction locked")
 )
 
 // Category関連エラー
 var (
 	ErrCategoryNotFound = errors.New("category not found")
 	ErrCategoryInUse    = errors.New("category in use")
+	ErrCategoryInTrash  = errors.New("category in trash")
 )
 
 // Budget関連エラー
@@ -24,3 +29,70 @@ var (
 	ErrBudgetNotFound      = errors.New("budget not found")
 	ErrBudgetAlreadyExists = errors.New("budget already exists")
 )
+
+// BudgetTemplate関連エラー
+var (
+	ErrBudgetTemplateNotFound      = errors.New("budget template not found")
+	ErrBudgetTemplateAlreadyExists = errors.New("budget template already exists")
+)
+
+// RecurringTransaction関連エラー
+var (
+	ErrRecurringTransactionNotFound = errors.New("recurring transaction not found")
+)
+
+// Import関連エラー
+var (
+	ErrImportProfileNotFound = errors.New("import profile not found")
+	ErrInvalidCSV            = errors.New("invalid csv")
+)
+
+// Account関連エラー
+var (
+	ErrAccountNotFound = errors.New("account not found")
+	ErrAccountInUse    = errors.New("account in use")
+)
+
+// Transfer関連エラー
+var (
+	ErrTransferNotFound = errors.New("transfer not found")
+)
+
+// Tag関連エラー
+var (
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrTagAlreadyExists = errors.New("tag already exists")
+)
+
+// Attachment関連エラー
+var (
+	ErrAttachmentNotFound = errors.New("attachment not found")
+)
+
+// ExchangeRate関連エラー
+var (
+	ErrExchangeRateNotFound      = errors.New("exchange rate not found")
+	ErrExchangeRateAlreadyExists = errors.New("exchange rate already exists")
+)
+
+// CategorizationRule関連エラー
+var (
+	ErrCategorizationRuleNotFound = errors.New("categorization rule not found")
+)
+
+// InstallmentPlan関連エラー
+var (
+	ErrInstallmentPlanNotFound = errors.New("installment plan not found")
+	ErrInstallmentNotAllowed   = errors.New("transaction cannot be paid in installments")
+)
+
+// CreditCard関連エラー
+var (
+	ErrCreditCardNotFound = errors.New("credit card not found")
+	ErrCreditCardInUse    = errors.New("credit card in use")
+)
+
+// Pagination関連エラー
+var (
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
@@ -0,0 +1,290 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// exchangeRateCSVColumns は為替レートのCSVに必要な列（ヘッダー名）
+var exchangeRateCSVColumns = []string{"date", "currency", "rate"}
+
+type ExchangeRateService interface {
+	FetchExchangeRates(userID uint, params *api.GetExchangeRatesParams) ([]models.ExchangeRate, error)
+	CreateExchangeRate(userID uint, input *api.CreateExchangeRateInput) (*models.ExchangeRate, error)
+	UpdateExchangeRate(id uint, userID uint, input *api.UpdateExchangeRateInput) (*models.ExchangeRate, error)
+	DeleteExchangeRate(id uint, userID uint) error
+	ImportExchangeRates(userID uint, input *api.ImportExchangeRatesInput) (int, error)
+}
+
+type exchangeRateService struct {
+	repo     repositories.ExchangeRateRepository
+	userRepo repositories.UserRepository
+}
+
+func NewExchangeRateService(repo repositories.ExchangeRateRepository, userRepo repositories.UserRepository) ExchangeRateService {
+	return &exchangeRateService{repo: repo, userRepo: userRepo}
+}
+
+// FetchExchangeRates はユーザーの現在の基準通貨に対する為替レートを取得する
+func (s *exchangeRateService) FetchExchangeRates(userID uint, params *api.GetExchangeRatesParams) ([]models.ExchangeRate, error) {
+	if err := validators.ValidateGetExchangeRates(params); err != nil {
+		return nil, err
+	}
+
+	base, err := baseCurrency(s.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindAll(userID, &repositories.ExchangeRateFindParams{
+		BaseCurrency: base,
+		Currency:     params.Currency,
+		StartDate:    params.StartDate,
+		EndDate:      params.EndDate,
+	})
+}
+
+func (s *exchangeRateService) CreateExchangeRate(userID uint, input *api.CreateExchangeRateInput) (*models.ExchangeRate, error) {
+	base, err := baseCurrency(s.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validators.ValidateCreateExchangeRate(input, base); err != nil {
+		return nil, err
+	}
+
+	rate := models.ExchangeRate{
+		UserID:       userID,
+		BaseCurrency: base,
+		Currency:     input.Currency,
+		Date:         input.Date.Time,
+		Rate:         input.Rate,
+	}
+
+	if err := s.repo.Create(&rate); err != nil {
+		if errors.Is(err, repositories.ErrDuplicateEntry) {
+			return nil, ErrExchangeRateAlreadyExists
+		}
+		return nil, err
+	}
+
+	// DECIMAL型の桁に揃えるため登録後のデータを取得する
+	return s.findExchangeRate(rate.ID, userID)
+}
+
+// UpdateExchangeRate は為替レートを更新する
+// NOTE: 登録済みの取引は登録時のレートで換算した金額を保持しており、再計算しない
+func (s *exchangeRateService) UpdateExchangeRate(id uint, userID uint, input *api.UpdateExchangeRateInput) (*models.ExchangeRate, error) {
+	if err := validators.ValidateUpdateExchangeRate(input); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if input.Date != nil {
+		updates["date"] = input.Date.Time
+	}
+	if input.Rate != nil {
+		updates["rate"] = *input.Rate
+	}
+
+	rate, err := s.repo.Update(id, userID, updates)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrExchangeRateNotFound
+		}
+		if errors.Is(err, repositories.ErrDuplicateEntry) {
+			return nil, ErrExchangeRateAlreadyExists
+		}
+		return nil, err
+	}
+
+	return rate, nil
+}
+
+func (s *exchangeRateService) DeleteExchangeRate(id uint, userID uint) error {
+	err := s.repo.Delete(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrExchangeRateNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ImportExchangeRates はCSVの為替レートをまとめて登録し、登録・上書きした件数を返す
+// 1行でもエラーがある場合は行番号ごとのエラーを返し、1件も登録しない
+func (s *exchangeRateService) ImportExchangeRates(userID uint, input *api.ImportExchangeRatesInput) (int, error) {
+	if err := validators.ValidateImportExchangeRates(input); err != nil {
+		return 0, err
+	}
+
+	base, err := baseCurrency(s.userRepo, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	data, err := input.File.Bytes()
+	if err != nil {
+		return 0, err
+	}
+	records, err := readExchangeRateCSV(data)
+	if err != nil {
+		return 0, err
+	}
+
+	rates := make([]models.ExchangeRate, 0, len(records))
+	errs := validation.Errors{}
+	for _, record := range records {
+		date, currency, rate := record.fields[0], record.fields[1], record.fields[2]
+		if err := validators.ValidateExchangeRateRow(date, currency, rate, base); err != nil {
+			errs[fmt.Sprintf("row_%d", record.rowNumber)] = err
+			continue
+		}
+
+		parsed, _ := time.Parse(dateLayout, date)
+		rates = append(rates, models.ExchangeRate{
+			UserID:       userID,
+			BaseCurrency: base,
+			Currency:     currency,
+			Date:         parsed,
+			Rate:         rate,
+		})
+	}
+	if len(errs) > 0 {
+		return 0, errs
+	}
+
+	if err := s.repo.Upsert(rates); err != nil {
+		return 0, err
+	}
+
+	return len(rates), nil
+}
+
+func (s *exchangeRateService) findExchangeRate(id uint, userID uint) (*models.ExchangeRate, error) {
+	rate, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrExchangeRateNotFound
+		}
+		return nil, err
+	}
+	return rate, nil
+}
+
+// readExchangeRateCSV はヘッダー行で date・currency・rate の列を特定し、データ行をその順に並べて返す
+// 文字コードは UTF-8（BOM付きも可）のみ受け付ける
+func readExchangeRateCSV(data []byte) ([]importRecord, error) {
+	data = bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+	if !utf8.Valid(data) {
+		return nil, ErrInvalidCSV
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, ErrInvalidCSV
+	}
+	indexes := make([]int, len(exchangeRateCSVColumns))
+	for i, column := range exchangeRateCSVColumns {
+		indexes[i] = -1
+		for j, name := range header {
+			if strings.EqualFold(strings.TrimSpace(name), column) {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] < 0 {
+			return nil, ErrInvalidCSV
+		}
+	}
+
+	var records []importRecord
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, ErrInvalidCSV
+		}
+		if isBlankRecord(fields) {
+			continue
+		}
+		if len(records) >= maxImportRows {
+			return nil, ErrInvalidCSV
+		}
+
+		values := make([]string, len(indexes))
+		for i, index := range indexes {
+			if index < len(fields) {
+				values[i] = strings.TrimSpace(fields[index])
+			}
+		}
+		line, _ := reader.FieldPos(0)
+		records = append(records, importRecord{rowNumber: line, fields: values})
+	}
+
+	return records, nil
+}
+
+// convertTransaction は取引の通貨・元の金額から基準通貨の金額を設定する
+// 分割明細も同じレートで換算し、端数の差は最後の明細で調整して合計を取引の金額と一致させる
+// 通貨が未指定の場合は基準通貨とし、基準通貨以外で取引日以前の為替レートがない場合は ErrExchangeRateNotFound を返す
+func convertTransaction(repo repositories.ExchangeRateRepository, userID uint, base string, transaction *models.Transaction) error {
+	if transaction.Currency == "" {
+		transaction.Currency = base
+	}
+
+	if transaction.Currency == base {
+		transaction.Amount = transaction.OriginalAmount
+		transaction.ExchangeRate = nil
+		for i := range transaction.Splits {
+			transaction.Splits[i].Amount = transaction.Splits[i].OriginalAmount
+		}
+		return nil
+	}
+
+	rate, err := repo.FindLatest(userID, base, transaction.Currency, transaction.Date)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrExchangeRateNotFound
+		}
+		return err
+	}
+	parsed, ok := helpers.ParseRate(rate.Rate)
+	if !ok {
+		return fmt.Errorf("invalid exchange rate: %s", rate.Rate)
+	}
+
+	transaction.Amount = helpers.ConvertCurrency(transaction.OriginalAmount, transaction.Currency, base, parsed)
+	transaction.ExchangeRate = &rate.Rate
+	remaining := transaction.Amount
+	for i := range transaction.Splits {
+		split := &transaction.Splits[i]
+		if i == len(transaction.Splits)-1 {
+			split.Amount = remaining
+			break
+		}
+		split.Amount = helpers.ConvertCurrency(split.OriginalAmount, transaction.Currency, base, parsed)
+		remaining -= split.Amount
+	}
+	return nil
+}
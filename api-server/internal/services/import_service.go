@@ -0,0 +1,410 @@
+package services
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/oapi-codegen/runtime/types"
+	"golang.org/x/text/encoding/japanese"
+)
+
+// maxImportRows は1回の取込で扱うデータ行の上限
+const maxImportRows = 10000
+
+// ImportRow はCSVの1行分の取込結果
+// 読み取れた項目のみ値を持ち、エラーがない行は Errors が nil となる
+type ImportRow struct {
+	RowNumber   int
+	CategoryID  *uint
+	Amount      *int
+	Date        *time.Time
+	Description *string
+	Errors      validation.Errors
+}
+
+type ImportResult struct {
+	DryRun        bool
+	AcceptedCount int
+	RejectedCount int
+	ImportedCount int
+	Rows          []ImportRow
+}
+
+type ImportService interface {
+	FetchImportProfiles(userID uint) ([]models.ImportProfile, error)
+	FetchImportProfileByID(id uint, userID uint) (*models.ImportProfile, error)
+	CreateImportProfile(userID uint, input *api.CreateImportProfileInput) (*models.ImportProfile, error)
+	UpdateImportProfile(id uint, userID uint, input *api.UpdateImportProfileInput) (*models.ImportProfile, error)
+	DeleteImportProfile(id uint, userID uint) error
+	ImportTransactions(userID uint, input *api.ImportTransactionsInput) (*ImportResult, error)
+}
+
+type importService struct {
+	profileRepo     repositories.ImportProfileRepository
+	transactionRepo repositories.TransactionRepository
+	userRepo        repositories.UserRepository
+}
+
+func NewImportService(profileRepo repositories.ImportProfileRepository, transactionRepo repositories.TransactionRepository, userRepo repositories.UserRepository) ImportService {
+	return &importService{profileRepo, transactionRepo, userRepo}
+}
+
+func (s *importService) FetchImportProfiles(userID uint) ([]models.ImportProfile, error) {
+	return s.profileRepo.FindAll(userID)
+}
+
+func (s *importService) FetchImportProfileByID(id uint, userID uint) (*models.ImportProfile, error) {
+	profile, err := s.profileRepo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrImportProfileNotFound
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (s *importService) CreateImportProfile(userID uint, input *api.CreateImportProfileInput) (*models.ImportProfile, error) {
+	if err := validators.ValidateCreateImportProfile(input); err != nil {
+		return nil, err
+	}
+
+	profile := models.ImportProfile{
+		UserID:            userID,
+		Name:              input.Name,
+		Encoding:          models.ImportEncoding(input.Encoding),
+		DateColumn:        int(input.DateColumn),
+		DateFormat:        input.DateFormat,
+		AmountColumn:      int(input.AmountColumn),
+		AmountSign:        models.AmountSign(input.AmountSign),
+		DescriptionColumn: toIntPtr(input.DescriptionColumn),
+		DefaultCategoryID: uint(input.DefaultCategoryId),
+	}
+	if input.HasHeader != nil {
+		profile.HasHeader = *input.HasHeader
+	}
+	if input.SkipRows != nil {
+		profile.SkipRows = int(*input.SkipRows)
+	}
+	if input.IncomeCategoryId != nil {
+		incomeCategoryID := uint(*input.IncomeCategoryId)
+		profile.IncomeCategoryID = &incomeCategoryID
+	}
+
+	if err := s.profileRepo.Create(&profile); err != nil {
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return &profile, nil
+}
+
+func (s *importService) UpdateImportProfile(id uint, userID uint, input *api.UpdateImportProfileInput) (*models.ImportProfile, error) {
+	if err := validators.ValidateUpdateImportProfile(input); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+
+	if input.Name != nil {
+		updates["name"] = *input.Name
+	}
+	if input.Encoding != nil {
+		updates["encoding"] = *input.Encoding
+	}
+	if input.HasHeader != nil {
+		updates["has_header"] = *input.HasHeader
+	}
+	if input.SkipRows != nil {
+		updates["skip_rows"] = *input.SkipRows
+	}
+	if input.DateColumn != nil {
+		updates["date_column"] = *input.DateColumn
+	}
+	if input.DateFormat != nil {
+		updates["date_format"] = *input.DateFormat
+	}
+	if input.AmountColumn != nil {
+		updates["amount_column"] = *input.AmountColumn
+	}
+	if input.AmountSign != nil {
+		updates["amount_sign"] = *input.AmountSign
+	}
+	if input.DescriptionColumn != nil {
+		updates["description_column"] = *input.DescriptionColumn
+	}
+	if input.DefaultCategoryId != nil {
+		updates["default_category_id"] = *input.DefaultCategoryId
+	}
+	if input.IncomeCategoryId != nil {
+		updates["income_category_id"] = *input.IncomeCategoryId
+	}
+
+	profile, err := s.profileRepo.Update(id, userID, updates)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrImportProfileNotFound
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return profile, nil
+}
+
+func (s *importService) DeleteImportProfile(id uint, userID uint) error {
+	err := s.profileRepo.Delete(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrImportProfileNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ImportTransactions は取込設定に従ってCSVを読み込み、行ごとに検証した結果を返す
+// 1行でもエラーがあっても、エラーのない行は登録する。dry_run の場合は登録せずに検証結果のみを返す
+func (s *importService) ImportTransactions(userID uint, input *api.ImportTransactionsInput) (*ImportResult, error) {
+	if err := validators.ValidateImportTransactions(input); err != nil {
+		return nil, err
+	}
+
+	profile, err := s.FetchImportProfileByID(uint(input.ProfileId), userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := input.File.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	records, err := readCSV(data, profile)
+	if err != nil {
+		return nil, err
+	}
+	// CSVの金額は基準通貨の金額として取り込む
+	base, err := baseCurrency(s.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ImportResult{
+		DryRun: input.DryRun != nil && *input.DryRun,
+		Rows:   make([]ImportRow, 0, len(records)),
+	}
+	var transactions []models.Transaction
+	for _, record := range records {
+		row := parseImportRecord(record, profile)
+		result.Rows = append(result.Rows, row)
+		if row.Errors != nil {
+			result.RejectedCount++
+			continue
+		}
+
+		result.AcceptedCount++
+		transaction := models.Transaction{
+			UserID:         userID,
+			CategoryID:     *row.CategoryID,
+			Amount:         *row.Amount,
+			Currency:       base,
+			OriginalAmount: *row.Amount,
+			Date:           *row.Date,
+		}
+		if row.Description != nil {
+			transaction.Description = *row.Description
+		}
+		transactions = append(transactions, transaction)
+	}
+
+	if result.DryRun {
+		return result, nil
+	}
+
+	if err := s.transactionRepo.CreateBatch(transactions); err != nil {
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	result.ImportedCount = len(transactions)
+
+	return result, nil
+}
+
+// importRecord はCSV上の行番号付きのレコード
+type importRecord struct {
+	rowNumber int
+	fields    []string
+}
+
+// readCSV は文字コードを変換してCSVを読み込み、読み飛ばし行・ヘッダー行・空行を除いたデータ行を返す
+func readCSV(data []byte, profile *models.ImportProfile) ([]importRecord, error) {
+	switch profile.Encoding {
+	case models.ImportEncodingShiftJIS:
+		decoded, err := japanese.ShiftJIS.NewDecoder().Bytes(data)
+		if err != nil {
+			return nil, ErrInvalidCSV
+		}
+		data = decoded
+	default:
+		data = bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))
+		if !utf8.Valid(data) {
+			return nil, ErrInvalidCSV
+		}
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	skip := profile.SkipRows
+	if profile.HasHeader {
+		skip++
+	}
+
+	var records []importRecord
+	for {
+		fields, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, ErrInvalidCSV
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		if isBlankRecord(fields) {
+			continue
+		}
+		if len(records) >= maxImportRows {
+			return nil, ErrInvalidCSV
+		}
+
+		line, _ := reader.FieldPos(0)
+		records = append(records, importRecord{rowNumber: line, fields: fields})
+	}
+
+	return records, nil
+}
+
+func isBlankRecord(fields []string) bool {
+	for _, field := range fields {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseImportRecord は取込設定の列の対応付けに従って1行を取引に変換し、取引作成時と同じ検証を行う
+func parseImportRecord(record importRecord, profile *models.ImportProfile) ImportRow {
+	row := ImportRow{RowNumber: record.rowNumber}
+	errs := validation.Errors{}
+
+	column := func(number int) (string, bool) {
+		if number < 1 || number > len(record.fields) {
+			return "", false
+		}
+		return strings.TrimSpace(record.fields[number-1]), true
+	}
+
+	if value, ok := column(profile.DateColumn); !ok {
+		errs["date"] = validation.NewError("missing_column", "日付の列がありません")
+	} else if date, err := time.Parse(toTimeLayout(profile.DateFormat), value); err != nil {
+		errs["date"] = validation.NewError("invalid_date", "日付を「"+profile.DateFormat+"」の形式で入力してください")
+	} else {
+		row.Date = &date
+	}
+
+	if value, ok := column(profile.AmountColumn); !ok {
+		errs["amount"] = validation.NewError("missing_column", "金額の列がありません")
+	} else if amount, err := parseAmount(value); err != nil {
+		errs["amount"] = validation.NewError("invalid_amount", "金額を数値で入力してください")
+	} else {
+		// NOTE: 金額は符号の扱いに従って支出・収入を判定し、絶対値で登録する
+		isExpense := amount < 0
+		if profile.AmountSign == models.AmountSignPositiveIsExpense {
+			isExpense = amount > 0
+		}
+		if amount < 0 {
+			amount = -amount
+		}
+		absAmount := int(amount)
+		row.Amount = &absAmount
+
+		if isExpense || amount == 0 {
+			row.CategoryID = &profile.DefaultCategoryID
+		} else if profile.IncomeCategoryID != nil {
+			row.CategoryID = profile.IncomeCategoryID
+		} else {
+			errs["category_id"] = validation.NewError("no_income_category", "収入の行を取り込むには取込設定に収入カテゴリを指定してください")
+		}
+	}
+
+	if profile.DescriptionColumn != nil {
+		if value, ok := column(*profile.DescriptionColumn); ok && value != "" {
+			row.Description = &value
+		}
+	}
+
+	if len(errs) == 0 {
+		input := api.CreateTransactionInput{
+			CategoryId:  int32(*row.CategoryID),
+			Amount:      int32(*row.Amount),
+			Date:        types.Date{Time: *row.Date},
+			Description: row.Description,
+		}
+		if err := validators.ValidateCreateTransaction(&input); err != nil {
+			if validationErrs, ok := err.(validation.Errors); ok {
+				errs = validationErrs
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		row.Errors = errs
+	}
+	return row
+}
+
+// NOTE: 長いトークンを先に指定し、MM が M として2回置換されないようにする
+var dateFormatReplacer = strings.NewReplacer("YYYY", "2006", "MM", "01", "DD", "02", "M", "1", "D", "2")
+
+// toTimeLayout は取込設定の日付形式（YYYY/MM/DD など）を time.Parse のレイアウトに変換する
+func toTimeLayout(dateFormat string) string {
+	return dateFormatReplacer.Replace(dateFormat)
+}
+
+var amountReplacer = strings.NewReplacer(",", "", "¥", "", "￥", "", "円", "", " ", "", "　", "")
+
+// parseAmount は「¥1,000」「-1,000円」のような表記の金額を数値に変換する
+func parseAmount(value string) (int64, error) {
+	amount, err := strconv.ParseInt(amountReplacer.Replace(value), 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if amount > math.MaxInt32 || amount < -math.MaxInt32 {
+		return 0, strconv.ErrRange
+	}
+	return amount, nil
+}
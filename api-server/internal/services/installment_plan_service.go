@@ -0,0 +1,190 @@
+package services
+
+import (
+	"errors"
+	"math/big"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+type InstallmentPlanService interface {
+	FetchInstallmentPlans(userID uint) ([]models.InstallmentPlan, error)
+	FetchInstallmentPlanByID(id, userID uint) (*models.InstallmentPlan, error)
+	CreateInstallmentPlan(transactionID, userID uint, input *api.CreateInstallmentPlanInput) (*models.InstallmentPlan, error)
+	DeleteInstallmentPlan(id, userID uint) error
+}
+
+type installmentPlanService struct {
+	repo            repositories.InstallmentPlanRepository
+	transactionRepo repositories.TransactionRepository
+	userRepo        repositories.UserRepository
+}
+
+func NewInstallmentPlanService(repo repositories.InstallmentPlanRepository, transactionRepo repositories.TransactionRepository, userRepo repositories.UserRepository) InstallmentPlanService {
+	return &installmentPlanService{repo: repo, transactionRepo: transactionRepo, userRepo: userRepo}
+}
+
+func (s *installmentPlanService) FetchInstallmentPlans(userID uint) ([]models.InstallmentPlan, error) {
+	return s.repo.FindAll(userID)
+}
+
+func (s *installmentPlanService) FetchInstallmentPlanByID(id, userID uint) (*models.InstallmentPlan, error) {
+	plan, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrInstallmentPlanNotFound
+		}
+		return nil, err
+	}
+	return plan, nil
+}
+
+// CreateInstallmentPlan は取引を分割払いにする
+// 取引を1回目の支払いとし、2回目以降の支払いを同じカテゴリ・口座・クレジットカード・説明・タグの取引として登録する
+// 各回の支払いの日付は、予算の集計期間に含まれるよう支払月のユーザーの月の開始日にする
+// NOTE: 1回目の支払いにする取引の日付も購入日から初回の支払月の開始日に変わる。購入日は分割払いの PurchaseDate に残し、取り消すと取引の日付を戻す
+// 支払額は基準通貨の金額で計算するため、外貨の取引は基準通貨の取引になる
+func (s *installmentPlanService) CreateInstallmentPlan(transactionID, userID uint, input *api.CreateInstallmentPlanInput) (*models.InstallmentPlan, error) {
+	transaction, err := s.transactionRepo.FindByID(transactionID, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, err
+	}
+
+	if err := validators.ValidateCreateInstallmentPlan(input, transaction.Date, transaction.Amount); err != nil {
+		return nil, err
+	}
+
+	// NOTE: 分割明細は支払いごとに分けられず、定期取引の取引は次回の生成と重複するため分割払いにできない
+	if len(transaction.Splits) > 0 || transaction.RecurringTransactionID != nil || transaction.InstallmentPlanID != nil {
+		return nil, ErrInstallmentNotAllowed
+	}
+
+	user, err := s.userRepo.FindByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	firstMonth, err := time.Parse("2006-01", input.FirstBillingMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	var annualRate *big.Rat
+	if input.AnnualInterestRate != nil {
+		annualRate, _ = new(big.Rat).SetString(*input.AnnualInterestRate)
+	}
+	amounts, totalInterest := installmentSchedule(transaction.Amount, int(input.Payments), annualRate)
+
+	tags := make([]models.Tag, len(transaction.Tags))
+	for i, tag := range transaction.Tags {
+		tags[i] = models.Tag{ID: tag.ID}
+	}
+
+	payments := make([]models.Transaction, len(amounts))
+	for i, amount := range amounts {
+		number := i + 1
+		payments[i] = models.Transaction{
+			UserID:            userID,
+			CategoryID:        transaction.CategoryID,
+			AccountID:         transaction.AccountID,
+			CreditCardID:      transaction.CreditCardID,
+			InstallmentNumber: &number,
+			Amount:            amount,
+			Currency:          user.BaseCurrency,
+			OriginalAmount:    amount,
+			Date:              monthStartDate(firstMonth.AddDate(0, i, 0), user.MonthStartDay, user.MonthStartAdjustment),
+			Description:       transaction.Description,
+			Tags:              tags,
+		}
+	}
+
+	plan := &models.InstallmentPlan{
+		UserID:                 userID,
+		PurchaseDate:           transaction.Date,
+		Principal:              transaction.Amount,
+		PurchaseCurrency:       transaction.Currency,
+		PurchaseOriginalAmount: transaction.OriginalAmount,
+		PurchaseExchangeRate:   transaction.ExchangeRate,
+		Payments:               int(input.Payments),
+		FirstBillingMonth:      input.FirstBillingMonth,
+		AnnualInterestRate:     input.AnnualInterestRate,
+		TotalInterest:          totalInterest,
+	}
+	if err := s.repo.Create(plan, transaction.ID, payments); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		if errors.Is(err, repositories.ErrTransactionLocked) {
+			return nil, ErrTransactionLocked
+		}
+		return nil, err
+	}
+
+	return s.repo.FindByID(plan.ID, userID)
+}
+
+func (s *installmentPlanService) DeleteInstallmentPlan(id, userID uint) error {
+	if err := s.repo.Delete(id, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrInstallmentPlanNotFound
+		}
+		if errors.Is(err, repositories.ErrTransactionLocked) {
+			return ErrTransactionLocked
+		}
+		return err
+	}
+	return nil
+}
+
+// installmentSchedule は元金 principal を payments 回で支払う場合の各回の支払額と手数料の合計を返す
+// 手数料がない場合は元金を均等に分け、割り切れない分は1回目の支払額に加える
+// 手数料がある場合は元利均等方式（毎回の支払額が同じ）で計算する。各回の手数料は残高に月利（年率 ÷ 12）を掛けて四捨五入し、
+// 端数は最終回の支払額で調整する
+func installmentSchedule(principal, payments int, annualRate *big.Rat) ([]int, int) {
+	amounts := make([]int, payments)
+	if annualRate == nil || annualRate.Sign() == 0 {
+		base := principal / payments
+		for i := range amounts {
+			amounts[i] = base
+		}
+		amounts[0] += principal - base*payments
+		return amounts, 0
+	}
+
+	one := big.NewRat(1, 1)
+	monthlyRate := new(big.Rat).Quo(annualRate, big.NewRat(1200, 1))
+
+	// 毎回の支払額 = 元金 × 月利 × (1 + 月利)^回数 ÷ ((1 + 月利)^回数 - 1)
+	growth := new(big.Rat).Set(one)
+	for i := 0; i < payments; i++ {
+		growth.Mul(growth, new(big.Rat).Add(one, monthlyRate))
+	}
+	installment := new(big.Rat).Mul(big.NewRat(int64(principal), 1), monthlyRate)
+	installment.Mul(installment, growth)
+	installment.Quo(installment, new(big.Rat).Sub(growth, one))
+	payment := helpers.RoundRat(installment)
+
+	balance := principal
+	totalInterest := 0
+	for i := range amounts {
+		interest := helpers.RoundRat(new(big.Rat).Mul(big.NewRat(int64(balance), 1), monthlyRate))
+		repaid := payment - interest
+		if i == payments-1 || repaid > balance {
+			repaid = balance
+		}
+		balance -= repaid
+		amounts[i] = repaid + interest
+		totalInterest += interest
+	}
+	return amounts, totalInterest
+}
@@ -0,0 +1,53 @@
+package services
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+)
+
+func TestInstallmentSchedule(t *testing.T) {
+	tests := []struct {
+		name          string
+		principal     int
+		payments      int
+		annualRate    string // 空の場合は手数料なし
+		want          []int
+		totalInterest int
+	}{
+		// 手数料なし: 割り切れない分は1回目の支払額に加える
+		{"手数料なし 割り切れる", 90000, 3, "", []int{30000, 30000, 30000}, 0},
+		{"手数料なし 端数1", 100000, 3, "", []int{33334, 33333, 33333}, 0},
+		{"手数料なし 端数2", 100001, 3, "", []int{33335, 33333, 33333}, 0},
+		{"手数料なし 端数が大きい", 99999, 12, "", []int{8336, 8333, 8333, 8333, 8333, 8333, 8333, 8333, 8333, 8333, 8333, 8333}, 0},
+		{"手数料なし 少額", 10, 3, "", []int{4, 3, 3}, 0},
+		{"年率0%", 100000, 3, "0", []int{33334, 33333, 33333}, 0},
+		// 手数料あり: 元利均等方式。端数は最終回の支払額で調整する
+		{"年率12% 最終回で調整", 100000, 3, "12", []int{34002, 34002, 34003}, 2007},
+		{"年率0.5% 最終回で調整", 50000, 2, "0.5", []int{25016, 25015}, 31},
+		{"年率15% 12回", 120000, 12, "15", []int{10831, 10831, 10831, 10831, 10831, 10831, 10831, 10831, 10831, 10831, 10831, 10831}, 9972},
+		{"年率12.25% 6回", 100000, 6, "12.25", []int{17267, 17267, 17267, 17267, 17267, 17267}, 3602},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var annualRate *big.Rat
+			if tt.annualRate != "" {
+				annualRate, _ = new(big.Rat).SetString(tt.annualRate)
+			}
+
+			amounts, totalInterest := installmentSchedule(tt.principal, tt.payments, annualRate)
+			if !reflect.DeepEqual(amounts, tt.want) || totalInterest != tt.totalInterest {
+				t.Errorf("installmentSchedule(%d, %d, %q) = %v, %d, want %v, %d", tt.principal, tt.payments, tt.annualRate, amounts, totalInterest, tt.want, tt.totalInterest)
+			}
+
+			sum := 0
+			for _, amount := range amounts {
+				sum += amount
+			}
+			if sum != tt.principal+totalInterest {
+				t.Errorf("sum of amounts = %d, want principal + total interest = %d", sum, tt.principal+totalInterest)
+			}
+		})
+	}
+}
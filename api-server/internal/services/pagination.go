@@ -0,0 +1,121 @@
+package services
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/repositories"
+)
+
+const (
+	defaultPageLimit = 100
+	maxPageLimit     = 500
+)
+
+// sortKey は並び替えキーごとのカラムと、カーソルに埋め込む値の変換方法
+type sortKey[T any] struct {
+	column string
+	value  func(item *T) string
+	parse  func(value string) (interface{}, error)
+}
+
+// pageCursor は next_cursor の中身。前のページの最後の行の位置と、そのときの並び替え条件を持つ
+type pageCursor struct {
+	Sort  string `json:"s"`
+	Order string `json:"o"`
+	Value string `json:"v"`
+	ID    uint   `json:"id"`
+}
+
+// newPageParams はクエリパラメータからキーセットページネーションの条件を組み立てる
+// カーソルが壊れている場合や、カーソル作成時と並び替え条件が異なる場合は ErrInvalidCursor を返す
+func newPageParams[T any](key sortKey[T], sort string, order *api.SortOrder, limit *int32, cursor *string) (*repositories.PageParams, error) {
+	page := &repositories.PageParams{
+		SortColumn: key.column,
+		Desc:       order == nil || *order == api.Desc,
+		Limit:      defaultPageLimit,
+	}
+	if limit != nil {
+		page.Limit = int(*limit)
+	}
+
+	if cursor != nil {
+		decoded, err := decodeCursor(*cursor)
+		if err != nil || decoded.Sort != sort || decoded.Order != orderName(page.Desc) {
+			return nil, ErrInvalidCursor
+		}
+		value, err := key.parse(decoded.Value)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		page.After = &repositories.PageCursor{Value: value, ID: decoded.ID}
+	}
+
+	return page, nil
+}
+
+// nextCursor は取得した行の最後の位置から次のページのカーソルを作る。続きがない場合は nil を返す
+func nextCursor[T any](key sortKey[T], sort string, page *repositories.PageParams, items []T, hasNext bool, id func(item *T) uint) *string {
+	if !hasNext || len(items) == 0 {
+		return nil
+	}
+
+	last := &items[len(items)-1]
+	cursor := encodeCursor(pageCursor{
+		Sort:  sort,
+		Order: orderName(page.Desc),
+		Value: key.value(last),
+		ID:    id(last),
+	})
+	return &cursor
+}
+
+func encodeCursor(cursor pageCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string) (*pageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+	var decoded pageCursor
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+func orderName(desc bool) string {
+	if desc {
+		return string(api.Desc)
+	}
+	return string(api.Asc)
+}
+
+// カーソル値の変換。DBに渡す前に形式を検証する
+func parseDateValue(value string) (interface{}, error) {
+	if _, err := time.Parse(dateLayout, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func parseMonthValue(value string) (interface{}, error) {
+	if _, err := time.Parse("2006-01", value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func parseIntValue(value string) (interface{}, error) {
+	return strconv.Atoi(value)
+}
+
+func parseTimeValue(value string) (interface{}, error) {
+	return time.Parse(time.RFC3339Nano, value)
+}
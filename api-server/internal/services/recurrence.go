@@ -0,0 +1,83 @@
+package services
+
+import (
+	"time"
+
+	"apps/internal/models"
+)
+
+// occurrences は定期取引のスケジュールのうち、from 以上 to 以下の日付を昇順で返す
+// 日付は types.Date と同じくUTCの0時で表す
+func occurrences(rt *models.RecurringTransaction, from, to time.Time) []time.Time {
+	start := toDate(rt.StartDate)
+	interval := rt.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	var dates []time.Time
+	add := func(d time.Time) {
+		if !d.Before(from) && !d.Before(start) {
+			dates = append(dates, d)
+		}
+	}
+
+	switch rt.Frequency {
+	case models.RecurrenceFrequencyWeekly:
+		weekday := int(start.Weekday())
+		if rt.DayOfWeek != nil {
+			weekday = *rt.DayOfWeek
+		}
+		first := start.AddDate(0, 0, (weekday-int(start.Weekday())+7)%7)
+		for d := first; !d.After(to); d = d.AddDate(0, 0, 7*interval) {
+			add(d)
+		}
+
+	case models.RecurrenceFrequencyMonthly:
+		day := start.Day()
+		if rt.DayOfMonth != nil {
+			day = *rt.DayOfMonth
+		}
+		for k := 0; ; k += interval {
+			d := clampedDate(start.Year(), start.Month()+time.Month(k), day)
+			if d.After(to) {
+				break
+			}
+			add(d)
+		}
+
+	case models.RecurrenceFrequencyYearly:
+		month, day := start.Month(), start.Day()
+		if rt.MonthOfYear != nil {
+			month = time.Month(*rt.MonthOfYear)
+		}
+		if rt.DayOfMonth != nil {
+			day = *rt.DayOfMonth
+		}
+		for k := 0; ; k += interval {
+			d := clampedDate(start.Year()+k, month, day)
+			if d.After(to) {
+				break
+			}
+			add(d)
+		}
+	}
+
+	return dates
+}
+
+// clampedDate は指定した年月日の日付を返す。日が月末を超える場合はその月の末日にする
+// month が12を超える場合は翌年以降の月として扱う
+func clampedDate(year int, month time.Month, day int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	if day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+// toDate は日時から時刻を切り捨て、同じ年月日のUTCの0時を返す
+func toDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,101 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"apps/internal/models"
+)
+
+func TestOccurrences(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name string
+		rt   models.RecurringTransaction
+		from string
+		to   string
+		want []string
+	}{
+		{
+			name: "毎月31日は月末日にする",
+			rt:   models.RecurringTransaction{Frequency: models.RecurrenceFrequencyMonthly, Interval: 1, DayOfMonth: intPtr(31), StartDate: parseDate(t, "2026-01-01")},
+			from: "2026-01-01", to: "2026-06-30",
+			want: []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31", "2026-06-30"},
+		},
+		{
+			name: "毎月31日 うるう年の2月",
+			rt:   models.RecurringTransaction{Frequency: models.RecurrenceFrequencyMonthly, Interval: 1, DayOfMonth: intPtr(31), StartDate: parseDate(t, "2028-01-31")},
+			from: "2028-01-01", to: "2028-03-31",
+			want: []string{"2028-01-31", "2028-02-29", "2028-03-31"},
+		},
+		{
+			name: "月末に開始した毎月の取引は、短い月の後も開始日の日付に戻る",
+			rt:   models.RecurringTransaction{Frequency: models.RecurrenceFrequencyMonthly, Interval: 1, StartDate: parseDate(t, "2026-01-31")},
+			from: "2026-01-01", to: "2026-04-30",
+			want: []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"},
+		},
+		{
+			name: "2ヶ月ごと 年をまたぐ",
+			rt:   models.RecurringTransaction{Frequency: models.RecurrenceFrequencyMonthly, Interval: 2, DayOfMonth: intPtr(30), StartDate: parseDate(t, "2026-10-01")},
+			from: "2026-10-01", to: "2027-04-30",
+			want: []string{"2026-10-30", "2026-12-30", "2027-02-28", "2027-04-30"},
+		},
+		{
+			name: "2月29日に開始した毎年の取引は、うるう年以外は2月28日にする",
+			rt:   models.RecurringTransaction{Frequency: models.RecurrenceFrequencyYearly, Interval: 1, StartDate: parseDate(t, "2028-02-29")},
+			from: "2028-01-01", to: "2032-12-31",
+			want: []string{"2028-02-29", "2029-02-28", "2030-02-28", "2031-02-28", "2032-02-29"},
+		},
+		{
+			name: "毎年2月29日を指定",
+			rt:   models.RecurringTransaction{Frequency: models.RecurrenceFrequencyYearly, Interval: 1, MonthOfYear: intPtr(2), DayOfMonth: intPtr(29), StartDate: parseDate(t, "2026-01-01")},
+			from: "2026-01-01", to: "2028-12-31",
+			want: []string{"2026-02-28", "2027-02-28", "2028-02-29"},
+		},
+		{
+			name: "毎年 開始日より前の月は翌年から",
+			rt:   models.RecurringTransaction{Frequency: models.RecurrenceFrequencyYearly, Interval: 1, MonthOfYear: intPtr(3), DayOfMonth: intPtr(15), StartDate: parseDate(t, "2026-06-01")},
+			from: "2026-01-01", to: "2028-12-31",
+			want: []string{"2027-03-15", "2028-03-15"},
+		},
+		{
+			name: "毎週 開始日の後の指定した曜日から",
+			rt:   models.RecurringTransaction{Frequency: models.RecurrenceFrequencyWeekly, Interval: 2, DayOfWeek: intPtr(int(time.Monday)), StartDate: parseDate(t, "2026-02-25")},
+			from: "2026-02-01", to: "2026-03-31",
+			want: []string{"2026-03-02", "2026-03-16", "2026-03-30"},
+		},
+		{
+			name: "from より前の日付は含めない",
+			rt:   models.RecurringTransaction{Frequency: models.RecurrenceFrequencyMonthly, Interval: 1, DayOfMonth: intPtr(25), StartDate: parseDate(t, "2026-01-01")},
+			from: "2026-03-25", to: "2026-05-24",
+			want: []string{"2026-03-25", "2026-04-25"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, d := range occurrences(&tt.rt, parseDate(t, tt.from), parseDate(t, tt.to)) {
+				if d.Location() != time.UTC || !d.Equal(toDate(d)) {
+					t.Errorf("occurrence %s is not UTC midnight", d)
+				}
+				got = append(got, d.Format(dateLayout))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("occurrences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// parseDate はYYYY-MM-DD形式の日付をUTCの0時で返す
+func parseDate(t *testing.T, value string) time.Time {
+	t.Helper()
+	d, err := time.Parse(dateLayout, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+type RecurringTransactionService interface {
+	FetchRecurringTransactions(userID uint) ([]models.RecurringTransaction, error)
+	FetchRecurringTransactionByID(id uint, userID uint) (*models.RecurringTransaction, error)
+	CreateRecurringTransaction(userID uint, input *api.CreateRecurringTransactionInput) (*models.RecurringTransaction, error)
+	UpdateRecurringTransaction(id uint, userID uint, input *api.UpdateRecurringTransactionInput) (*models.RecurringTransaction, error)
+	DeleteRecurringTransaction(id uint, userID uint) error
+	GenerateTransactions(userID uint, input *api.GenerateRecurringTransactionsInput) (int, error)
+}
+
+type recurringTransactionService struct {
+	repo     repositories.RecurringTransactionRepository
+	userRepo repositories.UserRepository
+}
+
+func NewRecurringTransactionService(repo repositories.RecurringTransactionRepository, userRepo repositories.UserRepository) RecurringTransactionService {
+	return &recurringTransactionService{repo: repo, userRepo: userRepo}
+}
+
+func (s *recurringTransactionService) FetchRecurringTransactions(userID uint) ([]models.RecurringTransaction, error) {
+	return s.repo.FindAll(userID)
+}
+
+func (s *recurringTransactionService) FetchRecurringTransactionByID(id uint, userID uint) (*models.RecurringTransaction, error) {
+	recurringTransaction, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrRecurringTransactionNotFound
+		}
+		return nil, err
+	}
+	return recurringTransaction, nil
+}
+
+func (s *recurringTransactionService) CreateRecurringTransaction(userID uint, input *api.CreateRecurringTransactionInput) (*models.RecurringTransaction, error) {
+	if err := validators.ValidateCreateRecurringTransaction(input); err != nil {
+		return nil, err
+	}
+
+	description := ""
+	if input.Description != nil {
+		description = *input.Description
+	}
+	interval := 1
+	if input.Interval != nil {
+		interval = int(*input.Interval)
+	}
+
+	recurringTransaction := models.RecurringTransaction{
+		UserID:      userID,
+		CategoryID:  uint(input.CategoryId),
+		Amount:      int(input.Amount),
+		Description: description,
+		Frequency:   models.RecurrenceFrequency(input.Frequency),
+		Interval:    interval,
+		StartDate:   input.StartDate.Time,
+	}
+	if input.EndDate != nil {
+		recurringTransaction.EndDate = &input.EndDate.Time
+	}
+
+	// NOTE: 頻度に関係しない項目は保存しない
+	schedule := scheduleFields(input.Frequency, input.DayOfMonth, input.DayOfWeek, input.MonthOfYear)
+	recurringTransaction.DayOfMonth = schedule["day_of_month"]
+	recurringTransaction.DayOfWeek = schedule["day_of_week"]
+	recurringTransaction.MonthOfYear = schedule["month_of_year"]
+
+	if err := s.repo.Create(&recurringTransaction); err != nil {
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return &recurringTransaction, nil
+}
+
+func (s *recurringTransactionService) UpdateRecurringTransaction(id uint, userID uint, input *api.UpdateRecurringTransactionInput) (*models.RecurringTransaction, error) {
+	if err := validators.ValidateUpdateRecurringTransaction(input); err != nil {
+		return nil, err
+	}
+
+	// 開始日・終了日の片方のみ更新する場合は既存の値と組み合わせて検証する
+	if (input.StartDate == nil) != (input.EndDate == nil) {
+		existing, err := s.FetchRecurringTransactionByID(id, userID)
+		if err != nil {
+			return nil, err
+		}
+		startDate, endDate := existing.StartDate, existing.EndDate
+		if input.StartDate != nil {
+			startDate = input.StartDate.Time
+		}
+		if input.EndDate != nil {
+			endDate = &input.EndDate.Time
+		}
+		if err := validators.ValidateRecurringTransactionPeriod(startDate, endDate); err != nil {
+			return nil, err
+		}
+	}
+
+	updates := make(map[string]interface{})
+
+	if input.CategoryId != nil {
+		updates["category_id"] = *input.CategoryId
+	}
+	if input.Amount != nil {
+		updates["amount"] = *input.Amount
+	}
+	if input.Description != nil {
+		updates["description"] = *input.Description
+	}
+	if input.Frequency != nil {
+		updates["frequency"] = *input.Frequency
+		for column, value := range scheduleFields(*input.Frequency, input.DayOfMonth, input.DayOfWeek, input.MonthOfYear) {
+			updates[column] = value
+		}
+		if input.Interval != nil {
+			updates["interval"] = *input.Interval
+		}
+	}
+	if input.StartDate != nil {
+		updates["start_date"] = input.StartDate.Time
+	}
+	if input.EndDate != nil {
+		updates["end_date"] = input.EndDate.Time
+	}
+
+	recurringTransaction, err := s.repo.Update(id, userID, updates)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrRecurringTransactionNotFound
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+
+	return recurringTransaction, nil
+}
+
+func (s *recurringTransactionService) DeleteRecurringTransaction(id uint, userID uint) error {
+	err := s.repo.Delete(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrRecurringTransactionNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// GenerateTransactions は until（省略時はユーザーのタイムゾーンでの今日）までに到来した定期取引を取引として登録し、新たに登録した件数を返す
+// 生成済みの最終日より後の日付のみを対象とし、さらに同じ定期取引・同じ日付の取引は登録しないため、
+// 何度実行しても取引が重複しない
+func (s *recurringTransactionService) GenerateTransactions(userID uint, input *api.GenerateRecurringTransactionsInput) (int, error) {
+	var until time.Time
+	if input != nil && input.Until != nil {
+		until = toDate(input.Until.Time)
+	} else {
+		today, err := userToday(s.userRepo, userID)
+		if err != nil {
+			return 0, err
+		}
+		until = today
+	}
+
+	recurringTransactions, err := s.repo.FindAll(userID)
+	if err != nil {
+		return 0, err
+	}
+	// 定期取引の金額は基準通貨で登録されている
+	base, err := baseCurrency(s.userRepo, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	generated := 0
+	for i := range recurringTransactions {
+		rt := &recurringTransactions[i]
+
+		from := toDate(rt.StartDate)
+		if rt.GeneratedUntil != nil {
+			from = toDate(*rt.GeneratedUntil).AddDate(0, 0, 1)
+		}
+		to := until
+		if rt.EndDate != nil && toDate(*rt.EndDate).Before(to) {
+			to = toDate(*rt.EndDate)
+		}
+		if to.Before(from) {
+			continue
+		}
+
+		dates := occurrences(rt, from, to)
+		transactions := make([]models.Transaction, len(dates))
+		for j, date := range dates {
+			transactions[j] = models.Transaction{
+				UserID:                 userID,
+				CategoryID:             rt.CategoryID,
+				RecurringTransactionID: &rt.ID,
+				Amount:                 rt.Amount,
+				Currency:               base,
+				OriginalAmount:         rt.Amount,
+				Date:                   date,
+				Description:            rt.Description,
+			}
+		}
+
+		created, err := s.repo.SaveOccurrences(rt, transactions, to)
+		if err != nil {
+			return generated, err
+		}
+		generated += int(created)
+	}
+
+	return generated, nil
+}
+
+// scheduleFields は頻度に応じて保存するスケジュール項目を返す
+// 頻度に関係しない項目はnilにする
+func scheduleFields(frequency api.RecurrenceFrequency, dayOfMonth, dayOfWeek, monthOfYear *int32) map[string]*int {
+	fields := map[string]*int{
+		"day_of_month":  nil,
+		"day_of_week":   nil,
+		"month_of_year": nil,
+	}
+	switch frequency {
+	case api.Weekly:
+		fields["day_of_week"] = toIntPtr(dayOfWeek)
+	case api.Monthly:
+		fields["day_of_month"] = toIntPtr(dayOfMonth)
+	case api.Yearly:
+		fields["day_of_month"] = toIntPtr(dayOfMonth)
+		fields["month_of_year"] = toIntPtr(monthOfYear)
+	}
+	return fields
+}
+
+func toIntPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
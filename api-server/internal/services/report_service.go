@@ -0,0 +1,272 @@
+package services
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+// TrendAmounts は収入・支出・収支の合計
+type TrendAmounts struct {
+	Income  int
+	Expense int
+	Net     int // 収入 - 支出
+}
+
+// TrendComparison は比較対象の期間の合計と、今回との増減
+type TrendComparison struct {
+	StartDate time.Time
+	EndDate   time.Time
+	TrendAmounts
+	IncomeDelta       int
+	ExpenseDelta      int
+	NetDelta          int
+	IncomeChangeRate  *float64 // 比較対象の収入が0の場合はnil
+	ExpenseChangeRate *float64 // 比較対象の支出が0の場合はnil
+}
+
+// TrendCategoryItem は期間内のカテゴリごとの合計
+type TrendCategoryItem struct {
+	CategoryID uint
+	TrendAmounts
+	Comparison *TrendComparison // 比較対象を指定しなかった場合はnil
+}
+
+// TrendBucket は集計単位の1期間分の合計
+type TrendBucket struct {
+	StartDate time.Time
+	EndDate   time.Time // 終了日を含む
+	TrendAmounts
+	Categories []TrendCategoryItem
+	Comparison *TrendComparison // 比較対象を指定しなかった場合はnil
+}
+
+// TrendReport は期間ごと・カテゴリごとの推移
+type TrendReport struct {
+	StartDate  time.Time
+	EndDate    time.Time
+	Period     api.TrendPeriod
+	Compare    *api.TrendCompare
+	Categories []models.Category
+	Buckets    []TrendBucket
+}
+
+type ReportService interface {
+	FetchTrendReport(userID uint, params *api.GetReportsTrendsParams) (*TrendReport, error)
+}
+
+type reportService struct {
+	transactionRepo repositories.TransactionRepository
+	categoryRepo    repositories.CategoryRepository
+}
+
+func NewReportService(transactionRepo repositories.TransactionRepository, categoryRepo repositories.CategoryRepository) ReportService {
+	return &reportService{transactionRepo: transactionRepo, categoryRepo: categoryRepo}
+}
+
+// FetchTrendReport は開始日〜終了日の取引を集計単位の期間ごと・カテゴリごとに集計する
+// NOTE: 取引のない期間も含めて開始日〜終了日のすべての期間を返す
+func (s *reportService) FetchTrendReport(userID uint, params *api.GetReportsTrendsParams) (*TrendReport, error) {
+	if err := validators.ValidateGetReportsTrends(params); err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse(dateLayout, params.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(dateLayout, params.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	period := api.Month
+	if params.Period != nil {
+		period = *params.Period
+	}
+
+	report := &TrendReport{
+		StartDate: start,
+		EndDate:   end,
+		Period:    period,
+		Compare:   params.Compare,
+	}
+
+	// 期間ごとの範囲と比較対象の範囲を決め、比較対象を含めた範囲の取引を1度に取得する
+	ranges := trendRanges(start, end, period)
+	var comparisons [][2]time.Time
+	fetchStart := start
+	if params.Compare != nil {
+		comparisons = make([][2]time.Time, len(ranges))
+		for i, r := range ranges {
+			comparisons[i] = comparisonRange(r[0], r[1], period, *params.Compare)
+		}
+		if comparisons[0][0].Before(fetchStart) {
+			fetchStart = comparisons[0][0]
+		}
+	}
+
+	totals, err := s.transactionRepo.SumDailyByCategory(userID, fetchStart.Format(dateLayout), end.AddDate(0, 0, 1).Format(dateLayout))
+	if err != nil {
+		return nil, err
+	}
+	totalsByDate := make(map[string][]repositories.TransactionCategoryDailyTotal)
+	for _, t := range totals {
+		key := t.Date.Format(dateLayout)
+		totalsByDate[key] = append(totalsByDate[key], t)
+	}
+
+	usedCategories := make(map[uint]bool)
+	for i, r := range ranges {
+		bucket := TrendBucket{StartDate: r[0], EndDate: r[1]}
+		current, currentByCategory := sumTrendRange(totalsByDate, r[0], r[1])
+		bucket.TrendAmounts = current
+
+		var previous TrendAmounts
+		var previousByCategory map[uint]TrendAmounts
+		if comparisons != nil {
+			c := comparisons[i]
+			previous, previousByCategory = sumTrendRange(totalsByDate, c[0], c[1])
+			bucket.Comparison = newTrendComparison(c[0], c[1], current, previous)
+		}
+
+		// NOTE: 今回・比較対象のいずれかに取引のあるカテゴリを含める
+		categoryIDs := make(map[uint]bool)
+		for id := range currentByCategory {
+			categoryIDs[id] = true
+		}
+		for id := range previousByCategory {
+			categoryIDs[id] = true
+		}
+		for id := range categoryIDs {
+			usedCategories[id] = true
+			item := TrendCategoryItem{CategoryID: id, TrendAmounts: currentByCategory[id]}
+			if bucket.Comparison != nil {
+				item.Comparison = newTrendComparison(bucket.Comparison.StartDate, bucket.Comparison.EndDate, currentByCategory[id], previousByCategory[id])
+			}
+			bucket.Categories = append(bucket.Categories, item)
+		}
+		sort.Slice(bucket.Categories, func(a, b int) bool {
+			return bucket.Categories[a].CategoryID < bucket.Categories[b].CategoryID
+		})
+
+		report.Buckets = append(report.Buckets, bucket)
+	}
+
+	categories, err := s.categoryRepo.FindAllByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range categories {
+		if usedCategories[c.ID] {
+			report.Categories = append(report.Categories, c)
+		}
+	}
+
+	return report, nil
+}
+
+// trendRanges は開始日〜終了日を集計単位の期間に区切り、各期間の開始日・終了日（終了日を含む）を返す
+// 先頭・末尾の期間は開始日・終了日で区切る
+func trendRanges(start, end time.Time, period api.TrendPeriod) [][2]time.Time {
+	var ranges [][2]time.Time
+	for d := start; !d.After(end); {
+		periodEnd := trendPeriodEnd(d, period)
+		if periodEnd.After(end) {
+			periodEnd = end
+		}
+		ranges = append(ranges, [2]time.Time{d, periodEnd})
+		d = periodEnd.AddDate(0, 0, 1)
+	}
+	return ranges
+}
+
+// trendPeriodEnd は d を含む集計単位の期間の最終日を返す（週は月曜日始まり）
+func trendPeriodEnd(d time.Time, period api.TrendPeriod) time.Time {
+	switch period {
+	case api.Week:
+		return d.AddDate(0, 0, 6-(int(d.Weekday())+6)%7)
+	case api.Month:
+		return time.Date(d.Year(), d.Month()+1, 0, 0, 0, 0, 0, d.Location())
+	case api.Year:
+		return time.Date(d.Year(), time.December, 31, 0, 0, 0, 0, d.Location())
+	}
+	return d
+}
+
+// comparisonRange は期間の比較対象となる1つ前の期間、または前年の同じ期間を返す
+func comparisonRange(start, end time.Time, period api.TrendPeriod, compare api.TrendCompare) [2]time.Time {
+	months := -12
+	if compare == api.PreviousPeriod {
+		switch period {
+		case api.Day:
+			return [2]time.Time{start.AddDate(0, 0, -1), end.AddDate(0, 0, -1)}
+		case api.Week:
+			return [2]time.Time{start.AddDate(0, 0, -7), end.AddDate(0, 0, -7)}
+		case api.Month:
+			months = -1
+		}
+	}
+	return [2]time.Time{shiftMonths(start, months, false), shiftMonths(end, months, true)}
+}
+
+// shiftMonths は d を months ヶ月ずらした日付を返す。移動先の月にない日は月末日とする
+// isEnd が true で d が月末日の場合は、移動先の月末日とする（例: 2月末日の前月は1月31日）
+func shiftMonths(d time.Time, months int, isEnd bool) time.Time {
+	firstDay := time.Date(d.Year(), d.Month()+time.Month(months), 1, 0, 0, 0, 0, d.Location())
+	lastDay := firstDay.AddDate(0, 1, -1).Day()
+
+	day := d.Day()
+	isMonthEnd := d.AddDate(0, 0, 1).Day() == 1
+	if day > lastDay || (isEnd && isMonthEnd) {
+		day = lastDay
+	}
+	return firstDay.AddDate(0, 0, day-1)
+}
+
+// sumTrendRange は start〜end（end を含む）の日別の合計を、全体とカテゴリごとに合計する
+func sumTrendRange(totalsByDate map[string][]repositories.TransactionCategoryDailyTotal, start, end time.Time) (TrendAmounts, map[uint]TrendAmounts) {
+	var total TrendAmounts
+	byCategory := make(map[uint]TrendAmounts)
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		for _, t := range totalsByDate[d.Format(dateLayout)] {
+			total = addTrendAmounts(total, t.Income, t.Expense)
+			byCategory[t.CategoryID] = addTrendAmounts(byCategory[t.CategoryID], t.Income, t.Expense)
+		}
+	}
+	return total, byCategory
+}
+
+func addTrendAmounts(a TrendAmounts, income, expense int) TrendAmounts {
+	a.Income += income
+	a.Expense += expense
+	a.Net = a.Income - a.Expense
+	return a
+}
+
+// newTrendComparison は比較対象の期間の合計と、今回との増減を返す
+func newTrendComparison(start, end time.Time, current, previous TrendAmounts) *TrendComparison {
+	return &TrendComparison{
+		StartDate:         start,
+		EndDate:           end,
+		TrendAmounts:      previous,
+		IncomeDelta:       current.Income - previous.Income,
+		ExpenseDelta:      current.Expense - previous.Expense,
+		NetDelta:          current.Net - previous.Net,
+		IncomeChangeRate:  changeRate(current.Income, previous.Income),
+		ExpenseChangeRate: changeRate(current.Expense, previous.Expense),
+	}
+}
+
+// changeRate は比較対象からの増減率（%）を小数第1位で丸めて返す
+func changeRate(current, previous int) *float64 {
+	if previous <= 0 {
+		return nil
+	}
+	r := math.Round(float64(current-previous)*1000/float64(previous)) / 10
+	return &r
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+// TagSummaryItem はタグごとの期間内の収入・支出の合計
+type TagSummaryItem struct {
+	Tag              models.Tag
+	Income           int
+	Expense          int
+	TransactionCount int
+}
+
+// TagSummary は指定期間のタグごとの集計結果
+type TagSummary struct {
+	StartDate time.Time
+	EndDate   time.Time
+	Items     []TagSummaryItem
+}
+
+type TagService interface {
+	FetchTags(userID uint) ([]models.Tag, error)
+	FetchTagByID(id uint, userID uint) (*models.Tag, error)
+	CreateTag(userID uint, input *api.CreateTagInput) (*models.Tag, error)
+	UpdateTag(id uint, userID uint, input *api.UpdateTagInput) (*models.Tag, error)
+	DeleteTag(id uint, userID uint) error
+	FetchTagSummary(userID uint, params *api.GetTagsSummaryParams) (*TagSummary, error)
+}
+
+type tagService struct {
+	repo repositories.TagRepository
+}
+
+func NewTagService(repo repositories.TagRepository) TagService {
+	return &tagService{repo}
+}
+
+func (s *tagService) FetchTags(userID uint) ([]models.Tag, error) {
+	return s.repo.FindAll(userID)
+}
+
+func (s *tagService) FetchTagByID(id uint, userID uint) (*models.Tag, error) {
+	tag, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTagNotFound
+		}
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (s *tagService) CreateTag(userID uint, input *api.CreateTagInput) (*models.Tag, error) {
+	if err := validators.ValidateCreateTag(input); err != nil {
+		return nil, err
+	}
+
+	tag := models.Tag{
+		UserID: userID,
+		Name:   input.Name,
+	}
+
+	if err := s.repo.Create(&tag); err != nil {
+		if errors.Is(err, repositories.ErrDuplicateEntry) {
+			return nil, ErrTagAlreadyExists
+		}
+		return nil, err
+	}
+
+	return &tag, nil
+}
+
+func (s *tagService) UpdateTag(id uint, userID uint, input *api.UpdateTagInput) (*models.Tag, error) {
+	if err := validators.ValidateUpdateTag(input); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if input.Name != nil {
+		updates["name"] = *input.Name
+	}
+
+	tag, err := s.repo.Update(id, userID, updates)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTagNotFound
+		}
+		if errors.Is(err, repositories.ErrDuplicateEntry) {
+			return nil, ErrTagAlreadyExists
+		}
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+// DeleteTag はタグを削除する。タグが付いていた取引からはタグが外れる
+func (s *tagService) DeleteTag(id uint, userID uint) error {
+	err := s.repo.Delete(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrTagNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// FetchTagSummary は開始日〜終了日の取引をタグごとに集計する
+// NOTE: 期間内に取引のないタグも収入・支出0として含める
+func (s *tagService) FetchTagSummary(userID uint, params *api.GetTagsSummaryParams) (*TagSummary, error) {
+	if err := validators.ValidateGetTagsSummary(params); err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse(dateLayout, params.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(dateLayout, params.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.repo.FindAll(userID)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := s.repo.SumByTag(userID, params.StartDate, params.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	totalByTag := make(map[uint]repositories.TagTotal, len(totals))
+	for _, t := range totals {
+		totalByTag[t.TagID] = t
+	}
+
+	items := make([]TagSummaryItem, len(tags))
+	for i, tag := range tags {
+		total := totalByTag[tag.ID]
+		items[i] = TagSummaryItem{
+			Tag:              tag,
+			Income:           total.Income,
+			Expense:          total.Expense,
+			TransactionCount: total.Count,
+		}
+	}
+
+	return &TagSummary{StartDate: start, EndDate: end, Items: items}, nil
+}
+
+// checkTags はタグがすべてユーザーのものか確認する。tagIDs が nil の場合は何もしない
+func checkTags(repo repositories.TagRepository, userID uint, tagIDs *[]int32) error {
+	if tagIDs == nil {
+		return nil
+	}
+	tags := transactionTags(tagIDs)
+	ids := make([]uint, len(tags))
+	for i, tag := range tags {
+		ids[i] = tag.ID
+	}
+	found, err := repo.FindByIDs(ids, userID)
+	if err != nil {
+		return err
+	}
+	if len(found) != len(ids) {
+		return ErrTagNotFound
+	}
+	return nil
+}
+
+// transactionTags はタグIDの入力を ID のみを持つモデルに変換する。重複したIDは1つにまとめる
+// 未指定の場合は nil、空配列の場合は空のスライスを返す
+func transactionTags(tagIDs *[]int32) []models.Tag {
+	if tagIDs == nil {
+		return nil
+	}
+
+	tags := make([]models.Tag, 0, len(*tagIDs))
+	seen := make(map[int32]bool, len(*tagIDs))
+	for _, id := range *tagIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		tags = append(tags, models.Tag{ID: uint(id)})
+	}
+	return tags
+}
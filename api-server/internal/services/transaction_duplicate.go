@@ -0,0 +1,230 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+// defaultDuplicateWindowDays は重複とみなす日付の差（日数）のデフォルト。取引の登録時の確認にも使う
+const defaultDuplicateWindowDays = 3
+
+// descriptionSimilarityThreshold は説明が似ているとみなす類似度（バイグラムのダイス係数）の下限
+const descriptionSimilarityThreshold = 0.7
+
+// DuplicateTransactionError は登録しようとした取引に重複の疑いがある場合のエラー
+type DuplicateTransactionError struct {
+	CandidateIDs []uint // 重複の候補の取引ID（IDの昇順）
+}
+
+func (e *DuplicateTransactionError) Error() string {
+	return fmt.Sprintf("possible duplicate of transactions %v", e.CandidateIDs)
+}
+
+func (e *DuplicateTransactionError) Unwrap() error {
+	return ErrPossibleDuplicateTransaction
+}
+
+// checkDuplicate は登録しようとした取引と金額（基準通貨）が同じで、日付の差が defaultDuplicateWindowDays 日以内、
+// 説明が似ている取引がある場合に *DuplicateTransactionError を返す
+func (s *transactionService) checkDuplicate(transaction *models.Transaction) error {
+	window := defaultDuplicateWindowDays
+	candidates, err := s.repo.FindByAmountAndDate(transaction.UserID, transaction.Amount,
+		transaction.Date.AddDate(0, 0, -window).Format(dateLayout), transaction.Date.AddDate(0, 0, window).Format(dateLayout))
+	if err != nil {
+		return err
+	}
+
+	var ids []uint
+	for _, candidate := range candidates {
+		if similarDescriptions(transaction.Description, candidate.Description) {
+			ids = append(ids, candidate.ID)
+		}
+	}
+	if len(ids) > 0 {
+		return &DuplicateTransactionError{CandidateIDs: ids}
+	}
+	return nil
+}
+
+// FetchDuplicateTransactions は重複の疑いがある取引をグループごとに返す
+// グループは最も新しい取引の日付の新しい順、グループ内の取引は日付・IDの古い順に並べる
+func (s *transactionService) FetchDuplicateTransactions(userID uint, params *api.GetTransactionsDuplicatesParams) ([][]models.Transaction, error) {
+	if err := validators.ValidateGetTransactionsDuplicates(params); err != nil {
+		return nil, err
+	}
+
+	window := defaultDuplicateWindowDays
+	if params.WindowDays != nil {
+		window = int(*params.WindowDays)
+	}
+
+	transactions, err := s.repo.FindPossibleDuplicates(userID, params.StartDate, params.EndDate, window)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := groupDuplicates(transactions, window)
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i][len(groups[i])-1].Date.After(groups[j][len(groups[j])-1].Date)
+	})
+	return groups, nil
+}
+
+// MergeDuplicateTransactions は duplicate_ids の取引のタグを keep_id の取引に追加し、duplicate_ids の取引をゴミ箱に移動する
+// 添付ファイルはゴミ箱に移動した取引に残る（ゴミ箱から元に戻せるようにするため）
+func (s *transactionService) MergeDuplicateTransactions(userID uint, input *api.MergeDuplicateTransactionsInput) (*models.Transaction, error) {
+	if err := validators.ValidateMergeDuplicateTransactions(input); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int32]bool, len(input.DuplicateIds))
+	duplicateIDs := make([]uint, 0, len(input.DuplicateIds))
+	for _, id := range input.DuplicateIds {
+		if !seen[id] {
+			seen[id] = true
+			duplicateIDs = append(duplicateIDs, uint(id))
+		}
+	}
+
+	transaction, err := s.repo.MergeDuplicates(uint(input.KeepId), duplicateIDs, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		if errors.Is(err, repositories.ErrTransactionLocked) {
+			return nil, ErrTransactionLocked
+		}
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// groupDuplicates は金額が同じで日付の差が windowDays 日以内、説明が似ている取引を同じグループにまとめ、
+// 2件以上の取引を含むグループを返す。transactions は日付・IDの昇順に並んでいる想定
+// NOTE: A と B、B と C が重複とみなされる場合は A と C も同じグループとする
+func groupDuplicates(transactions []models.Transaction, windowDays int) [][]models.Transaction {
+	parent := make([]int, len(transactions))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+
+	window := time.Duration(windowDays) * 24 * time.Hour
+	byAmount := make(map[int][]int)
+	for i, t := range transactions {
+		byAmount[t.Amount] = append(byAmount[t.Amount], i)
+	}
+	for _, indexes := range byAmount {
+		for a := 0; a < len(indexes); a++ {
+			for b := a + 1; b < len(indexes); b++ {
+				i, j := indexes[a], indexes[b]
+				if transactions[j].Date.Sub(transactions[i].Date) > window {
+					break
+				}
+				if similarDescriptions(transactions[i].Description, transactions[j].Description) {
+					parent[find(j)] = find(i)
+				}
+			}
+		}
+	}
+
+	members := make(map[int][]models.Transaction)
+	var roots []int
+	for i := range transactions {
+		root := find(i)
+		if _, ok := members[root]; !ok {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], transactions[i])
+	}
+
+	var groups [][]models.Transaction
+	for _, root := range roots {
+		if len(members[root]) >= 2 {
+			groups = append(groups, members[root])
+		}
+	}
+	return groups
+}
+
+// similarDescriptions は2つの説明が似ているか判定する
+// 大文字・小文字、全角・半角の英数字、空白・記号の違いを無視して比較し、一方がもう一方を含むか、
+// バイグラムの類似度が descriptionSimilarityThreshold 以上の場合に似ているとみなす。どちらも空の場合も似ているとみなす
+func similarDescriptions(a, b string) bool {
+	a, b = normalizeDescription(a), normalizeDescription(b)
+	if a == "" || b == "" {
+		return a == b
+	}
+	if a == b {
+		return true
+	}
+
+	shorter, longer := a, b
+	if len([]rune(shorter)) > len([]rune(longer)) {
+		shorter, longer = longer, shorter
+	}
+	if len([]rune(shorter)) >= 3 && strings.Contains(longer, shorter) {
+		return true
+	}
+	return bigramSimilarity(a, b) >= descriptionSimilarityThreshold
+}
+
+// normalizeDescription は説明を比較用に正規化する（小文字化・全角英数字の半角化・空白と記号の除去）
+func normalizeDescription(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		// 全角の英数字・記号（！〜～）を半角に変換
+		if r >= '！' && r <= '～' {
+			r -= '！' - '!'
+		}
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// bigramSimilarity は2つの文字列の文字バイグラムのダイス係数（0〜1）を返す
+func bigramSimilarity(a, b string) float64 {
+	bigramsA, bigramsB := bigrams(a), bigrams(b)
+	total := 0
+	for _, n := range bigramsA {
+		total += n
+	}
+	for _, n := range bigramsB {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+
+	common := 0
+	for bigram, n := range bigramsA {
+		common += min(n, bigramsB[bigram])
+	}
+	return float64(2*common) / float64(total)
+}
+
+func bigrams(s string) map[string]int {
+	runes := []rune(s)
+	counts := make(map[string]int)
+	for i := 0; i+1 < len(runes); i++ {
+		counts[string(runes[i:i+2])]++
+	}
+	return counts
+}
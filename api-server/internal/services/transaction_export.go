@@ -0,0 +1,90 @@
+package services
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	api "apps/apis"
+	"apps/internal/repositories"
+)
+
+// exportHeader はCSV出力時のヘッダー行。NDJSONのキーも同じ名前にそろえる
+var exportHeader = []string{"id", "date", "category_id", "category_name", "category_type", "amount", "description", "currency", "original_amount"}
+
+// exportRecord はNDJSON出力時の1行
+type exportRecord struct {
+	ID             uint   `json:"id"`
+	Date           string `json:"date"`
+	CategoryID     uint   `json:"category_id"`
+	CategoryName   string `json:"category_name"`
+	CategoryType   string `json:"category_type"`
+	Amount         int    `json:"amount"`
+	Description    string `json:"description"`
+	Currency       string `json:"currency"`
+	OriginalAmount int    `json:"original_amount"`
+}
+
+// exportWriter は取引を1件ずつ出力形式に変換して書き込む
+type exportWriter interface {
+	Write(row *repositories.TransactionExportRow) error
+	Flush() error
+}
+
+func newExportWriter(format api.TransactionExportFormat, w io.Writer) (exportWriter, error) {
+	if format == api.Ndjson {
+		return &ndjsonExportWriter{encoder: json.NewEncoder(w)}, nil
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportHeader); err != nil {
+		return nil, err
+	}
+	return &csvExportWriter{writer: writer}, nil
+}
+
+type csvExportWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvExportWriter) Write(row *repositories.TransactionExportRow) error {
+	return w.writer.Write([]string{
+		strconv.FormatUint(uint64(row.ID), 10),
+		row.Date.Format(dateLayout),
+		strconv.FormatUint(uint64(row.CategoryID), 10),
+		row.CategoryName,
+		string(row.CategoryType),
+		strconv.Itoa(row.Amount),
+		row.Description,
+		row.Currency,
+		strconv.Itoa(row.OriginalAmount),
+	})
+}
+
+func (w *csvExportWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type ndjsonExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *ndjsonExportWriter) Write(row *repositories.TransactionExportRow) error {
+	return w.encoder.Encode(exportRecord{
+		ID:             row.ID,
+		Date:           row.Date.Format(dateLayout),
+		CategoryID:     row.CategoryID,
+		CategoryName:   row.CategoryName,
+		CategoryType:   string(row.CategoryType),
+		Amount:         row.Amount,
+		Description:    row.Description,
+		Currency:       row.Currency,
+		OriginalAmount: row.OriginalAmount,
+	})
+}
+
+func (w *ndjsonExportWriter) Flush() error {
+	return nil
+}
@@ -1,46 +1,137 @@
 package services
 
 import (
+	"bufio"
+	"context"
 	"errors"
+	"io"
+	"strconv"
+	"time"
 
 	api "apps/apis"
 	"apps/internal/models"
 	"apps/internal/repositories"
 	"apps/internal/validators"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
 type TransactionService interface {
-	FetchTransactions(userID uint, params *api.GetTransactionsParams) ([]models.Transaction, error)
+	FetchTransactions(userID uint, params *api.GetTransactionsParams) ([]models.Transaction, *string, error)
 	FetchTransactionByID(id uint, userID uint) (*models.Transaction, error)
 	CreateTransaction(userID uint, input *api.CreateTransactionInput) (*models.Transaction, error)
 	UpdateTransaction(id uint, userID uint, input *api.UpdateTransactionInput) (*models.Transaction, error)
 	DeleteTransaction(id uint, userID uint) error
+	ExportTransactions(ctx context.Context, userID uint, params *api.GetTransactionsExportParams) (io.ReadCloser, error)
+	BatchTransactions(userID uint, input *api.BatchTransactionsInput) (*BatchResult, error)
+	RecategorizeTransactions(userID uint, input *api.RecategorizeTransactionsInput) (int, error)
+	FetchTransactionHistory(id uint, userID uint) ([]models.ChangeHistory, error)
+	FetchDuplicateTransactions(userID uint, params *api.GetTransactionsDuplicatesParams) ([][]models.Transaction, error)
+	MergeDuplicateTransactions(userID uint, input *api.MergeDuplicateTransactionsInput) (*models.Transaction, error)
+	UnlockTransaction(id uint, userID uint) (*models.Transaction, error)
+}
+
+// BatchResultItem は一括操作の1件分の結果
+// 実行された場合は Transaction（delete の場合は nil）、失敗した場合は Errors を持つ
+type BatchResultItem struct {
+	Action      api.BatchTransactionAction
+	Transaction *models.Transaction
+	Errors      validation.Errors
+}
+
+// BatchResult は一括操作の結果。1件でも失敗した場合は Applied が false となり、何も実行されない
+type BatchResult struct {
+	Applied bool
+	Items   []BatchResultItem
 }
 
 type transactionService struct {
-	repo repositories.TransactionRepository
+	repo        repositories.TransactionRepository
+	accountRepo repositories.AccountRepository
+	cardRepo    repositories.CreditCardRepository
+	tagRepo     repositories.TagRepository
+	userRepo    repositories.UserRepository
+	rateRepo    repositories.ExchangeRateRepository
+	historyRepo repositories.ChangeHistoryRepository
+	ruleRepo    repositories.CategorizationRuleRepository
 }
 
-func NewTransactionService(repo repositories.TransactionRepository) TransactionService {
-	return &transactionService{repo}
+func NewTransactionService(repo repositories.TransactionRepository, accountRepo repositories.AccountRepository, cardRepo repositories.CreditCardRepository, tagRepo repositories.TagRepository, userRepo repositories.UserRepository, rateRepo repositories.ExchangeRateRepository, historyRepo repositories.ChangeHistoryRepository, ruleRepo repositories.CategorizationRuleRepository) TransactionService {
+	return &transactionService{repo: repo, accountRepo: accountRepo, cardRepo: cardRepo, tagRepo: tagRepo, userRepo: userRepo, rateRepo: rateRepo, historyRepo: historyRepo, ruleRepo: ruleRepo}
 }
 
-func (s *transactionService) FetchTransactions(userID uint, params *api.GetTransactionsParams) ([]models.Transaction, error) {
-	var repoParams *repositories.TransactionFindParams
+// transactionSortKeys は取引一覧の並び替えキーごとのカラムとカーソル値の変換方法
+var transactionSortKeys = map[api.TransactionSortKey]sortKey[models.Transaction]{
+	api.TransactionSortKeyDate: {
+		column: "transactions.date",
+		value:  func(t *models.Transaction) string { return t.Date.Format(dateLayout) },
+		parse:  parseDateValue,
+	},
+	api.TransactionSortKeyAmount: {
+		column: "transactions.amount",
+		value:  func(t *models.Transaction) string { return strconv.Itoa(t.Amount) },
+		parse:  parseIntValue,
+	},
+	api.TransactionSortKeyCreatedAt: {
+		column: "transactions.created_at",
+		value:  func(t *models.Transaction) string { return t.CreatedAt.Format(time.RFC3339Nano) },
+		parse:  parseTimeValue,
+	},
+}
 
-	if params != nil {
-		repoParams = &repositories.TransactionFindParams{
-			StartDate:  params.StartDate,
-			EndDate:    params.EndDate,
-			CategoryID: params.CategoryId,
-		}
-		if params.Type != nil {
-			typeStr := string(*params.Type)
-			repoParams.Type = &typeStr
+// FetchTransactions は条件に一致する取引を1ページ分取得し、次のページのカーソルとともに返す
+func (s *transactionService) FetchTransactions(userID uint, params *api.GetTransactionsParams) ([]models.Transaction, *string, error) {
+	if err := validators.ValidateGetTransactions(params); err != nil {
+		return nil, nil, err
+	}
+
+	sort := api.TransactionSortKeyDate
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+	key := transactionSortKeys[sort]
+
+	page, err := newPageParams(key, string(sort), params.Order, params.Limit, params.Cursor)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	repoParams := toTransactionFindParams(params.StartDate, params.EndDate, params.Type, params.CategoryId)
+	if params.Month != nil {
+		// 予算の対象月はユーザーの月の開始日に基づく期間に変換する
+		start, end, err := userBudgetMonthRange(s.userRepo, userID, *params.Month)
+		if err != nil {
+			return nil, nil, err
 		}
+		startDate, endDate := start.Format(dateLayout), end.AddDate(0, 0, -1).Format(dateLayout)
+		repoParams.StartDate, repoParams.EndDate = &startDate, &endDate
+	}
+	repoParams.AccountID = params.AccountId
+	repoParams.CreditCardID = params.CreditCardId
+	repoParams.Cleared = params.Cleared
+	repoParams.TagID = params.Tag
+	if params.AnyTag != nil {
+		repoParams.AnyTagIDs = *params.AnyTag
+	}
+	if params.AllTags != nil {
+		repoParams.AllTagIDs = *params.AllTags
+	}
+	repoParams.Query = params.Q
+	repoParams.MinAmount = params.MinAmount
+	repoParams.MaxAmount = params.MaxAmount
+	if params.CategoryIds != nil {
+		repoParams.CategoryIDs = *params.CategoryIds
+	}
+	if params.ExcludeCategoryIds != nil {
+		repoParams.ExcludeCategoryIDs = *params.ExcludeCategoryIds
 	}
 
-	return s.repo.FindAll(userID, repoParams)
+	transactions, hasNext, err := s.repo.FindAll(userID, repoParams, page)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return transactions, nextCursor(key, string(sort), page, transactions, hasNext, func(t *models.Transaction) uint { return t.ID }), nil
 }
 
 func (s *transactionService) FetchTransactionByID(id uint, userID uint) (*models.Transaction, error) {
@@ -58,71 +149,571 @@ func (s *transactionService) CreateTransaction(userID uint, input *api.CreateTra
 	if err := validators.ValidateCreateTransaction(input); err != nil {
 		return nil, err
 	}
+	if err := checkAccount(s.accountRepo, userID, input.AccountId); err != nil {
+		return nil, err
+	}
+	if err := checkCreditCard(s.cardRepo, userID, input.CreditCardId); err != nil {
+		return nil, err
+	}
+	if err := checkTags(s.tagRepo, userID, input.Tags); err != nil {
+		return nil, err
+	}
 
-	description := ""
-	if input.Description != nil {
-		description = *input.Description
+	base, err := baseCurrency(s.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	transaction := newTransaction(userID, input)
+	if err := convertTransaction(s.rateRepo, userID, base, transaction); err != nil {
+		return nil, err
+	}
+
+	// NOTE: 金額の範囲は基準通貨に換算した金額で判定する。分割明細を指定した場合は判定しない
+	if input.AutoCategorize != nil && *input.AutoCategorize && len(transaction.Splits) == 0 {
+		rules, err := s.ruleRepo.FindAll(userID)
+		if err != nil {
+			return nil, err
+		}
+		rule, err := categorize(rules, transaction.Description, transaction.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if rule != nil {
+			transaction.CategoryID = rule.CategoryID
+		}
 	}
 
-	transaction := models.Transaction{
-		UserID:      userID,
-		CategoryID:  uint(input.CategoryId),
-		Amount:      int(input.Amount),
-		Date:        input.Date.Time,
-		Description: description,
+	if input.Force == nil || !*input.Force {
+		if err := s.checkDuplicate(transaction); err != nil {
+			return nil, err
+		}
 	}
 
-	if err := s.repo.Create(&transaction); err != nil {
+	if err := s.repo.Create(transaction); err != nil {
 		if errors.Is(err, repositories.ErrForeignKeyViolation) {
 			return nil, ErrCategoryNotFound
 		}
 		return nil, err
 	}
 
-	return &transaction, nil
+	return transaction, nil
 }
 
 func (s *transactionService) UpdateTransaction(id uint, userID uint, input *api.UpdateTransactionInput) (*models.Transaction, error) {
 	if err := validators.ValidateUpdateTransaction(input); err != nil {
 		return nil, err
 	}
-
-	updates := make(map[string]interface{})
-
-	if input.CategoryId != nil {
-		updates["category_id"] = *input.CategoryId
+	if err := checkAccount(s.accountRepo, userID, input.AccountId); err != nil {
+		return nil, err
 	}
-	if input.Amount != nil {
-		updates["amount"] = *input.Amount
+	if err := checkCreditCard(s.cardRepo, userID, input.CreditCardId); err != nil {
+		return nil, err
 	}
-	if input.Date != nil {
-		updates["date"] = input.Date.Time
+	if err := checkTags(s.tagRepo, userID, input.Tags); err != nil {
+		return nil, err
 	}
-	if input.Description != nil {
-		updates["description"] = *input.Description
+
+	base, err := baseCurrency(s.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+	updates, splits, err := s.convertUpdate(id, userID, base, input)
+	if err != nil {
+		return nil, err
 	}
 
-	transaction, err := s.repo.Update(id, userID, updates)
+	transaction, err := s.repo.Update(id, userID, updates, splits, transactionTags(input.Tags))
 	if err != nil {
 		if errors.Is(err, repositories.ErrNotFound) {
 			return nil, ErrTransactionNotFound
 		}
+		if errors.Is(err, repositories.ErrSplitAmountMismatch) {
+			return nil, splitAmountMismatchError()
+		}
 		if errors.Is(err, repositories.ErrForeignKeyViolation) {
 			return nil, ErrCategoryNotFound
 		}
+		if errors.Is(err, repositories.ErrTransactionLocked) {
+			return nil, ErrTransactionLocked
+		}
 		return nil, err
 	}
 
 	return transaction, nil
 }
 
+// DeleteTransaction は取引をゴミ箱に移動する。添付ファイルは完全に削除されるまでストレージに残す
 func (s *transactionService) DeleteTransaction(id uint, userID uint) error {
 	err := s.repo.Delete(id, userID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrNotFound) {
 			return ErrTransactionNotFound
 		}
+		if errors.Is(err, repositories.ErrTransactionLocked) {
+			return ErrTransactionLocked
+		}
+		return err
+	}
+	return nil
+}
+
+// UnlockTransaction は照合済みの取引のロックを解除する。消込済みの状態は変更しない
+func (s *transactionService) UnlockTransaction(id uint, userID uint) (*models.Transaction, error) {
+	transaction, err := s.repo.Unlock(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// BatchTransactions は取引の作成・更新・削除をまとめて実行する
+// すべての操作を検証してから1つのDBトランザクションで実行し、1件でも失敗した場合はすべて取り消す
+func (s *transactionService) BatchTransactions(userID uint, input *api.BatchTransactionsInput) (*BatchResult, error) {
+	if err := validators.ValidateBatchTransactions(input); err != nil {
+		return nil, err
+	}
+
+	base, err := baseCurrency(s.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BatchResult{Items: make([]BatchResultItem, len(input.Operations))}
+	operations := make([]repositories.TransactionBatchOperation, len(input.Operations))
+	valid := true
+	for i := range input.Operations {
+		operation := &input.Operations[i]
+		result.Items[i].Action = operation.Action
+
+		if err := validators.ValidateBatchTransactionOperation(operation); err != nil {
+			validationErrs, ok := err.(validation.Errors)
+			if !ok {
+				return nil, err
+			}
+			result.Items[i].Errors = validationErrs
+			valid = false
+			continue
+		}
+		if err := checkAccount(s.accountRepo, userID, batchAccountID(operation)); err != nil {
+			if !errors.Is(err, ErrAccountNotFound) {
+				return nil, err
+			}
+			result.Items[i].Errors = validation.Errors{
+				"account_id": validation.NewError("not_found", "指定された口座が見つかりません"),
+			}
+			valid = false
+			continue
+		}
+		if err := checkCreditCard(s.cardRepo, userID, batchCreditCardID(operation)); err != nil {
+			if !errors.Is(err, ErrCreditCardNotFound) {
+				return nil, err
+			}
+			result.Items[i].Errors = validation.Errors{
+				"credit_card_id": validation.NewError("not_found", "指定されたクレジットカードが見つかりません"),
+			}
+			valid = false
+			continue
+		}
+		if err := checkTags(s.tagRepo, userID, batchTagIDs(operation)); err != nil {
+			if !errors.Is(err, ErrTagNotFound) {
+				return nil, err
+			}
+			result.Items[i].Errors = validation.Errors{
+				"tags": validation.NewError("not_found", "指定されたタグが見つかりません"),
+			}
+			valid = false
+			continue
+		}
+
+		batchOperation, err := s.toBatchOperation(userID, base, operation)
+		if err != nil {
+			switch {
+			case errors.Is(err, ErrExchangeRateNotFound):
+				result.Items[i].Errors = validation.Errors{
+					"currency": validation.NewError("not_found", "取引日以前の為替レートが登録されていません"),
+				}
+			case errors.Is(err, ErrTransactionNotFound):
+				result.Items[i].Errors = validation.Errors{
+					"id": validation.NewError("not_found", "取引が見つかりません"),
+				}
+			default:
+				validationErrs, ok := err.(validation.Errors)
+				if !ok {
+					return nil, err
+				}
+				result.Items[i].Errors = validationErrs
+			}
+			valid = false
+			continue
+		}
+		operations[i] = batchOperation
+	}
+	if !valid {
+		return result, nil
+	}
+
+	transactions, err := s.repo.ApplyBatch(userID, operations)
+	if err != nil {
+		var batchErr *repositories.BatchError
+		if !errors.As(err, &batchErr) {
+			return nil, err
+		}
+		switch {
+		case errors.Is(batchErr.Err, repositories.ErrNotFound):
+			result.Items[batchErr.Index].Errors = validation.Errors{
+				"id": validation.NewError("not_found", "取引が見つかりません"),
+			}
+		case errors.Is(batchErr.Err, repositories.ErrForeignKeyViolation):
+			result.Items[batchErr.Index].Errors = validation.Errors{
+				"category_id": validation.NewError("not_found", "指定されたカテゴリが見つかりません"),
+			}
+		case errors.Is(batchErr.Err, repositories.ErrSplitAmountMismatch):
+			result.Items[batchErr.Index].Errors = splitAmountMismatchError()
+		case errors.Is(batchErr.Err, repositories.ErrTransactionLocked):
+			result.Items[batchErr.Index].Errors = validation.Errors{
+				"id": validation.NewError("locked", "照合済みの取引は変更できません。ロックを解除してから操作してください"),
+			}
+		default:
+			return nil, batchErr.Err
+		}
+		return result, nil
+	}
+
+	result.Applied = true
+	for i, transaction := range transactions {
+		result.Items[i].Transaction = transaction
+	}
+	return result, nil
+}
+
+// RecategorizeTransactions は条件に一致するすべての取引を指定したカテゴリに変更し、変更した件数を返す
+func (s *transactionService) RecategorizeTransactions(userID uint, input *api.RecategorizeTransactionsInput) (int, error) {
+	if err := validators.ValidateRecategorizeTransactions(input); err != nil {
+		return 0, err
+	}
+
+	filter := input.Filter
+	params := toTransactionFindParams(filter.StartDate, filter.EndDate, filter.Type, filter.CategoryId)
+	params.Query = filter.Q
+	params.MinAmount = filter.MinAmount
+	params.MaxAmount = filter.MaxAmount
+	if filter.CategoryIds != nil {
+		params.CategoryIDs = *filter.CategoryIds
+	}
+	if filter.ExcludeCategoryIds != nil {
+		params.ExcludeCategoryIDs = *filter.ExcludeCategoryIds
+	}
+
+	updated, err := s.repo.UpdateCategoryByFilter(userID, params, uint(input.CategoryId))
+	if err != nil {
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return 0, ErrCategoryNotFound
+		}
+		return 0, err
+	}
+
+	return int(updated), nil
+}
+
+// ExportTransactions は条件に一致する取引を指定された形式で出力する Reader を返す
+// 取引はDBから1件ずつ読み込みながら書き出すため、Reader を読み進めた分だけ処理が進む
+// NOTE: 出力開始後にDBエラーが発生した場合は、Reader の読み込みがそのエラーで終了する
+// ctx がキャンセルされた場合（クライアントの切断など）はDBからの読み込みを中断する
+func (s *transactionService) ExportTransactions(ctx context.Context, userID uint, params *api.GetTransactionsExportParams) (io.ReadCloser, error) {
+	if err := validators.ValidateGetTransactionsExport(params); err != nil {
+		return nil, err
+	}
+
+	repoParams := toTransactionFindParams(params.StartDate, params.EndDate, params.Type, params.CategoryId)
+
+	reader, writer := io.Pipe()
+	go func() {
+		buffered := bufio.NewWriter(writer)
+		err := s.writeExport(ctx, buffered, params.Format, userID, repoParams)
+		if err == nil {
+			err = buffered.Flush()
+		}
+		writer.CloseWithError(err)
+	}()
+
+	return reader, nil
+}
+
+func (s *transactionService) writeExport(ctx context.Context, w io.Writer, format api.TransactionExportFormat, userID uint, params *repositories.TransactionFindParams) error {
+	exporter, err := newExportWriter(format, w)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Each(ctx, userID, params, exporter.Write); err != nil {
 		return err
 	}
+	return exporter.Flush()
+}
+
+func toTransactionFindParams(startDate, endDate *string, categoryType *api.CategoryType, categoryID *int32) *repositories.TransactionFindParams {
+	params := &repositories.TransactionFindParams{
+		StartDate:  startDate,
+		EndDate:    endDate,
+		CategoryID: categoryID,
+	}
+	if categoryType != nil {
+		typeStr := string(*categoryType)
+		params.Type = &typeStr
+	}
+	return params
+}
+
+func newTransaction(userID uint, input *api.CreateTransactionInput) *models.Transaction {
+	description := ""
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	var accountID *uint
+	if input.AccountId != nil {
+		id := uint(*input.AccountId)
+		accountID = &id
+	}
+
+	var creditCardID *uint
+	if input.CreditCardId != nil {
+		id := uint(*input.CreditCardId)
+		creditCardID = &id
+	}
+
+	currency := ""
+	if input.Currency != nil {
+		currency = *input.Currency
+	}
+
+	cleared := false
+	if input.Cleared != nil {
+		cleared = *input.Cleared
+	}
+
+	return &models.Transaction{
+		UserID:         userID,
+		CategoryID:     uint(input.CategoryId),
+		AccountID:      accountID,
+		CreditCardID:   creditCardID,
+		Cleared:        cleared,
+		Currency:       currency,
+		OriginalAmount: int(input.Amount),
+		Date:           input.Date.Time,
+		Description:    description,
+		Splits:         transactionSplits(input.Splits),
+		Tags:           transactionTags(input.Tags),
+	}
+}
+
+// transactionSplits は分割明細の入力をモデルに変換する。未指定の場合は nil、空配列の場合は空のスライスを返す
+// 入力の金額は元の金額として扱い、基準通貨の金額は convertTransaction で設定する
+func transactionSplits(inputs *[]api.TransactionSplitInput) []models.TransactionSplit {
+	if inputs == nil {
+		return nil
+	}
+
+	splits := make([]models.TransactionSplit, len(*inputs))
+	for i, input := range *inputs {
+		memo := ""
+		if input.Memo != nil {
+			memo = *input.Memo
+		}
+		splits[i] = models.TransactionSplit{
+			CategoryID:     uint(input.CategoryId),
+			OriginalAmount: int(input.Amount),
+			Memo:           memo,
+		}
+	}
+	return splits
+}
+
+// FetchTransactionHistory は取引の変更履歴を古い順に取得する。ゴミ箱にある取引の履歴も取得できる
+func (s *transactionService) FetchTransactionHistory(id uint, userID uint) ([]models.ChangeHistory, error) {
+	histories, err := s.historyRepo.FindByRecord(models.ChangeRecordTypeTransaction, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: ゴミ箱にある取引には必ず削除の履歴があるため、履歴がない場合のみ取引の存在を確認する
+	// （変更履歴の記録を始める前に登録された取引は履歴が空になる）
+	if len(histories) == 0 {
+		if _, err := s.FetchTransactionByID(id, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return histories, nil
+}
+
+// splitAmountMismatchError は更新後の分割明細の金額の合計が取引の金額と一致しない場合のバリデーションエラーを返す
+func splitAmountMismatchError() validation.Errors {
+	return validation.Errors{
+		"splits": validation.NewError("split_amount_mismatch", "分割明細の金額の合計は取引の金額と一致させてください"),
+	}
+}
+
+// transactionUpdates は金額・通貨以外の更新内容を返す。金額・通貨は convertUpdate で換算して設定する
+func transactionUpdates(input *api.UpdateTransactionInput) map[string]interface{} {
+	updates := make(map[string]interface{})
+
+	if input.CategoryId != nil {
+		updates["category_id"] = *input.CategoryId
+	}
+	if input.AccountId != nil {
+		updates["account_id"] = *input.AccountId
+	}
+	if input.CreditCardId != nil {
+		updates["credit_card_id"] = *input.CreditCardId
+	}
+	if input.Cleared != nil {
+		updates["cleared"] = *input.Cleared
+	}
+	if input.Date != nil {
+		updates["date"] = input.Date.Time
+	}
+	if input.Description != nil {
+		updates["description"] = *input.Description
+	}
+
+	return updates
+}
+
+// batchAccountID は一括操作で指定された口座IDを返す
+func batchAccountID(operation *api.BatchTransactionOperation) *int32 {
+	switch operation.Action {
+	case api.BatchTransactionActionCreate:
+		return operation.Create.AccountId
+	case api.BatchTransactionActionUpdate:
+		return operation.Update.AccountId
+	}
 	return nil
 }
+
+// batchCreditCardID は一括操作で指定されたクレジットカードIDを返す
+func batchCreditCardID(operation *api.BatchTransactionOperation) *int32 {
+	switch operation.Action {
+	case api.BatchTransactionActionCreate:
+		return operation.Create.CreditCardId
+	case api.BatchTransactionActionUpdate:
+		return operation.Update.CreditCardId
+	}
+	return nil
+}
+
+// batchTagIDs は一括操作で指定されたタグIDを返す
+func batchTagIDs(operation *api.BatchTransactionOperation) *[]int32 {
+	switch operation.Action {
+	case api.BatchTransactionActionCreate:
+		return operation.Create.Tags
+	case api.BatchTransactionActionUpdate:
+		return operation.Update.Tags
+	}
+	return nil
+}
+
+// convertUpdate は取引の更新内容と、置き換える分割明細を返す
+// 金額・通貨・取引日・分割明細のいずれかを変更する場合は、更新後の値で基準通貨の金額を換算し直す
+// 分割明細を指定せずに換算後の金額が変わる場合は、既存の分割明細も換算し直して置き換える
+func (s *transactionService) convertUpdate(id uint, userID uint, base string, input *api.UpdateTransactionInput) (map[string]interface{}, []models.TransactionSplit, error) {
+	updates := transactionUpdates(input)
+	splits := transactionSplits(input.Splits)
+	if input.Amount == nil && input.Currency == nil && input.Date == nil && input.Splits == nil {
+		return updates, splits, nil
+	}
+
+	existing, err := s.FetchTransactionByID(id, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transaction := &models.Transaction{
+		Currency:       existing.Currency,
+		OriginalAmount: existing.OriginalAmount,
+		Date:           existing.Date,
+		Splits:         splits,
+	}
+	if input.Currency != nil {
+		transaction.Currency = *input.Currency
+	}
+	if input.Amount != nil {
+		transaction.OriginalAmount = int(*input.Amount)
+	}
+	if input.Date != nil {
+		transaction.Date = input.Date.Time
+	}
+	if splits == nil {
+		transaction.Splits = make([]models.TransactionSplit, len(existing.Splits))
+		for i, split := range existing.Splits {
+			transaction.Splits[i] = models.TransactionSplit{
+				CategoryID:     split.CategoryID,
+				Amount:         split.Amount,
+				OriginalAmount: split.OriginalAmount,
+				Memo:           split.Memo,
+			}
+		}
+	}
+
+	// 元の金額の合計を確認（換算後は端数の調整で常に一致するため、換算前に確認する）
+	if len(transaction.Splits) > 0 {
+		total := 0
+		for _, split := range transaction.Splits {
+			total += split.OriginalAmount
+		}
+		if total != transaction.OriginalAmount {
+			return nil, nil, splitAmountMismatchError()
+		}
+	}
+
+	if err := convertTransaction(s.rateRepo, userID, base, transaction); err != nil {
+		return nil, nil, err
+	}
+
+	updates["currency"] = transaction.Currency
+	updates["original_amount"] = transaction.OriginalAmount
+	updates["amount"] = transaction.Amount
+	if transaction.ExchangeRate != nil {
+		updates["exchange_rate"] = *transaction.ExchangeRate
+	} else {
+		updates["exchange_rate"] = nil
+	}
+
+	if splits == nil {
+		for i, split := range existing.Splits {
+			if transaction.Splits[i].Amount != split.Amount {
+				return updates, transaction.Splits, nil
+			}
+		}
+		return updates, nil, nil
+	}
+	return updates, transaction.Splits, nil
+}
+
+func (s *transactionService) toBatchOperation(userID uint, base string, operation *api.BatchTransactionOperation) (repositories.TransactionBatchOperation, error) {
+	batchOperation := repositories.TransactionBatchOperation{
+		Action: repositories.TransactionBatchAction(operation.Action),
+	}
+	if operation.Id != nil {
+		batchOperation.ID = uint(*operation.Id)
+	}
+
+	switch operation.Action {
+	case api.BatchTransactionActionCreate:
+		batchOperation.Transaction = newTransaction(userID, operation.Create)
+		if err := convertTransaction(s.rateRepo, userID, base, batchOperation.Transaction); err != nil {
+			return batchOperation, err
+		}
+	case api.BatchTransactionActionUpdate:
+		updates, splits, err := s.convertUpdate(batchOperation.ID, userID, base, operation.Update)
+		if err != nil {
+			return batchOperation, err
+		}
+		batchOperation.Updates = updates
+		batchOperation.Splits = splits
+		batchOperation.Tags = transactionTags(operation.Update.Tags)
+	}
+	return batchOperation, nil
+}
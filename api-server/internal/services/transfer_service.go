@@ -0,0 +1,96 @@
+package services
+
+import (
+	"errors"
+
+	api "apps/apis"
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/internal/validators"
+)
+
+type TransferService interface {
+	FetchTransfers(userID uint, params *api.GetTransfersParams) ([]models.Transfer, error)
+	FetchTransferByID(id uint, userID uint) (*models.Transfer, error)
+	CreateTransfer(userID uint, input *api.CreateTransferInput) (*models.Transfer, error)
+	DeleteTransfer(id uint, userID uint) error
+}
+
+type transferService struct {
+	repo        repositories.TransferRepository
+	accountRepo repositories.AccountRepository
+}
+
+func NewTransferService(repo repositories.TransferRepository, accountRepo repositories.AccountRepository) TransferService {
+	return &transferService{repo: repo, accountRepo: accountRepo}
+}
+
+func (s *transferService) FetchTransfers(userID uint, params *api.GetTransfersParams) ([]models.Transfer, error) {
+	if err := validators.ValidateGetTransfers(params); err != nil {
+		return nil, err
+	}
+
+	return s.repo.FindAll(userID, &repositories.TransferFindParams{
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+		AccountID: params.AccountId,
+	})
+}
+
+func (s *transferService) FetchTransferByID(id uint, userID uint) (*models.Transfer, error) {
+	transfer, err := s.repo.FindByID(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, err
+	}
+	return transfer, nil
+}
+
+// CreateTransfer は口座間の振替を作成する。振替元・振替先はどちらもユーザーの口座である必要がある
+func (s *transferService) CreateTransfer(userID uint, input *api.CreateTransferInput) (*models.Transfer, error) {
+	if err := validators.ValidateCreateTransfer(input); err != nil {
+		return nil, err
+	}
+
+	for _, accountID := range []int32{input.FromAccountId, input.ToAccountId} {
+		if err := checkAccount(s.accountRepo, userID, &accountID); err != nil {
+			return nil, err
+		}
+	}
+
+	description := ""
+	if input.Description != nil {
+		description = *input.Description
+	}
+
+	transfer := models.Transfer{
+		UserID:        userID,
+		FromAccountID: uint(input.FromAccountId),
+		ToAccountID:   uint(input.ToAccountId),
+		Amount:        int(input.Amount),
+		Date:          input.Date.Time,
+		Description:   description,
+	}
+
+	if err := s.repo.Create(&transfer); err != nil {
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrAccountNotFound
+		}
+		return nil, err
+	}
+
+	return &transfer, nil
+}
+
+func (s *transferService) DeleteTransfer(id uint, userID uint) error {
+	err := s.repo.Delete(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrTransferNotFound
+		}
+		return err
+	}
+	return nil
+}
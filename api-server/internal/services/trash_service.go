@@ -0,0 +1,181 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"apps/internal/models"
+	"apps/internal/repositories"
+	"apps/storage"
+)
+
+// Trash はゴミ箱にある取引・予算・カテゴリ
+type Trash struct {
+	Transactions []models.Transaction
+	Budgets      []models.Budget
+	Categories   []models.Category
+	Retention    time.Duration
+}
+
+// PurgeAt は deletedAt にゴミ箱に移動した行が自動的に完全削除される日時を返す
+func (t *Trash) PurgeAt(deletedAt time.Time) time.Time {
+	return deletedAt.Add(t.Retention)
+}
+
+type TrashService interface {
+	FetchTrash(userID uint) (*Trash, error)
+	RestoreTransaction(id uint, userID uint) (*models.Transaction, error)
+	RestoreBudget(id uint, userID uint) (*models.Budget, error)
+	RestoreCategory(id uint, userID uint) (*models.Category, error)
+	PurgeTransaction(id uint, userID uint) error
+	PurgeBudget(id uint, userID uint) error
+	PurgeCategory(id uint, userID uint) error
+	PurgeExpired() (int, error)
+}
+
+type trashService struct {
+	transactionRepo repositories.TransactionRepository
+	budgetRepo      repositories.BudgetRepository
+	categoryRepo    repositories.CategoryRepository
+	storage         storage.Storage
+	retention       time.Duration
+}
+
+// NewTrashService はゴミ箱のサービスを返す。ゴミ箱に移動してから retention を過ぎた行は PurgeExpired で完全に削除する
+func NewTrashService(transactionRepo repositories.TransactionRepository, budgetRepo repositories.BudgetRepository, categoryRepo repositories.CategoryRepository, storage storage.Storage, retention time.Duration) TrashService {
+	return &trashService{
+		transactionRepo: transactionRepo,
+		budgetRepo:      budgetRepo,
+		categoryRepo:    categoryRepo,
+		storage:         storage,
+		retention:       retention,
+	}
+}
+
+func (s *trashService) FetchTrash(userID uint) (*Trash, error) {
+	transactions, err := s.transactionRepo.FindDeleted(userID)
+	if err != nil {
+		return nil, err
+	}
+	budgets, err := s.budgetRepo.FindDeleted(userID)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.categoryRepo.FindDeleted(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Trash{
+		Transactions: transactions,
+		Budgets:      budgets,
+		Categories:   categories,
+		Retention:    s.retention,
+	}, nil
+}
+
+// RestoreTransaction はゴミ箱にある取引を元に戻す。カテゴリがゴミ箱にある場合は先にカテゴリを元に戻す必要がある
+func (s *trashService) RestoreTransaction(id uint, userID uint) (*models.Transaction, error) {
+	transaction, err := s.transactionRepo.Restore(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrTransactionNotFound
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryInTrash
+		}
+		return nil, err
+	}
+	return transaction, nil
+}
+
+// RestoreBudget はゴミ箱にある予算を元に戻す。同じカテゴリ・月の予算が既にある場合は元に戻せない
+func (s *trashService) RestoreBudget(id uint, userID uint) (*models.Budget, error) {
+	budget, err := s.budgetRepo.Restore(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrBudgetNotFound
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return nil, ErrCategoryInTrash
+		}
+		if errors.Is(err, repositories.ErrDuplicateEntry) {
+			return nil, ErrBudgetAlreadyExists
+		}
+		return nil, err
+	}
+	return budget, nil
+}
+
+func (s *trashService) RestoreCategory(id uint, userID uint) (*models.Category, error) {
+	category, err := s.categoryRepo.Restore(id, userID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrCategoryNotFound
+		}
+		return nil, err
+	}
+	return category, nil
+}
+
+// PurgeTransaction はゴミ箱にある取引を完全に削除する。添付ファイルもストレージから削除する
+func (s *trashService) PurgeTransaction(id uint, userID uint) error {
+	if err := s.transactionRepo.Purge(id, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrTransactionNotFound
+		}
+		return err
+	}
+	deleteTransactionAttachmentFiles(s.storage, userID, id)
+	return nil
+}
+
+func (s *trashService) PurgeBudget(id uint, userID uint) error {
+	if err := s.budgetRepo.Purge(id, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrBudgetNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// PurgeCategory はゴミ箱にあるカテゴリを完全に削除する。ゴミ箱にある取引・予算から参照されている場合は削除できない
+func (s *trashService) PurgeCategory(id uint, userID uint) error {
+	if err := s.categoryRepo.Purge(id, userID); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrCategoryNotFound
+		}
+		if errors.Is(err, repositories.ErrForeignKeyViolation) {
+			return ErrCategoryInUse
+		}
+		return err
+	}
+	return nil
+}
+
+// PurgeExpired は保持期間を過ぎたゴミ箱の行をすべてのユーザーについて完全に削除し、削除した件数を返す
+// カテゴリを参照する取引・予算を先に削除する
+func (s *trashService) PurgeExpired() (int, error) {
+	before := time.Now().Add(-s.retention)
+
+	transactions, err := s.transactionRepo.PurgeDeletedBefore(before)
+	if err != nil {
+		return 0, err
+	}
+	for _, transaction := range transactions {
+		deleteTransactionAttachmentFiles(s.storage, transaction.UserID, transaction.ID)
+	}
+
+	budgets, err := s.budgetRepo.PurgeDeletedBefore(before)
+	if err != nil {
+		return len(transactions), err
+	}
+
+	categories, err := s.categoryRepo.PurgeDeletedBefore(before)
+	if err != nil {
+		return len(transactions) + int(budgets), err
+	}
+
+	return len(transactions) + int(budgets) + int(categories), nil
+}
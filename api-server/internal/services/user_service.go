@@ -10,6 +10,7 @@ import (
 	"apps/internal/models"
 	"apps/internal/repositories"
 	"apps/internal/validators"
+	"apps/storage"
 
 	"github.com/golang-jwt/jwt/v5"
 	"golang.org/x/crypto/bcrypt"
@@ -19,14 +20,18 @@ type UserService interface {
 	SignUp(input *api.UserSignUpInput) (string, error)
 	SignIn(input *api.UserSignInInput) (string, error)
 	ExistsUser(id uint) bool
+	FetchCurrentUser(id uint) (*models.User, error)
+	UpdateCurrentUser(id uint, input *api.UserUpdateCurrentUserInput) (*models.User, error)
+	DeleteUser(id uint) error
 }
 
 type userService struct {
-	repo repositories.UserRepository
+	repo    repositories.UserRepository
+	storage storage.Storage
 }
 
-func NewUserService(repo repositories.UserRepository) UserService {
-	return &userService{repo: repo}
+func NewUserService(repo repositories.UserRepository, storage storage.Storage) UserService {
+	return &userService{repo: repo, storage: storage}
 }
 
 // SignUp - 会員登録
@@ -54,6 +59,12 @@ func (us *userService) SignUp(input *api.UserSignUpInput) (string, error) {
 		Email:    input.Email,
 		Password: hashedPassword,
 	}
+	if input.BaseCurrency != nil {
+		user.BaseCurrency = *input.BaseCurrency
+	}
+	if input.TimeZone != nil {
+		user.TimeZone = *input.TimeZone
+	}
 
 	if err := us.repo.Create(&user); err != nil {
 		return "", err
@@ -110,6 +121,108 @@ func (us *userService) ExistsUser(id uint) bool {
 	return exists
 }
 
+// FetchCurrentUser - ログイン中のユーザー情報取得
+func (us *userService) FetchCurrentUser(id uint) (*models.User, error) {
+	user, err := us.repo.FindByID(id)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// UpdateCurrentUser - ログイン中のユーザー情報更新
+// 取引・予算・定期取引・振替・口座の初期残高などは基準通貨の補助単位で金額を保持しているため、これらがある場合は基準通貨を変更できない
+func (us *userService) UpdateCurrentUser(id uint, input *api.UserUpdateCurrentUserInput) (*models.User, error) {
+	if err := validators.ValidateUpdateCurrentUser(input); err != nil {
+		return nil, err
+	}
+
+	updates := make(map[string]interface{})
+	if input.Name != nil {
+		updates["name"] = *input.Name
+	}
+	if input.BaseCurrency != nil {
+		current, err := us.FetchCurrentUser(id)
+		if err != nil {
+			return nil, err
+		}
+		if current.BaseCurrency != *input.BaseCurrency {
+			hasAmounts, err := us.repo.HasBaseCurrencyAmounts(id)
+			if err != nil {
+				return nil, err
+			}
+			if hasAmounts {
+				return nil, ErrBaseCurrencyInUse
+			}
+			updates["base_currency"] = *input.BaseCurrency
+		}
+	}
+	if input.TimeZone != nil {
+		updates["time_zone"] = *input.TimeZone
+	}
+	if input.MonthStartDay != nil {
+		updates["month_start_day"] = int(*input.MonthStartDay)
+	}
+	if input.MonthStartAdjustment != nil {
+		updates["month_start_adjustment"] = string(*input.MonthStartAdjustment)
+	}
+
+	user, err := us.repo.Update(id, updates)
+	if err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// DeleteUser - ユーザー削除
+// ユーザーのすべてのデータを削除した後、ストレージにあるユーザーの添付ファイルを削除する
+// NOTE: ユーザーを削除する処理は、添付ファイルがストレージに残らないよう必ずこのメソッドを使う
+func (us *userService) DeleteUser(id uint) error {
+	if err := us.repo.Delete(id); err != nil {
+		if errors.Is(err, repositories.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+
+	deleteUserAttachmentFiles(us.storage, id)
+	return nil
+}
+
+// baseCurrency はユーザーの基準通貨を返す
+func baseCurrency(repo repositories.UserRepository, userID uint) (string, error) {
+	user, err := repo.FindByID(userID)
+	if err != nil {
+		return "", err
+	}
+	return user.BaseCurrency, nil
+}
+
+// userToday はユーザーのタイムゾーンでの今日の日付を、その日のUTCの0時として返す
+// NOTE: 日付はUTCの0時として保存しているため、サーバーのタイムゾーンではなくユーザーのタイムゾーンで日付を判定する
+func userToday(repo repositories.UserRepository, userID uint) (time.Time, error) {
+	user, err := repo.FindByID(userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return todayIn(user.TimeZone, time.Now())
+}
+
+// todayIn は now のタイムゾーン timeZone での日付を、その日のUTCの0時として返す
+func todayIn(timeZone string, now time.Time) (time.Time, error) {
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return toDate(now.In(loc)), nil
+}
+
 func (us *userService) encryptPassword(password string) (string, error) {
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
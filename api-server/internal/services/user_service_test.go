@@ -0,0 +1,75 @@
+package services
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTodayIn(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeZone string
+		now      string
+		want     string
+	}{
+		// 2026-03-08 02:00 EST -> 03:00 EDT
+		{"ニューヨーク 夏時間開始の前日深夜", "America/New_York", "2026-03-08T04:59:00Z", "2026-03-07"},
+		{"ニューヨーク 夏時間開始の直前", "America/New_York", "2026-03-08T06:59:00Z", "2026-03-08"},
+		{"ニューヨーク 夏時間開始の直後", "America/New_York", "2026-03-08T07:00:00Z", "2026-03-08"},
+		{"ニューヨーク 夏時間開始日の終わり", "America/New_York", "2026-03-09T03:59:00Z", "2026-03-08"},
+		{"ニューヨーク 夏時間開始の翌日", "America/New_York", "2026-03-09T04:00:00Z", "2026-03-09"},
+		// 2026-11-01 02:00 EDT -> 01:00 EST
+		{"ニューヨーク 夏時間終了日", "America/New_York", "2026-11-01T05:30:00Z", "2026-11-01"},
+		{"ニューヨーク 夏時間終了日の終わり", "America/New_York", "2026-11-02T04:59:00Z", "2026-11-01"},
+		{"ニューヨーク 夏時間終了の翌日", "America/New_York", "2026-11-02T05:00:00Z", "2026-11-02"},
+		{"ニューヨーク 大晦日", "America/New_York", "2027-01-01T04:59:00Z", "2026-12-31"},
+		{"ニューヨーク 元日", "America/New_York", "2027-01-01T05:00:00Z", "2027-01-01"},
+		// 2026-03-29 01:00 GMT -> 02:00 BST
+		{"ロンドン 夏時間開始の前日", "Europe/London", "2026-03-28T23:59:00Z", "2026-03-28"},
+		{"ロンドン 夏時間開始の直前", "Europe/London", "2026-03-29T00:59:00Z", "2026-03-29"},
+		{"ロンドン 夏時間開始の翌日", "Europe/London", "2026-03-29T23:00:00Z", "2026-03-30"},
+		// 2026-10-25 02:00 BST -> 01:00 GMT
+		{"ロンドン 夏時間終了の前日", "Europe/London", "2026-10-24T22:59:00Z", "2026-10-24"},
+		{"ロンドン 夏時間終了日", "Europe/London", "2026-10-24T23:00:00Z", "2026-10-25"},
+		{"ロンドン 夏時間終了日の終わり", "Europe/London", "2026-10-25T23:59:00Z", "2026-10-25"},
+		// UTC+13（夏時間）/ UTC+12
+		{"オークランド 大晦日", "Pacific/Auckland", "2025-12-31T10:59:00Z", "2025-12-31"},
+		{"オークランド 元日", "Pacific/Auckland", "2025-12-31T11:00:00Z", "2026-01-01"},
+		{"オークランド 夏時間終了の前日", "Pacific/Auckland", "2026-04-04T10:59:00Z", "2026-04-04"},
+		{"オークランド 夏時間終了日", "Pacific/Auckland", "2026-04-04T11:00:00Z", "2026-04-05"},
+		{"オークランド 夏時間終了日の終わり", "Pacific/Auckland", "2026-04-05T11:59:00Z", "2026-04-05"},
+		{"オークランド 夏時間終了の翌日", "Pacific/Auckland", "2026-04-05T12:00:00Z", "2026-04-06"},
+		{"東京 うるう日", "Asia/Tokyo", "2028-02-29T14:59:00Z", "2028-02-29"},
+		{"東京 うるう年の3月1日", "Asia/Tokyo", "2028-02-29T15:00:00Z", "2028-03-01"},
+		{"東京 平年の2月末日", "Asia/Tokyo", "2027-02-28T14:59:00Z", "2027-02-28"},
+		{"東京 平年の3月1日", "Asia/Tokyo", "2027-02-28T15:00:00Z", "2027-03-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now, err := time.Parse(time.RFC3339, tt.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := time.Parse(dateLayout, tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := todayIn(tt.timeZone, now)
+			if err != nil {
+				t.Fatalf("todayIn(%q, %s) returned error: %v", tt.timeZone, tt.now, err)
+			}
+			// NOTE: 取引日と比較できるよう、その日のUTCの0時で返す
+			if !got.Equal(want) || got.Location() != time.UTC {
+				t.Errorf("todayIn(%q, %s) = %s, want %s", tt.timeZone, tt.now, got, want)
+			}
+		})
+	}
+}
+
+func TestTodayInInvalidTimeZone(t *testing.T) {
+	if _, err := todayIn("Invalid/Zone", time.Now()); err == nil {
+		t.Error("todayIn(\"Invalid/Zone\") returned no error")
+	}
+}
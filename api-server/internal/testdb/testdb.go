@@ -0,0 +1,294 @@
+// Package testdb はDBサーバーなしでリポジトリ・サービス・ハンドラーをテストするためのDB接続を提供する
+// 実行したSQLを記録し、クエリには登録した応答（登録がない場合は0件）を返す
+package testdb
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// Statement は実行したSQLと引数
+type Statement struct {
+	Query string
+	Args  []driver.Value
+}
+
+var insertRegex = regexp.MustCompile("^INSERT INTO `\\w+` \\(([^)]*)\\) VALUES \\((.*?)\\)(,\\(|$| ON )")
+
+// Inserted はINSERT文の1行目の値を列名ごとに返す。プレースホルダーでない値（NULL など）は nil とする
+func (s Statement) Inserted() map[string]driver.Value {
+	m := insertRegex.FindStringSubmatch(s.Query)
+	if m == nil {
+		return nil
+	}
+	columns := strings.Split(m[1], ",")
+	placeholders := strings.Split(m[2], ",")
+	values := make(map[string]driver.Value, len(columns))
+	arg := 0
+	for i, column := range columns {
+		var value driver.Value
+		if i < len(placeholders) && placeholders[i] == "?" && arg < len(s.Args) {
+			value = s.Args[arg]
+			arg++
+		}
+		values[strings.Trim(column, "`")] = value
+	}
+	return values
+}
+
+// Rows はクエリの応答
+type Rows struct {
+	Columns []string
+	Values  [][]driver.Value
+}
+
+// Responder はクエリと引数に応じた応答を返す。nil を返した場合は次に一致する応答を探す
+type Responder func(query string, args []driver.Value) *Rows
+
+// Affecter はSQLの実行で変更された件数を返す
+type Affecter func(query string, args []driver.Value) int64
+
+type route struct {
+	pattern *regexp.Regexp
+	respond Responder
+}
+
+type execRoute struct {
+	pattern *regexp.Regexp
+	affect  Affecter
+}
+
+// DB は実行したSQLを記録するDB接続
+type DB struct {
+	mu         sync.Mutex
+	routes     []route
+	execRoutes []execRoute
+	statements []Statement
+	lastID     int64
+}
+
+var (
+	registerOnce sync.Once
+	connectors   sync.Map
+	nextDSN      int64
+	nextDSNMu    sync.Mutex
+)
+
+// New はテスト用のDB接続を作成する
+func New(t testing.TB) (*gorm.DB, *DB) {
+	t.Helper()
+	registerOnce.Do(func() {
+		sql.Register("testdb", &fakeDriver{})
+	})
+
+	nextDSNMu.Lock()
+	nextDSN++
+	dsn := fmt.Sprintf("testdb-%d", nextDSN)
+	nextDSNMu.Unlock()
+
+	fake := &DB{}
+	connectors.Store(dsn, fake)
+	t.Cleanup(func() { connectors.Delete(dsn) })
+
+	sqlDB, err := sql.Open("testdb", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{
+		Logger: logger.Discard,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db, fake
+}
+
+// On は pattern に一致するクエリに respond の応答を返すよう登録する。後に登録したものを優先する
+func (d *DB) On(pattern string, respond Responder) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.routes = append([]route{{pattern: regexp.MustCompile(pattern), respond: respond}}, d.routes...)
+}
+
+// Returns は pattern に一致するクエリに常に同じ行を返すよう登録する
+func (d *DB) Returns(pattern string, columns []string, values ...[]driver.Value) {
+	d.On(pattern, func(string, []driver.Value) *Rows {
+		return &Rows{Columns: columns, Values: values}
+	})
+}
+
+// Affects は pattern に一致するSQLの実行で affect が返す件数を変更された件数とするよう登録する
+// 登録がない場合は1件とする。後に登録したものを優先する
+func (d *DB) Affects(pattern string, affect Affecter) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.execRoutes = append([]execRoute{{pattern: regexp.MustCompile(pattern), affect: affect}}, d.execRoutes...)
+}
+
+// Statements は実行したSQLのうち pattern に一致するものを実行順に返す
+func (d *DB) Statements(pattern string) []Statement {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	re := regexp.MustCompile(pattern)
+	var matched []Statement
+	for _, s := range d.statements {
+		if re.MatchString(s.Query) {
+			matched = append(matched, s)
+		}
+	}
+	return matched
+}
+
+func (d *DB) record(query string, args []driver.NamedValue) []driver.Value {
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	d.mu.Lock()
+	d.statements = append(d.statements, Statement{Query: query, Args: values})
+	d.mu.Unlock()
+	return values
+}
+
+func (d *DB) query(query string, args []driver.NamedValue) *Rows {
+	values := d.record(query, args)
+	d.mu.Lock()
+	routes := d.routes
+	d.mu.Unlock()
+	for _, r := range routes {
+		if r.pattern.MatchString(query) {
+			if rows := r.respond(query, values); rows != nil {
+				return rows
+			}
+		}
+	}
+	return &Rows{}
+}
+
+func (d *DB) exec(query string, args []driver.NamedValue) driver.Result {
+	values := d.record(query, args)
+	d.mu.Lock()
+	execRoutes := d.execRoutes
+	d.mu.Unlock()
+
+	var affected int64 = 1
+	for _, r := range execRoutes {
+		if r.pattern.MatchString(query) {
+			affected = r.affect(query, values)
+			break
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if affected == 0 {
+		return result{}
+	}
+	d.lastID++
+	return result{lastID: d.lastID, affected: affected}
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(dsn string) (driver.Conn, error) {
+	fake, ok := connectors.Load(dsn)
+	if !ok {
+		return nil, fmt.Errorf("testdb: unknown dsn %q", dsn)
+	}
+	return &conn{db: fake.(*DB)}, nil
+}
+
+type conn struct {
+	db *DB
+}
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("testdb: prepared statements are not supported")
+}
+
+func (c *conn) Close() error { return nil }
+
+func (c *conn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *conn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	c.db.record("BEGIN", nil)
+	return tx{db: c.db}, nil
+}
+
+func (c *conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	return &rows{Rows: c.db.query(query, args)}, nil
+}
+
+func (c *conn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	return c.db.exec(query, args), nil
+}
+
+// CheckNamedValue は driver.Valuer と既定の変換で引数を変換する。変換できない値はそのまま記録する
+func (c *conn) CheckNamedValue(nv *driver.NamedValue) error {
+	if valuer, ok := nv.Value.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return err
+		}
+		nv.Value = v
+		return nil
+	}
+	v, err := driver.DefaultParameterConverter.ConvertValue(nv.Value)
+	if err != nil {
+		return nil
+	}
+	nv.Value = v
+	return nil
+}
+
+type tx struct {
+	db *DB
+}
+
+func (t tx) Commit() error {
+	t.db.record("COMMIT", nil)
+	return nil
+}
+
+func (t tx) Rollback() error {
+	t.db.record("ROLLBACK", nil)
+	return nil
+}
+
+type result struct {
+	lastID   int64
+	affected int64
+}
+
+func (r result) LastInsertId() (int64, error) { return r.lastID, nil }
+func (r result) RowsAffected() (int64, error) { return r.affected, nil }
+
+type rows struct {
+	*Rows
+	next int
+}
+
+func (r *rows) Columns() []string { return r.Rows.Columns }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if r.next >= len(r.Values) {
+		return io.EOF
+	}
+	copy(dest, r.Values[r.next])
+	r.next++
+	return nil
+}
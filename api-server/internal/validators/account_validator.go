@@ -0,0 +1,72 @@
+package validators
+
+import (
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// maxBalanceHistoryDays は残高推移を取得できる期間の最大日数
+const maxBalanceHistoryDays = 366
+
+var accountTypeRule = validation.In(api.AccountTypeCash, api.AccountTypeBank, api.AccountTypeCreditCard, api.AccountTypeEMoney).Error("口座の種類はcash、bank、credit_card、e_moneyのいずれかを指定してください")
+
+func ValidateCreateAccount(input *api.CreateAccountInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.Required.Error("口座名は必須です"),
+			validation.RuneLength(1, 100).Error("口座名は1〜100文字で入力してください"),
+		),
+		validation.Field(&input.Type,
+			validation.Required.Error("口座の種類は必須です"),
+			accountTypeRule,
+		),
+	)
+}
+
+func ValidateUpdateAccount(input *api.UpdateAccountInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.By(atLeastOneField(func() bool {
+				return input.Name != nil || input.Type != nil || input.OpeningBalance != nil
+			})),
+			validation.NilOrNotEmpty.Error("口座名は1〜100文字で入力してください"),
+			validation.RuneLength(1, 100).Error("口座名は1〜100文字で入力してください"),
+		),
+		validation.Field(&input.Type, accountTypeRule),
+	)
+}
+
+func ValidateGetAccountsBalances(params *api.GetAccountsBalancesParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.StartDate,
+			validation.Required.Error("開始日は必須です"),
+			validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください"),
+		),
+		validation.Field(&params.EndDate,
+			validation.Required.Error("終了日は必須です"),
+			validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください"),
+			validation.By(dateRange(params.StartDate, maxBalanceHistoryDays)),
+		),
+	)
+}
+
+func ValidateGetAccountEntries(params *api.GetAccountsIdEntriesParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.StartDate,
+			validation.Required.Error("開始日は必須です"),
+			validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください"),
+		),
+		validation.Field(&params.EndDate,
+			validation.Required.Error("終了日は必須です"),
+			validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください"),
+			validation.By(dateRange(params.StartDate, maxBalanceHistoryDays)),
+		),
+	)
+}
+
+func ValidateReconcileAccount(input *api.ReconcileAccountInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.StatementDate, validation.Required.Error("明細の日付は必須です")),
+	)
+}
@@ -0,0 +1,43 @@
+package validators
+
+import (
+	"net/http"
+
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// MaxAttachmentFileSize は添付可能なファイルの最大サイズ（10MB）
+const MaxAttachmentFileSize = 10 << 20
+
+// attachmentContentTypes は添付可能なファイルの形式（http.DetectContentType の判定結果）
+var attachmentContentTypes = []interface{}{"image/jpeg", "image/png", "image/webp", "application/pdf"}
+
+// ValidateUploadAttachment は添付ファイルのサイズと形式をチェックする
+// NOTE: 形式はリクエストの Content-Type ではなく、ファイルの内容から判定する
+func ValidateUploadAttachment(input *api.UploadAttachmentInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.File, validation.By(func(value interface{}) error {
+			file, _ := value.(types.File)
+			if file.FileSize() == 0 {
+				return validation.NewError("required", "ファイルは必須です")
+			}
+			if file.FileSize() > MaxAttachmentFileSize {
+				return validation.NewError("too_large", "ファイルは10MB以内にしてください")
+			}
+			if len([]rune(file.Filename())) > 255 {
+				return validation.NewError("too_long_file_name", "ファイル名は255文字以内にしてください")
+			}
+			data, err := file.Bytes()
+			if err != nil {
+				return err
+			}
+			if validation.In(attachmentContentTypes...).Validate(http.DetectContentType(data)) != nil {
+				return validation.NewError("unsupported_type", "ファイルはJPEG・PNG・WebP・PDFのいずれかにしてください")
+			}
+			return nil
+		})),
+	)
+}
@@ -0,0 +1,85 @@
+package validators
+
+import (
+	"strconv"
+
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateCreateBudgetTemplate(input *api.CreateBudgetTemplateInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.Required.Error("テンプレート名は必須です"),
+			validation.RuneLength(1, 100).Error("テンプレート名は1〜100文字で入力してください"),
+		),
+		validation.Field(&input.Items,
+			validation.Required.Error("予算額は1件以上指定してください"),
+			validation.By(budgetTemplateItems),
+		),
+	)
+}
+
+func ValidateUpdateBudgetTemplate(input *api.UpdateBudgetTemplateInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.By(atLeastOneField(func() bool {
+				return input.Name != nil || input.Items != nil
+			})),
+			validation.NilOrNotEmpty.Error("テンプレート名は1〜100文字で入力してください"),
+			validation.RuneLength(1, 100).Error("テンプレート名は1〜100文字で入力してください"),
+		),
+		validation.Field(&input.Items,
+			validation.NilOrNotEmpty.Error("予算額は1件以上指定してください"),
+			validation.By(budgetTemplateItems),
+		),
+	)
+}
+
+// budgetTemplateItems は予算テンプレートの予算額が100件以内で、各予算額が正しく、カテゴリが重複していないかチェックする
+func budgetTemplateItems(value interface{}) error {
+	var items []api.BudgetTemplateItemInput
+	switch value := value.(type) {
+	case []api.BudgetTemplateItemInput:
+		items = value
+	case *[]api.BudgetTemplateItemInput:
+		if value == nil {
+			return nil
+		}
+		items = *value
+	default:
+		return nil
+	}
+	if len(items) > 100 {
+		return validation.NewError("too_many_items", "予算額は100件以内で指定してください")
+	}
+
+	errs := validation.Errors{}
+	seen := make(map[int32]bool, len(items))
+	for i := range items {
+		item := &items[i]
+		err := validation.ValidateStruct(item,
+			validation.Field(&item.CategoryId, RequiredCategoryID...),
+			validation.Field(&item.Amount,
+				validation.Required.Error("予算額は必須です"),
+				validation.Min(1).Error("予算額は1以上で入力してください"),
+			),
+		)
+		if err != nil {
+			errs[strconv.Itoa(i)] = err
+			continue
+		}
+		if seen[item.CategoryId] {
+			errs[strconv.Itoa(i)] = validation.Errors{
+				"category_id": validation.NewError("duplicate_category", "同じカテゴリの予算額は1件のみ指定してください"),
+			}
+			continue
+		}
+		seen[item.CategoryId] = true
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
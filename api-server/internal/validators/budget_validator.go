@@ -10,6 +10,18 @@ import (
 
 var monthRegex = regexp.MustCompile(`^\d{4}-(0[1-9]|1[0-2])$`)
 
+func ValidateGetBudgets(params *api.GetBudgetsParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.Month, validation.Match(monthRegex).Error("月はYYYY-MM形式で入力してください")),
+		validation.Field(&params.Sort,
+			validation.In(api.BudgetSortKeyMonth, api.BudgetSortKeyAmount, api.BudgetSortKeyCreatedAt).Error("並び替えキーはmonth、amount、created_atのいずれかを指定してください"),
+		),
+		validation.Field(&params.Order, sortOrderRule),
+		validation.Field(&params.Limit, pageLimitRule),
+		validation.Field(&params.Cursor, cursorRule),
+	)
+}
+
 func ValidateCreateBudget(input *api.CreateBudgetInput) error {
 	return validation.ValidateStruct(input,
 		validation.Field(&input.CategoryId, RequiredCategoryID...),
@@ -36,3 +48,49 @@ func ValidateUpdateBudget(input *api.UpdateBudgetInput) error {
 		validation.Field(&input.Month, validation.Match(monthRegex).Error("月はYYYY-MM形式で入力してください")),
 	)
 }
+
+func ValidateGetBudgetsSummary(params *api.GetBudgetsSummaryParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.Month,
+			validation.Required.Error("月は必須です"),
+			validation.Match(monthRegex).Error("月はYYYY-MM形式で入力してください"),
+		),
+	)
+}
+
+func ValidateGetBudgetsAvailable(params *api.GetBudgetsAvailableParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.Month,
+			validation.Required.Error("月は必須です"),
+			validation.Match(monthRegex).Error("月はYYYY-MM形式で入力してください"),
+		),
+	)
+}
+
+func ValidateCopyBudgets(input *api.CopyBudgetsInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.TargetMonth,
+			validation.Required.Error("コピー先の月は必須です"),
+			validation.Match(monthRegex).Error("コピー先の月はYYYY-MM形式で入力してください"),
+		),
+		validation.Field(&input.SourceMonth,
+			validation.By(func(value interface{}) error {
+				if (input.SourceMonth == nil) == (input.TemplateId == nil) {
+					return validation.NewError("invalid_source", "コピー元の月と予算テンプレートIDのどちらか一方を指定してください")
+				}
+				return nil
+			}),
+			validation.Match(monthRegex).Error("コピー元の月はYYYY-MM形式で入力してください"),
+			validation.By(func(value interface{}) error {
+				if input.SourceMonth != nil && *input.SourceMonth == input.TargetMonth {
+					return validation.NewError("same_month", "コピー元の月はコピー先の月と異なる月を指定してください")
+				}
+				return nil
+			}),
+		),
+		validation.Field(&input.TemplateId, validation.Min(1).Error("予算テンプレートIDは1以上で入力してください")),
+		validation.Field(&input.OnConflict,
+			validation.In(api.Skip, api.Overwrite).Error("on_conflictはskip、overwriteのいずれかを指定してください"),
+		),
+	)
+}
@@ -0,0 +1,16 @@
+package validators
+
+import (
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateGetCalendar(params *api.GetCalendarParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.Month,
+			validation.Required.Error("月は必須です"),
+			validation.Match(monthRegex).Error("月はYYYY-MM形式で入力してください"),
+		),
+	)
+}
@@ -0,0 +1,81 @@
+package validators
+
+import (
+	"math"
+	"regexp"
+	"time"
+
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var (
+	categorizationMatchTypes = []interface{}{api.Contains, api.Prefix, api.Regex}
+	ruleAmountRule           = validation.By(intRange(0, math.MaxInt32, "金額は0以上で入力してください"))
+)
+
+func ValidateCreateCategorizationRule(input *api.CreateCategorizationRuleInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.CategoryId, RequiredCategoryID...),
+		validation.Field(&input.MatchType,
+			validation.Required.Error("一致方法は必須です"),
+			validation.In(categorizationMatchTypes...).Error("一致方法はcontains、prefix、regexのいずれかを指定してください"),
+		),
+		validation.Field(&input.Pattern,
+			validation.Required.Error("パターンは必須です"),
+			validation.RuneLength(1, 255).Error("パターンは1〜255文字で入力してください"),
+		),
+		validation.Field(&input.MinAmount, ruleAmountRule),
+		validation.Field(&input.MaxAmount, ruleAmountRule),
+	)
+}
+
+func ValidateUpdateCategorizationRule(input *api.UpdateCategorizationRuleInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.CategoryId,
+			validation.By(atLeastOneField(func() bool {
+				return input.CategoryId != nil || input.MatchType != nil || input.Pattern != nil || input.MinAmount != nil || input.MaxAmount != nil || input.Priority != nil
+			})),
+			OptionalCategoryID,
+		),
+		validation.Field(&input.MatchType,
+			validation.In(categorizationMatchTypes...).Error("一致方法はcontains、prefix、regexのいずれかを指定してください"),
+		),
+		validation.Field(&input.Pattern,
+			validation.NilOrNotEmpty.Error("パターンは1〜255文字で入力してください"),
+			validation.RuneLength(1, 255).Error("パターンは1〜255文字で入力してください"),
+		),
+		validation.Field(&input.MinAmount, ruleAmountRule),
+		validation.Field(&input.MaxAmount, ruleAmountRule),
+	)
+}
+
+// ValidateCategorizationRuleCondition は一致方法・パターン・金額の範囲の組み合わせをチェックする
+// 更新時は既存の値と組み合わせた結果をチェックするため、入力の検証とは分けている
+func ValidateCategorizationRuleCondition(matchType api.CategorizationMatchType, pattern string, minAmount, maxAmount *int32) error {
+	errs := validation.Errors{}
+	if matchType == api.Regex {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errs["pattern"] = validation.NewError("invalid_pattern", "正規表現の形式が正しくありません")
+		}
+	}
+	if minAmount != nil && maxAmount != nil && *maxAmount < *minAmount {
+		errs["max_amount"] = validation.NewError("invalid_amount_range", "金額の上限は下限以上で入力してください")
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func ValidateApplyCategorizationRule(input *api.ApplyCategorizationRuleInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.EndDate, validation.By(endDateNotBefore(func() *time.Time {
+			if input.StartDate == nil {
+				return nil
+			}
+			return &input.StartDate.Time
+		}))),
+	)
+}
@@ -6,6 +6,8 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
+var budgetRolloverRule = validation.In(api.BudgetRolloverNone, api.BudgetRolloverSurplus, api.BudgetRolloverSurplusAndDeficit).Error("予算の繰越方法はnone、surplus、surplus_and_deficitのいずれかを指定してください")
+
 func ValidateCreateCategory(input *api.CreateCategoryInput) error {
 	return validation.ValidateStruct(input,
 		validation.Field(&input.Name,
@@ -20,6 +22,7 @@ func ValidateCreateCategory(input *api.CreateCategoryInput) error {
 			validation.Required.Error("色は必須です"),
 			validation.Length(1, 20).Error("色は1〜20文字で入力してください"),
 		),
+		validation.Field(&input.BudgetRollover, budgetRolloverRule),
 	)
 }
 
@@ -27,10 +30,11 @@ func ValidateUpdateCategory(input *api.UpdateCategoryInput) error {
 	return validation.ValidateStruct(input,
 		validation.Field(&input.Name,
 			validation.By(atLeastOneField(func() bool {
-				return input.Name != nil || input.Color != nil
+				return input.Name != nil || input.Color != nil || input.BudgetRollover != nil
 			})),
 			validation.Length(1, 100).Error("カテゴリ名は1〜100文字で入力してください"),
 		),
 		validation.Field(&input.Color, validation.Length(1, 20).Error("色は1〜20文字で入力してください")),
+		validation.Field(&input.BudgetRollover, budgetRolloverRule),
 	)
 }
@@ -0,0 +1,86 @@
+package validators
+
+import (
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// maxStatementMonths はクレジットカードの請求を一度に取得できる月数の上限
+	maxStatementMonths = 24
+	// maxWithdrawalDays は引き落とし予定を一度に取得できる期間の最大日数
+	maxWithdrawalDays = 366
+)
+
+var (
+	closingDayRule         = validation.By(intRange(1, 31, "締め日は1〜31で入力してください"))
+	paymentDayRule         = validation.By(intRange(1, 31, "支払日は1〜31で入力してください"))
+	paymentMonthOffsetRule = validation.By(intRange(1, 2, "締め日から支払日までの月数は1〜2で入力してください"))
+)
+
+func ValidateCreateCreditCard(input *api.CreateCreditCardInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.Required.Error("カード名は必須です"),
+			validation.RuneLength(1, 100).Error("カード名は1〜100文字で入力してください"),
+		),
+		validation.Field(&input.ClosingDay,
+			validation.Required.Error("締め日は必須です"),
+			closingDayRule,
+		),
+		validation.Field(&input.PaymentDay,
+			validation.Required.Error("支払日は必須です"),
+			paymentDayRule,
+		),
+		validation.Field(&input.PaymentMonthOffset, paymentMonthOffsetRule),
+	)
+}
+
+func ValidateUpdateCreditCard(input *api.UpdateCreditCardInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.By(atLeastOneField(func() bool {
+				return input.Name != nil || input.ClosingDay != nil || input.PaymentDay != nil || input.PaymentMonthOffset != nil
+			})),
+			validation.NilOrNotEmpty.Error("カード名は1〜100文字で入力してください"),
+			validation.RuneLength(1, 100).Error("カード名は1〜100文字で入力してください"),
+		),
+		validation.Field(&input.ClosingDay, closingDayRule),
+		validation.Field(&input.PaymentDay, paymentDayRule),
+		validation.Field(&input.PaymentMonthOffset, paymentMonthOffsetRule),
+	)
+}
+
+func ValidateGetCreditCardStatements(params *api.GetCreditCardsIdStatementsParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.StartMonth,
+			validation.Required.Error("開始月は必須です"),
+			validation.Match(monthRegex).Error("開始月はYYYY-MM形式で入力してください"),
+		),
+		validation.Field(&params.EndMonth,
+			validation.Required.Error("終了月は必須です"),
+			validation.Match(monthRegex).Error("終了月はYYYY-MM形式で入力してください"),
+			validation.By(monthRange(params.StartMonth, maxStatementMonths)),
+		),
+	)
+}
+
+// ValidateGetCreditCardWithdrawals は引き落とし予定の取得条件をチェックする
+// startDate は開始日の省略時の値（ユーザーのタイムゾーンでの今日）で、終了日の範囲のチェックに使う
+func ValidateGetCreditCardWithdrawals(params *api.GetCreditCardsWithdrawalsParams, startDate string) error {
+	if params.StartDate != nil {
+		startDate = *params.StartDate
+	}
+	return validation.ValidateStruct(params,
+		validation.Field(&params.StartDate,
+			validation.NilOrNotEmpty.Error("開始日を指定する場合は空にしないでください"),
+			validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください"),
+		),
+		validation.Field(&params.EndDate,
+			validation.NilOrNotEmpty.Error("終了日を指定する場合は空にしないでください"),
+			validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください"),
+			validation.By(dateRange(startDate, maxWithdrawalDays)),
+		),
+	)
+}
@@ -0,0 +1,94 @@
+package validators
+
+import (
+	"regexp"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// rateRegex は為替レートの形式（整数部・小数部それぞれ10桁以内）
+var rateRegex = regexp.MustCompile(`^\d{1,10}(\.\d{1,10})?$`)
+
+var rateRules = []validation.Rule{
+	validation.Match(rateRegex).Error("レートは整数部・小数部それぞれ10桁以内の数値で入力してください"),
+	validation.By(positiveRate),
+}
+
+func ValidateGetExchangeRates(params *api.GetExchangeRatesParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.Currency, currencyRule),
+		validation.Field(&params.StartDate, validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください")),
+		validation.Field(&params.EndDate, validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください")),
+	)
+}
+
+func ValidateCreateExchangeRate(input *api.CreateExchangeRateInput, baseCurrency string) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Currency,
+			validation.Required.Error("通貨は必須です"),
+			currencyRule,
+			validation.NotIn(baseCurrency).Error("基準通貨の為替レートは登録できません"),
+		),
+		validation.Field(&input.Date, validation.Required.Error("日付は必須です")),
+		validation.Field(&input.Rate, append([]validation.Rule{validation.Required.Error("レートは必須です")}, rateRules...)...),
+	)
+}
+
+func ValidateUpdateExchangeRate(input *api.UpdateExchangeRateInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Date,
+			validation.By(atLeastOneField(func() bool {
+				return input.Date != nil || input.Rate != nil
+			})),
+		),
+		validation.Field(&input.Rate, append([]validation.Rule{validation.NilOrNotEmpty.Error("レートを指定する場合は空にしないでください")}, rateRules...)...),
+	)
+}
+
+func ValidateImportExchangeRates(input *api.ImportExchangeRatesInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.File, validation.By(func(value interface{}) error {
+			file, _ := value.(types.File)
+			if file.FileSize() == 0 {
+				return validation.NewError("required", "CSVファイルは必須です")
+			}
+			if file.FileSize() > MaxImportFileSize {
+				return validation.NewError("too_large", "CSVファイルは10MB以内にしてください")
+			}
+			return nil
+		})),
+	)
+}
+
+// ValidateExchangeRateRow は為替レートのCSVの1行をチェックする
+func ValidateExchangeRateRow(date, currency, rate, baseCurrency string) error {
+	return validation.Errors{
+		"date": validation.Validate(date,
+			validation.Required.Error("日付は必須です"),
+			validation.Date("2006-01-02").Error("日付はYYYY-MM-DD形式で入力してください"),
+		),
+		"currency": validation.Validate(currency,
+			validation.Required.Error("通貨は必須です"),
+			currencyRule,
+			validation.NotIn(baseCurrency).Error("基準通貨の為替レートは登録できません"),
+		),
+		"rate": validation.Validate(rate, append([]validation.Rule{validation.Required.Error("レートは必須です")}, rateRules...)...),
+	}.Filter()
+}
+
+// positiveRate はレートが0より大きいかチェックする。形式が正しくない場合は他のルールでエラーとなるため、ここではチェックしない
+func positiveRate(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	rate, ok := value.(string)
+	if isNil || !ok || !rateRegex.MatchString(rate) {
+		return nil
+	}
+	if _, ok := helpers.ParseRate(rate); !ok {
+		return validation.NewError("invalid_rate", "レートは0より大きい値を入力してください")
+	}
+	return nil
+}
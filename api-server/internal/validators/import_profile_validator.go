@@ -0,0 +1,109 @@
+package validators
+
+import (
+	"regexp"
+
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+var (
+	importEncodings = []interface{}{api.Utf8, api.ShiftJis}
+	amountSigns     = []interface{}{api.NegativeIsExpense, api.PositiveIsExpense}
+
+	// 日付形式はYYYY・MM・M・DD・Dと区切り文字の組み合わせのみ許可する
+	dateFormatRegex = regexp.MustCompile(`^(YYYY|MM|M|DD|D|[/\-. 年月日])+$`)
+)
+
+func ValidateCreateImportProfile(input *api.CreateImportProfileInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.Required.Error("取込設定名は必須です"),
+			validation.Length(1, 100).Error("取込設定名は1〜100文字で入力してください"),
+		),
+		validation.Field(&input.Encoding,
+			validation.Required.Error("文字コードは必須です"),
+			validation.In(importEncodings...).Error("文字コードはutf-8、shift_jisのいずれかを指定してください"),
+		),
+		validation.Field(&input.SkipRows, skipRowsRule),
+		validation.Field(&input.DateColumn,
+			validation.Required.Error("日付の列番号は必須です"),
+			columnRule,
+		),
+		validation.Field(&input.DateFormat,
+			validation.Required.Error("日付の形式は必須です"),
+			dateFormatRule,
+		),
+		validation.Field(&input.AmountColumn,
+			validation.Required.Error("金額の列番号は必須です"),
+			columnRule,
+		),
+		validation.Field(&input.AmountSign,
+			validation.Required.Error("金額の符号の扱いは必須です"),
+			validation.In(amountSigns...).Error("金額の符号の扱いはnegative_is_expense、positive_is_expenseのいずれかを指定してください"),
+		),
+		validation.Field(&input.DescriptionColumn, columnRule),
+		validation.Field(&input.DefaultCategoryId, RequiredCategoryID...),
+		validation.Field(&input.IncomeCategoryId, OptionalCategoryID),
+	)
+}
+
+func ValidateUpdateImportProfile(input *api.UpdateImportProfileInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.By(atLeastOneField(func() bool {
+				return input.Name != nil || input.Encoding != nil || input.HasHeader != nil || input.SkipRows != nil ||
+					input.DateColumn != nil || input.DateFormat != nil || input.AmountColumn != nil || input.AmountSign != nil ||
+					input.DescriptionColumn != nil || input.DefaultCategoryId != nil || input.IncomeCategoryId != nil
+			})),
+			validation.NilOrNotEmpty.Error("取込設定名を入力する場合は空にしないでください"),
+			validation.Length(1, 100).Error("取込設定名は1〜100文字で入力してください"),
+		),
+		validation.Field(&input.Encoding,
+			validation.In(importEncodings...).Error("文字コードはutf-8、shift_jisのいずれかを指定してください"),
+		),
+		validation.Field(&input.SkipRows, skipRowsRule),
+		validation.Field(&input.DateColumn, columnRule),
+		validation.Field(&input.DateFormat,
+			validation.NilOrNotEmpty.Error("日付の形式を入力する場合は空にしないでください"),
+			dateFormatRule,
+		),
+		validation.Field(&input.AmountColumn, columnRule),
+		validation.Field(&input.AmountSign,
+			validation.In(amountSigns...).Error("金額の符号の扱いはnegative_is_expense、positive_is_expenseのいずれかを指定してください"),
+		),
+		validation.Field(&input.DescriptionColumn, columnRule),
+		validation.Field(&input.DefaultCategoryId, OptionalCategoryID),
+		validation.Field(&input.IncomeCategoryId, OptionalCategoryID),
+	)
+}
+
+var (
+	columnRule     = validation.By(intRange(1, 1000, "列番号は1〜1000で入力してください"))
+	skipRowsRule   = validation.By(intRange(0, 1000, "読み飛ばす行数は0〜1000で入力してください"))
+	dateFormatRule = validation.Match(dateFormatRegex).Error("日付の形式はYYYY、MM、M、DD、Dと区切り文字（/ - . 年 月 日 空白）で指定してください")
+)
+
+// MaxImportFileSize は取込可能なCSVファイルの最大サイズ（10MB）
+const MaxImportFileSize = 10 << 20
+
+func ValidateImportTransactions(input *api.ImportTransactionsInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.File, validation.By(func(value interface{}) error {
+			file, _ := value.(types.File)
+			if file.FileSize() == 0 {
+				return validation.NewError("required", "CSVファイルは必須です")
+			}
+			if file.FileSize() > MaxImportFileSize {
+				return validation.NewError("too_large", "CSVファイルは10MB以内にしてください")
+			}
+			return nil
+		})),
+		validation.Field(&input.ProfileId,
+			validation.Required.Error("取込設定IDは必須です"),
+			validation.Min(1).Error("取込設定IDは1以上で入力してください"),
+		),
+	)
+}
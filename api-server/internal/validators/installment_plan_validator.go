@@ -0,0 +1,84 @@
+package validators
+
+import (
+	"math/big"
+	"regexp"
+	"time"
+
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// interestRateRegex は手数料の実質年率の形式（整数部2桁・小数部2桁以内）
+var interestRateRegex = regexp.MustCompile(`^\d{1,2}(\.\d{1,2})?$`)
+
+// maxAnnualInterestRate は手数料の実質年率（%）の上限
+var maxAnnualInterestRate = big.NewRat(30, 1)
+
+// ValidateCreateInstallmentPlan は分割払いの登録内容をチェックする
+// purchaseDate は分割払いにする取引の日付で、初回の支払月が購入日の月より前でないかのチェックに使う
+// principal は分割払いにする取引の金額（基準通貨）で、各回の支払額が0にならないかのチェックに使う
+func ValidateCreateInstallmentPlan(input *api.CreateInstallmentPlanInput, purchaseDate time.Time, principal int) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Payments,
+			validation.Required.Error("支払回数は必須です"),
+			validation.By(intRange(2, 60, "支払回数は2〜60で入力してください")),
+			validation.By(paymentsNotExceedPrincipal(principal)),
+		),
+		validation.Field(&input.FirstBillingMonth,
+			validation.Required.Error("初回の支払月は必須です"),
+			validation.Match(monthRegex).Error("初回の支払月はYYYY-MM形式で入力してください"),
+			validation.By(monthNotBefore(purchaseDate.Format("2006-01"), "初回の支払月は購入日の月以降を指定してください")),
+		),
+		validation.Field(&input.AnnualInterestRate,
+			validation.NilOrNotEmpty.Error("実質年率を指定する場合は空にしないでください"),
+			validation.Match(interestRateRegex).Error("実質年率は0〜30の数値（小数点以下2桁まで）で入力してください"),
+			validation.By(interestRateRange),
+		),
+	)
+}
+
+// monthNotBefore は YYYY-MM 形式の月が min 以降かチェックするルールを生成する
+// 月の形式が正しくない場合は他のルールでエラーとなるため、ここではチェックしない
+func monthNotBefore(min, message string) validation.RuleFunc {
+	return func(value interface{}) error {
+		month, ok := value.(string)
+		if !ok || !monthRegex.MatchString(month) {
+			return nil
+		}
+		// NOTE: YYYY-MM 形式どうしは文字列の比較で前後を判定できる
+		if month < min {
+			return validation.NewError("month_before_min", message)
+		}
+		return nil
+	}
+}
+
+// paymentsNotExceedPrincipal は支払回数が購入金額以下かチェックするルールを生成する
+// NOTE: 支払回数が購入金額より多いと、元金を均等に分けた各回の支払額が0になるため
+func paymentsNotExceedPrincipal(principal int) validation.RuleFunc {
+	return func(value interface{}) error {
+		payments, ok := value.(int32)
+		if !ok {
+			return nil
+		}
+		if int(payments) > principal {
+			return validation.NewError("payments_exceed_principal", "支払回数は購入金額以下で入力してください")
+		}
+		return nil
+	}
+}
+
+func interestRateRange(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	rate, ok := value.(string)
+	if isNil || !ok || !interestRateRegex.MatchString(rate) {
+		return nil
+	}
+	r, ok := new(big.Rat).SetString(rate)
+	if !ok || r.Cmp(maxAnnualInterestRate) > 0 {
+		return validation.NewError("invalid_interest_rate", "実質年率は0〜30の数値（小数点以下2桁まで）で入力してください")
+	}
+	return nil
+}
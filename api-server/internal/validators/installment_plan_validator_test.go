@@ -0,0 +1,34 @@
+package validators
+
+import (
+	"testing"
+	"time"
+
+	api "apps/apis"
+)
+
+func TestValidateCreateInstallmentPlanPayments(t *testing.T) {
+	purchaseDate := time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		payments  int32
+		principal int
+		wantErr   bool
+	}{
+		{"支払回数が購入金額より少ない", 12, 120000, false},
+		{"支払回数が購入金額と同じ", 3, 3, false},
+		{"支払回数が購入金額より多い", 3, 2, true},
+		{"購入金額が0", 2, 0, true},
+		{"支払回数が範囲外", 61, 120000, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &api.CreateInstallmentPlanInput{Payments: tt.payments, FirstBillingMonth: "2026-04"}
+			err := ValidateCreateInstallmentPlan(input, purchaseDate, tt.principal)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreateInstallmentPlan(payments=%d, principal=%d) error = %v, wantErr %v", tt.payments, tt.principal, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package validators
+
+import (
+	"math"
+	"time"
+
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+var recurrenceFrequencies = []interface{}{api.Weekly, api.Monthly, api.Yearly}
+
+func ValidateCreateRecurringTransaction(input *api.CreateRecurringTransactionInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.CategoryId, RequiredCategoryID...),
+		validation.Field(&input.Amount,
+			validation.Required.Error("金額は必須です"),
+			validation.Min(1).Error("金額は1以上で入力してください"),
+		),
+		validation.Field(&input.Description,
+			validation.NilOrNotEmpty.Error("説明を入力する場合は空にしないでください"),
+			validation.Length(0, 255).Error("説明は255文字以内で入力してください"),
+		),
+		validation.Field(&input.Frequency,
+			validation.Required.Error("頻度は必須です"),
+			validation.In(recurrenceFrequencies...).Error("頻度はweekly、monthly、yearlyのいずれかを指定してください"),
+		),
+		validation.Field(&input.Interval, intervalRule),
+		validation.Field(&input.DayOfMonth,
+			validation.When(input.Frequency == api.Monthly || input.Frequency == api.Yearly,
+				validation.NotNil.Error("monthly、yearlyの場合は日を指定してください"),
+			),
+			dayOfMonthRule,
+		),
+		validation.Field(&input.DayOfWeek,
+			validation.When(input.Frequency == api.Weekly,
+				validation.NotNil.Error("weeklyの場合は曜日を指定してください"),
+			),
+			dayOfWeekRule,
+		),
+		validation.Field(&input.MonthOfYear,
+			validation.When(input.Frequency == api.Yearly,
+				validation.NotNil.Error("yearlyの場合は月を指定してください"),
+			),
+			monthOfYearRule,
+		),
+		validation.Field(&input.StartDate, validation.Required.Error("開始日は必須です")),
+		validation.Field(&input.EndDate, validation.By(endDateNotBefore(func() *time.Time {
+			return &input.StartDate.Time
+		}))),
+	)
+}
+
+func ValidateUpdateRecurringTransaction(input *api.UpdateRecurringTransactionInput) error {
+	scheduleChanged := input.Interval != nil || input.DayOfMonth != nil || input.DayOfWeek != nil || input.MonthOfYear != nil
+	frequencyIs := func(frequencies ...api.RecurrenceFrequency) bool {
+		if input.Frequency == nil {
+			return false
+		}
+		for _, f := range frequencies {
+			if *input.Frequency == f {
+				return true
+			}
+		}
+		return false
+	}
+
+	return validation.ValidateStruct(input,
+		validation.Field(&input.CategoryId,
+			validation.By(atLeastOneField(func() bool {
+				return input.CategoryId != nil || input.Amount != nil || input.Description != nil ||
+					input.Frequency != nil || scheduleChanged || input.StartDate != nil || input.EndDate != nil
+			})),
+			OptionalCategoryID,
+		),
+		validation.Field(&input.Amount, validation.Min(1).Error("金額は1以上で入力してください")),
+		validation.Field(&input.Description,
+			validation.NilOrNotEmpty.Error("説明を入力する場合は空にしないでください"),
+			validation.Length(0, 255).Error("説明は255文字以内で入力してください"),
+		),
+		validation.Field(&input.Frequency,
+			validation.When(scheduleChanged,
+				validation.NotNil.Error("スケジュールを変更する場合は頻度を指定してください"),
+			),
+			validation.In(recurrenceFrequencies...).Error("頻度はweekly、monthly、yearlyのいずれかを指定してください"),
+		),
+		validation.Field(&input.Interval, intervalRule),
+		validation.Field(&input.DayOfMonth,
+			validation.When(frequencyIs(api.Monthly, api.Yearly),
+				validation.NotNil.Error("monthly、yearlyの場合は日を指定してください"),
+			),
+			dayOfMonthRule,
+		),
+		validation.Field(&input.DayOfWeek,
+			validation.When(frequencyIs(api.Weekly),
+				validation.NotNil.Error("weeklyの場合は曜日を指定してください"),
+			),
+			dayOfWeekRule,
+		),
+		validation.Field(&input.MonthOfYear,
+			validation.When(frequencyIs(api.Yearly),
+				validation.NotNil.Error("yearlyの場合は月を指定してください"),
+			),
+			monthOfYearRule,
+		),
+		validation.Field(&input.EndDate, validation.By(endDateNotBefore(func() *time.Time {
+			if input.StartDate == nil {
+				return nil
+			}
+			return &input.StartDate.Time
+		}))),
+	)
+}
+
+// ValidateRecurringTransactionPeriod は更新後の開始日・終了日の前後関係をチェックする
+// 片方のみ更新された場合に、既存の値と組み合わせて検証するために使用する
+func ValidateRecurringTransactionPeriod(startDate time.Time, endDate *time.Time) error {
+	if endDate != nil && endDate.Before(startDate) {
+		return validation.Errors{
+			"end_date": validation.NewError("invalid_end_date", "終了日は開始日以降の日付を指定してください"),
+		}
+	}
+	return nil
+}
+
+var (
+	intervalRule    = validation.By(intRange(1, math.MaxInt32, "間隔は1以上で入力してください"))
+	dayOfMonthRule  = validation.By(intRange(1, 31, "日は1〜31で入力してください"))
+	dayOfWeekRule   = validation.By(intRange(0, 6, "曜日は0（日曜）〜6（土曜）で入力してください"))
+	monthOfYearRule = validation.By(intRange(1, 12, "月は1〜12で入力してください"))
+)
+
+// intRange は int32 / *int32 の値が min 以上 max 以下かチェックするルールを生成する
+// validation.Min は0を空値として扱い検証をスキップするため、0を許容する範囲チェックに使用する
+func intRange(min, max int32, message string) validation.RuleFunc {
+	return func(value interface{}) error {
+		var v int32
+		switch value := value.(type) {
+		case int32:
+			v = value
+		case *int32:
+			if value == nil {
+				return nil
+			}
+			v = *value
+		default:
+			return nil
+		}
+		if v < min || v > max {
+			return validation.NewError("out_of_range", message)
+		}
+		return nil
+	}
+}
+
+// endDateNotBefore は終了日が開始日以降かチェックするルールを生成する
+func endDateNotBefore(startDate func() *time.Time) validation.RuleFunc {
+	return func(value interface{}) error {
+		endDate, ok := value.(*types.Date)
+		if !ok || endDate == nil {
+			return nil
+		}
+		start := startDate()
+		if start != nil && endDate.Time.Before(*start) {
+			return validation.NewError("invalid_end_date", "終了日は開始日以降の日付を指定してください")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,38 @@
+package validators
+
+import (
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// 推移レポートの期間の上限（日数）
+const (
+	maxDailyTrendDays = 366
+	maxTrendDays      = 3653 // 10年
+)
+
+func ValidateGetReportsTrends(params *api.GetReportsTrendsParams) error {
+	maxDays := maxTrendDays
+	if params.Period != nil && *params.Period == api.Day {
+		maxDays = maxDailyTrendDays
+	}
+
+	return validation.ValidateStruct(params,
+		validation.Field(&params.StartDate,
+			validation.Required.Error("開始日は必須です"),
+			validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください"),
+		),
+		validation.Field(&params.EndDate,
+			validation.Required.Error("終了日は必須です"),
+			validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください"),
+			validation.By(dateRange(params.StartDate, maxDays)),
+		),
+		validation.Field(&params.Period,
+			validation.In(api.Day, api.Week, api.Month, api.Year).Error("集計単位はday、week、month、yearのいずれかを指定してください"),
+		),
+		validation.Field(&params.Compare,
+			validation.In(api.PreviousPeriod, api.PreviousYear).Error("比較対象はprevious_period、previous_yearのいずれかを指定してください"),
+		),
+	)
+}
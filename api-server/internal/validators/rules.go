@@ -1,6 +1,12 @@
 package validators
 
 import (
+	"fmt"
+	"time"
+
+	api "apps/apis"
+	"apps/internal/helpers"
+
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
@@ -12,6 +18,27 @@ var (
 		validation.Min(1).Error("カテゴリIDは1以上で入力してください"),
 	}
 	OptionalCategoryID = validation.Min(1).Error("カテゴリIDは1以上で入力してください")
+
+	// 口座ID（transaction, transfer で使用）
+	RequiredAccountID = []validation.Rule{
+		validation.Required.Error("口座IDは必須です"),
+		validation.Min(1).Error("口座IDは1以上で入力してください"),
+	}
+	OptionalAccountID = validation.Min(1).Error("口座IDは1以上で入力してください")
+
+	// クレジットカードID（transaction で使用）
+	OptionalCreditCardID = validation.Min(1).Error("クレジットカードIDは1以上で入力してください")
+
+	// 一覧取得のページネーション（transaction, budget で使用）
+	sortOrderRule = validation.In(api.Asc, api.Desc).Error("並び順はasc、descのいずれかを指定してください")
+	pageLimitRule = validation.By(intRange(1, 500, "取得件数は1〜500で入力してください"))
+	cursorRule    = validation.NilOrNotEmpty.Error("カーソルを指定する場合は空にしないでください")
+
+	// 通貨（transaction, exchange rate, user で使用）
+	currencyRule = validation.By(currencyCode)
+
+	// タイムゾーン（user で使用）
+	timeZoneRule = validation.By(timeZoneName)
 )
 
 // atLeastOneField は少なくとも1つのフィールドが指定されているかチェックするルールを生成する
@@ -23,3 +50,84 @@ func atLeastOneField(hasValue func() bool) validation.RuleFunc {
 		return nil
 	}
 }
+
+// dateRange は YYYY-MM-DD 形式の終了日が開始日以降かチェックするルールを生成する
+// maxDays が0より大きい場合は、期間が開始日を含めて maxDays 日以内かもチェックする
+// 日付の形式が正しくない場合は他のルールでエラーとなるため、ここではチェックしない
+func dateRange(startDate string, maxDays int) validation.RuleFunc {
+	return func(value interface{}) error {
+		endDate, ok := value.(string)
+		if !ok {
+			return nil
+		}
+		start, err := time.Parse("2006-01-02", startDate)
+		if err != nil {
+			return nil
+		}
+		end, err := time.Parse("2006-01-02", endDate)
+		if err != nil {
+			return nil
+		}
+		if end.Before(start) {
+			return validation.NewError("invalid_date_range", "終了日は開始日以降の日付を指定してください")
+		}
+		if maxDays > 0 && end.Sub(start) >= time.Duration(maxDays)*24*time.Hour {
+			return validation.NewError("date_range_too_long", fmt.Sprintf("期間は%d日以内で指定してください", maxDays))
+		}
+		return nil
+	}
+}
+
+// monthRange は YYYY-MM 形式の終了月が開始月以降かチェックするルールを生成する
+// maxMonths が0より大きい場合は、期間が開始月を含めて maxMonths か月以内かもチェックする
+// 月の形式が正しくない場合は他のルールでエラーとなるため、ここではチェックしない
+func monthRange(startMonth string, maxMonths int) validation.RuleFunc {
+	return func(value interface{}) error {
+		endMonth, ok := value.(string)
+		if !ok {
+			return nil
+		}
+		start, err := time.Parse("2006-01", startMonth)
+		if err != nil {
+			return nil
+		}
+		end, err := time.Parse("2006-01", endMonth)
+		if err != nil {
+			return nil
+		}
+		if end.Before(start) {
+			return validation.NewError("invalid_month_range", "終了月は開始月以降の月を指定してください")
+		}
+		if maxMonths > 0 && !end.Before(start.AddDate(0, maxMonths, 0)) {
+			return validation.NewError("month_range_too_long", fmt.Sprintf("期間は%dか月以内で指定してください", maxMonths))
+		}
+		return nil
+	}
+}
+
+// currencyCode は ISO 4217 の通貨コードかチェックする。未指定の場合は他のルールでチェックする
+func currencyCode(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	code, ok := value.(string)
+	if isNil || !ok || code == "" {
+		return nil
+	}
+	if !helpers.IsCurrency(code) {
+		return validation.NewError("invalid_currency", "通貨はISO 4217の通貨コード（例: USD）で入力してください")
+	}
+	return nil
+}
+
+// timeZoneName はIANAタイムゾーン名（例: Asia/Tokyo）かチェックする
+// NOTE: time.LoadLocation は "Local" や空文字も受け付けるため、サーバーの設定に依存する値は除外する
+func timeZoneName(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	name, ok := value.(string)
+	if isNil || !ok || name == "" {
+		return nil
+	}
+	if _, err := time.LoadLocation(name); err != nil || name == "Local" {
+		return validation.NewError("invalid_time_zone", "タイムゾーンはIANAタイムゾーン名（例: Asia/Tokyo）で入力してください")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package validators
+
+import (
+	"testing"
+
+	api "apps/apis"
+)
+
+func TestTimeZoneName(t *testing.T) {
+	tokyo := "Asia/Tokyo"
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{"IANAタイムゾーン名", "Asia/Tokyo", false},
+		{"夏時間のあるタイムゾーン", "America/New_York", false},
+		{"UTC", "UTC", false},
+		{"ポインタ", &tokyo, false},
+		{"存在しないタイムゾーン", "Invalid/Zone", true},
+		{"サーバーのローカルタイムゾーン", "Local", true},
+		// NOTE: 空文字のチェックは NilOrNotEmpty で行う
+		{"空文字", "", false},
+		{"nil", (*string)(nil), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := timeZoneName(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("timeZoneName(%v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUpdateCurrentUserTimeZone(t *testing.T) {
+	tests := []struct {
+		timeZone string
+		wantErr  bool
+	}{
+		{"Europe/London", false},
+		{"Invalid/Zone", true},
+		{"Local", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timeZone, func(t *testing.T) {
+			timeZone := tt.timeZone
+			err := ValidateUpdateCurrentUser(&api.UserUpdateCurrentUserInput{TimeZone: &timeZone})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateUpdateCurrentUser(time_zone=%q) error = %v, wantErr %v", tt.timeZone, err, tt.wantErr)
+			}
+		})
+	}
+}
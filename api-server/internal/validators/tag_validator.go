@@ -0,0 +1,42 @@
+package validators
+
+import (
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateCreateTag(input *api.CreateTagInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.Required.Error("タグ名は必須です"),
+			validation.RuneLength(1, 50).Error("タグ名は1〜50文字で入力してください"),
+		),
+	)
+}
+
+func ValidateUpdateTag(input *api.UpdateTagInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.By(atLeastOneField(func() bool {
+				return input.Name != nil
+			})),
+			validation.NilOrNotEmpty.Error("タグ名は1〜50文字で入力してください"),
+			validation.RuneLength(1, 50).Error("タグ名は1〜50文字で入力してください"),
+		),
+	)
+}
+
+func ValidateGetTagsSummary(params *api.GetTagsSummaryParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.StartDate,
+			validation.Required.Error("開始日は必須です"),
+			validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください"),
+		),
+		validation.Field(&params.EndDate,
+			validation.Required.Error("終了日は必須です"),
+			validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください"),
+			validation.By(dateRange(params.StartDate, 0)),
+		),
+	)
+}
@@ -1,6 +1,10 @@
 package validators
 
 import (
+	"fmt"
+	"math"
+	"strconv"
+
 	api "apps/apis"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -9,15 +13,26 @@ import (
 func ValidateCreateTransaction(input *api.CreateTransactionInput) error {
 	return validation.ValidateStruct(input,
 		validation.Field(&input.CategoryId, RequiredCategoryID...),
+		validation.Field(&input.AccountId, OptionalAccountID),
+		validation.Field(&input.CreditCardId, OptionalCreditCardID),
 		validation.Field(&input.Amount,
 			validation.Required.Error("金額は必須です"),
 			validation.Min(1).Error("金額は1以上で入力してください"),
 		),
+		validation.Field(&input.Currency,
+			validation.NilOrNotEmpty.Error("通貨を指定する場合は空にしないでください"),
+			currencyRule,
+		),
 		validation.Field(&input.Date, validation.Required.Error("日付は必須です")),
 		validation.Field(&input.Description,
 			validation.NilOrNotEmpty.Error("説明を入力する場合は空にしないでください"),
 			validation.Length(0, 255).Error("説明は255文字以内で入力してください"),
 		),
+		validation.Field(&input.Splits,
+			validation.NilOrNotEmpty.Error("分割明細は2件以上指定してください"),
+			validation.By(transactionSplits(&input.Amount)),
+		),
+		validation.Field(&input.Tags, validation.By(idList(20, "タグID"))),
 	)
 }
 
@@ -25,14 +40,269 @@ func ValidateUpdateTransaction(input *api.UpdateTransactionInput) error {
 	return validation.ValidateStruct(input,
 		validation.Field(&input.CategoryId,
 			validation.By(atLeastOneField(func() bool {
-				return input.CategoryId != nil || input.Amount != nil || input.Date != nil || input.Description != nil
+				return input.CategoryId != nil || input.AccountId != nil || input.Amount != nil || input.Currency != nil || input.Date != nil || input.Description != nil || input.Splits != nil || input.Tags != nil
 			})),
 			OptionalCategoryID,
 		),
+		validation.Field(&input.AccountId, OptionalAccountID),
+		validation.Field(&input.CreditCardId, OptionalCreditCardID),
 		validation.Field(&input.Amount, validation.Min(1).Error("金額は1以上で入力してください")),
+		validation.Field(&input.Currency,
+			validation.NilOrNotEmpty.Error("通貨を指定する場合は空にしないでください"),
+			currencyRule,
+		),
 		validation.Field(&input.Description,
 			validation.NilOrNotEmpty.Error("説明を入力する場合は空にしないでください"),
 			validation.Length(0, 255).Error("説明は255文字以内で入力してください"),
 		),
+		// NOTE: 空配列は分割の解除として扱う。金額・分割明細の片方のみ更新する場合の合計の確認は更新時に行う
+		validation.Field(&input.Splits, validation.By(transactionSplits(input.Amount))),
+		validation.Field(&input.Tags, validation.By(idList(20, "タグID"))),
+	)
+}
+
+func ValidateGetTransactions(params *api.GetTransactionsParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.Month,
+			validation.Match(monthRegex).Error("月はYYYY-MM形式で入力してください"),
+			validation.When(params.StartDate != nil || params.EndDate != nil,
+				validation.Nil.Error("月と開始日・終了日は同時に指定できません"),
+			),
+		),
+		validation.Field(&params.Q, keywordRules...),
+		validation.Field(&params.MinAmount, amountFilterRule),
+		validation.Field(&params.MaxAmount, amountFilterRule, validation.By(maxAmountNotBelow(params.MinAmount))),
+		validation.Field(&params.CategoryIds, categoryIDListRule),
+		validation.Field(&params.ExcludeCategoryIds, categoryIDListRule),
+		validation.Field(&params.AccountId, OptionalAccountID),
+		validation.Field(&params.CreditCardId, OptionalCreditCardID),
+		validation.Field(&params.Tag, validation.Min(1).Error("タグIDは1以上で入力してください")),
+		validation.Field(&params.AnyTag, tagIDListRule),
+		validation.Field(&params.AllTags, tagIDListRule),
+		validation.Field(&params.Sort,
+			validation.In(api.TransactionSortKeyDate, api.TransactionSortKeyAmount, api.TransactionSortKeyCreatedAt).Error("並び替えキーはdate、amount、created_atのいずれかを指定してください"),
+		),
+		validation.Field(&params.Order, sortOrderRule),
+		validation.Field(&params.Limit, pageLimitRule),
+		validation.Field(&params.Cursor, cursorRule),
+	)
+}
+
+func ValidateGetTransactionsExport(params *api.GetTransactionsExportParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.Format,
+			validation.Required.Error("出力形式は必須です"),
+			validation.In(api.Csv, api.Ndjson).Error("出力形式はcsv、ndjsonのいずれかを指定してください"),
+		),
+		validation.Field(&params.StartDate, validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください")),
+		validation.Field(&params.EndDate, validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください")),
+		validation.Field(&params.Type,
+			validation.In(api.Income, api.Expense).Error("カテゴリタイプはincome、expenseのいずれかを指定してください"),
+		),
+		validation.Field(&params.CategoryId, OptionalCategoryID),
+	)
+}
+
+func ValidateBatchTransactions(input *api.BatchTransactionsInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Operations,
+			validation.Required.Error("操作を1件以上指定してください"),
+			validation.Length(1, 100).Error("操作は100件以内で指定してください"),
+		),
+	)
+}
+
+// ValidateBatchTransactionOperation は一括操作の1件を、操作の種類に応じて取引の作成・更新と同じルールで検証する
+func ValidateBatchTransactionOperation(operation *api.BatchTransactionOperation) error {
+	err := validation.ValidateStruct(operation,
+		validation.Field(&operation.Action,
+			validation.Required.Error("操作の種類は必須です"),
+			validation.In(api.BatchTransactionActionCreate, api.BatchTransactionActionUpdate, api.BatchTransactionActionDelete).Error("操作の種類はcreate、update、deleteのいずれかを指定してください"),
+		),
+		validation.Field(&operation.Id,
+			validation.When(operation.Action == api.BatchTransactionActionUpdate || operation.Action == api.BatchTransactionActionDelete,
+				validation.NotNil.Error("update、deleteの場合は取引IDを指定してください"),
+			),
+			validation.Min(1).Error("取引IDは1以上で入力してください"),
+		),
+		validation.Field(&operation.Create,
+			validation.When(operation.Action == api.BatchTransactionActionCreate, validation.NotNil.Error("createの場合は作成内容を指定してください")),
+		),
+		validation.Field(&operation.Update,
+			validation.When(operation.Action == api.BatchTransactionActionUpdate, validation.NotNil.Error("updateの場合は更新内容を指定してください")),
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	switch operation.Action {
+	case api.BatchTransactionActionCreate:
+		return ValidateCreateTransaction(operation.Create)
+	case api.BatchTransactionActionUpdate:
+		return ValidateUpdateTransaction(operation.Update)
+	}
+	return nil
+}
+
+func ValidateRecategorizeTransactions(input *api.RecategorizeTransactionsInput) error {
+	filter := &input.Filter
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Filter, validation.By(func(value interface{}) error {
+			return validation.ValidateStruct(filter,
+				validation.Field(&filter.StartDate,
+					validation.By(func(value interface{}) error {
+						if filter.StartDate == nil && filter.EndDate == nil && filter.Type == nil && filter.CategoryId == nil &&
+							filter.Q == nil && filter.MinAmount == nil && filter.MaxAmount == nil &&
+							filter.CategoryIds == nil && filter.ExcludeCategoryIds == nil {
+							return validation.NewError("no_filter", "対象の取引の条件を1つ以上指定してください")
+						}
+						return nil
+					}),
+					validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください"),
+				),
+				validation.Field(&filter.EndDate, validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください")),
+				validation.Field(&filter.Type,
+					validation.In(api.Income, api.Expense).Error("カテゴリタイプはincome、expenseのいずれかを指定してください"),
+				),
+				validation.Field(&filter.CategoryId, OptionalCategoryID),
+				validation.Field(&filter.Q, keywordRules...),
+				validation.Field(&filter.MinAmount, amountFilterRule),
+				validation.Field(&filter.MaxAmount, amountFilterRule, validation.By(maxAmountNotBelow(filter.MinAmount))),
+				validation.Field(&filter.CategoryIds, categoryIDListRule),
+				validation.Field(&filter.ExcludeCategoryIds, categoryIDListRule),
+			)
+		})),
+		validation.Field(&input.CategoryId, RequiredCategoryID...),
+	)
+}
+
+// 重複の疑いがある取引の取得期間の上限（日数）
+const maxDuplicateSearchDays = 366
+
+func ValidateGetTransactionsDuplicates(params *api.GetTransactionsDuplicatesParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.StartDate,
+			validation.Required.Error("開始日は必須です"),
+			validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください"),
+		),
+		validation.Field(&params.EndDate,
+			validation.Required.Error("終了日は必須です"),
+			validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください"),
+			validation.By(dateRange(params.StartDate, maxDuplicateSearchDays)),
+		),
+		validation.Field(&params.WindowDays, validation.By(intRange(0, 31, "日付の差は0〜31日で入力してください"))),
 	)
 }
+
+func ValidateMergeDuplicateTransactions(input *api.MergeDuplicateTransactionsInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.KeepId,
+			validation.Required.Error("残す取引のIDは必須です"),
+			validation.Min(1).Error("取引IDは1以上で入力してください"),
+		),
+		validation.Field(&input.DuplicateIds,
+			validation.Required.Error("ゴミ箱に移動する取引のIDを1件以上指定してください"),
+			validation.By(idList(20, "取引ID")),
+			validation.By(func(value interface{}) error {
+				for _, id := range input.DuplicateIds {
+					if id == input.KeepId {
+						return validation.NewError("keep_id_included", "残す取引のIDは含めないでください")
+					}
+				}
+				return nil
+			}),
+		),
+	)
+}
+
+var (
+	keywordRules = []validation.Rule{
+		validation.NilOrNotEmpty.Error("キーワードを指定する場合は空にしないでください"),
+		validation.RuneLength(1, 100).Error("キーワードは100文字以内で入力してください"),
+	}
+	amountFilterRule   = validation.By(intRange(0, math.MaxInt32, "金額は0以上で入力してください"))
+	categoryIDListRule = validation.By(idList(50, "カテゴリID"))
+	tagIDListRule      = validation.By(idList(50, "タグID"))
+)
+
+// maxAmountNotBelow は金額の上限が下限以上かチェックするルールを生成する
+func maxAmountNotBelow(minAmount *int32) validation.RuleFunc {
+	return func(value interface{}) error {
+		maxAmount, ok := value.(*int32)
+		if !ok || maxAmount == nil || minAmount == nil {
+			return nil
+		}
+		if *maxAmount < *minAmount {
+			return validation.NewError("invalid_amount_range", "金額の上限は下限以上の値を指定してください")
+		}
+		return nil
+	}
+}
+
+// transactionSplits は分割明細が2〜20件で、各明細が正しく、金額の合計が amount と一致するかチェックするルールを生成する
+// 空配列と amount が nil の場合の合計はチェックしない
+func transactionSplits(amount *int32) validation.RuleFunc {
+	return func(value interface{}) error {
+		splits, ok := value.(*[]api.TransactionSplitInput)
+		if !ok || splits == nil || len(*splits) == 0 {
+			return nil
+		}
+		if len(*splits) < 2 || len(*splits) > 20 {
+			return validation.NewError("invalid_split_count", "分割明細は2〜20件で指定してください")
+		}
+
+		errs := validation.Errors{}
+		var total int64
+		for i := range *splits {
+			split := &(*splits)[i]
+			err := validation.ValidateStruct(split,
+				validation.Field(&split.CategoryId, RequiredCategoryID...),
+				validation.Field(&split.Amount,
+					validation.Required.Error("金額は必須です"),
+					validation.Min(1).Error("金額は1以上で入力してください"),
+				),
+				validation.Field(&split.Memo, validation.Length(0, 255).Error("メモは255文字以内で入力してください")),
+			)
+			if err != nil {
+				errs[strconv.Itoa(i)] = err
+			}
+			total += int64(split.Amount)
+		}
+		if len(errs) > 0 {
+			return errs
+		}
+
+		if amount != nil && total != int64(*amount) {
+			return validation.NewError("split_amount_mismatch", "分割明細の金額の合計は取引の金額と一致させてください")
+		}
+		return nil
+	}
+}
+
+// idList は []int32 / *[]int32 の name（カテゴリIDなど）が max 件以内で、すべて1以上かチェックするルールを生成する
+func idList(max int, name string) validation.RuleFunc {
+	return func(value interface{}) error {
+		var ids []int32
+		switch value := value.(type) {
+		case []int32:
+			ids = value
+		case *[]int32:
+			if value == nil {
+				return nil
+			}
+			ids = *value
+		default:
+			return nil
+		}
+		if len(ids) > max {
+			return validation.NewError("too_many_ids", fmt.Sprintf("%sは%d件以内で指定してください", name, max))
+		}
+		for _, id := range ids {
+			if id < 1 {
+				return validation.NewError("invalid_id", name+"は1以上で入力してください")
+			}
+		}
+		return nil
+	}
+}
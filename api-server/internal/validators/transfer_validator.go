@@ -0,0 +1,35 @@
+package validators
+
+import (
+	api "apps/apis"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func ValidateGetTransfers(params *api.GetTransfersParams) error {
+	return validation.ValidateStruct(params,
+		validation.Field(&params.StartDate, validation.Date("2006-01-02").Error("開始日はYYYY-MM-DD形式で入力してください")),
+		validation.Field(&params.EndDate, validation.Date("2006-01-02").Error("終了日はYYYY-MM-DD形式で入力してください")),
+		validation.Field(&params.AccountId, OptionalAccountID),
+	)
+}
+
+func ValidateCreateTransfer(input *api.CreateTransferInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.FromAccountId, RequiredAccountID...),
+		validation.Field(&input.ToAccountId,
+			validation.Required.Error("口座IDは必須です"),
+			validation.Min(1).Error("口座IDは1以上で入力してください"),
+			validation.NotIn(input.FromAccountId).Error("振替先には振替元と異なる口座を指定してください"),
+		),
+		validation.Field(&input.Amount,
+			validation.Required.Error("金額は必須です"),
+			validation.Min(1).Error("金額は1以上で入力してください"),
+		),
+		validation.Field(&input.Date, validation.Required.Error("日付は必須です")),
+		validation.Field(&input.Description,
+			validation.NilOrNotEmpty.Error("説明を入力する場合は空にしないでください"),
+			validation.Length(0, 255).Error("説明は255文字以内で入力してください"),
+		),
+	)
+}
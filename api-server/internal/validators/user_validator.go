@@ -32,6 +32,14 @@ func ValidateSignUp(input *api.UserSignUpInput) error {
 			validation.Match(lowercaseRule).Error("パスワードには小文字を含めてください。"),
 			validation.Match(digitRule).Error("パスワードには数字を含めてください。"),
 		),
+		validation.Field(&input.BaseCurrency,
+			validation.NilOrNotEmpty.Error("基準通貨を指定する場合は空にしないでください。"),
+			currencyRule,
+		),
+		validation.Field(&input.TimeZone,
+			validation.NilOrNotEmpty.Error("タイムゾーンを指定する場合は空にしないでください。"),
+			timeZoneRule,
+		),
 	)
 }
 
@@ -41,3 +49,28 @@ func ValidateSignIn(input *api.UserSignInInput) error {
 		validation.Field(&input.Password, validation.Required.Error("パスワードは必須入力です。")),
 	)
 }
+
+func ValidateUpdateCurrentUser(input *api.UserUpdateCurrentUserInput) error {
+	return validation.ValidateStruct(input,
+		validation.Field(&input.Name,
+			validation.By(atLeastOneField(func() bool {
+				return input.Name != nil || input.BaseCurrency != nil || input.TimeZone != nil ||
+					input.MonthStartDay != nil || input.MonthStartAdjustment != nil
+			})),
+			validation.NilOrNotEmpty.Error("ユーザー名は1 ~ 20文字での入力をお願いします。"),
+			validation.RuneLength(1, 20).Error("ユーザー名は1 ~ 20文字での入力をお願いします。"),
+		),
+		validation.Field(&input.BaseCurrency,
+			validation.NilOrNotEmpty.Error("基準通貨を指定する場合は空にしないでください。"),
+			currencyRule,
+		),
+		validation.Field(&input.TimeZone,
+			validation.NilOrNotEmpty.Error("タイムゾーンを指定する場合は空にしないでください。"),
+			timeZoneRule,
+		),
+		validation.Field(&input.MonthStartDay, validation.By(intRange(1, 28, "月の開始日は1〜28で入力してください。"))),
+		validation.Field(&input.MonthStartAdjustment,
+			validation.In(api.ModelsMonthStartAdjustmentNone, api.ModelsMonthStartAdjustmentPreviousWeekday, api.ModelsMonthStartAdjustmentNextWeekday).Error("月の開始日の調整方法はnone、previous_weekday、next_weekdayのいずれかを指定してください。"),
+		),
+	)
+}
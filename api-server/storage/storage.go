@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"log"
+	"os"
+)
+
+// ErrNotFound は指定したキーのファイルが存在しない場合のエラー
+var ErrNotFound = errors.New("object not found")
+
+// Storage は添付ファイルなどのファイルを保存するストレージ
+// キーは "/" 区切りのパスとし、DeletePrefix でキーの前方一致でまとめて削除できる
+type Storage interface {
+	Put(key string, body io.Reader, size int64, contentType string) error
+	Get(key string) (io.ReadCloser, error)
+	Delete(key string) error
+	DeletePrefix(prefix string) error
+}
+
+// Init は環境変数 STORAGE_DRIVER に応じたストレージを生成する
+// NOTE: "s3" の場合はS3互換ストレージ（ローカルではMinIO）、それ以外の場合はローカルディスクに保存する
+func Init() Storage {
+	if os.Getenv("STORAGE_DRIVER") == "s3" {
+		s, err := NewS3Storage(S3Config{
+			Endpoint:        os.Getenv("S3_ENDPOINT"),
+			Region:          os.Getenv("S3_REGION"),
+			Bucket:          os.Getenv("S3_BUCKET"),
+			AccessKeyID:     os.Getenv("S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("S3_SECRET_ACCESS_KEY"),
+			UsePathStyle:    os.Getenv("S3_USE_PATH_STYLE") == "true",
+		})
+		if err != nil {
+			log.Fatal("Failed to initialize S3 storage:", err)
+		}
+		log.Println("Using S3 storage")
+		return s
+	}
+
+	dir := os.Getenv("STORAGE_LOCAL_DIR")
+	if dir == "" {
+		dir = "storage-data"
+	}
+	log.Println("Using local storage:", dir)
+	return NewLocalStorage(dir)
+}
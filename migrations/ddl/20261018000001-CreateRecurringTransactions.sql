@@ -0,0 +1,28 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS recurring_transactions(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	category_id BIGINT NOT NULL,
+	amount INT NOT NULL,
+	description VARCHAR(255),
+	frequency ENUM('weekly', 'monthly', 'yearly') NOT NULL,
+	`interval` INT NOT NULL DEFAULT 1,
+	day_of_month TINYINT,
+	day_of_week TINYINT,
+	month_of_year TINYINT,
+	start_date DATE NOT NULL,
+	end_date DATE,
+	generated_until DATE,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_user_id (user_id),
+	INDEX idx_category_id (category_id),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE RESTRICT,
+	CHECK (amount >= 0),
+	CHECK (`interval` >= 1)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS recurring_transactions;
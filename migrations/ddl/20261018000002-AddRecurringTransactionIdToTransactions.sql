@@ -0,0 +1,12 @@
+
+-- +migrate Up
+ALTER TABLE transactions
+	ADD COLUMN recurring_transaction_id BIGINT AFTER category_id,
+	ADD UNIQUE KEY uk_recurring_transaction_date (recurring_transaction_id, date),
+	ADD CONSTRAINT fk_transactions_recurring_transaction_id FOREIGN KEY (recurring_transaction_id) REFERENCES recurring_transactions(id) ON DELETE SET NULL;
+
+-- +migrate Down
+ALTER TABLE transactions
+	DROP FOREIGN KEY fk_transactions_recurring_transaction_id,
+	DROP INDEX uk_recurring_transaction_date,
+	DROP COLUMN recurring_transaction_id;
@@ -0,0 +1,26 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS import_profiles(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	name VARCHAR(100) NOT NULL,
+	encoding ENUM('utf-8', 'shift_jis') NOT NULL,
+	has_header BOOLEAN NOT NULL DEFAULT FALSE,
+	skip_rows INT NOT NULL DEFAULT 0,
+	date_column INT NOT NULL,
+	date_format VARCHAR(20) NOT NULL,
+	amount_column INT NOT NULL,
+	amount_sign ENUM('negative_is_expense', 'positive_is_expense') NOT NULL,
+	description_column INT,
+	default_category_id BIGINT NOT NULL,
+	income_category_id BIGINT,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_user_id (user_id),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	FOREIGN KEY (default_category_id) REFERENCES categories(id) ON DELETE RESTRICT,
+	FOREIGN KEY (income_category_id) REFERENCES categories(id) ON DELETE RESTRICT
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS import_profiles;
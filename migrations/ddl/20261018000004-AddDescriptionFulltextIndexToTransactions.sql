@@ -0,0 +1,8 @@
+
+-- +migrate Up
+ALTER TABLE transactions
+	ADD FULLTEXT INDEX ft_description (description) WITH PARSER ngram;
+
+-- +migrate Down
+ALTER TABLE transactions
+	DROP INDEX ft_description;
@@ -0,0 +1,19 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS transaction_splits(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	transaction_id BIGINT NOT NULL,
+	category_id BIGINT NOT NULL,
+	amount INT NOT NULL,
+	memo VARCHAR(255),
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_transaction_id (transaction_id),
+	INDEX idx_category_id (category_id),
+	FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+	FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE RESTRICT,
+	CHECK (amount >= 0)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS transaction_splits;
@@ -0,0 +1,16 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS accounts(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	name VARCHAR(100) NOT NULL,
+	type ENUM('cash', 'bank', 'credit_card', 'e_money') NOT NULL,
+	opening_balance INT NOT NULL DEFAULT 0,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_user_id (user_id),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS accounts;
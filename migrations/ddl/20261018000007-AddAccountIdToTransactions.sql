@@ -0,0 +1,12 @@
+
+-- +migrate Up
+ALTER TABLE transactions
+	ADD COLUMN account_id BIGINT AFTER category_id,
+	ADD INDEX idx_account_id (account_id),
+	ADD CONSTRAINT fk_transactions_account_id FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE RESTRICT;
+
+-- +migrate Down
+ALTER TABLE transactions
+	DROP FOREIGN KEY fk_transactions_account_id,
+	DROP INDEX idx_account_id,
+	DROP COLUMN account_id;
@@ -0,0 +1,24 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS transfers(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	from_account_id BIGINT NOT NULL,
+	to_account_id BIGINT NOT NULL,
+	amount INT NOT NULL,
+	date DATETIME NOT NULL,
+	description VARCHAR(255),
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_user_id (user_id),
+	INDEX idx_from_account_id (from_account_id),
+	INDEX idx_to_account_id (to_account_id),
+	INDEX idx_date (date),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	FOREIGN KEY (from_account_id) REFERENCES accounts(id) ON DELETE RESTRICT,
+	FOREIGN KEY (to_account_id) REFERENCES accounts(id) ON DELETE RESTRICT,
+	CHECK (amount >= 0)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS transfers;
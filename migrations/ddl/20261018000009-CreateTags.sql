@@ -0,0 +1,24 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS tags(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	name VARCHAR(50) NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE KEY uk_user_name (user_id, name),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS transaction_tags(
+	transaction_id BIGINT NOT NULL,
+	tag_id BIGINT NOT NULL,
+	PRIMARY KEY (transaction_id, tag_id),
+	INDEX idx_tag_id (tag_id),
+	FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+	FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS transaction_tags;
+DROP TABLE IF EXISTS tags;
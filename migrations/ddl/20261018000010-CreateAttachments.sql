@@ -0,0 +1,19 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS attachments(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	transaction_id BIGINT NOT NULL,
+	file_name VARCHAR(255) NOT NULL,
+	content_type VARCHAR(100) NOT NULL,
+	size INT NOT NULL,
+	storage_key VARCHAR(255) NOT NULL,
+	created_at DATETIME NOT NULL,
+	UNIQUE KEY uk_storage_key (storage_key),
+	INDEX idx_transaction_id (transaction_id),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS attachments;
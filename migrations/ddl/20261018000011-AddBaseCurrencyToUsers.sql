@@ -0,0 +1,8 @@
+
+-- +migrate Up
+ALTER TABLE users
+	ADD COLUMN base_currency CHAR(3) NOT NULL DEFAULT 'JPY' AFTER name;
+
+-- +migrate Down
+ALTER TABLE users
+	DROP COLUMN base_currency;
@@ -0,0 +1,29 @@
+
+-- +migrate Up
+-- NOTE: 既存の取引はすべて基準通貨（JPY）で登録されたものとして、取引・分割明細の元の金額に amount をコピーする
+ALTER TABLE transactions
+	ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'JPY' AFTER amount,
+	ADD COLUMN original_amount INT NOT NULL DEFAULT 0 AFTER currency,
+	ADD COLUMN exchange_rate DECIMAL(20,10) AFTER original_amount;
+
+ALTER TABLE transaction_splits
+	ADD COLUMN original_amount INT NOT NULL DEFAULT 0 AFTER amount;
+
+UPDATE transactions SET original_amount = amount;
+UPDATE transaction_splits SET original_amount = amount;
+
+ALTER TABLE transactions
+	ALTER COLUMN currency DROP DEFAULT,
+	ALTER COLUMN original_amount DROP DEFAULT;
+
+ALTER TABLE transaction_splits
+	ALTER COLUMN original_amount DROP DEFAULT;
+
+-- +migrate Down
+ALTER TABLE transaction_splits
+	DROP COLUMN original_amount;
+
+ALTER TABLE transactions
+	DROP COLUMN exchange_rate,
+	DROP COLUMN original_amount,
+	DROP COLUMN currency;
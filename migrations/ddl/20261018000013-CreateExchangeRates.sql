@@ -0,0 +1,18 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS exchange_rates(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	base_currency CHAR(3) NOT NULL,
+	currency CHAR(3) NOT NULL,
+	date DATE NOT NULL,
+	rate DECIMAL(20,10) NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE KEY uk_user_currency_date (user_id, base_currency, currency, date),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	CHECK (rate > 0)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS exchange_rates;
@@ -0,0 +1,40 @@
+
+-- +migrate Up
+ALTER TABLE transactions
+	ADD COLUMN deleted_at DATETIME NULL,
+	ADD INDEX idx_deleted_at (deleted_at);
+
+ALTER TABLE categories
+	ADD COLUMN deleted_at DATETIME NULL,
+	ADD INDEX idx_deleted_at (deleted_at);
+
+-- NOTE: ゴミ箱にある予算が同じカテゴリ・月の予算の登録を妨げないよう、削除されていない予算のみを一意にする
+-- alive は削除されていない場合は1、削除済みの場合は NULL となり、NULL 同士は一意制約で重複とみなされない
+ALTER TABLE budgets
+	ADD COLUMN deleted_at DATETIME NULL,
+	ADD COLUMN alive TINYINT AS (IF(deleted_at IS NULL, 1, NULL)) STORED,
+	ADD INDEX idx_deleted_at (deleted_at),
+	DROP INDEX uk_user_category_month,
+	ADD UNIQUE KEY uk_user_category_month (user_id, category_id, month, alive);
+
+-- +migrate Down
+DELETE FROM budgets WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE budgets
+	DROP INDEX uk_user_category_month,
+	ADD UNIQUE KEY uk_user_category_month (user_id, category_id, month),
+	DROP INDEX idx_deleted_at,
+	DROP COLUMN alive,
+	DROP COLUMN deleted_at;
+
+DELETE FROM transactions WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE transactions
+	DROP INDEX idx_deleted_at,
+	DROP COLUMN deleted_at;
+
+DELETE FROM categories WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE categories
+	DROP INDEX idx_deleted_at,
+	DROP COLUMN deleted_at;
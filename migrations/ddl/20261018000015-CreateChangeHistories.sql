@@ -0,0 +1,17 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS change_histories(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	record_type VARCHAR(20) NOT NULL,
+	record_id BIGINT NOT NULL,
+	action VARCHAR(10) NOT NULL,
+	`before` JSON NULL,
+	`after` JSON NULL,
+	created_at DATETIME NOT NULL,
+	INDEX idx_record (record_type, record_id, id),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS change_histories;
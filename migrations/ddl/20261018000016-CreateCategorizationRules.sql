@@ -0,0 +1,23 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS categorization_rules(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	category_id BIGINT NOT NULL,
+	match_type ENUM('contains', 'prefix', 'regex') NOT NULL,
+	pattern VARCHAR(255) NOT NULL,
+	min_amount INT,
+	max_amount INT,
+	priority INT NOT NULL DEFAULT 0,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_user_priority (user_id, priority, id),
+	INDEX idx_category_id (category_id),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE RESTRICT,
+	CHECK (min_amount IS NULL OR min_amount >= 0),
+	CHECK (min_amount IS NULL OR max_amount IS NULL OR min_amount <= max_amount)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS categorization_rules;
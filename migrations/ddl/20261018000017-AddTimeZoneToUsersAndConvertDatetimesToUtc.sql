@@ -0,0 +1,119 @@
+
+-- +migrate Up
+ALTER TABLE users
+	ADD COLUMN time_zone VARCHAR(64) NOT NULL DEFAULT 'Asia/Tokyo' AFTER base_currency;
+
+-- NOTE: これまでの日時はアプリケーションサーバーのタイムゾーン（loc=Local、docker-compose の TZ=Asia/Tokyo）で保存していたため、UTCに変換する
+-- 時差は夏時間などで日時ごとに異なりうるため、固定の時差ではなくタイムゾーン名で行ごとに変換する
+-- 取引日・振替日はUTCの0時を変換して保存していたため、変換後はその日のUTCの0時になる
+SET @app_time_zone = 'Asia/Tokyo';
+
+-- NOTE: 名前付きのタイムゾーンはDBサーバーにタイムゾーン情報（mysql_tzinfo_to_sql）が読み込まれていない場合に
+-- CONVERT_TZ が NULL を返すため、NOT NULL の列への挿入でエラーにして変換を中止する
+CREATE TEMPORARY TABLE time_zone_check (converted DATETIME NOT NULL);
+INSERT INTO time_zone_check VALUES (CONVERT_TZ('2000-01-01 00:00:00', @app_time_zone, '+00:00'));
+DROP TEMPORARY TABLE time_zone_check;
+
+UPDATE users SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00');
+UPDATE categories SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00'),
+	deleted_at = CONVERT_TZ(deleted_at, @app_time_zone, '+00:00');
+UPDATE budgets SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00'),
+	deleted_at = CONVERT_TZ(deleted_at, @app_time_zone, '+00:00');
+UPDATE transactions SET
+	date = CONVERT_TZ(date, @app_time_zone, '+00:00'),
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00'),
+	deleted_at = CONVERT_TZ(deleted_at, @app_time_zone, '+00:00');
+UPDATE recurring_transactions SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00');
+UPDATE import_profiles SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00');
+UPDATE transaction_splits SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00');
+UPDATE accounts SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00');
+UPDATE transfers SET
+	date = CONVERT_TZ(date, @app_time_zone, '+00:00'),
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00');
+UPDATE tags SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00');
+UPDATE attachments SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00');
+UPDATE exchange_rates SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00');
+UPDATE change_histories SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00');
+UPDATE categorization_rules SET
+	created_at = CONVERT_TZ(created_at, @app_time_zone, '+00:00'),
+	updated_at = CONVERT_TZ(updated_at, @app_time_zone, '+00:00');
+
+-- +migrate Down
+SET @app_time_zone = 'Asia/Tokyo';
+
+-- NOTE: 名前付きのタイムゾーンはDBサーバーにタイムゾーン情報（mysql_tzinfo_to_sql）が読み込まれていない場合に
+-- CONVERT_TZ が NULL を返すため、NOT NULL の列への挿入でエラーにして変換を中止する
+CREATE TEMPORARY TABLE time_zone_check (converted DATETIME NOT NULL);
+INSERT INTO time_zone_check VALUES (CONVERT_TZ('2000-01-01 00:00:00', @app_time_zone, '+00:00'));
+DROP TEMPORARY TABLE time_zone_check;
+
+UPDATE users SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone);
+UPDATE categories SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone),
+	deleted_at = CONVERT_TZ(deleted_at, '+00:00', @app_time_zone);
+UPDATE budgets SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone),
+	deleted_at = CONVERT_TZ(deleted_at, '+00:00', @app_time_zone);
+UPDATE transactions SET
+	date = CONVERT_TZ(date, '+00:00', @app_time_zone),
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone),
+	deleted_at = CONVERT_TZ(deleted_at, '+00:00', @app_time_zone);
+UPDATE recurring_transactions SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone);
+UPDATE import_profiles SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone);
+UPDATE transaction_splits SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone);
+UPDATE accounts SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone);
+UPDATE transfers SET
+	date = CONVERT_TZ(date, '+00:00', @app_time_zone),
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone);
+UPDATE tags SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone);
+UPDATE attachments SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone);
+UPDATE exchange_rates SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone);
+UPDATE change_histories SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone);
+UPDATE categorization_rules SET
+	created_at = CONVERT_TZ(created_at, '+00:00', @app_time_zone),
+	updated_at = CONVERT_TZ(updated_at, '+00:00', @app_time_zone);
+
+ALTER TABLE users
+	DROP COLUMN time_zone;
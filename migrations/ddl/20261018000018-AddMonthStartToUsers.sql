@@ -0,0 +1,12 @@
+
+-- +migrate Up
+ALTER TABLE users
+	ADD COLUMN month_start_day TINYINT NOT NULL DEFAULT 1 AFTER time_zone,
+	ADD COLUMN month_start_adjustment ENUM('none', 'previous_weekday', 'next_weekday') NOT NULL DEFAULT 'none' AFTER month_start_day,
+	ADD CONSTRAINT chk_users_month_start_day CHECK (month_start_day BETWEEN 1 AND 28);
+
+-- +migrate Down
+ALTER TABLE users
+	DROP CHECK chk_users_month_start_day,
+	DROP COLUMN month_start_adjustment,
+	DROP COLUMN month_start_day;
@@ -0,0 +1,34 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS installment_plans(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	purchase_date DATE NOT NULL,
+	principal INT NOT NULL,
+	payments INT NOT NULL,
+	first_billing_month CHAR(7) NOT NULL,
+	annual_interest_rate DECIMAL(5, 2),
+	total_interest INT NOT NULL DEFAULT 0,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_user_id (user_id),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	CHECK (principal >= 0),
+	CHECK (payments >= 2),
+	CHECK (total_interest >= 0)
+);
+
+ALTER TABLE transactions
+	ADD COLUMN installment_plan_id BIGINT AFTER recurring_transaction_id,
+	ADD COLUMN installment_number INT AFTER installment_plan_id,
+	ADD INDEX idx_installment_plan_id (installment_plan_id),
+	ADD CONSTRAINT fk_transactions_installment_plan_id FOREIGN KEY (installment_plan_id) REFERENCES installment_plans(id) ON DELETE SET NULL;
+
+-- +migrate Down
+ALTER TABLE transactions
+	DROP FOREIGN KEY fk_transactions_installment_plan_id,
+	DROP INDEX idx_installment_plan_id,
+	DROP COLUMN installment_number,
+	DROP COLUMN installment_plan_id;
+
+DROP TABLE IF EXISTS installment_plans;
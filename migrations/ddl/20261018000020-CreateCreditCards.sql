@@ -0,0 +1,30 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS credit_cards(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	name VARCHAR(100) NOT NULL,
+	closing_day TINYINT NOT NULL,
+	payment_day TINYINT NOT NULL,
+	payment_month_offset TINYINT NOT NULL DEFAULT 1,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_user_id (user_id),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	CHECK (closing_day BETWEEN 1 AND 31),
+	CHECK (payment_day BETWEEN 1 AND 31),
+	CHECK (payment_month_offset BETWEEN 1 AND 2)
+);
+
+ALTER TABLE transactions
+	ADD COLUMN credit_card_id BIGINT AFTER account_id,
+	ADD INDEX idx_credit_card_id (credit_card_id),
+	ADD CONSTRAINT fk_transactions_credit_card_id FOREIGN KEY (credit_card_id) REFERENCES credit_cards(id) ON DELETE RESTRICT;
+
+-- +migrate Down
+ALTER TABLE transactions
+	DROP FOREIGN KEY fk_transactions_credit_card_id,
+	DROP INDEX idx_credit_card_id,
+	DROP COLUMN credit_card_id;
+
+DROP TABLE IF EXISTS credit_cards;
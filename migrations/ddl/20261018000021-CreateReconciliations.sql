@@ -0,0 +1,30 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS reconciliations(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	account_id BIGINT NOT NULL,
+	statement_date DATE NOT NULL,
+	balance INT NOT NULL,
+	transaction_count INT NOT NULL,
+	created_at DATETIME NOT NULL,
+	INDEX idx_user_id (user_id),
+	INDEX idx_account_id_statement_date (account_id, statement_date),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+	FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+ALTER TABLE transactions
+	ADD COLUMN cleared BOOLEAN NOT NULL DEFAULT FALSE AFTER credit_card_id,
+	ADD COLUMN reconciliation_id BIGINT AFTER cleared,
+	ADD INDEX idx_reconciliation_id (reconciliation_id),
+	ADD CONSTRAINT fk_transactions_reconciliation_id FOREIGN KEY (reconciliation_id) REFERENCES reconciliations(id) ON DELETE SET NULL;
+
+-- +migrate Down
+ALTER TABLE transactions
+	DROP FOREIGN KEY fk_transactions_reconciliation_id,
+	DROP INDEX idx_reconciliation_id,
+	DROP COLUMN reconciliation_id,
+	DROP COLUMN cleared;
+
+DROP TABLE IF EXISTS reconciliations;
@@ -0,0 +1,8 @@
+
+-- +migrate Up
+ALTER TABLE categories
+	ADD COLUMN budget_rollover ENUM('none', 'surplus', 'surplus_and_deficit') NOT NULL DEFAULT 'none' AFTER color;
+
+-- +migrate Down
+ALTER TABLE categories
+	DROP COLUMN budget_rollover;
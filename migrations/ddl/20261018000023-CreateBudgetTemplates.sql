@@ -0,0 +1,29 @@
+
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS budget_templates(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	user_id BIGINT NOT NULL,
+	name VARCHAR(100) NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	UNIQUE KEY uk_user_name (user_id, name),
+	FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- NOTE: カテゴリを完全に削除した場合は、テンプレートからそのカテゴリの予算額のみを削除する
+CREATE TABLE IF NOT EXISTS budget_template_items(
+	id BIGINT NOT NULL PRIMARY KEY AUTO_INCREMENT,
+	budget_template_id BIGINT NOT NULL,
+	category_id BIGINT NOT NULL,
+	amount INT NOT NULL,
+	created_at DATETIME NOT NULL,
+	updated_at DATETIME NOT NULL,
+	INDEX idx_category_id (category_id),
+	UNIQUE KEY uk_budget_template_category (budget_template_id, category_id),
+	FOREIGN KEY (budget_template_id) REFERENCES budget_templates(id) ON DELETE CASCADE,
+	FOREIGN KEY (category_id) REFERENCES categories(id) ON DELETE CASCADE
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS budget_template_items;
+DROP TABLE IF EXISTS budget_templates;
@@ -0,0 +1,22 @@
+
+-- +migrate Up
+-- NOTE: 既存の分割払いは購入した取引の通貨が残っていないため、基準通貨の取引を分割払いにしたものとして購入金額をコピーする
+ALTER TABLE installment_plans
+	ADD COLUMN purchase_currency CHAR(3) NOT NULL DEFAULT 'JPY' AFTER principal,
+	ADD COLUMN purchase_original_amount INT NOT NULL DEFAULT 0 AFTER purchase_currency,
+	ADD COLUMN purchase_exchange_rate DECIMAL(20,10) AFTER purchase_original_amount;
+
+UPDATE installment_plans
+	INNER JOIN users ON users.id = installment_plans.user_id
+	SET installment_plans.purchase_currency = users.base_currency,
+		installment_plans.purchase_original_amount = installment_plans.principal;
+
+ALTER TABLE installment_plans
+	ALTER COLUMN purchase_currency DROP DEFAULT,
+	ALTER COLUMN purchase_original_amount DROP DEFAULT;
+
+-- +migrate Down
+ALTER TABLE installment_plans
+	DROP COLUMN purchase_exchange_rate,
+	DROP COLUMN purchase_original_amount,
+	DROP COLUMN purchase_currency;